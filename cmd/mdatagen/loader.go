@@ -49,8 +49,9 @@ func (mn attributeName) RenderUnexported() (string, error) {
 }
 
 type metric struct {
-	// Enabled defines whether the metric is enabled by default.
-	Enabled bool `yaml:"enabled" validate:"required"`
+	// Enabled defines whether the metric is enabled by default. It is a pointer
+	// so that the validation can tell a disabled metric from a missing key.
+	Enabled *bool `yaml:"enabled" validate:"required"`
 
 	// Description of the metric.
 	Description string `validate:"required,notblank"`
@@ -73,6 +74,11 @@ type metric struct {
 	Attributes []attributeName
 }
 
+// IsEnabled returns whether the metric is enabled by default.
+func (m metric) IsEnabled() bool {
+	return m.Enabled != nil && *m.Enabled
+}
+
 func (m metric) Data() MetricData {
 	if m.Sum != nil {
 		return m.Sum
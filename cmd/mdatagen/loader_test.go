@@ -22,6 +22,11 @@ import (
 	"go.opentelemetry.io/collector/model/pdata"
 )
 
+var (
+	trueValue  = true
+	falseValue = false
+)
+
 func Test_loadMetadata(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -47,7 +52,7 @@ func Test_loadMetadata(t *testing.T) {
 						Value:       "state"}},
 				Metrics: map[metricName]metric{
 					"system.cpu.time": {
-						Enabled:               true,
+						Enabled:               &trueValue,
 						Description:           "Total CPU seconds broken down by different states.",
 						ExtendedDocumentation: "Additional information on CPU Time can be found [here](https://en.wikipedia.org/wiki/CPU_time).",
 						Unit:                  "s",
@@ -75,6 +80,24 @@ func Test_loadMetadata(t *testing.T) {
 			wantErr: "metric system.cpu.time doesn't have a metric type key, " +
 				"one of the following has to be specified: sum, gauge, histogram",
 		},
+		{
+			name: "disabled metric",
+			yml:  "disabled_metric.yaml",
+			want: metadata{
+				Name: "metricreceiver",
+				Metrics: map[metricName]metric{
+					"system.cpu.time": {
+						Enabled:     &falseValue,
+						Description: "Total CPU seconds broken down by different states.",
+						Unit:        "s",
+						Sum: &sum{
+							MetricValueType: MetricValueType{pdata.MetricValueTypeDouble},
+							Aggregated:      Aggregated{Aggregation: "cumulative"},
+							Mono:            Mono{Monotonic: true},
+						},
+					}},
+			},
+		},
 		{
 			name:    "no enabled",
 			yml:     "no_enabled.yaml",
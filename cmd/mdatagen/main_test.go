@@ -18,6 +18,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -97,6 +98,40 @@ func Test_runContents(t *testing.T) {
 	}
 }
 
+func Test_runContentsEnabled(t *testing.T) {
+	tests := []struct {
+		name    string
+		enabled string
+		want    string
+	}{
+		{
+			name:    "enabled metric",
+			enabled: "true",
+			want:    "SystemCPUTime: MetricSettings{\n\t\t\tEnabled: true,",
+		},
+		{
+			name:    "disabled metric",
+			enabled: "false",
+			want:    "SystemCPUTime: MetricSettings{\n\t\t\tEnabled: false,",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpdir := t.TempDir()
+			metadataFile := path.Join(tmpdir, "metadata.yaml")
+			yml := strings.Replace(validMetadata, "enabled: true", "enabled: "+tt.enabled, 1)
+			require.NoError(t, ioutil.WriteFile(metadataFile, []byte(yml), 0600))
+
+			require.NoError(t, run(metadataFile, true))
+
+			// The default settings follow the enabled key of each metric.
+			contents, err := ioutil.ReadFile(path.Join(tmpdir, "internal/metadata/generated_metrics_v2.go"))
+			require.NoError(t, err)
+			require.Contains(t, string(contents), tt.want)
+		})
+	}
+}
+
 func Test_run(t *testing.T) {
 	type args struct {
 		ymlPath string
@@ -44,7 +44,10 @@ type Config struct {
 	// NumWorkers configures the number of workers publishing bulk requests.
 	NumWorkers int `mapstructure:"num_workers"`
 
-	// Index configures the index, index alias, or data stream name events should be indexed in.
+	// Index configures the index, index alias, or data stream name log records should be indexed in.
+	// The name can contain placeholders replaced by the value of an attribute
+	// of the record or resource, e.g. `{service.name}`, or by the date of the
+	// record, e.g. `{yyyy.MM.dd}`.
 	//
 	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices.html
 	// https://www.elastic.co/guide/en/elasticsearch/reference/current/data-streams.html
@@ -52,6 +55,12 @@ type Config struct {
 	// This setting is required.
 	Index string `mapstructure:"index"`
 
+	// TracesIndex configures the index, index alias, or data stream name spans and span
+	// events should be indexed in. It supports the same placeholders as Index.
+	//
+	// This setting is required.
+	TracesIndex string `mapstructure:"traces_index"`
+
 	// Pipeline configures the ingest node pipeline name that should be used to process the
 	// events.
 	//
@@ -59,10 +68,11 @@ type Config struct {
 	Pipeline string `mapstructure:"pipeline"`
 
 	HTTPClientSettings `mapstructure:",squash"`
-	Discovery          DiscoverySettings `mapstructure:"discover"`
-	Retry              RetrySettings     `mapstructure:"retry"`
-	Flush              FlushSettings     `mapstructure:"flush"`
-	Mapping            MappingsSettings  `mapstructure:"mapping"`
+	Discovery          DiscoverySettings  `mapstructure:"discover"`
+	Retry              RetrySettings      `mapstructure:"retry"`
+	Flush              FlushSettings      `mapstructure:"flush"`
+	Mapping            MappingsSettings   `mapstructure:"mapping"`
+	DataStream         DataStreamSettings `mapstructure:"data_stream"`
 }
 
 type HTTPClientSettings struct {
@@ -160,6 +170,24 @@ type MappingsSettings struct {
 	Dedot bool `mapstructure:"dedot"`
 }
 
+// DataStreamSettings configures the routing of documents to data streams,
+// following the `<type>-<dataset>-<namespace>` naming scheme.
+//
+// https://www.elastic.co/guide/en/fleet/current/data-streams.html#data-streams-naming-scheme
+type DataStreamSettings struct {
+	// Enabled routes log records to the `logs-*` data streams and spans to the
+	// `traces-*` data streams instead of Index and TracesIndex.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Dataset is used if the record or resource does not have a
+	// `data_stream.dataset` attribute.
+	Dataset string `mapstructure:"dataset"`
+
+	// Namespace is used if the record or resource does not have a
+	// `data_stream.namespace` attribute.
+	Namespace string `mapstructure:"namespace"`
+}
+
 type MappingMode int
 
 // Enum values for MappingMode.
@@ -172,6 +200,7 @@ var (
 	errConfigNoEndpoint    = errors.New("endpoints or cloudid must be specified")
 	errConfigEmptyEndpoint = errors.New("endpoints must not include empty entries")
 	errConfigNoIndex       = errors.New("index must be specified")
+	errConfigNoTracesIndex = errors.New("traces_index must be specified")
 )
 
 func (m MappingMode) String() string {
@@ -217,8 +246,24 @@ func (cfg *Config) Validate() error {
 		}
 	}
 
-	if cfg.Index == "" {
-		return errConfigNoIndex
+	if cfg.DataStream.Enabled {
+		if err := cfg.DataStream.Validate(); err != nil {
+			return err
+		}
+	} else {
+		if cfg.Index == "" {
+			return errConfigNoIndex
+		}
+		if _, err := parseIndexTemplate(cfg.Index); err != nil {
+			return err
+		}
+
+		if cfg.TracesIndex == "" {
+			return errConfigNoTracesIndex
+		}
+		if _, err := parseIndexTemplate(cfg.TracesIndex); err != nil {
+			return err
+		}
 	}
 
 	if _, ok := mappingModes[cfg.Mapping.Mode]; !ok {
@@ -227,3 +272,13 @@ func (cfg *Config) Validate() error {
 
 	return nil
 }
+
+func (cfg *DataStreamSettings) Validate() error {
+	if cfg.Dataset == "" || strings.Contains(cfg.Dataset, "-") {
+		return fmt.Errorf("invalid data stream dataset %q: must be set and must not contain '-'", cfg.Dataset)
+	}
+	if cfg.Namespace == "" || strings.Contains(cfg.Namespace, "-") {
+		return fmt.Errorf("invalid data stream namespace %q: must be set and must not contain '-'", cfg.Namespace)
+	}
+	return nil
+}
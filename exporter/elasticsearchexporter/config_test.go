@@ -36,19 +36,25 @@ func TestLoadConfig(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, cfg)
 
-	assert.Equal(t, len(cfg.Exporters), 2)
+	assert.Equal(t, len(cfg.Exporters), 3)
 
 	defaultCfg := factory.CreateDefaultConfig()
 	defaultCfg.(*Config).Endpoints = []string{"https://elastic.example.com:9200"}
 	r0 := cfg.Exporters[config.NewComponentID(typeStr)]
 	assert.Equal(t, r0, defaultCfg)
 
+	r2 := cfg.Exporters[config.NewComponentIDWithName(typeStr, "datastream")].(*Config)
+	assert.True(t, r2.DataStream.Enabled)
+	assert.Equal(t, "generic", r2.DataStream.Dataset)
+	assert.Equal(t, "production", r2.DataStream.Namespace)
+
 	r1 := cfg.Exporters[config.NewComponentIDWithName(typeStr, "customname")].(*Config)
 	assert.Equal(t, r1, &Config{
 		ExporterSettings: config.NewExporterSettings(config.NewComponentIDWithName(typeStr, "customname")),
 		Endpoints:        []string{"https://elastic.example.com:9200"},
 		CloudID:          "TRNMxjXlNJEt",
-		Index:            "myindex",
+		Index:            "myindex-{service.name}-{yyyy.MM.dd}",
+		TracesIndex:      "mytraces",
 		Pipeline:         "mypipeline",
 		HTTPClientSettings: HTTPClientSettings{
 			Authentication: AuthenticationSettings{
@@ -78,9 +84,66 @@ func TestLoadConfig(t *testing.T) {
 			Dedup: true,
 			Dedot: true,
 		},
+		DataStream: DataStreamSettings{
+			Dataset:   "generic",
+			Namespace: "default",
+		},
 	})
 }
 
+func TestConfig_Validate(t *testing.T) {
+	tests := map[string]struct {
+		config *Config
+		err    string
+	}{
+		"default with endpoints": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoints = []string{"test:9200"}
+			}),
+		},
+		"no traces index": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoints = []string{"test:9200"}
+				cfg.TracesIndex = ""
+			}),
+			err: errConfigNoTracesIndex.Error(),
+		},
+		"unterminated placeholder": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoints = []string{"test:9200"}
+				cfg.Index = "logs-{service.name"
+			}),
+			err: `unterminated placeholder in index "logs-{service.name"`,
+		},
+		"data stream ignores indices": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoints = []string{"test:9200"}
+				cfg.Index = "logs-{"
+				cfg.DataStream.Enabled = true
+			}),
+		},
+		"invalid data stream namespace": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoints = []string{"test:9200"}
+				cfg.DataStream.Enabled = true
+				cfg.DataStream.Namespace = "my-namespace"
+			}),
+			err: `invalid data stream namespace "my-namespace": must be set and must not contain '-'`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.config.Validate()
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
 func withDefaultConfig(fns ...func(*Config)) *Config {
 	cfg := createDefaultConfig().(*Config)
 	for _, fn := range fns {
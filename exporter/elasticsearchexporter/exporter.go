@@ -42,7 +42,8 @@ type esBulkIndexerResponseItem = esutil7.BulkIndexerResponseItem
 type elasticsearchExporter struct {
 	logger *zap.Logger
 
-	index       string
+	logsIndex   *indexResolver
+	tracesIndex *indexResolver
 	maxAttempts int
 
 	client      *esClientCurrent
@@ -64,6 +65,16 @@ func newExporter(logger *zap.Logger, cfg *Config) (*elasticsearchExporter, error
 		return nil, err
 	}
 
+	logsIndex, err := newIndexResolver(dataStreamTypeLogs, cfg.Index, &cfg.DataStream)
+	if err != nil {
+		return nil, err
+	}
+
+	tracesIndex, err := newIndexResolver(dataStreamTypeTraces, cfg.TracesIndex, &cfg.DataStream)
+	if err != nil {
+		return nil, err
+	}
+
 	bulkIndexer, err := newBulkIndexer(logger, client, cfg)
 	if err != nil {
 		return nil, err
@@ -82,7 +93,8 @@ func newExporter(logger *zap.Logger, cfg *Config) (*elasticsearchExporter, error
 		client:      client,
 		bulkIndexer: bulkIndexer,
 
-		index:       cfg.Index,
+		logsIndex:   logsIndex,
+		tracesIndex: tracesIndex,
 		maxAttempts: maxAttempts,
 		model:       model,
 	}, nil
@@ -101,7 +113,7 @@ func (e *elasticsearchExporter) pushLogsData(ctx context.Context, ld pdata.Logs)
 		resource := rl.Resource()
 		ills := rl.InstrumentationLibraryLogs()
 		for j := 0; j < ills.Len(); j++ {
-			logs := ills.At(i).Logs()
+			logs := ills.At(j).Logs()
 			for k := 0; k < logs.Len(); k++ {
 				if err := e.pushLogRecord(ctx, resource, logs.At(k)); err != nil {
 					if cerr := ctx.Err(); cerr != nil {
@@ -118,17 +130,72 @@ func (e *elasticsearchExporter) pushLogsData(ctx context.Context, ld pdata.Logs)
 }
 
 func (e *elasticsearchExporter) pushLogRecord(ctx context.Context, resource pdata.Resource, record pdata.LogRecord) error {
-	document, err := e.model.encodeLog(resource, record)
+	index, ds := e.logsIndex.resolve(resource, record.Attributes(), record.Timestamp())
+
+	document, err := e.model.encodeLog(resource, record, ds)
 	if err != nil {
 		return fmt.Errorf("Failed to encode log event: %w", err)
 	}
-	return e.pushEvent(ctx, document)
+	return e.pushEvent(ctx, index, document)
+}
+
+func (e *elasticsearchExporter) pushTracesData(ctx context.Context, td pdata.Traces) error {
+	var errs []error
+
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		resource := rs.Resource()
+		ilss := rs.InstrumentationLibrarySpans()
+		for j := 0; j < ilss.Len(); j++ {
+			spans := ilss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				if err := e.pushSpan(ctx, resource, spans.At(k)); err != nil {
+					if cerr := ctx.Err(); cerr != nil {
+						return cerr
+					}
+
+					errs = append(errs, err)
+				}
+			}
+		}
+	}
+
+	return multierr.Combine(errs...)
+}
+
+// pushSpan indexes the span and each of its events as separate documents.
+func (e *elasticsearchExporter) pushSpan(ctx context.Context, resource pdata.Resource, span pdata.Span) error {
+	index, ds := e.tracesIndex.resolve(resource, span.Attributes(), span.StartTimestamp())
+	document, err := e.model.encodeSpan(resource, span, ds)
+	if err != nil {
+		return fmt.Errorf("Failed to encode span: %w", err)
+	}
+	if err = e.pushEvent(ctx, index, document); err != nil {
+		return err
+	}
+
+	var errs []error
+	events := span.Events()
+	for i := 0; i < events.Len(); i++ {
+		event := events.At(i)
+		index, ds = e.tracesIndex.resolve(resource, span.Attributes(), event.Timestamp())
+		document, err = e.model.encodeSpanEvent(resource, span, event, ds)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("Failed to encode span event: %w", err))
+			continue
+		}
+		if err = e.pushEvent(ctx, index, document); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return multierr.Combine(errs...)
 }
 
-func (e *elasticsearchExporter) pushEvent(ctx context.Context, document []byte) error {
+func (e *elasticsearchExporter) pushEvent(ctx context.Context, index string, document []byte) error {
 	attempts := 1
 	body := bytes.NewReader(document)
-	item := esBulkIndexerItem{Action: createAction, Index: e.index, Body: body}
+	item := esBulkIndexerItem{Action: createAction, Index: index, Body: body}
 
 	// Setup error handler. The handler handles the per item response status based on the
 	// selective ACKing in the bulk response.
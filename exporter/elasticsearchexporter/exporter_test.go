@@ -27,6 +27,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest"
 )
@@ -196,9 +197,11 @@ func TestExporter_PushEvent(t *testing.T) {
 		}
 
 		for name, handler := range handlers {
+			handler := handler
 			t.Run(name, func(t *testing.T) {
 				t.Parallel()
 				for name, configurer := range configurations {
+					configurer := configurer
 					t.Run(name, func(t *testing.T) {
 						t.Parallel()
 						var attempts int64
@@ -308,6 +311,145 @@ func TestExporter_PushEvent(t *testing.T) {
 	})
 }
 
+func TestExporter_PushLogs(t *testing.T) {
+	t.Run("index template", func(t *testing.T) {
+		rec := newBulkRecorder()
+		server := newESTestServer(t, func(docs []itemRequest) ([]itemResponse, error) {
+			rec.Record(docs)
+			return itemsAllOK(docs)
+		})
+
+		exporter := newTestExporter(t, server.URL, func(cfg *Config) {
+			cfg.Index = "logs-{service.name}-{yyyy.MM.dd}"
+		})
+
+		logs := pdata.NewLogs()
+		rl := logs.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().InsertString("service.name", "Checkout")
+		records := rl.InstrumentationLibraryLogs().AppendEmpty().Logs()
+		record := records.AppendEmpty()
+		record.SetTimestamp(pdata.NewTimestampFromTime(time.Date(2021, 12, 24, 10, 0, 0, 0, time.UTC)))
+		record.Body().SetStringVal("resource service")
+		record = records.AppendEmpty()
+		record.SetTimestamp(pdata.NewTimestampFromTime(time.Date(2021, 12, 25, 10, 0, 0, 0, time.UTC)))
+		record.Attributes().InsertString("service.name", "cart")
+		record.Body().SetStringVal("record service")
+		// Records of a second library are exported as well.
+		record = rl.InstrumentationLibraryLogs().AppendEmpty().Logs().AppendEmpty()
+		record.SetTimestamp(pdata.NewTimestampFromTime(time.Date(2021, 12, 26, 10, 0, 0, 0, time.UTC)))
+		record.Body().SetStringVal("second library")
+
+		require.NoError(t, exporter.pushLogsData(context.TODO(), logs))
+
+		rec.WaitItems(3)
+		assert.ElementsMatch(t, []string{
+			"logs-checkout-2021.12.24",
+			"logs-cart-2021.12.25",
+			"logs-checkout-2021.12.26",
+		}, itemIndices(t, rec.Items()))
+	})
+
+	t.Run("data stream", func(t *testing.T) {
+		rec := newBulkRecorder()
+		server := newESTestServer(t, func(docs []itemRequest) ([]itemResponse, error) {
+			rec.Record(docs)
+			return itemsAllOK(docs)
+		})
+
+		exporter := newTestExporter(t, server.URL, func(cfg *Config) {
+			cfg.DataStream.Enabled = true
+		})
+
+		logs := pdata.NewLogs()
+		rl := logs.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().InsertString(dataStreamNamespaceAttribute, "Prod")
+		record := rl.InstrumentationLibraryLogs().AppendEmpty().Logs().AppendEmpty()
+		record.Attributes().InsertString(dataStreamDatasetAttribute, "nginx-access")
+		record.Body().SetStringVal("GET /")
+
+		require.NoError(t, exporter.pushLogsData(context.TODO(), logs))
+
+		rec.WaitItems(1)
+		items := rec.Items()
+		assert.Equal(t, []string{"logs-nginx_access-prod"}, itemIndices(t, items))
+
+		var doc map[string]interface{}
+		require.NoError(t, json.Unmarshal(items[0].Document, &doc))
+		assert.Equal(t, "logs", doc["data_stream.type"])
+		assert.Equal(t, "nginx_access", doc["data_stream.dataset"])
+		assert.Equal(t, "prod", doc["data_stream.namespace"])
+	})
+}
+
+func TestExporter_PushTraces(t *testing.T) {
+	rec := newBulkRecorder()
+	server := newESTestServer(t, func(docs []itemRequest) ([]itemResponse, error) {
+		rec.Record(docs)
+		return itemsAllOK(docs)
+	})
+
+	exporter := newTestExporter(t, server.URL, func(cfg *Config) {
+		cfg.TracesIndex = "traces-{service.name}"
+	})
+
+	start := time.Date(2021, 12, 24, 10, 0, 0, 0, time.UTC)
+	traces := pdata.NewTraces()
+	rs := traces.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().InsertString("service.name", "checkout")
+	span := rs.InstrumentationLibrarySpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetTraceID(pdata.NewTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}))
+	span.SetSpanID(pdata.NewSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8}))
+	span.SetName("GET /cart")
+	span.SetKind(pdata.SpanKindServer)
+	span.SetStartTimestamp(pdata.NewTimestampFromTime(start))
+	span.SetEndTimestamp(pdata.NewTimestampFromTime(start.Add(1500 * time.Millisecond)))
+	span.Status().SetCode(pdata.StatusCodeError)
+	span.Status().SetMessage("timeout")
+	span.Attributes().InsertString("http.method", "GET")
+	event := span.Events().AppendEmpty()
+	event.SetName("exception")
+	event.SetTimestamp(pdata.NewTimestampFromTime(start.Add(time.Second)))
+	event.Attributes().InsertString("exception.type", "TimeoutError")
+
+	require.NoError(t, exporter.pushTracesData(context.TODO(), traces))
+
+	rec.WaitItems(2)
+	items := rec.Items()
+	assert.Equal(t, []string{"traces-checkout", "traces-checkout"}, itemIndices(t, items))
+
+	var docs []map[string]interface{}
+	for _, item := range items {
+		var doc map[string]interface{}
+		require.NoError(t, json.Unmarshal(item.Document, &doc))
+		docs = append(docs, doc)
+	}
+	if _, ok := docs[0]["EventName"]; ok {
+		docs[0], docs[1] = docs[1], docs[0]
+	}
+
+	assert.Equal(t, map[string]interface{}{
+		"@timestamp":             "2021-12-24T10:00:00.000000000Z",
+		"EndTimestamp":           "2021-12-24T10:00:01.500000000Z",
+		"Duration":               float64(1500 * time.Millisecond),
+		"TraceId":                "0102030405060708090a0b0c0d0e0f10",
+		"SpanId":                 "0102030405060708",
+		"Name":                   "GET /cart",
+		"Kind":                   "SPAN_KIND_SERVER",
+		"Status.Code":            "STATUS_CODE_ERROR",
+		"Status.Message":         "timeout",
+		"Attributes.http.method": "GET",
+		"Resource.service.name":  "checkout",
+	}, docs[0])
+	assert.Equal(t, map[string]interface{}{
+		"@timestamp":                "2021-12-24T10:00:01.000000000Z",
+		"TraceId":                   "0102030405060708090a0b0c0d0e0f10",
+		"SpanId":                    "0102030405060708",
+		"EventName":                 "exception",
+		"Attributes.exception.type": "TimeoutError",
+		"Resource.service.name":     "checkout",
+	}, docs[1])
+}
+
 func newTestExporter(t *testing.T, url string, fns ...func(*Config)) *elasticsearchExporter {
 	exporter, err := newExporter(zaptest.NewLogger(t), withTestExporterConfig(fns...)(url))
 	require.NoError(t, err)
@@ -330,6 +472,21 @@ func withTestExporterConfig(fns ...func(*Config)) func(string) *Config {
 }
 
 func mustSend(t *testing.T, exporter *elasticsearchExporter, contents string) {
-	err := exporter.pushEvent(context.TODO(), []byte(contents))
+	err := exporter.pushEvent(context.TODO(), "logs-generic-default", []byte(contents))
 	require.NoError(t, err)
 }
+
+// itemIndices returns the index of the bulk create actions.
+func itemIndices(t *testing.T, items []itemRequest) []string {
+	indices := make([]string, len(items))
+	for i, item := range items {
+		var action struct {
+			Create struct {
+				Index string `json:"_index"`
+			} `json:"create"`
+		}
+		require.NoError(t, json.Unmarshal(item.Action, &action))
+		indices[i] = action.Create.Index
+	}
+	return indices
+}
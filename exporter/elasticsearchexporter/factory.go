@@ -35,6 +35,7 @@ func NewFactory() component.ExporterFactory {
 		typeStr,
 		createDefaultConfig,
 		exporterhelper.WithLogs(createLogsExporter),
+		exporterhelper.WithTraces(createTracesExporter),
 	)
 }
 
@@ -44,7 +45,8 @@ func createDefaultConfig() config.Exporter {
 		HTTPClientSettings: HTTPClientSettings{
 			Timeout: 90 * time.Second,
 		},
-		Index: "logs-generic-default",
+		Index:       "logs-generic-default",
+		TracesIndex: "traces-generic-default",
 		Retry: RetrySettings{
 			Enabled:         true,
 			MaxRequests:     3,
@@ -56,6 +58,10 @@ func createDefaultConfig() config.Exporter {
 			Dedup: true,
 			Dedot: true,
 		},
+		DataStream: DataStreamSettings{
+			Dataset:   "generic",
+			Namespace: "default",
+		},
 	}
 }
 
@@ -79,3 +85,21 @@ func createLogsExporter(
 		exporterhelper.WithShutdown(exporter.Shutdown),
 	)
 }
+
+func createTracesExporter(
+	ctx context.Context,
+	set component.ExporterCreateSettings,
+	cfg config.Exporter,
+) (component.TracesExporter, error) {
+	exporter, err := newExporter(set.Logger, cfg.(*Config))
+	if err != nil {
+		return nil, fmt.Errorf("cannot configure Elasticsearch traces exporter: %w", err)
+	}
+
+	return exporterhelper.NewTracesExporter(
+		cfg,
+		set,
+		exporter.pushTracesData,
+		exporterhelper.WithShutdown(exporter.Shutdown),
+	)
+}
@@ -49,13 +49,18 @@ func TestFactory_CreateMetricsExporter_Fail(t *testing.T) {
 	cfg := factory.CreateDefaultConfig()
 	params := componenttest.NewNopExporterCreateSettings()
 	_, err := factory.CreateMetricsExporter(context.Background(), params, cfg)
-	require.Error(t, err, "expected an error when creating a traces exporter")
+	require.Error(t, err, "expected an error when creating a metrics exporter")
 }
 
-func TestFactory_CreateTracesExporter_Fail(t *testing.T) {
+func TestFactory_CreateTracesExporter(t *testing.T) {
 	factory := NewFactory()
-	cfg := factory.CreateDefaultConfig()
+	cfg := withDefaultConfig(func(cfg *Config) {
+		cfg.Endpoints = []string{"test:9200"}
+	})
 	params := componenttest.NewNopExporterCreateSettings()
-	_, err := factory.CreateTracesExporter(context.Background(), params, cfg)
-	require.Error(t, err, "expected an error when creating a traces exporter")
+	exporter, err := factory.CreateTracesExporter(context.Background(), params, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, exporter)
+
+	require.NoError(t, exporter.Shutdown(context.TODO()))
 }
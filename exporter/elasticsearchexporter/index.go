@@ -0,0 +1,218 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter"
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+const (
+	dataStreamTypeLogs   = "logs"
+	dataStreamTypeTraces = "traces"
+
+	dataStreamDatasetAttribute   = "data_stream.dataset"
+	dataStreamNamespaceAttribute = "data_stream.namespace"
+
+	// unknownIndexValue replaces the placeholders of the index templates
+	// referring to attributes that are not set.
+	unknownIndexValue = "unknown"
+)
+
+// dateLayouts maps the date patterns supported in the index templates to the
+// Go time layout.
+var dateLayouts = map[string]string{
+	"yyyy": "2006",
+	"yy":   "06",
+	"MM":   "01",
+	"dd":   "02",
+	"HH":   "15",
+	"mm":   "04",
+	"ss":   "05",
+}
+
+// dateSeparators are the characters allowed between the date patterns.
+const dateSeparators = ".-_"
+
+// invalidIndexChars replaces the characters that are not allowed in index
+// names, or that have a special meaning, in the values of attributes.
+var invalidIndexChars = strings.NewReplacer(
+	`\`, "_", "/", "_", "*", "_", "?", "_", `"`, "_", "<", "_", ">", "_",
+	"|", "_", " ", "_", ",", "_", "#", "_", ":", "_",
+)
+
+// dataStream identifies the data stream a document is written to.
+type dataStream struct {
+	typ       string
+	dataset   string
+	namespace string
+}
+
+func (ds *dataStream) String() string {
+	return ds.typ + "-" + ds.dataset + "-" + ds.namespace
+}
+
+// indexResolver resolves the index, or the data stream, of a document from
+// the attributes and the timestamp of the record.
+type indexResolver struct {
+	template indexTemplate
+
+	// dataStream is set when the documents are routed to data streams, in which
+	// case the template is not used.
+	dataStream *DataStreamSettings
+	signal     string
+}
+
+func newIndexResolver(signal string, index string, settings *DataStreamSettings) (*indexResolver, error) {
+	if settings.Enabled {
+		return &indexResolver{dataStream: settings, signal: signal}, nil
+	}
+
+	template, err := parseIndexTemplate(index)
+	if err != nil {
+		return nil, err
+	}
+	return &indexResolver{template: template}, nil
+}
+
+// resolve returns the index of the document, along with the data stream when
+// data streams are enabled. Attributes of the record take precedence over
+// the attributes of the resource.
+func (r *indexResolver) resolve(resource pdata.Resource, attributes pdata.AttributeMap, ts pdata.Timestamp) (string, *dataStream) {
+	lookup := func(key string) (string, bool) {
+		if v, ok := attributes.Get(key); ok {
+			return v.AsString(), true
+		}
+		if v, ok := resource.Attributes().Get(key); ok {
+			return v.AsString(), true
+		}
+		return "", false
+	}
+
+	if r.dataStream != nil {
+		ds := &dataStream{typ: r.signal, dataset: r.dataStream.Dataset, namespace: r.dataStream.Namespace}
+		// The type, dataset and namespace are separated by dashes in the name
+		// of the data stream and can not contain any.
+		if v, ok := lookup(dataStreamDatasetAttribute); ok && v != "" {
+			ds.dataset = strings.ReplaceAll(sanitizeIndexValue(v), "-", "_")
+		}
+		if v, ok := lookup(dataStreamNamespaceAttribute); ok && v != "" {
+			ds.namespace = strings.ReplaceAll(sanitizeIndexValue(v), "-", "_")
+		}
+		return ds.String(), ds
+	}
+
+	return r.template.render(lookup, ts), nil
+}
+
+func sanitizeIndexValue(v string) string {
+	return strings.ToLower(invalidIndexChars.Replace(v))
+}
+
+// indexTemplate is an index name with placeholders, e.g.
+// "logs-{service.name}-{yyyy.MM.dd}". Placeholders only made of date patterns
+// are replaced with the timestamp of the record, the other ones with the value
+// of the attribute.
+type indexTemplate []indexTemplatePart
+
+type indexTemplatePart struct {
+	literal    string
+	attribute  string
+	dateLayout string
+}
+
+func parseIndexTemplate(index string) (indexTemplate, error) {
+	var template indexTemplate
+	for rest := index; rest != ""; {
+		start := strings.IndexByte(rest, '{')
+		if start < 0 {
+			template = append(template, indexTemplatePart{literal: rest})
+			break
+		}
+		if start > 0 {
+			template = append(template, indexTemplatePart{literal: rest[:start]})
+		}
+
+		end := strings.IndexByte(rest[start:], '}')
+		if end < 0 {
+			return nil, fmt.Errorf("unterminated placeholder in index %q", index)
+		}
+		placeholder := rest[start+1 : start+end]
+		if placeholder == "" {
+			return nil, fmt.Errorf("empty placeholder in index %q", index)
+		}
+
+		if layout, ok := dateLayout(placeholder); ok {
+			template = append(template, indexTemplatePart{dateLayout: layout})
+		} else {
+			template = append(template, indexTemplatePart{attribute: placeholder})
+		}
+		rest = rest[start+end+1:]
+	}
+	return template, nil
+}
+
+// dateLayout returns the Go time layout of the placeholder when it is made of
+// date patterns separated by dateSeparators, e.g. "yyyy.MM.dd". Other
+// placeholders, such as the "id" or "ms" attributes, are not dates.
+func dateLayout(placeholder string) (string, bool) {
+	var sb strings.Builder
+	start := 0
+	for i := 0; i <= len(placeholder); i++ {
+		if i < len(placeholder) && strings.IndexByte(dateSeparators, placeholder[i]) < 0 {
+			continue
+		}
+		layout, ok := dateLayouts[placeholder[start:i]]
+		if !ok {
+			return "", false
+		}
+		sb.WriteString(layout)
+		if i < len(placeholder) {
+			sb.WriteByte(placeholder[i])
+		}
+		start = i + 1
+	}
+	return sb.String(), true
+}
+
+func (t indexTemplate) render(lookup func(string) (string, bool), ts pdata.Timestamp) string {
+	if len(t) == 1 && t[0].literal != "" {
+		return t[0].literal
+	}
+
+	if ts == 0 {
+		ts = pdata.NewTimestampFromTime(time.Now())
+	}
+
+	var sb strings.Builder
+	for _, part := range t {
+		switch {
+		case part.attribute != "":
+			v, ok := lookup(part.attribute)
+			if !ok || v == "" {
+				v = unknownIndexValue
+			}
+			sb.WriteString(sanitizeIndexValue(v))
+		case part.dateLayout != "":
+			sb.WriteString(ts.AsTime().UTC().Format(part.dateLayout))
+		default:
+			sb.WriteString(part.literal)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearchexporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+func TestIndexTemplate(t *testing.T) {
+	ts := pdata.NewTimestampFromTime(time.Date(2021, 12, 24, 9, 5, 0, 0, time.UTC))
+	attributes := pdata.NewAttributeMapFromMap(map[string]pdata.AttributeValue{
+		"service.name": pdata.NewAttributeValueString("My Service"),
+		"tenant":       pdata.NewAttributeValueString("Acme/EU"),
+		"id":           pdata.NewAttributeValueString("42"),
+		"ms":           pdata.NewAttributeValueString("billing"),
+	})
+	lookup := func(key string) (string, bool) {
+		if v, ok := attributes.Get(key); ok {
+			return v.AsString(), true
+		}
+		return "", false
+	}
+
+	tests := map[string]string{
+		"logs-generic-default":               "logs-generic-default",
+		"logs-{service.name}-{yyyy.MM.dd}":   "logs-my_service-2021.12.24",
+		"{tenant}-logs-{yy.MM.dd-HH}":        "acme_eu-logs-21.12.24-09",
+		"logs-{k8s.namespace.name}":          "logs-unknown",
+		"logs-{service.name}{tenant}-{yyyy}": "logs-my_serviceacme_eu-2021",
+		"logs-{id}-{ms}-{yyyy-MM}":           "logs-42-billing-2021-12",
+		"logs-{dd_HH_mm_ss}":                 "logs-24_09_05_00",
+		"logs-{yyyy..MM}-{d}":                "logs-unknown-unknown",
+	}
+	for index, want := range tests {
+		t.Run(index, func(t *testing.T) {
+			template, err := parseIndexTemplate(index)
+			require.NoError(t, err)
+			assert.Equal(t, want, template.render(lookup, ts))
+		})
+	}
+
+	for _, index := range []string{"logs-{service.name", "logs-{}"} {
+		_, err := parseIndexTemplate(index)
+		assert.Error(t, err, index)
+	}
+}
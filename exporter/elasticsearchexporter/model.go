@@ -23,7 +23,9 @@ import (
 )
 
 type mappingModel interface {
-	encodeLog(pdata.Resource, pdata.LogRecord) ([]byte, error)
+	encodeLog(pdata.Resource, pdata.LogRecord, *dataStream) ([]byte, error)
+	encodeSpan(pdata.Resource, pdata.Span, *dataStream) ([]byte, error)
+	encodeSpanEvent(pdata.Resource, pdata.Span, pdata.SpanEvent, *dataStream) ([]byte, error)
 }
 
 // encodeModel tries to keep the event as close to the original open telemetry semantics as is.
@@ -37,7 +39,7 @@ type encodeModel struct {
 	dedot bool
 }
 
-func (m *encodeModel) encodeLog(resource pdata.Resource, record pdata.LogRecord) ([]byte, error) {
+func (m *encodeModel) encodeLog(resource pdata.Resource, record pdata.LogRecord, ds *dataStream) ([]byte, error) {
 	var document objmodel.Document
 	document.AddTimestamp("@timestamp", record.Timestamp()) // We use @timestamp in order to ensure that we can index if the default data stream logs template is used.
 	document.AddID("TraceId", record.TraceID())
@@ -50,6 +52,51 @@ func (m *encodeModel) encodeLog(resource pdata.Resource, record pdata.LogRecord)
 	document.AddAttributes("Attributes", record.Attributes())
 	document.AddAttributes("Resource", resource.Attributes())
 
+	return m.serialize(document, ds)
+}
+
+func (m *encodeModel) encodeSpan(resource pdata.Resource, span pdata.Span, ds *dataStream) ([]byte, error) {
+	var document objmodel.Document
+	document.AddTimestamp("@timestamp", span.StartTimestamp())
+	document.AddTimestamp("EndTimestamp", span.EndTimestamp())
+	document.AddInt("Duration", int64(span.EndTimestamp()-span.StartTimestamp()))
+	document.AddID("TraceId", span.TraceID())
+	document.AddID("SpanId", span.SpanID())
+	document.AddID("ParentSpanId", span.ParentSpanID())
+	document.AddString("TraceState", string(span.TraceState()))
+	document.AddString("Name", span.Name())
+	document.AddString("Kind", span.Kind().String())
+	document.AddString("Status.Code", span.Status().Code().String())
+	document.AddString("Status.Message", span.Status().Message())
+	document.AddAttributes("Attributes", span.Attributes())
+	document.AddAttributes("Resource", resource.Attributes())
+
+	return m.serialize(document, ds)
+}
+
+// encodeSpanEvent encodes an event of a span. The name of the event is stored
+// in EventName to tell events apart from spans indexed alongside.
+func (m *encodeModel) encodeSpanEvent(resource pdata.Resource, span pdata.Span, event pdata.SpanEvent, ds *dataStream) ([]byte, error) {
+	var document objmodel.Document
+	document.AddTimestamp("@timestamp", event.Timestamp())
+	document.AddID("TraceId", span.TraceID())
+	document.AddID("SpanId", span.SpanID())
+	document.AddString("EventName", event.Name())
+	document.AddAttributes("Attributes", event.Attributes())
+	document.AddAttributes("Resource", resource.Attributes())
+
+	return m.serialize(document, ds)
+}
+
+func (m *encodeModel) serialize(document objmodel.Document, ds *dataStream) ([]byte, error) {
+	// Data streams require the data_stream fields to match the name of the
+	// data stream.
+	if ds != nil {
+		document.AddString("data_stream.type", ds.typ)
+		document.AddString("data_stream.dataset", ds.dataset)
+		document.AddString("data_stream.namespace", ds.namespace)
+	}
+
 	if m.dedup {
 		document.Dedup()
 	} else if m.dedot {
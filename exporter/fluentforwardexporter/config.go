@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentforwardexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fluentforwardexporter"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+)
+
+const (
+	// CompressionNone sends the logs in PackedForward mode.
+	CompressionNone = "none"
+	// CompressionGzip sends the logs in CompressedPackedForward mode.
+	CompressionGzip = "gzip"
+)
+
+// Config defines configuration for the Fluent Forward exporter.
+type Config struct {
+	config.ExporterSettings        `mapstructure:",squash"`
+	exporterhelper.TimeoutSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct.
+	exporterhelper.QueueSettings   `mapstructure:"sending_queue"`
+	exporterhelper.RetrySettings   `mapstructure:"retry_on_failure"`
+
+	// Endpoint is the address of the Fluent Forward server. Should be of the
+	// form `<host>:<port>` (TCP) or `unix://<socket_path>` (Unix domain socket).
+	Endpoint string `mapstructure:"endpoint"`
+
+	// TLSSetting enables TLS on the connection if set.
+	TLSSetting *configtls.TLSClientSetting `mapstructure:"tls"`
+
+	// Tag is the tag of the log records without the TagAttribute attribute.
+	Tag string `mapstructure:"tag"`
+
+	// TagAttribute is the attribute of the log records or of their resource
+	// holding their tag. Defaults to the "fluent.tag" attribute set by the
+	// Fluent Forward receiver, leave empty to send all the records with Tag.
+	TagAttribute string `mapstructure:"tag_attribute"`
+
+	// Compression is the compression of the entries: "none" sends them in
+	// PackedForward mode and "gzip" in CompressedPackedForward mode.
+	Compression string `mapstructure:"compression"`
+
+	// RequireAck makes the exporter wait for the server to acknowledge each
+	// message before considering its logs sent.
+	RequireAck bool `mapstructure:"require_ack"`
+
+	// AckTimeout is the time to wait for the acknowledgement of a message.
+	AckTimeout time.Duration `mapstructure:"ack_timeout"`
+
+	// Security enables the handshake of the forward protocol if its shared
+	// key is set.
+	Security SecuritySettings `mapstructure:"security"`
+
+	// Reconnect configures the backoff between the attempts to connect to
+	// the server.
+	Reconnect ReconnectSettings `mapstructure:"reconnect"`
+}
+
+// SecuritySettings defines the authentication to the server during the
+// handshake of the forward protocol.
+type SecuritySettings struct {
+	// SelfHostname is the hostname the exporter identifies with to the server.
+	SelfHostname string `mapstructure:"self_hostname"`
+
+	// SharedKey is the key shared with the server to authenticate each other.
+	SharedKey string `mapstructure:"shared_key"`
+
+	// Username and Password authenticate the exporter if the server requires it.
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+}
+
+// ReconnectSettings defines the exponential backoff between the attempts to
+// connect to the server.
+type ReconnectSettings struct {
+	// InitialInterval is the time to wait after the first failed attempt.
+	InitialInterval time.Duration `mapstructure:"initial_interval"`
+
+	// MaxInterval is the upper bound on the time between two attempts.
+	MaxInterval time.Duration `mapstructure:"max_interval"`
+
+	// MaxElapsedTime is the time after which the exporter stops trying to
+	// connect and fails the logs, if the timeout of the export is not
+	// reached before.
+	MaxElapsedTime time.Duration `mapstructure:"max_elapsed_time"`
+}
+
+var (
+	errNoEndpoint = errors.New(`requires a non-empty "endpoint"`)
+	errNoTag      = errors.New(`requires a non-empty "tag"`)
+)
+
+// Validate checks the exporter configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errNoEndpoint
+	}
+	if cfg.Tag == "" {
+		return errNoTag
+	}
+	if cfg.Compression != CompressionNone && cfg.Compression != CompressionGzip {
+		return fmt.Errorf(`unsupported "compression" %q, must be %q or %q`, cfg.Compression, CompressionNone, CompressionGzip)
+	}
+	if cfg.RequireAck && cfg.AckTimeout <= 0 {
+		return errors.New(`requires "ack_timeout" > 0`)
+	}
+	if cfg.Reconnect.InitialInterval <= 0 || cfg.Reconnect.MaxInterval < cfg.Reconnect.InitialInterval {
+		return errors.New(`requires 0 < "reconnect.initial_interval" <= "reconnect.max_interval"`)
+	}
+	if cfg.Reconnect.MaxElapsedTime <= 0 {
+		return errors.New(`requires "reconnect.max_elapsed_time" > 0`)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentforwardexporter
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configtest"
+	"go.opentelemetry.io/collector/config/configtls"
+)
+
+func TestLoadConfig(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	assert.Nil(t, err)
+
+	factory := NewFactory()
+	factories.Exporters[typeStr] = factory
+	cfg, err := configtest.LoadConfigAndValidate(path.Join(".", "testdata", "config.yaml"), factories)
+
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	e0 := cfg.Exporters[config.NewComponentID(typeStr)]
+	defaultCfg := factory.CreateDefaultConfig().(*Config)
+	defaultCfg.Endpoint = "localhost:24224"
+	assert.Equal(t, defaultCfg, e0)
+
+	e1 := cfg.Exporters[config.NewComponentIDWithName(typeStr, "allsettings")]
+	expectedCfg := factory.CreateDefaultConfig().(*Config)
+	expectedCfg.ExporterSettings = config.NewExporterSettings(config.NewComponentIDWithName(typeStr, "allsettings"))
+	expectedCfg.Endpoint = "fluentd:24224"
+	expectedCfg.Timeout = 10 * time.Second
+	expectedCfg.TLSSetting = &configtls.TLSClientSetting{
+		TLSSetting: configtls.TLSSetting{CAFile: "/var/lib/fluentd/ca.pem"},
+	}
+	expectedCfg.Tag = "app"
+	expectedCfg.TagAttribute = "fluent.source"
+	expectedCfg.Compression = CompressionGzip
+	expectedCfg.RequireAck = true
+	expectedCfg.AckTimeout = 5 * time.Second
+	expectedCfg.Security = SecuritySettings{
+		SelfHostname: "collector",
+		SharedKey:    "secret",
+		Username:     "otel",
+		Password:     "password",
+	}
+	expectedCfg.Reconnect = ReconnectSettings{
+		InitialInterval: 2 * time.Second,
+		MaxInterval:     time.Minute,
+		MaxElapsedTime:  5 * time.Minute,
+	}
+	assert.Equal(t, expectedCfg, e1)
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(cfg *Config)
+		wantErr string
+	}{
+		{
+			name:    "no endpoint",
+			modify:  func(cfg *Config) { cfg.Endpoint = "" },
+			wantErr: `requires a non-empty "endpoint"`,
+		},
+		{
+			name:    "no tag",
+			modify:  func(cfg *Config) { cfg.Tag = "" },
+			wantErr: `requires a non-empty "tag"`,
+		},
+		{
+			name:    "unsupported compression",
+			modify:  func(cfg *Config) { cfg.Compression = "zstd" },
+			wantErr: `unsupported "compression" "zstd", must be "none" or "gzip"`,
+		},
+		{
+			name: "no ack timeout",
+			modify: func(cfg *Config) {
+				cfg.RequireAck = true
+				cfg.AckTimeout = 0
+			},
+			wantErr: `requires "ack_timeout" > 0`,
+		},
+		{
+			name:    "invalid reconnect",
+			modify:  func(cfg *Config) { cfg.Reconnect.MaxInterval = time.Millisecond },
+			wantErr: `requires 0 < "reconnect.initial_interval" <= "reconnect.max_interval"`,
+		},
+		{
+			name:    "invalid reconnect max elapsed time",
+			modify:  func(cfg *Config) { cfg.Reconnect.MaxElapsedTime = 0 },
+			wantErr: `requires "reconnect.max_elapsed_time" > 0`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Endpoint = "localhost:24224"
+			require.NoError(t, cfg.Validate())
+			tt.modify(cfg)
+			assert.EqualError(t, cfg.Validate(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentforwardexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fluentforwardexporter"
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/tinylib/msgp/msgp"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// bodyKey is the key of the record holding the body of a log record, the
+// key used by Fluent Bit.
+const bodyKey = "log"
+
+// eventTime is the EventTime extension of the forward protocol encoding a
+// timestamp with nanosecond precision.
+type eventTime time.Time
+
+func (*eventTime) ExtensionType() int8 {
+	return 0x00
+}
+
+func (*eventTime) Len() int {
+	return 8
+}
+
+func (e *eventTime) MarshalBinaryTo(b []byte) error {
+	binary.BigEndian.PutUint32(b[0:], uint32(time.Time(*e).Unix()))
+	binary.BigEndian.PutUint32(b[4:], uint32(time.Time(*e).Nanosecond()))
+	return nil
+}
+
+func (e *eventTime) UnmarshalBinary(b []byte) error {
+	if len(b) != 8 {
+		return errors.New("data should be exactly 8 bytes")
+	}
+	*e = eventTime(time.Unix(int64(binary.BigEndian.Uint32(b[0:])), int64(binary.BigEndian.Uint32(b[4:]))))
+	return nil
+}
+
+// tagEntries holds the entries of the log records sharing a tag, encoded as
+// the entries of a PackedForward message.
+type tagEntries struct {
+	tag     string
+	entries []byte
+	size    int
+}
+
+// groupLogsByTag encodes the log records as entries grouped by tag, in the
+// order the tags first appear in ld.
+func groupLogsByTag(ld pdata.Logs, defaultTag string, tagAttribute string) ([]*tagEntries, error) {
+	var groups []*tagEntries
+	byTag := map[string]*tagEntries{}
+
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		resourceTag := defaultTag
+		if tagAttribute != "" {
+			if v, ok := rl.Resource().Attributes().Get(tagAttribute); ok && v.StringVal() != "" {
+				resourceTag = v.StringVal()
+			}
+		}
+
+		ills := rl.InstrumentationLibraryLogs()
+		for j := 0; j < ills.Len(); j++ {
+			logs := ills.At(j).Logs()
+			for k := 0; k < logs.Len(); k++ {
+				lr := logs.At(k)
+				tag := resourceTag
+				if tagAttribute != "" {
+					if v, ok := lr.Attributes().Get(tagAttribute); ok && v.StringVal() != "" {
+						tag = v.StringVal()
+					}
+				}
+
+				group, ok := byTag[tag]
+				if !ok {
+					group = &tagEntries{tag: tag}
+					byTag[tag] = group
+					groups = append(groups, group)
+				}
+
+				var err error
+				if group.entries, err = appendEntry(group.entries, rl.Resource().Attributes(), lr, tagAttribute); err != nil {
+					return nil, err
+				}
+				group.size++
+			}
+		}
+	}
+	return groups, nil
+}
+
+// appendEntry appends the [time, record] entry of the log record. The record
+// holds the attributes of the resource and of the log record, the latter
+// taking precedence, and the body under bodyKey.
+func appendEntry(b []byte, resourceAttrs pdata.AttributeMap, lr pdata.LogRecord, tagAttribute string) ([]byte, error) {
+	ts := lr.Timestamp().AsTime()
+	if lr.Timestamp() == 0 {
+		ts = time.Now()
+	}
+
+	record := pdata.NewAttributeMap()
+	resourceAttrs.CopyTo(record)
+	lr.Attributes().Range(func(k string, v pdata.AttributeValue) bool {
+		record.Upsert(k, v)
+		return true
+	})
+	if tagAttribute != "" {
+		record.Delete(tagAttribute)
+	}
+	if lr.Body().Type() != pdata.AttributeValueTypeEmpty {
+		record.Upsert(bodyKey, lr.Body())
+	}
+
+	b = msgp.AppendArrayHeader(b, 2)
+	et := eventTime(ts)
+	b, err := msgp.AppendExtension(b, &et)
+	if err != nil {
+		return nil, err
+	}
+	return appendAttributeMap(b, record), nil
+}
+
+func appendAttributeMap(b []byte, m pdata.AttributeMap) []byte {
+	b = msgp.AppendMapHeader(b, uint32(m.Len()))
+	m.Range(func(k string, v pdata.AttributeValue) bool {
+		b = msgp.AppendString(b, k)
+		b = appendAttributeValue(b, v)
+		return true
+	})
+	return b
+}
+
+func appendAttributeValue(b []byte, v pdata.AttributeValue) []byte {
+	switch v.Type() {
+	case pdata.AttributeValueTypeString:
+		return msgp.AppendString(b, v.StringVal())
+	case pdata.AttributeValueTypeInt:
+		return msgp.AppendInt64(b, v.IntVal())
+	case pdata.AttributeValueTypeDouble:
+		return msgp.AppendFloat64(b, v.DoubleVal())
+	case pdata.AttributeValueTypeBool:
+		return msgp.AppendBool(b, v.BoolVal())
+	case pdata.AttributeValueTypeBytes:
+		return msgp.AppendBytes(b, v.BytesVal())
+	case pdata.AttributeValueTypeMap:
+		return appendAttributeMap(b, v.MapVal())
+	case pdata.AttributeValueTypeArray:
+		values := v.SliceVal()
+		b = msgp.AppendArrayHeader(b, uint32(values.Len()))
+		for i := 0; i < values.Len(); i++ {
+			b = appendAttributeValue(b, values.At(i))
+		}
+		return b
+	default:
+		return msgp.AppendNil(b)
+	}
+}
+
+// appendPackedForward appends the PackedForward message of the entries, or
+// the CompressedPackedForward message if compressed is set. The chunk option
+// requesting an acknowledgement is only set if not empty.
+func appendPackedForward(b []byte, group *tagEntries, compressed bool, chunk string) ([]byte, error) {
+	entries := group.entries
+	if compressed {
+		var buf bytes.Buffer
+		gw := gzip.NewWriter(&buf)
+		if _, err := gw.Write(entries); err != nil {
+			return nil, err
+		}
+		if err := gw.Close(); err != nil {
+			return nil, err
+		}
+		entries = buf.Bytes()
+	}
+
+	options := uint32(1)
+	if chunk != "" {
+		options++
+	}
+	if compressed {
+		options++
+	}
+
+	b = msgp.AppendArrayHeader(b, 3)
+	b = msgp.AppendString(b, group.tag)
+	b = msgp.AppendBytes(b, entries)
+	b = msgp.AppendMapHeader(b, options)
+	b = msgp.AppendString(b, "size")
+	b = msgp.AppendInt(b, group.size)
+	if chunk != "" {
+		b = msgp.AppendString(b, "chunk")
+		b = msgp.AppendString(b, chunk)
+	}
+	if compressed {
+		b = msgp.AppendString(b, "compressed")
+		b = msgp.AppendString(b, CompressionGzip)
+	}
+	return b, nil
+}
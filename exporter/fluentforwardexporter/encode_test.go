@@ -0,0 +1,170 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentforwardexporter
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tinylib/msgp/msgp"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+func testLogs() pdata.Logs {
+	ld := pdata.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().InsertString("host.name", "host1")
+	rl.Resource().Attributes().InsertString("fluent.tag", "resource.tag")
+	logs := rl.InstrumentationLibraryLogs().AppendEmpty().Logs()
+
+	lr := logs.AppendEmpty()
+	lr.SetTimestamp(pdata.NewTimestampFromTime(time.Unix(1600000000, 123456789)))
+	lr.Body().SetStringVal("first")
+	lr.Attributes().InsertInt("count", 1)
+
+	lr = logs.AppendEmpty()
+	lr.SetTimestamp(pdata.NewTimestampFromTime(time.Unix(1600000001, 0)))
+	lr.Body().SetStringVal("second")
+	lr.Attributes().InsertString("fluent.tag", "record.tag")
+	lr.Attributes().InsertString("host.name", "host2")
+
+	lr = logs.AppendEmpty()
+	lr.SetTimestamp(pdata.NewTimestampFromTime(time.Unix(1600000002, 0)))
+	lr.Body().SetStringVal("third")
+	return ld
+}
+
+type decodedEntry struct {
+	time   time.Time
+	record map[string]interface{}
+}
+
+func decodeEntries(t *testing.T, entries []byte) []decodedEntry {
+	var out []decodedEntry
+	for len(entries) > 0 {
+		size, rest, err := msgp.ReadArrayHeaderBytes(entries)
+		require.NoError(t, err)
+		require.Equal(t, uint32(2), size)
+		var et eventTime
+		rest, err = msgp.ReadExtensionBytes(rest, &et)
+		require.NoError(t, err)
+		record, rest, err := msgp.ReadMapStrIntfBytes(rest, nil)
+		require.NoError(t, err)
+		out = append(out, decodedEntry{time: time.Time(et), record: record})
+		entries = rest
+	}
+	return out
+}
+
+func TestGroupLogsByTag(t *testing.T) {
+	groups, err := groupLogsByTag(testLogs(), "default", "fluent.tag")
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+
+	assert.Equal(t, "resource.tag", groups[0].tag)
+	assert.Equal(t, 2, groups[0].size)
+	entries := decodeEntries(t, groups[0].entries)
+	require.Len(t, entries, 2)
+	assert.True(t, time.Unix(1600000000, 123456789).Equal(entries[0].time))
+	assert.Equal(t, map[string]interface{}{"host.name": "host1", "count": int64(1), "log": "first"}, entries[0].record)
+	assert.Equal(t, map[string]interface{}{"host.name": "host1", "log": "third"}, entries[1].record)
+
+	assert.Equal(t, "record.tag", groups[1].tag)
+	assert.Equal(t, 1, groups[1].size)
+	entries = decodeEntries(t, groups[1].entries)
+	require.Len(t, entries, 1)
+	assert.Equal(t, map[string]interface{}{"host.name": "host2", "log": "second"}, entries[0].record)
+}
+
+func TestGroupLogsByTag_DefaultTag(t *testing.T) {
+	groups, err := groupLogsByTag(testLogs(), "default", "")
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	assert.Equal(t, "default", groups[0].tag)
+	assert.Equal(t, 3, groups[0].size)
+
+	entries := decodeEntries(t, groups[0].entries)
+	require.Len(t, entries, 3)
+	assert.Equal(t, "resource.tag", entries[0].record["fluent.tag"])
+	assert.Equal(t, "record.tag", entries[1].record["fluent.tag"])
+}
+
+func TestAppendAttributeValue(t *testing.T) {
+	m := pdata.NewAttributeMap()
+	m.InsertString("string", "value")
+	m.InsertBool("bool", true)
+	m.InsertDouble("double", 1.5)
+	m.InsertBytes("bytes", []byte{1, 2})
+	m.Insert("empty", pdata.NewAttributeValueEmpty())
+	nested := pdata.NewAttributeValueMap()
+	nested.MapVal().InsertInt("int", 2)
+	m.Insert("map", nested)
+	array := pdata.NewAttributeValueArray()
+	array.SliceVal().AppendEmpty().SetStringVal("a")
+	array.SliceVal().AppendEmpty().SetIntVal(3)
+	m.Insert("array", array)
+
+	decoded, rest, err := msgp.ReadMapStrIntfBytes(appendAttributeMap(nil, m), nil)
+	require.NoError(t, err)
+	assert.Empty(t, rest)
+	assert.Equal(t, map[string]interface{}{
+		"string": "value",
+		"bool":   true,
+		"double": 1.5,
+		"bytes":  []byte{1, 2},
+		"empty":  nil,
+		"map":    map[string]interface{}{"int": int64(2)},
+		"array":  []interface{}{"a", int64(3)},
+	}, decoded)
+}
+
+func TestAppendPackedForward(t *testing.T) {
+	groups, err := groupLogsByTag(testLogs(), "default", "")
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+
+	for _, compressed := range []bool{false, true} {
+		msg, err := appendPackedForward(nil, groups[0], compressed, "chunk-id")
+		require.NoError(t, err)
+
+		size, rest, err := msgp.ReadArrayHeaderBytes(msg)
+		require.NoError(t, err)
+		require.Equal(t, uint32(3), size)
+		tag, rest, err := msgp.ReadStringBytes(rest)
+		require.NoError(t, err)
+		assert.Equal(t, "default", tag)
+		entries, rest, err := msgp.ReadBytesBytes(rest, nil)
+		require.NoError(t, err)
+		options, rest, err := msgp.ReadMapStrIntfBytes(rest, nil)
+		require.NoError(t, err)
+		assert.Empty(t, rest)
+
+		if compressed {
+			assert.Equal(t, map[string]interface{}{"size": int64(3), "chunk": "chunk-id", "compressed": "gzip"}, options)
+			gr, err := gzip.NewReader(bytes.NewReader(entries))
+			require.NoError(t, err)
+			entries, err = io.ReadAll(gr)
+			require.NoError(t, err)
+		} else {
+			assert.Equal(t, map[string]interface{}{"size": int64(3), "chunk": "chunk-id"}, options)
+		}
+		assert.Equal(t, groups[0].entries, entries)
+	}
+}
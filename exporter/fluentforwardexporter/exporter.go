@@ -0,0 +1,297 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentforwardexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fluentforwardexporter"
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/tinylib/msgp/msgp"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/fluentforward"
+)
+
+type fluentExporter struct {
+	config       *Config
+	logger       *zap.Logger
+	tlsConfig    *tls.Config
+	selfHostname string
+
+	// mu guards the connection to the server, which is shared by the calls
+	// to pushLogsData. It is not held while connecting so that shutdown is
+	// not blocked by the backoff between the attempts.
+	mu      sync.Mutex
+	conn    net.Conn
+	reader  *msgp.Reader
+	stopped bool
+	// done is closed on shutdown to abort the attempts to connect.
+	done chan struct{}
+}
+
+var errConnectionClosed = errors.New("connection to the server closed")
+
+func newExporter(config *Config, logger *zap.Logger) *fluentExporter {
+	selfHostname := config.Security.SelfHostname
+	if selfHostname == "" {
+		selfHostname, _ = os.Hostname()
+	}
+	return &fluentExporter{
+		config:       config,
+		logger:       logger,
+		selfHostname: selfHostname,
+		done:         make(chan struct{}),
+	}
+}
+
+func (e *fluentExporter) start(context.Context, component.Host) error {
+	if e.config.TLSSetting == nil {
+		return nil
+	}
+	// LoadTLSConfig returns no configuration if the connection is insecure,
+	// in which case the logs are sent in plaintext.
+	tlsConfig, err := e.config.TLSSetting.LoadTLSConfig()
+	if err != nil {
+		return err
+	}
+	e.tlsConfig = tlsConfig
+	return nil
+}
+
+func (e *fluentExporter) shutdown(context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.stopped {
+		e.stopped = true
+		close(e.done)
+	}
+	e.closeConn()
+	return nil
+}
+
+// pushLogsData sends the log records as a PackedForward message per tag. If
+// an acknowledgement is required the logs are only considered sent once the
+// server acknowledged all the messages, which makes the delivery at least
+// once since the messages acknowledged before a failure are sent again when
+// the logs are retried.
+func (e *fluentExporter) pushLogsData(ctx context.Context, ld pdata.Logs) error {
+	groups, err := groupLogsByTag(ld, e.config.Tag, e.config.TagAttribute)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	if len(groups) == 0 {
+		return nil
+	}
+
+	if err = e.ensureConnection(ctx); err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.conn == nil {
+		// The exporter shut down or another call failed and closed the
+		// connection meanwhile.
+		return errConnectionClosed
+	}
+
+	for _, group := range groups {
+		if err = e.send(ctx, group); err != nil {
+			// The state of the connection is unknown, start over with a new one.
+			e.closeConn()
+			return err
+		}
+	}
+	return nil
+}
+
+// ensureConnection connects to the server if there is no connection yet.
+func (e *fluentExporter) ensureConnection(ctx context.Context) error {
+	e.mu.Lock()
+	connected := e.conn != nil
+	e.mu.Unlock()
+	if connected {
+		return nil
+	}
+
+	conn, reader, err := e.connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.stopped || e.conn != nil {
+		// The exporter shut down or another call connected meanwhile.
+		conn.Close()
+		return nil
+	}
+	e.conn = conn
+	e.reader = reader
+	return nil
+}
+
+func (e *fluentExporter) send(ctx context.Context, group *tagEntries) error {
+	var chunk string
+	if e.config.RequireAck {
+		id, err := fluentforward.RandomBytes()
+		if err != nil {
+			return err
+		}
+		chunk = base64.StdEncoding.EncodeToString(id)
+	}
+	msg, err := appendPackedForward(nil, group, e.config.Compression == CompressionGzip, chunk)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+
+	deadline, _ := ctx.Deadline()
+	if err = e.conn.SetWriteDeadline(deadline); err != nil {
+		return err
+	}
+	if _, err = e.conn.Write(msg); err != nil {
+		return fmt.Errorf("failed to send the logs of tag %q: %w", group.tag, err)
+	}
+
+	if chunk != "" {
+		return e.waitForAck(ctx, chunk)
+	}
+	return nil
+}
+
+// waitForAck reads the acknowledgement of the chunk sent by the server, for
+// at most the ack timeout or until the deadline of ctx if it is earlier.
+func (e *fluentExporter) waitForAck(ctx context.Context, chunk string) error {
+	deadline := time.Now().Add(e.config.AckTimeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	if err := e.conn.SetReadDeadline(deadline); err != nil {
+		return err
+	}
+	ack, err := readAck(e.reader)
+	if err != nil {
+		return fmt.Errorf("failed to read the acknowledgement of chunk %s: %w", chunk, err)
+	}
+	if ack != chunk {
+		return fmt.Errorf("unexpected acknowledgement of chunk %s instead of %s", ack, chunk)
+	}
+	return nil
+}
+
+func readAck(reader *msgp.Reader) (string, error) {
+	size, err := reader.ReadMapHeader()
+	if err != nil {
+		return "", err
+	}
+	var ack string
+	for ; size > 0; size-- {
+		key, err := reader.ReadString()
+		if err != nil {
+			return "", err
+		}
+		if key == "ack" {
+			ack, err = reader.ReadString()
+		} else {
+			err = reader.Skip()
+		}
+		if err != nil {
+			return "", msgp.WrapError(err, key)
+		}
+	}
+	return ack, nil
+}
+
+// connect connects to the server, retrying with an exponential backoff until
+// it succeeds, the server rejects the exporter, the max elapsed time is
+// reached, ctx is done or the exporter shuts down.
+func (e *fluentExporter) connect(ctx context.Context) (net.Conn, *msgp.Reader, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-e.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	expBackoff := backoff.NewExponentialBackOff()
+	expBackoff.InitialInterval = e.config.Reconnect.InitialInterval
+	expBackoff.MaxInterval = e.config.Reconnect.MaxInterval
+	expBackoff.MaxElapsedTime = e.config.Reconnect.MaxElapsedTime
+
+	var conn net.Conn
+	var reader *msgp.Reader
+	err := backoff.RetryNotify(func() error {
+		var err error
+		if conn, err = e.dial(ctx); err != nil {
+			return err
+		}
+		reader = msgp.NewReader(conn)
+		if e.config.Security.SharedKey != "" {
+			if err = handshake(conn, reader, e.config.Security, e.selfHostname); err != nil {
+				conn.Close()
+				if errors.Is(err, errAuthenticationFailed) {
+					return backoff.Permanent(err)
+				}
+				return err
+			}
+		}
+		return nil
+	}, backoff.WithContext(expBackoff, ctx), func(err error, wait time.Duration) {
+		e.logger.Debug("Failed to connect to the server, retrying", zap.String("endpoint", e.config.Endpoint), zap.Duration("wait", wait), zap.Error(err))
+	})
+	if errors.Is(err, errAuthenticationFailed) {
+		return nil, nil, consumererror.NewPermanent(err)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to %s: %w", e.config.Endpoint, err)
+	}
+	return conn, reader, nil
+}
+
+func (e *fluentExporter) dial(ctx context.Context) (net.Conn, error) {
+	network, address := "tcp", e.config.Endpoint
+	if strings.HasPrefix(address, "unix://") {
+		network, address = "unix", strings.TrimPrefix(address, "unix://")
+	}
+	if e.tlsConfig != nil {
+		dialer := &tls.Dialer{Config: e.tlsConfig}
+		return dialer.DialContext(ctx, network, address)
+	}
+	var dialer net.Dialer
+	return dialer.DialContext(ctx, network, address)
+}
+
+func (e *fluentExporter) closeConn() {
+	if e.conn != nil {
+		e.conn.Close()
+		e.conn = nil
+		e.reader = nil
+	}
+}
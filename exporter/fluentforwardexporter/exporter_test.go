@@ -0,0 +1,418 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentforwardexporter
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/testutil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/fluentforwardreceiver"
+)
+
+// startReceiver starts a Fluent Forward receiver as the server of the exporter.
+func startReceiver(t *testing.T, modify func(cfg *fluentforwardreceiver.Config)) (string, *consumertest.LogsSink) {
+	factory := fluentforwardreceiver.NewFactory()
+	cfg := factory.CreateDefaultConfig().(*fluentforwardreceiver.Config)
+	cfg.ListenAddress = testutil.GetAvailableLocalAddress(t)
+	if modify != nil {
+		modify(cfg)
+	}
+
+	sink := new(consumertest.LogsSink)
+	rcv, err := factory.CreateLogsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, rcv.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, rcv.Shutdown(context.Background()))
+	})
+	return cfg.ListenAddress, sink
+}
+
+func newTestExporter(t *testing.T, endpoint string, modify func(cfg *Config)) *fluentExporter {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = endpoint
+	cfg.AckTimeout = 5 * time.Second
+	cfg.Reconnect.InitialInterval = 10 * time.Millisecond
+	cfg.Reconnect.MaxInterval = 100 * time.Millisecond
+	if modify != nil {
+		modify(cfg)
+	}
+	require.NoError(t, cfg.Validate())
+
+	exp := newExporter(cfg, zap.NewNop())
+	require.NoError(t, exp.start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, exp.shutdown(context.Background()))
+	})
+	return exp
+}
+
+func pushLogs(t *testing.T, exp *fluentExporter, ld pdata.Logs) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return exp.pushLogsData(ctx, ld)
+}
+
+// receivedRecords returns the log records received by the sink, keyed by body.
+func receivedRecords(t *testing.T, sink *consumertest.LogsSink, count int) map[string]pdata.LogRecord {
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() >= count
+	}, 5*time.Second, 10*time.Millisecond)
+
+	records := map[string]pdata.LogRecord{}
+	for _, ld := range sink.AllLogs() {
+		rls := ld.ResourceLogs()
+		for i := 0; i < rls.Len(); i++ {
+			ills := rls.At(i).InstrumentationLibraryLogs()
+			for j := 0; j < ills.Len(); j++ {
+				logs := ills.At(j).Logs()
+				for k := 0; k < logs.Len(); k++ {
+					records[logs.At(k).Body().StringVal()] = logs.At(k)
+				}
+			}
+		}
+	}
+	assert.Len(t, records, count)
+	return records
+}
+
+func TestPushLogsData(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(cfg *Config)
+	}{
+		{
+			name: "packed forward",
+		},
+		{
+			name: "compressed packed forward",
+			modify: func(cfg *Config) {
+				cfg.Compression = CompressionGzip
+			},
+		},
+		{
+			name: "ack",
+			modify: func(cfg *Config) {
+				cfg.RequireAck = true
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint, sink := startReceiver(t, nil)
+			exp := newTestExporter(t, endpoint, tt.modify)
+
+			require.NoError(t, pushLogs(t, exp, testLogs()))
+			// The connection is reused by the next push.
+			require.NoError(t, pushLogs(t, exp, pdata.NewLogs()))
+
+			records := receivedRecords(t, sink, 3)
+			first := records["first"]
+			assert.Equal(t, pdata.NewTimestampFromTime(time.Unix(1600000000, 123456789)), first.Timestamp())
+			assert.Equal(t, map[string]interface{}{
+				"fluent.tag": "resource.tag",
+				"host.name":  "host1",
+				"count":      int64(1),
+			}, first.Attributes().AsRaw())
+
+			second := records["second"]
+			tag, _ := second.Attributes().Get("fluent.tag")
+			assert.Equal(t, "record.tag", tag.StringVal())
+			host, _ := second.Attributes().Get("host.name")
+			assert.Equal(t, "host2", host.StringVal())
+		})
+	}
+}
+
+func TestPushLogsData_Reconnect(t *testing.T) {
+	endpoint, sink := startReceiver(t, nil)
+	exp := newTestExporter(t, endpoint, func(cfg *Config) {
+		cfg.RequireAck = true
+	})
+
+	require.NoError(t, pushLogs(t, exp, testLogs()))
+	receivedRecords(t, sink, 3)
+
+	// The server closing the connection fails the next push, the one after
+	// reconnects.
+	exp.mu.Lock()
+	require.NoError(t, exp.conn.(*net.TCPConn).CloseRead())
+	exp.mu.Unlock()
+	assert.Error(t, pushLogs(t, exp, testLogs()))
+	require.Nil(t, exp.conn)
+
+	sink.Reset()
+	require.NoError(t, pushLogs(t, exp, testLogs()))
+	receivedRecords(t, sink, 3)
+}
+
+func TestPushLogsData_ConnectionRefused(t *testing.T) {
+	exp := newTestExporter(t, testutil.GetAvailableLocalAddress(t), nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err := exp.pushLogsData(ctx, testLogs())
+	require.Error(t, err)
+	assert.False(t, consumererror.IsPermanent(err))
+}
+
+func TestPushLogsData_ShutdownWhileConnecting(t *testing.T) {
+	exp := newTestExporter(t, testutil.GetAvailableLocalAddress(t), func(cfg *Config) {
+		cfg.Reconnect.InitialInterval = time.Second
+		cfg.Reconnect.MaxInterval = time.Second
+	})
+
+	errs := make(chan error, 1)
+	go func() {
+		// No deadline, only the shutdown stops the attempts to connect.
+		errs <- exp.pushLogsData(context.Background(), testLogs())
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	shutdownDone := make(chan struct{})
+	go func() {
+		assert.NoError(t, exp.shutdown(context.Background()))
+		close(shutdownDone)
+	}()
+	select {
+	case <-shutdownDone:
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("shutdown blocked by the attempts to connect")
+	}
+	select {
+	case err := <-errs:
+		assert.Error(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the push did not stop on shutdown")
+	}
+}
+
+func TestPushLogsData_ReconnectMaxElapsedTime(t *testing.T) {
+	exp := newTestExporter(t, testutil.GetAvailableLocalAddress(t), func(cfg *Config) {
+		cfg.Reconnect.MaxElapsedTime = 100 * time.Millisecond
+	})
+
+	// No deadline, only the max elapsed time stops the attempts to connect.
+	err := exp.pushLogsData(context.Background(), testLogs())
+	require.Error(t, err)
+	assert.False(t, consumererror.IsPermanent(err))
+}
+
+func TestPushLogsData_AckTimeout(t *testing.T) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		// Accept the connection but never acknowledge the messages.
+		conn, err := listener.Accept()
+		if err == nil {
+			defer conn.Close()
+			time.Sleep(time.Second)
+		}
+	}()
+
+	exp := newTestExporter(t, listener.Addr().String(), func(cfg *Config) {
+		cfg.RequireAck = true
+		cfg.AckTimeout = 50 * time.Millisecond
+	})
+	err = pushLogs(t, exp, testLogs())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read the acknowledgement of chunk")
+	assert.False(t, consumererror.IsPermanent(err))
+}
+
+func TestPushLogsData_AckContextDeadline(t *testing.T) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		// Accept the connection but never acknowledge the messages.
+		conn, err := listener.Accept()
+		if err == nil {
+			defer conn.Close()
+			time.Sleep(time.Second)
+		}
+	}()
+
+	exp := newTestExporter(t, listener.Addr().String(), func(cfg *Config) {
+		cfg.RequireAck = true
+		cfg.AckTimeout = time.Minute
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err = exp.pushLogsData(ctx, testLogs())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read the acknowledgement of chunk")
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+}
+
+func TestPushLogsData_Handshake(t *testing.T) {
+	tests := []struct {
+		name      string
+		sharedKey string
+		username  string
+		password  string
+		wantErr   string
+	}{
+		{
+			name:      "authenticated",
+			sharedKey: "secret",
+			username:  "alice",
+			password:  "password",
+		},
+		{
+			name:      "shared key mismatch",
+			sharedKey: "wrong",
+			username:  "alice",
+			password:  "password",
+			wantErr:   "authentication failed: shared key mismatch",
+		},
+		{
+			name:      "password mismatch",
+			sharedKey: "secret",
+			username:  "alice",
+			password:  "wrong",
+			wantErr:   "authentication failed: username/password mismatch",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint, sink := startReceiver(t, func(cfg *fluentforwardreceiver.Config) {
+				cfg.Security = fluentforwardreceiver.SecuritySettings{
+					SelfHostname: "server",
+					SharedKey:    "secret",
+					Users:        []fluentforwardreceiver.UserSettings{{Username: "alice", Password: "password"}},
+				}
+			})
+			exp := newTestExporter(t, endpoint, func(cfg *Config) {
+				cfg.RequireAck = true
+				cfg.Security = SecuritySettings{
+					SelfHostname: "client",
+					SharedKey:    tt.sharedKey,
+					Username:     tt.username,
+					Password:     tt.password,
+				}
+			})
+
+			err := pushLogs(t, exp, testLogs())
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				assert.True(t, consumererror.IsPermanent(err))
+				return
+			}
+			require.NoError(t, err)
+			receivedRecords(t, sink, 3)
+		})
+	}
+}
+
+func TestPushLogsData_TLS(t *testing.T) {
+	certFile, keyFile := generateCertificate(t)
+	tests := []struct {
+		name      string
+		serverTLS bool
+		clientTLS configtls.TLSClientSetting
+	}{
+		{
+			name:      "verified",
+			serverTLS: true,
+			clientTLS: configtls.TLSClientSetting{
+				TLSSetting: configtls.TLSSetting{CAFile: certFile},
+				ServerName: "localhost",
+			},
+		},
+		{
+			name:      "insecure_skip_verify",
+			serverTLS: true,
+			clientTLS: configtls.TLSClientSetting{InsecureSkipVerify: true},
+		},
+		{
+			name:      "insecure",
+			clientTLS: configtls.TLSClientSetting{Insecure: true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			endpoint, sink := startReceiver(t, func(cfg *fluentforwardreceiver.Config) {
+				if test.serverTLS {
+					cfg.TLSSetting = &configtls.TLSServerSetting{
+						TLSSetting: configtls.TLSSetting{CertFile: certFile, KeyFile: keyFile},
+					}
+				}
+			})
+			exp := newTestExporter(t, endpoint, func(cfg *Config) {
+				tlsSetting := test.clientTLS
+				cfg.TLSSetting = &tlsSetting
+				cfg.RequireAck = true
+			})
+
+			require.NoError(t, pushLogs(t, exp, testLogs()))
+			receivedRecords(t, sink, 3)
+		})
+	}
+}
+
+// generateCertificate writes a self-signed certificate for localhost and its
+// key, returning their paths.
+func generateCertificate(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}), 0600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}), 0600))
+	return certFile, keyFile
+}
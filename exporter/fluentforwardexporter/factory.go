@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentforwardexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fluentforwardexporter"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+)
+
+const (
+	// The value of "type" key in configuration.
+	typeStr = "fluentforward"
+
+	defaultTag                      = "otel"
+	defaultTagAttribute             = "fluent.tag"
+	defaultAckTimeout               = 30 * time.Second
+	defaultReconnectInitialInterval = time.Second
+	defaultReconnectMaxInterval     = 30 * time.Second
+	defaultReconnectMaxElapsedTime  = time.Minute
+)
+
+// NewFactory creates a factory for the Fluent Forward exporter.
+func NewFactory() component.ExporterFactory {
+	return exporterhelper.NewFactory(
+		typeStr,
+		createDefaultConfig,
+		exporterhelper.WithLogs(createLogsExporter))
+}
+
+func createDefaultConfig() config.Exporter {
+	return &Config{
+		ExporterSettings: config.NewExporterSettings(config.NewComponentID(typeStr)),
+		TimeoutSettings:  exporterhelper.DefaultTimeoutSettings(),
+		QueueSettings:    exporterhelper.DefaultQueueSettings(),
+		RetrySettings:    exporterhelper.DefaultRetrySettings(),
+		Tag:              defaultTag,
+		TagAttribute:     defaultTagAttribute,
+		Compression:      CompressionNone,
+		AckTimeout:       defaultAckTimeout,
+		Reconnect: ReconnectSettings{
+			InitialInterval: defaultReconnectInitialInterval,
+			MaxInterval:     defaultReconnectMaxInterval,
+			MaxElapsedTime:  defaultReconnectMaxElapsedTime,
+		},
+	}
+}
+
+func createLogsExporter(
+	_ context.Context,
+	set component.ExporterCreateSettings,
+	cfg config.Exporter,
+) (component.LogsExporter, error) {
+	oCfg := cfg.(*Config)
+	exp := newExporter(oCfg, set.Logger)
+
+	return exporterhelper.NewLogsExporter(
+		cfg,
+		set,
+		exp.pushLogsData,
+		exporterhelper.WithStart(exp.start),
+		exporterhelper.WithShutdown(exp.shutdown),
+		exporterhelper.WithTimeout(oCfg.TimeoutSettings),
+		exporterhelper.WithQueue(oCfg.QueueSettings),
+		exporterhelper.WithRetry(oCfg.RetrySettings),
+	)
+}
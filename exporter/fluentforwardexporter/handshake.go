@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentforwardexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fluentforwardexporter"
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/tinylib/msgp/msgp"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/fluentforward"
+)
+
+// handshakeTimeout bounds the time the server has to authenticate the exporter.
+const handshakeTimeout = 10 * time.Second
+
+var errAuthenticationFailed = errors.New("authentication failed")
+
+// handshake authenticates the exporter to the server as per the handshake
+// phase of the forward protocol: the server sends a HELO message, the
+// exporter answers with a PING message holding the digest of the shared key
+// and optionally its credentials, and the server replies with a PONG message
+// holding the result and its own digest of the shared key that is checked to
+// authenticate the server.
+func handshake(conn net.Conn, reader *msgp.Reader, security SecuritySettings, selfHostname string) error {
+	if err := conn.SetDeadline(time.Now().Add(handshakeTimeout)); err != nil {
+		return err
+	}
+
+	helo, err := fluentforward.ReadHelo(reader)
+	if err != nil {
+		return fmt.Errorf("failed to read HELO: %w", err)
+	}
+
+	sharedKeySalt, err := fluentforward.RandomBytes()
+	if err != nil {
+		return err
+	}
+	ping := &fluentforward.Ping{
+		Hostname:        selfHostname,
+		SharedKeySalt:   sharedKeySalt,
+		SharedKeyDigest: fluentforward.Digest(sharedKeySalt, []byte(selfHostname), helo.Nonce, []byte(security.SharedKey)),
+		Username:        security.Username,
+	}
+	if len(helo.AuthSalt) > 0 {
+		ping.PasswordDigest = fluentforward.Digest(helo.AuthSalt, []byte(security.Username), []byte(security.Password))
+	}
+	if _, err = conn.Write(fluentforward.AppendPing(nil, ping)); err != nil {
+		return fmt.Errorf("failed to send PING: %w", err)
+	}
+
+	pong, err := fluentforward.ReadPong(reader)
+	if err != nil {
+		return fmt.Errorf("failed to read PONG: %w", err)
+	}
+	if !pong.Authenticated {
+		return fmt.Errorf("%w: %s", errAuthenticationFailed, pong.Reason)
+	}
+	if !fluentforward.DigestsEqual(pong.SharedKeyDigest, fluentforward.Digest(sharedKeySalt, []byte(pong.Hostname), helo.Nonce, []byte(security.SharedKey))) {
+		return fmt.Errorf("%w: shared key mismatch of server %q", errAuthenticationFailed, pong.Hostname)
+	}
+
+	return conn.SetDeadline(time.Time{})
+}
@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lokiexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/lokiexporter"
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"go.uber.org/zap"
+)
+
+// overflowLabelValue replaces the values of a label exceeding the cardinality limit when folding.
+const overflowLabelValue = "__overflow__"
+
+// cardinalityLimiter bounds the number of distinct values seen for each label during a reset
+// interval.
+type cardinalityLimiter struct {
+	maxValues     int
+	fold          bool
+	resetInterval time.Duration
+	logger        *zap.Logger
+
+	mu          sync.Mutex
+	values      map[model.LabelName]map[model.LabelValue]struct{}
+	limited     map[model.LabelName]bool
+	windowStart time.Time
+}
+
+func newCardinalityLimiter(cfg CardinalityLimitConfig, logger *zap.Logger) *cardinalityLimiter {
+	if cfg.MaxValues <= 0 {
+		return nil
+	}
+	return &cardinalityLimiter{
+		maxValues:     cfg.MaxValues,
+		fold:          cfg.Action == cardinalityActionFold,
+		resetInterval: cfg.ResetInterval,
+		logger:        logger,
+		values:        make(map[model.LabelName]map[model.LabelValue]struct{}),
+		limited:       make(map[model.LabelName]bool),
+		windowStart:   time.Now(),
+	}
+}
+
+// limit drops or folds, in place, the labels of ls whose value would exceed the limit.
+func (c *cardinalityLimiter) limit(ls model.LabelSet) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// The values seen are forgotten at each interval so that the labels whose values changed
+	// over time, e.g. the pods of a deployment, are not limited forever.
+	if c.resetInterval > 0 && time.Since(c.windowStart) >= c.resetInterval {
+		c.values = make(map[model.LabelName]map[model.LabelValue]struct{})
+		c.limited = make(map[model.LabelName]bool)
+		c.windowStart = time.Now()
+	}
+
+	for name, value := range ls {
+		seen, ok := c.values[name]
+		if !ok {
+			seen = make(map[model.LabelValue]struct{})
+			c.values[name] = seen
+		}
+		if _, ok := seen[value]; ok {
+			continue
+		}
+		if len(seen) < c.maxValues {
+			seen[value] = struct{}{}
+			continue
+		}
+
+		if !c.limited[name] {
+			c.limited[name] = true
+			c.logger.Warn("label exceeded the cardinality limit, new values are no longer sent to Loki",
+				zap.String("label", string(name)),
+				zap.Int("max_values", c.maxValues),
+				zap.Bool("fold", c.fold))
+		}
+		if c.fold {
+			ls[name] = overflowLabelValue
+		} else {
+			delete(ls, name)
+		}
+	}
+}
@@ -17,6 +17,8 @@ package lokiexporter // import "github.com/open-telemetry/opentelemetry-collecto
 import (
 	"fmt"
 	"net/url"
+	"text/template"
+	"time"
 
 	"github.com/prometheus/common/model"
 	"go.opentelemetry.io/collector/config"
@@ -34,17 +36,33 @@ type Config struct {
 	// TenantID defines the tenant ID to associate log streams with.
 	TenantID string `mapstructure:"tenant_id"`
 
+	// TenantAttribute is the log record attribute, or resource attribute, holding the tenant ID
+	// of the log record. Log records without this attribute are associated with TenantID.
+	TenantAttribute string `mapstructure:"tenant_attribute"`
+
 	// Labels defines how labels should be applied to log streams sent to Loki.
 	Labels LabelsConfig `mapstructure:"labels"`
 	// Allows you to choose the entry format in the exporter
 	Format string `mapstructure:"format"`
 }
 
+const (
+	formatBody   = "body"
+	formatJSON   = "json"
+	formatLogfmt = "logfmt"
+)
+
 func (c *Config) validate() error {
 	if _, err := url.Parse(c.Endpoint); c.Endpoint == "" || err != nil {
 		return fmt.Errorf("\"endpoint\" must be a valid URL")
 	}
 
+	switch c.Format {
+	case "", formatBody, formatJSON, formatLogfmt:
+	default:
+		return fmt.Errorf("\"format\" must be one of %q, %q or %q", formatBody, formatJSON, formatLogfmt)
+	}
+
 	return c.Labels.validate()
 }
 
@@ -55,11 +73,39 @@ type LabelsConfig struct {
 
 	// ResourceAttributes are the resource attributes that are allowed to be added as labels on a log stream.
 	ResourceAttributes map[string]string `mapstructure:"resource"`
+
+	// Templates are labels whose value is built from a Go template executed with the log record
+	// attributes as `.attributes`, the resource attributes as `.resource` and the severity text
+	// as `.severity`. Labels with an empty value are not added.
+	Templates map[string]string `mapstructure:"templates"`
+
+	// CardinalityLimit bounds the number of distinct values of each label.
+	CardinalityLimit CardinalityLimitConfig `mapstructure:"cardinality_limit"`
+}
+
+const (
+	cardinalityActionDrop = "drop"
+	cardinalityActionFold = "fold"
+)
+
+// CardinalityLimitConfig defines the cardinality guard of the labels.
+type CardinalityLimitConfig struct {
+	// MaxValues is the number of distinct values of a label after which new values are
+	// dropped or folded. Zero disables the guard.
+	MaxValues int `mapstructure:"max_values"`
+
+	// Action is what happens to the values exceeding MaxValues: "drop" removes the label from
+	// the log stream, "fold" replaces the value with "__overflow__".
+	Action string `mapstructure:"action"`
+
+	// ResetInterval is the interval at which the values seen are forgotten, so that labels
+	// whose values change over time are not limited forever. Zero never forgets them.
+	ResetInterval time.Duration `mapstructure:"reset_interval"`
 }
 
 func (c *LabelsConfig) validate() error {
-	if len(c.Attributes) == 0 && len(c.ResourceAttributes) == 0 {
-		return fmt.Errorf("\"labels.attributes\" or \"labels.resource\" must be configured with at least one attribute")
+	if len(c.Attributes) == 0 && len(c.ResourceAttributes) == 0 && len(c.Templates) == 0 {
+		return fmt.Errorf("\"labels.attributes\", \"labels.resource\" or \"labels.templates\" must be configured with at least one attribute")
 	}
 
 	logRecordNameInvalidErr := "the label `%s` in \"labels.attributes\" is not a valid label name. Label names must match " + model.LabelNameRE.String()
@@ -80,9 +126,32 @@ func (c *LabelsConfig) validate() error {
 		}
 	}
 
+	for l, text := range c.Templates {
+		if !model.LabelName(l).IsValid() {
+			return fmt.Errorf("the label `%s` in \"labels.templates\" is not a valid label name. Label names must match "+model.LabelNameRE.String(), l)
+		}
+		if _, err := parseLabelTemplate(l, text); err != nil {
+			return fmt.Errorf("the template of the label `%s` in \"labels.templates\" is invalid: %w", l, err)
+		}
+	}
+
+	if c.CardinalityLimit.MaxValues < 0 {
+		return fmt.Errorf("\"labels.cardinality_limit.max_values\" must not be negative")
+	}
+	if c.CardinalityLimit.MaxValues > 0 && c.CardinalityLimit.Action != cardinalityActionDrop && c.CardinalityLimit.Action != cardinalityActionFold {
+		return fmt.Errorf("\"labels.cardinality_limit.action\" must be %q or %q", cardinalityActionDrop, cardinalityActionFold)
+	}
+	if c.CardinalityLimit.ResetInterval < 0 {
+		return fmt.Errorf("\"labels.cardinality_limit.reset_interval\" must not be negative")
+	}
+
 	return nil
 }
 
+func parseLabelTemplate(label string, text string) (*template.Template, error) {
+	return template.New(label).Option("missingkey=zero").Parse(text)
+}
+
 // getAttributes creates a lookup of allowed attributes to valid Loki label names.
 func (c *LabelsConfig) getAttributes(labels map[string]string) map[string]model.LabelName {
 
@@ -75,7 +75,8 @@ func TestLoadConfig(t *testing.T) {
 			NumConsumers: 2,
 			QueueSize:    10,
 		},
-		TenantID: "example",
+		TenantID:        "example",
+		TenantAttribute: "tenant.id",
 		Labels: LabelsConfig{
 			Attributes: map[string]string{
 				conventions.AttributeContainerName:  "container_name",
@@ -86,6 +87,14 @@ func TestLoadConfig(t *testing.T) {
 				"resource.name": "resource_name",
 				"severity":      "severity",
 			},
+			Templates: map[string]string{
+				"service": `{{ index .resource "service.namespace" }}/{{ index .resource "service.name" }}`,
+			},
+			CardinalityLimit: CardinalityLimitConfig{
+				MaxValues:     100,
+				Action:        "fold",
+				ResetInterval: 30 * time.Minute,
+			},
 		},
 		Format: "body",
 	}
@@ -138,6 +147,11 @@ func TestJSONLoadConfig(t *testing.T) {
 		Labels: LabelsConfig{
 			Attributes:         map[string]string{},
 			ResourceAttributes: map[string]string{},
+			Templates:          map[string]string{},
+			CardinalityLimit: CardinalityLimitConfig{
+				Action:        "drop",
+				ResetInterval: time.Hour,
+			},
 		},
 		Format: "json",
 	}
@@ -160,6 +174,7 @@ func TestConfig_validate(t *testing.T) {
 		CredentialFile string
 		Audience       string
 		Labels         LabelsConfig
+		Format         string
 	}
 	tests := []struct {
 		name         string
@@ -202,7 +217,7 @@ func TestConfig_validate(t *testing.T) {
 					ResourceAttributes: nil,
 				},
 			},
-			errorMessage: "\"labels.attributes\" or \"labels.resource\" must be configured with at least one attribute",
+			errorMessage: "\"labels.attributes\", \"labels.resource\" or \"labels.templates\" must be configured with at least one attribute",
 			shouldError:  true,
 		},
 		{
@@ -221,6 +236,25 @@ func TestConfig_validate(t *testing.T) {
 			},
 			shouldError: false,
 		},
+		{
+			name: "with logfmt format",
+			fields: fields{
+				Endpoint: validEndpoint,
+				Labels:   validAttribLabelsConfig,
+				Format:   "logfmt",
+			},
+			shouldError: false,
+		},
+		{
+			name: "with invalid format",
+			fields: fields{
+				Endpoint: validEndpoint,
+				Labels:   validAttribLabelsConfig,
+				Format:   "xml",
+			},
+			errorMessage: "\"format\" must be one of \"body\", \"json\" or \"logfmt\"",
+			shouldError:  true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -230,6 +264,9 @@ func TestConfig_validate(t *testing.T) {
 			cfg.ExporterSettings = config.NewExporterSettings(config.NewComponentID(typeStr))
 			cfg.Endpoint = tt.fields.Endpoint
 			cfg.Labels = tt.fields.Labels
+			if tt.fields.Format != "" {
+				cfg.Format = tt.fields.Format
+			}
 
 			err := cfg.validate()
 			if (err != nil) != tt.shouldError {
@@ -260,7 +297,7 @@ func TestLabelsConfig_validate(t *testing.T) {
 				Attributes:         map[string]string{},
 				ResourceAttributes: map[string]string{},
 			},
-			errorMessage: "\"labels.attributes\" or \"labels.resource\" must be configured with at least one attribute",
+			errorMessage: "\"labels.attributes\", \"labels.resource\" or \"labels.templates\" must be configured with at least one attribute",
 			shouldError:  true,
 		},
 		{
@@ -311,6 +348,76 @@ func TestLabelsConfig_validate(t *testing.T) {
 			errorMessage: "the label `invalid.attribute` in \"labels.attributes\" is not a valid label name. Label names must match " + model.LabelNameRE.String(),
 			shouldError:  true,
 		},
+		{
+			name: "with valid template",
+			labels: LabelsConfig{
+				Templates: map[string]string{
+					"service": `{{ index .resource "service.name" }}`,
+				},
+			},
+			shouldError: false,
+		},
+		{
+			name: "with template having an invalid label name",
+			labels: LabelsConfig{
+				Templates: map[string]string{
+					"invalid.label": `{{ .severity }}`,
+				},
+			},
+			errorMessage: "the label `invalid.label` in \"labels.templates\" is not a valid label name. Label names must match " + model.LabelNameRE.String(),
+			shouldError:  true,
+		},
+		{
+			name: "with invalid template",
+			labels: LabelsConfig{
+				Templates: map[string]string{
+					"service": `{{ .severity `,
+				},
+			},
+			shouldError: true,
+		},
+		{
+			name: "with cardinality limit and invalid action",
+			labels: LabelsConfig{
+				Attributes: map[string]string{
+					"some.attribute": "some_attribute",
+				},
+				CardinalityLimit: CardinalityLimitConfig{
+					MaxValues: 10,
+					Action:    "ignore",
+				},
+			},
+			errorMessage: "\"labels.cardinality_limit.action\" must be \"drop\" or \"fold\"",
+			shouldError:  true,
+		},
+		{
+			name: "with negative cardinality limit",
+			labels: LabelsConfig{
+				Attributes: map[string]string{
+					"some.attribute": "some_attribute",
+				},
+				CardinalityLimit: CardinalityLimitConfig{
+					MaxValues: -1,
+				},
+			},
+			errorMessage: "\"labels.cardinality_limit.max_values\" must not be negative",
+			shouldError:  true,
+		},
+		{
+			name: "with negative cardinality reset interval",
+			labels: LabelsConfig{
+				Attributes: map[string]string{
+					"some.attribute": "some_attribute",
+				},
+				CardinalityLimit: CardinalityLimitConfig{
+					MaxValues:     10,
+					Action:        "drop",
+					ResetInterval: -time.Minute,
+				},
+			},
+			errorMessage: "\"labels.cardinality_limit.reset_interval\" must not be negative",
+			shouldError:  true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lokiexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/lokiexporter"
+
+import (
+	"bytes"
+
+	"github.com/go-logfmt/logfmt"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// encodeLogfmt renders the log record as logfmt, using the same keys as the JSON format. Attributes
+// and resource attributes are flattened with the "attributes." and "resources." prefixes.
+func encodeLogfmt(lr pdata.LogRecord, res pdata.Resource) (string, error) {
+	var buf bytes.Buffer
+	enc := logfmt.NewEncoder(&buf)
+
+	var err error
+	add := func(key string, value string) {
+		if err != nil || value == "" {
+			return
+		}
+		err = enc.EncodeKeyval(key, value)
+	}
+
+	add("name", lr.Name())
+	add("body", lr.Body().AsString())
+	add("traceid", lr.TraceID().HexString())
+	add("spanid", lr.SpanID().HexString())
+	add("severity", lr.SeverityText())
+	lr.Attributes().Range(func(k string, v pdata.AttributeValue) bool {
+		add("attributes."+k, v.AsString())
+		return err == nil
+	})
+	res.Attributes().Range(func(k string, v pdata.AttributeValue) bool {
+		add("resources."+k, v.AsString())
+		return err == nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lokiexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+func TestEncodeLogfmtWithStringBody(t *testing.T) {
+	in := `name=name body="Example log" traceid=01020304000000000000000000000000 spanid=0506070800000000 severity=error attributes.attr1=1 attributes.attr2=2 resources.host.name=something`
+
+	out, err := encodeLogfmt(exampleLog())
+	assert.NoError(t, err)
+	assert.Equal(t, in, out)
+}
+
+func TestEncodeLogfmtWithMapBody(t *testing.T) {
+	in := `name=name body="{\"key1\":\"value\",\"key2\":2}" traceid=01020304000000000000000000000000 spanid=0506070800000000 severity=error attributes.attr1=1 attributes.attr2=2 resources.host.name=something`
+
+	log, resource := exampleLog()
+	mapVal := pdata.NewAttributeValueMap()
+	mapVal.MapVal().InsertString("key1", "value")
+	mapVal.MapVal().InsertInt("key2", 2)
+	mapVal.CopyTo(log.Body())
+
+	out, err := encodeLogfmt(log, resource)
+	assert.NoError(t, err)
+	assert.Equal(t, in, out)
+}
+
+func TestEncodeLogfmtSkipsEmptyFields(t *testing.T) {
+	log := pdata.NewLogRecord()
+	log.Body().SetStringVal("msg")
+	log.Attributes().InsertString("empty", "")
+	log.Attributes().InsertInt("count", 3)
+
+	out, err := encodeLogfmt(log, pdata.NewResource())
+	assert.NoError(t, err)
+	assert.Equal(t, `body=msg attributes.count=3`, out)
+}
@@ -25,6 +25,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"text/template"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -40,23 +41,38 @@ import (
 )
 
 type lokiExporter struct {
-	config  *Config
-	logger  *zap.Logger
-	client  *http.Client
-	wg      sync.WaitGroup
-	convert func(pdata.LogRecord, pdata.Resource) (*logproto.Entry, error)
+	config      *Config
+	logger      *zap.Logger
+	client      *http.Client
+	wg          sync.WaitGroup
+	convert     func(pdata.LogRecord, pdata.Resource) (*logproto.Entry, error)
+	templates   map[model.LabelName]*template.Template
+	cardinality *cardinalityLimiter
 }
 
 func newExporter(config *Config, logger *zap.Logger) *lokiExporter {
 	lokiexporter := &lokiExporter{
-		config: config,
-		logger: logger,
+		config:      config,
+		logger:      logger,
+		templates:   make(map[model.LabelName]*template.Template),
+		cardinality: newCardinalityLimiter(config.Labels.CardinalityLimit, logger),
 	}
-	if config.Format == "json" {
+	switch config.Format {
+	case formatJSON:
 		lokiexporter.convert = lokiexporter.convertLogToJSONEntry
-	} else {
+	case formatLogfmt:
+		lokiexporter.convert = lokiexporter.convertLogToLogfmtEntry
+	default:
 		lokiexporter.convert = lokiexporter.convertLogBodyToEntry
 	}
+	for label, text := range config.Labels.Templates {
+		tmpl, err := parseLabelTemplate(label, text)
+		if err != nil {
+			logger.Warn("ignoring invalid label template", zap.String("label", label), zap.Error(err))
+			continue
+		}
+		lokiexporter.templates[model.LabelName(label)] = tmpl
+	}
 	return lokiexporter
 }
 
@@ -64,6 +80,49 @@ func (l *lokiExporter) pushLogData(ctx context.Context, ld pdata.Logs) error {
 	l.wg.Add(1)
 	defer l.wg.Done()
 
+	if l.config.TenantAttribute == "" {
+		return l.pushTenantLogData(ctx, l.config.TenantID, ld)
+	}
+
+	// Each tenant is pushed in its own request, only the logs of the requests that can be retried
+	// are returned as failed.
+	var retryableErrs, permanentErrs error
+	failed := pdata.NewLogs()
+	for _, tl := range l.splitLogsByTenant(ld) {
+		err := l.pushTenantLogData(ctx, tl.tenant, tl.logs)
+		if err == nil {
+			continue
+		}
+		err = fmt.Errorf("failed to push logs of tenant %q: %w", tl.tenant, err)
+		var logsErr consumererror.Logs
+		if !errors.As(err, &logsErr) {
+			permanentErrs = multierr.Append(permanentErrs, consumererror.NewPermanent(err))
+			continue
+		}
+		retryableErrs = multierr.Append(retryableErrs, err)
+		logsErr.GetLogs().ResourceLogs().MoveAndAppendTo(failed.ResourceLogs())
+	}
+	if retryableErrs == nil {
+		return permanentErrs
+	}
+	if permanentErrs != nil {
+		retryableErrs = multierr.Append(retryableErrs, droppedTenantsError{errs: permanentErrs})
+	}
+	return consumererror.NewLogs(retryableErrs, failed)
+}
+
+// droppedTenantsError reports the permanent errors of the tenants whose logs are dropped along
+// the errors of the tenants to retry. It does not unwrap to the permanent errors, which would make
+// the exporter helper drop the logs of the tenants to retry as well.
+type droppedTenantsError struct {
+	errs error
+}
+
+func (e droppedTenantsError) Error() string {
+	return "dropped logs: " + e.errs.Error()
+}
+
+func (l *lokiExporter) pushTenantLogData(ctx context.Context, tenant string, ld pdata.Logs) error {
 	pushReq, _ := l.logDataToLoki(ld)
 	if len(pushReq.Streams) == 0 {
 		return consumererror.NewPermanent(fmt.Errorf("failed to transform logs into Loki log streams"))
@@ -84,8 +143,8 @@ func (l *lokiExporter) pushLogData(ctx context.Context, ld pdata.Logs) error {
 	}
 	req.Header.Set("Content-Type", "application/x-protobuf")
 
-	if len(l.config.TenantID) > 0 {
-		req.Header.Set("X-Scope-OrgID", l.config.TenantID)
+	if len(tenant) > 0 {
+		req.Header.Set("X-Scope-OrgID", tenant)
 	}
 
 	resp, err := l.client.Do(req)
@@ -142,7 +201,7 @@ func (l *lokiExporter) logDataToLoki(ld pdata.Logs) (pr *logproto.PushRequest, n
 			for k := 0; k < logs.Len(); k++ {
 				log := logs.At(k)
 
-				mergedLabels, dropped := l.convertAttributesAndMerge(log.Attributes(), resource.Attributes())
+				mergedLabels, dropped := l.recordLabels(log, resource)
 				if dropped {
 					numDroppedLogs++
 					continue
@@ -210,6 +269,118 @@ func (l *lokiExporter) convertAttributesAndMerge(logAttrs pdata.AttributeMap, re
 	return mergedAttributes, false
 }
 
+// recordLabels returns the labels of the log stream of a log record, built from its attributes,
+// the resource attributes and the label templates, and bounded by the cardinality limit.
+func (l *lokiExporter) recordLabels(lr pdata.LogRecord, res pdata.Resource) (labels model.LabelSet, dropped bool) {
+	labels, _ = l.convertAttributesAndMerge(lr.Attributes(), res.Attributes())
+	if labels == nil {
+		labels = model.LabelSet{}
+	}
+	if len(l.templates) > 0 {
+		labels = labels.Merge(l.executeTemplates(lr, res))
+	}
+	l.cardinality.limit(labels)
+
+	if len(labels) == 0 {
+		return nil, true
+	}
+	return labels, false
+}
+
+func (l *lokiExporter) executeTemplates(lr pdata.LogRecord, res pdata.Resource) model.LabelSet {
+	data := map[string]interface{}{
+		"attributes": attributesAsStrings(lr.Attributes()),
+		"resource":   attributesAsStrings(res.Attributes()),
+		"severity":   lr.SeverityText(),
+	}
+
+	ls := model.LabelSet{}
+	var b strings.Builder
+	for label, tmpl := range l.templates {
+		b.Reset()
+		if err := tmpl.Execute(&b, data); err != nil {
+			l.logger.Debug("Failed to execute label template", zap.String("label", string(label)), zap.Error(err))
+			continue
+		}
+		if b.Len() > 0 {
+			ls[label] = model.LabelValue(b.String())
+		}
+	}
+	return ls
+}
+
+func attributesAsStrings(attributes pdata.AttributeMap) map[string]string {
+	values := make(map[string]string, attributes.Len())
+	attributes.Range(func(k string, v pdata.AttributeValue) bool {
+		values[k] = v.AsString()
+		return true
+	})
+	return values
+}
+
+type tenantLogs struct {
+	tenant string
+	logs   pdata.Logs
+}
+
+// splitLogsByTenant groups the log records by tenant, keeping the order of the tenants and the
+// resource and instrumentation library of the log records.
+func (l *lokiExporter) splitLogsByTenant(ld pdata.Logs) []*tenantLogs {
+	var tenants []*tenantLogs
+	byTenant := make(map[string]*tenantLogs)
+
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		destRLs := make(map[string]pdata.ResourceLogs)
+		ills := rl.InstrumentationLibraryLogs()
+		for j := 0; j < ills.Len(); j++ {
+			ill := ills.At(j)
+			destILLs := make(map[string]pdata.InstrumentationLibraryLogs)
+			logs := ill.Logs()
+			for k := 0; k < logs.Len(); k++ {
+				log := logs.At(k)
+				tenant := l.tenantOf(log, rl.Resource())
+
+				destILL, ok := destILLs[tenant]
+				if !ok {
+					destRL, ok := destRLs[tenant]
+					if !ok {
+						tl, ok := byTenant[tenant]
+						if !ok {
+							tl = &tenantLogs{tenant: tenant, logs: pdata.NewLogs()}
+							byTenant[tenant] = tl
+							tenants = append(tenants, tl)
+						}
+						destRL = tl.logs.ResourceLogs().AppendEmpty()
+						rl.Resource().CopyTo(destRL.Resource())
+						destRL.SetSchemaUrl(rl.SchemaUrl())
+						destRLs[tenant] = destRL
+					}
+					destILL = destRL.InstrumentationLibraryLogs().AppendEmpty()
+					ill.InstrumentationLibrary().CopyTo(destILL.InstrumentationLibrary())
+					destILL.SetSchemaUrl(ill.SchemaUrl())
+					destILLs[tenant] = destILL
+				}
+				log.CopyTo(destILL.Logs().AppendEmpty())
+			}
+		}
+	}
+	return tenants
+}
+
+// tenantOf returns the tenant of a log record, read from the tenant attribute of the log record
+// or of its resource, and defaulting to the configured tenant ID.
+func (l *lokiExporter) tenantOf(lr pdata.LogRecord, res pdata.Resource) string {
+	if av, ok := lr.Attributes().Get(l.config.TenantAttribute); ok && av.AsString() != "" {
+		return av.AsString()
+	}
+	if av, ok := res.Attributes().Get(l.config.TenantAttribute); ok && av.AsString() != "" {
+		return av.AsString()
+	}
+	return l.config.TenantID
+}
+
 func (l *lokiExporter) convertAttributesToLabels(attributes pdata.AttributeMap, allowedAttributes map[string]string) model.LabelSet {
 	ls := model.LabelSet{}
 
@@ -300,3 +471,14 @@ func (l *lokiExporter) convertLogToJSONEntry(lr pdata.LogRecord, res pdata.Resou
 		Line:      line,
 	}, nil
 }
+
+func (l *lokiExporter) convertLogToLogfmtEntry(lr pdata.LogRecord, res pdata.Resource) (*logproto.Entry, error) {
+	line, err := encodeLogfmt(lr, res)
+	if err != nil {
+		return nil, err
+	}
+	return &logproto.Entry{
+		Timestamp: time.Unix(0, int64(lr.Timestamp())),
+		Line:      line,
+	}, nil
+}
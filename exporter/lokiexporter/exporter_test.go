@@ -22,9 +22,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/golang/snappy"
 	"github.com/prometheus/common/model"
 	"github.com/stretchr/testify/assert"
@@ -34,6 +36,7 @@ import (
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/model/pdata"
 	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/lokiexporter/internal/third_party/loki/logproto"
@@ -612,3 +615,276 @@ func TestExporter_convertLogtoJSONEntry(t *testing.T) {
 	require.NotNil(t, entry)
 	require.Equal(t, expEntry, entry)
 }
+
+func TestExporter_convertLogToLogfmtEntry(t *testing.T) {
+	ts := pdata.Timestamp(int64(1) * time.Millisecond.Nanoseconds())
+	lr := pdata.NewLogRecord()
+	lr.Body().SetStringVal("log message")
+	lr.SetTimestamp(ts)
+	res := pdata.NewResource()
+	res.Attributes().Insert("host.name", pdata.NewAttributeValueString("something"))
+
+	exp := newExporter(&Config{Format: "logfmt"}, zap.NewNop())
+	entry, err := exp.convert(lr, res)
+	expEntry := &logproto.Entry{
+		Timestamp: time.Unix(0, int64(lr.Timestamp())),
+		Line:      `body="log message" resources.host.name=something`,
+	}
+	require.Nil(t, err)
+	require.NotNil(t, entry)
+	require.Equal(t, expEntry, entry)
+}
+
+func TestExporter_labelTemplates(t *testing.T) {
+	config := &Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: validEndpoint,
+		},
+		Labels: LabelsConfig{
+			Attributes: map[string]string{
+				"severity": "severity",
+			},
+			Templates: map[string]string{
+				"service": `{{ index .resource "service.namespace" }}/{{ index .resource "service.name" }}`,
+				"level":   `{{ .severity }}`,
+				"missing": `{{ index .attributes "not.set" }}`,
+			},
+		},
+	}
+	exp := newExporter(config, zap.NewNop())
+
+	lr := pdata.NewLogRecord()
+	lr.SetSeverityText("WARN")
+	lr.Attributes().InsertString("severity", "warning")
+	res := pdata.NewResource()
+	res.Attributes().InsertString("service.namespace", "shop")
+	res.Attributes().InsertString("service.name", "cart")
+
+	labels, dropped := exp.recordLabels(lr, res)
+	require.False(t, dropped)
+	assert.Equal(t, model.LabelSet{
+		"severity": "warning",
+		"service":  "shop/cart",
+		"level":    "WARN",
+	}, labels)
+}
+
+func TestExporter_cardinalityLimit(t *testing.T) {
+	tests := []struct {
+		name     string
+		action   string
+		expected []model.LabelSet
+	}{
+		{
+			name:   "drop",
+			action: "drop",
+			expected: []model.LabelSet{
+				{"user": "a", "level": "info"},
+				{"user": "b", "level": "info"},
+				{"level": "info"},
+				{"user": "a", "level": "info"},
+			},
+		},
+		{
+			name:   "fold",
+			action: "fold",
+			expected: []model.LabelSet{
+				{"user": "a", "level": "info"},
+				{"user": "b", "level": "info"},
+				{"user": overflowLabelValue, "level": "info"},
+				{"user": "a", "level": "info"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: validEndpoint,
+				},
+				Labels: LabelsConfig{
+					Attributes: map[string]string{
+						"user":  "user",
+						"level": "level",
+					},
+					CardinalityLimit: CardinalityLimitConfig{
+						MaxValues: 2,
+						Action:    tt.action,
+					},
+				},
+			}
+			exp := newExporter(config, zap.NewNop())
+
+			for i, user := range []string{"a", "b", "c", "a"} {
+				lr := pdata.NewLogRecord()
+				lr.Attributes().InsertString("user", user)
+				lr.Attributes().InsertString("level", "info")
+				labels, dropped := exp.recordLabels(lr, pdata.NewResource())
+				require.False(t, dropped)
+				assert.Equal(t, tt.expected[i], labels)
+			}
+		})
+	}
+}
+
+func TestExporter_cardinalityLimitReset(t *testing.T) {
+	config := &Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: validEndpoint,
+		},
+		Labels: LabelsConfig{
+			Attributes: map[string]string{
+				"user":  "user",
+				"level": "level",
+			},
+			CardinalityLimit: CardinalityLimitConfig{
+				MaxValues:     1,
+				Action:        "drop",
+				ResetInterval: time.Hour,
+			},
+		},
+	}
+	exp := newExporter(config, zap.NewNop())
+
+	labelsOf := func(user string) model.LabelSet {
+		lr := pdata.NewLogRecord()
+		lr.Attributes().InsertString("user", user)
+		lr.Attributes().InsertString("level", "info")
+		labels, dropped := exp.recordLabels(lr, pdata.NewResource())
+		require.False(t, dropped)
+		return labels
+	}
+	assert.Equal(t, model.LabelSet{"user": "a", "level": "info"}, labelsOf("a"))
+	assert.Equal(t, model.LabelSet{"level": "info"}, labelsOf("b"))
+
+	// The values seen are forgotten once the interval elapsed.
+	exp.cardinality.windowStart = time.Now().Add(-time.Hour)
+	assert.Equal(t, model.LabelSet{"user": "b", "level": "info"}, labelsOf("b"))
+	assert.Equal(t, model.LabelSet{"level": "info"}, labelsOf("a"))
+}
+
+func TestExporter_pushLogDataByTenant(t *testing.T) {
+	var mu sync.Mutex
+	received := map[string][]logproto.Stream{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		buf, err := snappy.Decode(nil, body)
+		require.NoError(t, err)
+		pr := &logproto.PushRequest{}
+		require.NoError(t, proto.Unmarshal(buf, pr))
+
+		tenant := r.Header.Get("X-Scope-OrgID")
+		mu.Lock()
+		received[tenant] = append(received[tenant], pr.Streams...)
+		mu.Unlock()
+
+		if tenant == "failing" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := &Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: server.URL,
+		},
+		TenantID:        "default",
+		TenantAttribute: "tenant",
+		Labels: LabelsConfig{
+			Attributes: map[string]string{
+				"severity": "severity",
+			},
+		},
+	}
+	exp := newExporter(config, zap.NewNop())
+	require.NoError(t, exp.start(context.Background(), componenttest.NewNopHost()))
+
+	logs := pdata.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().InsertString("tenant", "team-a")
+	ill := rl.InstrumentationLibraryLogs().AppendEmpty()
+	ill.InstrumentationLibrary().SetName("lib")
+	for _, tenant := range []string{"", "team-b", "failing", ""} {
+		lr := ill.Logs().AppendEmpty()
+		lr.Body().SetStringVal("message for " + tenant)
+		lr.Attributes().InsertString("severity", "info")
+		if tenant != "" {
+			lr.Attributes().InsertString("tenant", tenant)
+		}
+	}
+	// The records without labels of a tenant cannot be sent.
+	lr := ill.Logs().AppendEmpty()
+	lr.Body().SetStringVal("message without labels")
+	lr.Attributes().InsertString("tenant", "unlabeled")
+	lr = logs.ResourceLogs().AppendEmpty().InstrumentationLibraryLogs().AppendEmpty().Logs().AppendEmpty()
+	lr.Body().SetStringVal("message without tenant")
+	lr.Attributes().InsertString("severity", "info")
+
+	err := exp.pushLogData(context.Background(), logs)
+	require.Error(t, err)
+	assert.False(t, consumererror.IsPermanent(err), "the logs of the failing tenant must be retried")
+	assert.Contains(t, err.Error(), `failed to push logs of tenant "failing"`)
+	assert.Contains(t, err.Error(), `failed to push logs of tenant "unlabeled"`)
+	var droppedErr droppedTenantsError
+	require.True(t, errors.As(err, &droppedErr))
+	assert.True(t, consumererror.IsPermanent(droppedErr.errs), "the logs of the unlabeled tenant are dropped")
+
+	var logsErr consumererror.Logs
+	require.True(t, errors.As(err, &logsErr))
+	failed := logsErr.GetLogs()
+	require.Equal(t, 1, failed.LogRecordCount())
+	failedRL := failed.ResourceLogs().At(0)
+	assert.Equal(t, "team-a", failedRL.Resource().Attributes().AsRaw()["tenant"])
+	assert.Equal(t, "lib", failedRL.InstrumentationLibraryLogs().At(0).InstrumentationLibrary().Name())
+	assert.Equal(t, "message for failing", failedRL.InstrumentationLibraryLogs().At(0).Logs().At(0).Body().StringVal())
+
+	require.Len(t, received, 4)
+	assert.Len(t, received["team-a"][0].Entries, 2)
+	assert.Len(t, received["team-b"][0].Entries, 1)
+	assert.Len(t, received["failing"][0].Entries, 1)
+	assert.Len(t, received["default"][0].Entries, 1)
+	assert.Equal(t, "message without tenant", received["default"][0].Entries[0].Line)
+}
+
+func TestExporter_pushLogDataByTenantPermanentError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := &Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: server.URL,
+		},
+		TenantAttribute: "tenant",
+		Labels: LabelsConfig{
+			Attributes: map[string]string{
+				"severity": "severity",
+			},
+		},
+	}
+	exp := newExporter(config, zap.NewNop())
+	require.NoError(t, exp.start(context.Background(), componenttest.NewNopHost()))
+
+	logs := pdata.NewLogs()
+	ill := logs.ResourceLogs().AppendEmpty().InstrumentationLibraryLogs().AppendEmpty()
+	for _, tenant := range []string{"team-a", "team-b"} {
+		lr := ill.Logs().AppendEmpty()
+		lr.Body().SetStringVal("message for " + tenant)
+		lr.Attributes().InsertString("tenant", tenant)
+	}
+	lr := ill.Logs().AppendEmpty()
+	lr.Body().SetStringVal("message with labels")
+	lr.Attributes().InsertString("tenant", "team-c")
+	lr.Attributes().InsertString("severity", "info")
+
+	err := exp.pushLogData(context.Background(), logs)
+	require.Error(t, err)
+	assert.True(t, consumererror.IsPermanent(err))
+	assert.Len(t, multierr.Errors(err), 2)
+	assert.Contains(t, err.Error(), `failed to push logs of tenant "team-a"`)
+	assert.Contains(t, err.Error(), `failed to push logs of tenant "team-b"`)
+}
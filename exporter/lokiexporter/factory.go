@@ -48,10 +48,15 @@ func createDefaultConfig() config.Exporter {
 		RetrySettings: exporterhelper.DefaultRetrySettings(),
 		QueueSettings: exporterhelper.DefaultQueueSettings(),
 		TenantID:      "",
-		Format:        "body",
+		Format:        formatBody,
 		Labels: LabelsConfig{
 			Attributes:         map[string]string{},
 			ResourceAttributes: map[string]string{},
+			Templates:          map[string]string{},
+			CardinalityLimit: CardinalityLimitConfig{
+				Action:        cardinalityActionDrop,
+				ResetInterval: time.Hour,
+			},
 		},
 	}
 }
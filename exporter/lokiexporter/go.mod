@@ -13,10 +13,12 @@ require (
 	go.opentelemetry.io/collector/model v0.41.1-0.20211210184707-4dcb3388a168
 	go.uber.org/zap v1.19.1
 	google.golang.org/grpc v1.43.0
-
 )
 
-require go.uber.org/multierr v1.7.0
+require (
+	github.com/go-logfmt/logfmt v0.5.0
+	go.uber.org/multierr v1.7.0
+)
 
 require (
 	github.com/cenkalti/backoff/v4 v4.1.2 // indirect
@@ -18,12 +18,15 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strconv"
 	"sync"
+	"time"
 
 	jsoniter "github.com/json-iterator/go"
 	"go.opentelemetry.io/collector/component"
@@ -44,6 +47,10 @@ type client struct {
 	zippers sync.Pool
 	wg      sync.WaitGroup
 	headers map[string]string
+	// ackURL and acks are only set when indexer acknowledgement is enabled.
+	ackURL *url.URL
+	acks   *ackPoller
+	rawURL *url.URL
 }
 
 // bufferState encapsulates intermediate buffer state when pushing log data
@@ -54,8 +61,14 @@ type bufferState struct {
 	bufLen   int
 	resource int
 	library  int
+	// metadata is the one of the raw endpoint when the log bodies are sent
+	// there instead of HEC events, it is empty otherwise.
+	metadata logMetadata
 }
 
+// sendFunc sends a batch of logs to the URL.
+type sendFunc func(ctx context.Context, url string, buf *bytes.Buffer, headers map[string]string) error
+
 // Minimum number of bytes to compress. 1500 is the MTU of an ethernet frame.
 const minCompressionLen = 1500
 
@@ -101,10 +114,7 @@ func (c *client) pushMetricsData(
 		return err
 	}
 
-	io.Copy(ioutil.Discard, resp.Body)
-	resp.Body.Close()
-
-	return splunk.HandleHTTPCode(resp)
+	return c.handleResponse(ctx, resp)
 }
 
 func (c *client) pushTraceData(
@@ -127,7 +137,7 @@ func (c *client) sendSplunkEvents(ctx context.Context, splunkEvents []*splunk.Ev
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
-	return c.postEvents(ctx, body, nil, compressed)
+	return c.postEvents(ctx, c.url.String(), body, nil, compressed)
 }
 
 func (c *client) pushLogData(ctx context.Context, ld pdata.Logs) error {
@@ -141,7 +151,7 @@ func (c *client) pushLogData(ctx context.Context, ld pdata.Logs) error {
 	gzipWriter.Reset(gzipBuffer)
 
 	// Callback when each batch is to be sent.
-	send := func(ctx context.Context, buf *bytes.Buffer, headers map[string]string) (err error) {
+	send := func(ctx context.Context, url string, buf *bytes.Buffer, headers map[string]string) (err error) {
 		localHeaders := headers
 		if ld.ResourceLogs().Len() != 0 {
 			accessToken, found := ld.ResourceLogs().At(0).Resource().Attributes().Get(splunk.HecTokenLabel)
@@ -168,13 +178,51 @@ func (c *client) pushLogData(ctx context.Context, ld pdata.Logs) error {
 				return fmt.Errorf("failed flushing compressed data to gzip writer: %v", err)
 			}
 
-			return c.postEvents(ctx, gzipBuffer, localHeaders, shouldCompress)
+			return c.postEvents(ctx, url, gzipBuffer, localHeaders, shouldCompress)
 		}
 
-		return c.postEvents(ctx, buf, localHeaders, shouldCompress)
+		return c.postEvents(ctx, url, buf, localHeaders, shouldCompress)
+	}
+
+	// Each request only holds records with the same metadata.
+	groups := groupLogsByMetadata(ld, c.config)
+	var permanentErrors []error
+	for i, group := range groups {
+		err := c.pushLogDataInBatches(ctx, group.logs, group.metadata, send)
+
+		var logsErr consumererror.Logs
+		if errors.As(err, &logsErr) {
+			// The logs of the next groups were not sent either.
+			failed := logsErr.GetLogs()
+			for _, next := range groups[i+1:] {
+				next.logs.ResourceLogs().MoveAndAppendTo(failed.ResourceLogs())
+			}
+			return consumererror.NewLogs(multierr.Combine(append(permanentErrors, logsErr.Unwrap())...), failed)
+		}
+		if err != nil {
+			permanentErrors = append(permanentErrors, err)
+		}
+	}
+
+	return multierr.Combine(permanentErrors...)
+}
+
+// logsURL returns the URL to send the logs with the metadata to. The
+// metadata of the logs sent to the raw endpoint is set as query parameters.
+func (c *client) logsURL(md logMetadata) string {
+	if !md.raw {
+		return c.url.String()
 	}
 
-	return c.pushLogDataInBatches(ctx, ld, send)
+	query := url.Values{}
+	for k, v := range map[string]string{"index": md.index, "source": md.source, "sourcetype": md.sourcetype, "host": md.host} {
+		if v != "" {
+			query.Set(k, v)
+		}
+	}
+	rawURL := *c.rawURL
+	rawURL.RawQuery = query.Encode()
+	return rawURL.String()
 }
 
 // A guesstimated value > length of bytes of a single event.
@@ -212,9 +260,13 @@ func makeBlankBufferState(bufCap uint) bufferState {
 // The batch content length is restricted to MaxContentLengthLogs.
 // ld log records are parsed to Splunk events.
 // The input data may contain both logs and profiling data.
-// They are batched separately and sent with different HTTP headers
-func (c *client) pushLogDataInBatches(ctx context.Context, ld pdata.Logs, send func(context.Context, *bytes.Buffer, map[string]string) error) error {
+// They are batched separately and sent with different HTTP headers.
+// When the metadata is the one of the raw endpoint, the bodies of the
+// non-profiling log records are sent there instead of Splunk events.
+// The profiling data is always sent as Splunk events.
+func (c *client) pushLogDataInBatches(ctx context.Context, ld pdata.Logs, md logMetadata, send sendFunc) error {
 	var bufState = makeBlankBufferState(c.config.MaxContentLengthLogs)
+	bufState.metadata = md
 	var profilingBufState = makeBlankBufferState(c.config.MaxContentLengthLogs)
 	var permanentErrors []error
 
@@ -243,14 +295,14 @@ func (c *client) pushLogDataInBatches(ctx context.Context, ld pdata.Logs, send f
 
 	// There's some leftover unsent non-profiling data
 	if bufState.buf.Len() > 0 {
-		if err := send(ctx, bufState.buf, nil); err != nil {
+		if err := send(ctx, c.logsURL(bufState.metadata), bufState.buf, nil); err != nil {
 			return consumererror.NewLogs(err, *subLogs(&ld, bufState.bufFront, profilingBufState.bufFront))
 		}
 	}
 
 	// There's some leftover unsent profiling data
 	if profilingBufState.buf.Len() > 0 {
-		if err := send(ctx, profilingBufState.buf, profilingHeaders); err != nil {
+		if err := send(ctx, c.url.String(), profilingBufState.buf, profilingHeaders); err != nil {
 			// Non-profiling bufFront is set to nil because all non-profiling data was flushed successfully above.
 			return consumererror.NewLogs(err, *subLogs(&ld, nil, profilingBufState.bufFront))
 		}
@@ -259,7 +311,7 @@ func (c *client) pushLogDataInBatches(ctx context.Context, ld pdata.Logs, send f
 	return multierr.Combine(permanentErrors...)
 }
 
-func (c *client) pushLogRecords(ctx context.Context, lds pdata.ResourceLogsSlice, state *bufferState, headers map[string]string, send func(context.Context, *bytes.Buffer, map[string]string) error) (permanentErrors []error, sendingError error) {
+func (c *client) pushLogRecords(ctx context.Context, lds pdata.ResourceLogsSlice, state *bufferState, headers map[string]string, send sendFunc) (permanentErrors []error, sendingError error) {
 	res := lds.At(state.resource)
 	logs := res.InstrumentationLibraryLogs().At(state.library).Logs()
 	bufCap := int(c.config.MaxContentLengthLogs)
@@ -269,15 +321,21 @@ func (c *client) pushLogRecords(ctx context.Context, lds pdata.ResourceLogsSlice
 			state.bufFront = &logIndex{resource: state.resource, library: state.library, record: k}
 		}
 
-		// Parsing log record to Splunk event.
-		event := mapLogRecordToSplunkEvent(res.Resource(), logs.At(k), c.config, c.logger)
-		// JSON encoding event and writing to buffer.
-		b, err := jsoniter.Marshal(event)
-		if err != nil {
-			permanentErrors = append(permanentErrors, consumererror.NewPermanent(fmt.Errorf("dropped log event: %v, error: %v", event, err)))
-			continue
+		if state.metadata.raw {
+			// Writing the log body as a line of raw data to buffer.
+			state.buf.WriteString(logs.At(k).Body().AsString())
+			state.buf.WriteByte('\n')
+		} else {
+			// Parsing log record to Splunk event.
+			event := mapLogRecordToSplunkEvent(res.Resource(), logs.At(k), c.config, c.logger)
+			// JSON encoding event and writing to buffer.
+			b, err := jsoniter.Marshal(event)
+			if err != nil {
+				permanentErrors = append(permanentErrors, consumererror.NewPermanent(fmt.Errorf("dropped log event: %v, error: %v", event, err)))
+				continue
+			}
+			state.buf.Write(b)
 		}
-		state.buf.Write(b)
 
 		// Continue adding events to buffer up to capacity.
 		// 0 capacity is interpreted as unknown/unbound consistent with ContentLength in http.Request.
@@ -301,7 +359,7 @@ func (c *client) pushLogRecords(ctx context.Context, lds pdata.ResourceLogsSlice
 		// Truncating buffer at tracked length below capacity and sending.
 		state.buf.Truncate(state.bufLen)
 		if state.buf.Len() > 0 {
-			if err := send(ctx, state.buf, headers); err != nil {
+			if err := send(ctx, c.logsURL(state.metadata), state.buf, headers); err != nil {
 				return permanentErrors, err
 			}
 		}
@@ -324,8 +382,8 @@ func (c *client) pushLogRecords(ctx context.Context, lds pdata.ResourceLogsSlice
 	return permanentErrors, nil
 }
 
-func (c *client) postEvents(ctx context.Context, events io.Reader, headers map[string]string, compressed bool) error {
-	req, err := http.NewRequestWithContext(ctx, "POST", c.url.String(), events)
+func (c *client) postEvents(ctx context.Context, url string, events io.Reader, headers map[string]string, compressed bool) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", url, events)
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
@@ -348,13 +406,193 @@ func (c *client) postEvents(ctx context.Context, events io.Reader, headers map[s
 	if err != nil {
 		return err
 	}
+
+	return c.handleResponse(ctx, resp)
+}
+
+// handleResponse checks the response to a request sending events and, when
+// indexer acknowledgement is enabled, waits for the events to be acknowledged.
+func (c *client) handleResponse(ctx context.Context, resp *http.Response) error {
 	defer resp.Body.Close()
 
-	err = splunk.HandleHTTPCode(resp)
+	if err := splunk.HandleHTTPCode(resp); err != nil || c.ackURL == nil {
+		io.Copy(ioutil.Discard, resp.Body)
+		return err
+	}
 
-	io.Copy(ioutil.Discard, resp.Body)
+	var hecResp splunk.HECResponse
+	if err := jsoniter.NewDecoder(resp.Body).Decode(&hecResp); err != nil {
+		return consumererror.NewPermanent(fmt.Errorf("failed to decode HEC response: %w", err))
+	}
+	if hecResp.AckID == nil {
+		return consumererror.NewPermanent(fmt.Errorf("HEC response has no ack ID, is indexer acknowledgement enabled on the token? %q", hecResp.Text))
+	}
+
+	return c.waitForAck(ctx, *hecResp.AckID)
+}
 
-	return err
+// waitForAck waits for the ack ID to be acknowledged. The returned error is
+// not permanent on timeout, so the events are sent again.
+func (c *client) waitForAck(ctx context.Context, ackID uint64) error {
+	acked := c.acks.add(ackID)
+	defer c.acks.remove(ackID)
+
+	timeout := time.NewTimer(c.config.Ack.Timeout)
+	defer timeout.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timeout.C:
+		return fmt.Errorf("ack ID %d was not acknowledged within %s", ackID, c.config.Ack.Timeout)
+	case <-acked:
+		return nil
+	}
+}
+
+// ackPoller polls the status of all the pending ack IDs of the channel of the
+// client in a single request, right after an ack ID is added and then at each
+// poll interval while ack IDs are pending.
+type ackPoller struct {
+	client *client
+
+	mu      sync.Mutex
+	pending map[uint64]chan struct{}
+	// wake is signaled when an ack ID is added.
+	wake chan struct{}
+
+	startOnce sync.Once
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+func newAckPoller(c *client) *ackPoller {
+	return &ackPoller{
+		client:  c,
+		pending: map[uint64]chan struct{}{},
+		wake:    make(chan struct{}, 1),
+		cancel:  func() {},
+	}
+}
+
+// add registers the ack ID, the returned channel is closed once it is acknowledged.
+func (p *ackPoller) add(ackID uint64) <-chan struct{} {
+	p.startOnce.Do(func() {
+		var ctx context.Context
+		ctx, p.cancel = context.WithCancel(context.Background())
+		p.done = make(chan struct{})
+		go p.run(ctx)
+	})
+
+	acked := make(chan struct{})
+	p.mu.Lock()
+	p.pending[ackID] = acked
+	p.mu.Unlock()
+
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+	return acked
+}
+
+// remove stops polling the status of the ack ID.
+func (p *ackPoller) remove(ackID uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.pending, ackID)
+}
+
+// stop stops polling, the pending ack IDs are no longer acknowledged.
+func (p *ackPoller) stop() {
+	p.startOnce.Do(func() {})
+	p.cancel()
+	if p.done != nil {
+		<-p.done
+	}
+}
+
+func (p *ackPoller) run(ctx context.Context) {
+	defer close(p.done)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-p.wake:
+		}
+
+		for p.poll(ctx) {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(p.client.config.Ack.PollInterval):
+			}
+		}
+	}
+}
+
+// poll queries the status of the pending ack IDs and returns whether some of
+// them are still pending.
+func (p *ackPoller) poll(ctx context.Context) bool {
+	p.mu.Lock()
+	ackIDs := make([]uint64, 0, len(p.pending))
+	for ackID := range p.pending {
+		ackIDs = append(ackIDs, ackID)
+	}
+	p.mu.Unlock()
+	if len(ackIDs) == 0 {
+		return false
+	}
+
+	acks, err := p.client.queryAcks(ctx, ackIDs)
+	if err != nil {
+		p.client.logger.Debug("Failed to query the status of ack IDs", zap.Uint64s("ack_ids", ackIDs), zap.Error(err))
+		return true
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, ackID := range ackIDs {
+		acked, ok := p.pending[ackID]
+		if ok && acks[strconv.FormatUint(ackID, 10)] {
+			close(acked)
+			delete(p.pending, ackID)
+		}
+	}
+	return len(p.pending) > 0
+}
+
+// queryAcks returns the status of the ack IDs, keyed by ack ID.
+func (c *client) queryAcks(ctx context.Context, ackIDs []uint64) (map[string]bool, error) {
+	body, err := jsoniter.Marshal(splunk.AckRequest{Acks: ackIDs})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", c.ackURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = splunk.HandleHTTPCode(resp); err != nil {
+		io.Copy(ioutil.Discard, resp.Body)
+		return nil, err
+	}
+
+	var ackResp splunk.AckResponse
+	if err = jsoniter.NewDecoder(resp.Body).Decode(&ackResp); err != nil {
+		return nil, err
+	}
+	return ackResp.Acks, nil
 }
 
 // subLogs returns a subset of `ld` starting from `profilingBufFront` for profiling data
@@ -451,6 +689,9 @@ func getReader(zippers *sync.Pool, b *bytes.Buffer, disableCompression bool) (io
 
 func (c *client) stop(context.Context) error {
 	c.wg.Wait()
+	if c.acks != nil {
+		c.acks.stop()
+	}
 	return nil
 }
 
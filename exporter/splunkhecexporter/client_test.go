@@ -17,13 +17,16 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"math"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"strconv"
 	"sync"
 	"testing"
 	"time"
@@ -985,7 +988,7 @@ func BenchmarkPushLogRecords(b *testing.B) {
 		config: NewFactory().CreateDefaultConfig().(*Config),
 		logger: zap.NewNop(),
 	}
-	sender := func(ctx context.Context, buffer *bytes.Buffer, headers map[string]string) error {
+	sender := func(ctx context.Context, url string, buffer *bytes.Buffer, headers map[string]string) error {
 		return nil
 	}
 	state := makeBlankBufferState(4096)
@@ -997,3 +1000,206 @@ func BenchmarkPushLogRecords(b *testing.B) {
 		}
 	}
 }
+
+// ackServer emulates a HEC endpoint with indexer acknowledgement, which
+// acknowledges the ack IDs after they are queried pendingQueries times.
+type ackServer struct {
+	mu             sync.Mutex
+	pendingQueries int
+	queries        map[uint64]int
+	nextID         uint64
+	channels       []string
+	noAckID        bool
+	// ackRequests holds the ack IDs queried by each ack request.
+	ackRequests [][]uint64
+}
+
+func (s *ackServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.channels = append(s.channels, r.Header.Get(splunk.HECChannelHeader))
+	if r.URL.Path == splunk.DefaultAckPath {
+		var ackReq splunk.AckRequest
+		if err := json.NewDecoder(r.Body).Decode(&ackReq); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.ackRequests = append(s.ackRequests, ackReq.Acks)
+		acks := map[string]bool{}
+		for _, id := range ackReq.Acks {
+			s.queries[id]++
+			acks[strconv.FormatUint(id, 10)] = s.queries[id] > s.pendingQueries
+		}
+		json.NewEncoder(w).Encode(splunk.AckResponse{Acks: acks})
+		return
+	}
+
+	if s.noAckID {
+		json.NewEncoder(w).Encode(splunk.HECResponse{Text: "Success"})
+		return
+	}
+	id := s.nextID
+	s.nextID++
+	json.NewEncoder(w).Encode(splunk.HECResponse{Text: "Success", AckID: &id})
+}
+
+func newAckTestExporter(t *testing.T, s *ackServer) (*httptest.Server, *client) {
+	server := httptest.NewServer(s)
+	t.Cleanup(server.Close)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = server.URL + "/services/collector"
+	cfg.Token = "1234-1234"
+	cfg.Ack.Enabled = true
+	cfg.Ack.PollInterval = 10 * time.Millisecond
+	cfg.Ack.Timeout = 500 * time.Millisecond
+
+	options, err := cfg.getOptionsFromConfig()
+	require.NoError(t, err)
+	c, err := buildClient(options, cfg, zap.NewNop())
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, c.stop(context.Background())) })
+	return server, c
+}
+
+func TestAck(t *testing.T) {
+	s := &ackServer{pendingQueries: 2, queries: map[uint64]int{}}
+	_, c := newAckTestExporter(t, s)
+
+	require.NoError(t, c.pushLogData(context.Background(), createLogData(1, 1, 10)))
+	require.NoError(t, c.pushMetricsData(context.Background(), createMetricsData(3)))
+
+	assert.Equal(t, map[uint64]int{0: 3, 1: 3}, s.queries)
+	require.NotEmpty(t, s.channels)
+	for _, channel := range s.channels {
+		assert.Equal(t, c.headers[splunk.HECChannelHeader], channel)
+	}
+	assert.NotEmpty(t, c.headers[splunk.HECChannelHeader])
+}
+
+func TestAck_BatchesPendingAckIDs(t *testing.T) {
+	s := &ackServer{pendingQueries: 0, queries: map[uint64]int{}}
+	_, c := newAckTestExporter(t, s)
+
+	// The first ack ID is acknowledged by the query sent right after the events.
+	require.NoError(t, c.pushMetricsData(context.Background(), createMetricsData(3)))
+	assert.Equal(t, [][]uint64{{0}}, s.ackRequests)
+
+	// The ack IDs pending at the same time are queried together.
+	s.mu.Lock()
+	s.pendingQueries = 3
+	s.mu.Unlock()
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, c.pushMetricsData(context.Background(), createMetricsData(3)))
+		}()
+	}
+	wg.Wait()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	batched := false
+	for _, ackIDs := range s.ackRequests {
+		batched = batched || len(ackIDs) == 2
+	}
+	assert.True(t, batched, "ack requests: %v", s.ackRequests)
+}
+
+func TestAck_Timeout(t *testing.T) {
+	s := &ackServer{pendingQueries: math.MaxInt32, queries: map[uint64]int{}}
+	_, c := newAckTestExporter(t, s)
+
+	err := c.pushMetricsData(context.Background(), createMetricsData(3))
+	require.EqualError(t, err, "ack ID 0 was not acknowledged within 500ms")
+	// The events are sent again when the ack ID is not acknowledged.
+	assert.False(t, consumererror.IsPermanent(err))
+}
+
+func TestAck_NoAckID(t *testing.T) {
+	s := &ackServer{noAckID: true, queries: map[uint64]int{}}
+	_, c := newAckTestExporter(t, s)
+
+	err := c.pushMetricsData(context.Background(), createMetricsData(3))
+	require.Error(t, err)
+	assert.True(t, consumererror.IsPermanent(err))
+	assert.Empty(t, s.queries)
+}
+
+func Test_pushLogData_GroupsByMetadata(t *testing.T) {
+	c := client{
+		url:    &url.URL{Scheme: "http", Host: "splunk", Path: "/services/collector"},
+		rawURL: &url.URL{Scheme: "http", Host: "splunk", Path: "/services/collector/raw"},
+		zippers: sync.Pool{New: func() interface{} {
+			return gzip.NewWriter(nil)
+		}},
+		config: NewFactory().CreateDefaultConfig().(*Config),
+		logger: zaptest.NewLogger(t),
+	}
+	c.config.DisableCompression = true
+	c.config.Raw.SourceTypes = []string{"syslog"}
+
+	var urls []string
+	var bodies []string
+	c.client = &http.Client{
+		Transport: testRoundTripper(func(req *http.Request) *http.Response {
+			body, err := ioutil.ReadAll(req.Body)
+			require.NoError(t, err)
+			urls = append(urls, req.URL.String())
+			bodies = append(bodies, string(body))
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(bytes.NewBufferString("OK"))}
+		}),
+	}
+
+	logs := createLogDataWithCustomLibraries(1, []string{"otel.logs"}, []int{4})
+	records := logs.ResourceLogs().At(0).InstrumentationLibraryLogs().At(0).Logs()
+	records.At(1).Attributes().UpsertString(splunk.DefaultIndexLabel, "otherindex")
+	records.At(2).Attributes().UpsertString(splunk.DefaultSourceTypeLabel, "syslog")
+	records.At(2).Body().SetStringVal("<13>Jan 1 00:00:00 myhost first")
+	records.At(3).Attributes().UpsertString(splunk.DefaultSourceTypeLabel, "syslog")
+	records.At(3).Body().SetStringVal("<13>Jan 1 00:00:01 myhost second")
+	// The profiling data is sent as HEC events, even with a raw sourcetype.
+	profiling := logs.ResourceLogs().At(0).InstrumentationLibraryLogs().AppendEmpty()
+	profiling.InstrumentationLibrary().SetName(profilingLibraryName)
+	profilingRecord := profiling.Logs().AppendEmpty()
+	profilingRecord.Attributes().UpsertString(splunk.DefaultSourceTypeLabel, "syslog")
+	profilingRecord.Body().SetStringVal("profile")
+
+	require.NoError(t, c.pushLogData(context.Background(), logs))
+
+	require.Len(t, urls, 3)
+	assert.Equal(t, "http://splunk/services/collector", urls[0])
+	assert.Contains(t, bodies[0], `"index":"myindex"`)
+	assert.Contains(t, bodies[0], `"index":"otherindex"`)
+	assert.Equal(t, "http://splunk/services/collector/raw?host=myhost&index=myindex&source=myapp&sourcetype=syslog", urls[1])
+	assert.Equal(t, "<13>Jan 1 00:00:00 myhost first\n<13>Jan 1 00:00:01 myhost second\n", bodies[1])
+	assert.Equal(t, "http://splunk/services/collector", urls[2])
+	assert.Contains(t, bodies[2], `"event":"profile"`)
+}
+
+func Test_pushLogData_ShouldReturnUnsentGroups(t *testing.T) {
+	c := client{
+		url: &url.URL{Scheme: "http", Host: "splunk"},
+		zippers: sync.Pool{New: func() interface{} {
+			return gzip.NewWriter(nil)
+		}},
+		config: NewFactory().CreateDefaultConfig().(*Config),
+		logger: zaptest.NewLogger(t),
+	}
+	c.config.DisableCompression = true
+	c.client, _ = newTestClient(500, "Internal Server Error")
+
+	logs := createLogDataWithCustomLibraries(2, []string{"otel.logs"}, []int{2})
+	logs.ResourceLogs().At(1).InstrumentationLibraryLogs().At(0).Logs().At(0).Attributes().UpsertString(splunk.DefaultIndexLabel, "otherindex")
+
+	err := c.pushLogData(context.Background(), logs)
+	require.Error(t, err)
+	assert.False(t, consumererror.IsPermanent(err))
+
+	var logsErr consumererror.Logs
+	require.True(t, errors.As(err, &logsErr))
+	assert.Equal(t, 4, logsErr.GetLogs().LogRecordCount())
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net/url"
 	"path"
+	"time"
 
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/configtls"
@@ -43,6 +44,34 @@ type OtelToHecFields struct {
 	Name string `mapstructure:"name"`
 }
 
+// AckSettings defines the indexer acknowledgement settings.
+type AckSettings struct {
+	// Enabled makes the exporter wait for the events of each request to be
+	// acknowledged by the indexers before reporting them as sent.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Path is the path of the ack endpoint on the Splunk instance. Defaults to "/services/collector/ack".
+	Path string `mapstructure:"path"`
+
+	// PollInterval is the interval at which the status of an ack ID is queried. Defaults to 1s.
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+
+	// Timeout is the time to wait for an ack ID to be acknowledged before
+	// failing the request, so it is retried. Defaults to 60s.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// RawSettings defines the settings to send logs to the raw endpoint.
+type RawSettings struct {
+	// Path is the path of the raw endpoint on the Splunk instance. Defaults to "/services/collector/raw".
+	Path string `mapstructure:"path"`
+
+	// SourceTypes lists the sourcetypes of the logs sent to the raw endpoint.
+	// Only the bodies of these logs are sent, one per line, for Splunk to
+	// parse them according to the sourcetype.
+	SourceTypes []string `mapstructure:"sourcetypes"`
+}
+
 // Config defines configuration for Splunk exporter.
 type Config struct {
 	config.ExporterSettings        `mapstructure:",squash"`
@@ -87,6 +116,10 @@ type Config struct {
 	HecToOtelAttrs splunk.HecToOtelAttrs `mapstructure:"hec_metadata_to_otel_attrs"`
 	// HecFields creates a mapping from attributes to HEC fields.
 	HecFields OtelToHecFields `mapstructure:"otel_to_hec_fields"`
+	// Ack configures the indexer acknowledgement.
+	Ack AckSettings `mapstructure:"ack"`
+	// Raw configures the logs sent to the raw endpoint.
+	Raw RawSettings `mapstructure:"raw"`
 }
 
 func (cfg *Config) getOptionsFromConfig() (*exporterOptions, error) {
@@ -118,6 +151,15 @@ func (cfg *Config) validateConfig() error {
 		return fmt.Errorf(`requires "max_content_length_logs" <= %d`, maxContentLengthLogsLimit)
 	}
 
+	if cfg.Ack.Enabled {
+		if cfg.Ack.PollInterval <= 0 {
+			return errors.New(`requires "ack.poll_interval" > 0`)
+		}
+		if cfg.Ack.Timeout <= 0 {
+			return errors.New(`requires "ack.timeout" > 0`)
+		}
+	}
+
 	return nil
 }
 
@@ -96,6 +96,16 @@ func TestLoadConfig(t *testing.T) {
 			SeverityNumber: "myseveritynumfield",
 			Name:           "mynamefield",
 		},
+		Ack: AckSettings{
+			Enabled:      true,
+			Path:         "/services/collector/ack",
+			PollInterval: 500 * time.Millisecond,
+			Timeout:      30 * time.Second,
+		},
+		Raw: RawSettings{
+			Path:        "/services/collector/raw",
+			SourceTypes: []string{"syslog", "access_combined"},
+		},
 	}
 	assert.Equal(t, &expectedCfg, e1)
 
@@ -113,6 +123,7 @@ func TestConfig_getOptionsFromConfig(t *testing.T) {
 		SourceType           string
 		Index                string
 		MaxContentLengthLogs uint
+		Ack                  AckSettings
 	}
 	tests := []struct {
 		name    string
@@ -167,6 +178,26 @@ func TestConfig_getOptionsFromConfig(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name: "Test ack without poll interval",
+			fields: fields{
+				Token:    "1234",
+				Endpoint: "https://example.com:8000",
+				Ack:      AckSettings{Enabled: true, Timeout: time.Second},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test ack without timeout",
+			fields: fields{
+				Token:    "1234",
+				Endpoint: "https://example.com:8000",
+				Ack:      AckSettings{Enabled: true, PollInterval: time.Second},
+			},
+			want:    nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -177,6 +208,7 @@ func TestConfig_getOptionsFromConfig(t *testing.T) {
 				SourceType:           tt.fields.SourceType,
 				Index:                tt.fields.Index,
 				MaxContentLengthLogs: tt.fields.MaxContentLengthLogs,
+				Ack:                  tt.fields.Ack,
 			}
 			got, err := cfg.getOptionsFromConfig()
 			if (err != nil) != tt.wantErr {
@@ -25,6 +25,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/model/pdata"
 	"go.uber.org/zap"
@@ -96,7 +97,24 @@ func buildClient(options *exporterOptions, config *Config, logger *zap.Logger) (
 	if err != nil {
 		return nil, fmt.Errorf("could not retrieve TLS config for Splunk HEC Exporter: %w", err)
 	}
-	return &client{
+	headers := map[string]string{
+		"Connection":           "keep-alive",
+		"Content-Type":         "application/json",
+		"User-Agent":           config.SplunkAppName + "/" + config.SplunkAppVersion,
+		"Authorization":        splunk.HECTokenHeader + " " + config.Token,
+		"__splunk_app_name":    config.SplunkAppName,
+		"__splunk_app_version": config.SplunkAppVersion,
+	}
+	// The ack IDs are scoped to a channel, so each exporter uses its own. The
+	// raw endpoint also requires the requests to be sent on a channel.
+	if config.Ack.Enabled || len(config.Raw.SourceTypes) > 0 {
+		headers[splunk.HECChannelHeader] = uuid.New().String()
+	}
+	var ackURL *url.URL
+	if config.Ack.Enabled {
+		ackURL = &url.URL{Scheme: options.url.Scheme, User: options.url.User, Host: options.url.Host, Path: config.Ack.Path}
+	}
+	c := &client{
 		url: options.url,
 		client: &http.Client{
 			Timeout: config.Timeout,
@@ -117,14 +135,13 @@ func buildClient(options *exporterOptions, config *Config, logger *zap.Logger) (
 		zippers: sync.Pool{New: func() interface{} {
 			return gzip.NewWriter(nil)
 		}},
-		headers: map[string]string{
-			"Connection":           "keep-alive",
-			"Content-Type":         "application/json",
-			"User-Agent":           config.SplunkAppName + "/" + config.SplunkAppVersion,
-			"Authorization":        splunk.HECTokenHeader + " " + config.Token,
-			"__splunk_app_name":    config.SplunkAppName,
-			"__splunk_app_version": config.SplunkAppVersion,
-		},
-		config: config,
-	}, nil
+		headers: headers,
+		ackURL:  ackURL,
+		rawURL:  &url.URL{Scheme: options.url.Scheme, User: options.url.User, Host: options.url.Host, Path: config.Raw.Path},
+		config:  config,
+	}
+	if ackURL != nil {
+		c.acks = newAckPoller(c)
+	}
+	return c, nil
 }
@@ -31,9 +31,11 @@ import (
 
 const (
 	// The value of "type" key in configuration.
-	typeStr            = "splunk_hec"
-	defaultMaxIdleCons = 100
-	defaultHTTPTimeout = 10 * time.Second
+	typeStr                = "splunk_hec"
+	defaultMaxIdleCons     = 100
+	defaultHTTPTimeout     = 10 * time.Second
+	defaultAckPollInterval = time.Second
+	defaultAckTimeout      = 60 * time.Second
 )
 
 // TODO: Find a place for this to be shared.
@@ -80,6 +82,14 @@ func createDefaultConfig() config.Exporter {
 			SeverityNumber: splunk.DefaultSeverityNumberLabel,
 			Name:           splunk.DefaultNameLabel,
 		},
+		Ack: AckSettings{
+			Path:         splunk.DefaultAckPath,
+			PollInterval: defaultAckPollInterval,
+			Timeout:      defaultAckTimeout,
+		},
+		Raw: RawSettings{
+			Path: splunk.DefaultRawPath,
+		},
 	}
 }
 
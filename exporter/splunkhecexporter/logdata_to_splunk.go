@@ -41,6 +41,133 @@ type logIndex struct {
 	record int
 }
 
+// logMetadata holds the Splunk metadata shared by the log records sent in
+// the same request to the raw endpoint. It is empty for the logs sent as HEC
+// events, which carry their own metadata.
+type logMetadata struct {
+	index      string
+	source     string
+	sourcetype string
+	// host and raw are only set for the logs sent to the raw endpoint, which
+	// takes the metadata of all the records of a request as query parameters.
+	host string
+	raw  bool
+}
+
+// logsGroup holds the log records sharing the same metadata.
+type logsGroup struct {
+	metadata logMetadata
+	logs     pdata.Logs
+
+	// Indexes of the resource and library being copied to the group.
+	resource int
+	library  int
+	rl       pdata.ResourceLogs
+	ill      pdata.InstrumentationLibraryLogs
+}
+
+func getLogMetadata(res pdata.Resource, lr pdata.LogRecord, config *Config) logMetadata {
+	md := logMetadata{
+		index:      config.Index,
+		source:     config.Source,
+		sourcetype: config.SourceType,
+	}
+	host := unknownHostName
+	// The attributes of the log record take precedence over the ones of the resource.
+	for _, attrs := range []pdata.AttributeMap{res.Attributes(), lr.Attributes()} {
+		if v, ok := attrs.Get(config.HecToOtelAttrs.Index); ok {
+			md.index = v.StringVal()
+		}
+		if v, ok := attrs.Get(config.HecToOtelAttrs.Source); ok {
+			md.source = v.StringVal()
+		}
+		if v, ok := attrs.Get(config.HecToOtelAttrs.SourceType); ok {
+			md.sourcetype = v.StringVal()
+		}
+		if v, ok := attrs.Get(config.HecToOtelAttrs.Host); ok {
+			host = v.StringVal()
+		}
+	}
+	for _, sourcetype := range config.Raw.SourceTypes {
+		if md.sourcetype == sourcetype {
+			md.host = host
+			md.raw = true
+			return md
+		}
+	}
+	return logMetadata{}
+}
+
+// groupLogsByMetadata splits the logs in groups of records sharing the same
+// metadata, in the order in which the groups first appear. Only the logs sent
+// to the raw endpoint are split, all the HEC events are in a single group.
+func groupLogsByMetadata(ld pdata.Logs, config *Config) []*logsGroup {
+	if len(config.Raw.SourceTypes) == 0 {
+		if ld.LogRecordCount() == 0 {
+			return nil
+		}
+		return []*logsGroup{{logs: ld}}
+	}
+
+	var mds []logMetadata
+	single := true
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		ills := rl.InstrumentationLibraryLogs()
+		for j := 0; j < ills.Len(); j++ {
+			logs := ills.At(j).Logs()
+			for k := 0; k < logs.Len(); k++ {
+				md := getLogMetadata(rl.Resource(), logs.At(k), config)
+				single = single && (len(mds) == 0 || md == mds[0])
+				mds = append(mds, md)
+			}
+		}
+	}
+	if len(mds) == 0 {
+		return nil
+	}
+	if single {
+		return []*logsGroup{{metadata: mds[0], logs: ld}}
+	}
+
+	var groups []*logsGroup
+	byMetadata := map[logMetadata]*logsGroup{}
+	n := 0
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		ills := rl.InstrumentationLibraryLogs()
+		for j := 0; j < ills.Len(); j++ {
+			ill := ills.At(j)
+			logs := ill.Logs()
+			for k := 0; k < logs.Len(); k++ {
+				md := mds[n]
+				n++
+				g, ok := byMetadata[md]
+				if !ok {
+					g = &logsGroup{metadata: md, logs: pdata.NewLogs(), resource: -1}
+					byMetadata[md] = g
+					groups = append(groups, g)
+				}
+				if g.resource != i {
+					g.rl = g.logs.ResourceLogs().AppendEmpty()
+					g.rl.SetSchemaUrl(rl.SchemaUrl())
+					rl.Resource().CopyTo(g.rl.Resource())
+					g.resource, g.library = i, -1
+				}
+				if g.library != j {
+					g.ill = g.rl.InstrumentationLibraryLogs().AppendEmpty()
+					g.ill.SetSchemaUrl(ill.SchemaUrl())
+					ill.InstrumentationLibrary().CopyTo(g.ill.InstrumentationLibrary())
+					g.library = j
+				}
+				logs.At(k).CopyTo(g.ill.Logs().AppendEmpty())
+			}
+		}
+	}
+	return groups
+}
+
 func mapLogRecordToSplunkEvent(res pdata.Resource, lr pdata.LogRecord, config *Config, logger *zap.Logger) *splunk.Event {
 	host := unknownHostName
 	source := config.Source
@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/model/pdata"
 	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
 	"go.uber.org/zap"
@@ -479,3 +480,62 @@ func Test_nanoTimestampToEpochMilliseconds(t *testing.T) {
 	splunkTs = nanoTimestampToEpochMilliseconds(0)
 	assert.True(t, nil == splunkTs)
 }
+
+func Test_groupLogsByMetadata(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	config.Index = "main"
+	config.Raw.SourceTypes = []string{"syslog"}
+
+	logs := pdata.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().InsertString(conventions.AttributeHostName, "myhost")
+	logRecords := rl.InstrumentationLibraryLogs().AppendEmpty().Logs()
+	logRecords.AppendEmpty().SetName("0")
+	lr := logRecords.AppendEmpty()
+	lr.SetName("1")
+	lr.Attributes().InsertString(splunk.DefaultIndexLabel, "myindex")
+	lr = logRecords.AppendEmpty()
+	lr.SetName("2")
+	lr.Attributes().InsertString(splunk.DefaultSourceTypeLabel, "syslog")
+	rl = logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().InsertString(splunk.DefaultIndexLabel, "myindex")
+	rl.InstrumentationLibraryLogs().AppendEmpty().Logs().AppendEmpty().SetName("3")
+
+	groups := groupLogsByMetadata(logs, config)
+	require.Len(t, groups, 2)
+
+	names := func(ld pdata.Logs) []string {
+		var names []string
+		rls := ld.ResourceLogs()
+		for i := 0; i < rls.Len(); i++ {
+			logs := rls.At(i).InstrumentationLibraryLogs().At(0).Logs()
+			for j := 0; j < logs.Len(); j++ {
+				names = append(names, logs.At(j).Name())
+			}
+		}
+		return names
+	}
+
+	// The HEC events carry their own metadata and are sent together.
+	assert.Equal(t, logMetadata{}, groups[0].metadata)
+	assert.Equal(t, []string{"0", "1", "3"}, names(groups[0].logs))
+	assert.Equal(t, 2, groups[0].logs.ResourceLogs().Len())
+	assert.Equal(t, logMetadata{index: "main", sourcetype: "syslog", host: "myhost", raw: true}, groups[1].metadata)
+	assert.Equal(t, []string{"2"}, names(groups[1].logs))
+	hostName, _ := groups[1].logs.ResourceLogs().At(0).Resource().Attributes().Get(conventions.AttributeHostName)
+	assert.Equal(t, "myhost", hostName.StringVal())
+}
+
+func Test_groupLogsByMetadata_Single(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	logs := pdata.NewLogs()
+	logRecords := logs.ResourceLogs().AppendEmpty().InstrumentationLibraryLogs().AppendEmpty().Logs()
+	logRecords.AppendEmpty()
+	logRecords.AppendEmpty()
+
+	groups := groupLogsByMetadata(logs, config)
+	require.Len(t, groups, 1)
+	assert.Equal(t, logs, groups[0].logs)
+
+	assert.Empty(t, groupLogsByMetadata(pdata.NewLogs(), config))
+}
@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslogexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/syslogexporter"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+)
+
+const (
+	// ProtocolRFC5424 formats the log records as RFC5424 messages.
+	ProtocolRFC5424 = "rfc5424"
+	// ProtocolRFC3164 formats the log records as RFC3164 (BSD) messages.
+	ProtocolRFC3164 = "rfc3164"
+
+	networkTCP = "tcp"
+	networkUDP = "udp"
+)
+
+// Config defines configuration for the syslog exporter.
+type Config struct {
+	config.ExporterSettings        `mapstructure:",squash"`
+	exporterhelper.TimeoutSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct.
+	exporterhelper.QueueSettings   `mapstructure:"sending_queue"`
+	exporterhelper.RetrySettings   `mapstructure:"retry_on_failure"`
+
+	// Endpoint is the address of the syslog server, of the form <host>:<port>.
+	Endpoint string `mapstructure:"endpoint"`
+
+	// Network is the transport of the messages, "tcp" or "udp".
+	Network string `mapstructure:"network"`
+
+	// TLSSetting enables TLS on the TCP connection if set.
+	TLSSetting *configtls.TLSClientSetting `mapstructure:"tls"`
+
+	// Protocol is the format of the messages, "rfc5424" or "rfc3164".
+	Protocol string `mapstructure:"protocol"`
+
+	// EnableOctetCounting frames the messages sent over TCP by prefixing them
+	// with their length as per RFC6587, otherwise the messages are terminated
+	// by a newline.
+	EnableOctetCounting bool `mapstructure:"enable_octet_counting"`
+
+	// Facility is the facility of the log records that do not have one.
+	Facility int `mapstructure:"facility"`
+
+	// StructuredDataID is the SD-ID of the structured data element holding
+	// the attributes of the log records in RFC5424 messages. The attributes
+	// are not sent if empty.
+	StructuredDataID string `mapstructure:"structured_data_id"`
+}
+
+var errNoEndpoint = errors.New(`requires a non-empty "endpoint"`)
+
+// Validate checks the exporter configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errNoEndpoint
+	}
+	switch cfg.Network {
+	case networkTCP:
+	case networkUDP:
+		if cfg.TLSSetting != nil {
+			return errors.New(`"tls" is not supported with the "udp" network`)
+		}
+	default:
+		return fmt.Errorf(`unsupported "network" %q, must be %q or %q`, cfg.Network, networkTCP, networkUDP)
+	}
+	if cfg.Protocol != ProtocolRFC5424 && cfg.Protocol != ProtocolRFC3164 {
+		return fmt.Errorf(`unsupported "protocol" %q, must be %q or %q`, cfg.Protocol, ProtocolRFC5424, ProtocolRFC3164)
+	}
+	if cfg.Facility < 0 || cfg.Facility > 23 {
+		return fmt.Errorf(`"facility" must be between 0 and 23, got %d`, cfg.Facility)
+	}
+	if cfg.StructuredDataID != "" && sanitizeSDName(cfg.StructuredDataID) != cfg.StructuredDataID {
+		return fmt.Errorf(`invalid "structured_data_id" %q`, cfg.StructuredDataID)
+	}
+	return nil
+}
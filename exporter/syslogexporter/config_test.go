@@ -0,0 +1,110 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslogexporter
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configtest"
+	"go.opentelemetry.io/collector/config/configtls"
+)
+
+func TestLoadConfig(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	assert.Nil(t, err)
+
+	factory := NewFactory()
+	factories.Exporters[typeStr] = factory
+	cfg, err := configtest.LoadConfigAndValidate(path.Join(".", "testdata", "config.yaml"), factories)
+
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	e0 := cfg.Exporters[config.NewComponentID(typeStr)]
+	defaultCfg := factory.CreateDefaultConfig().(*Config)
+	defaultCfg.Endpoint = "localhost:514"
+	assert.Equal(t, defaultCfg, e0)
+
+	e1 := cfg.Exporters[config.NewComponentIDWithName(typeStr, "allsettings")]
+	expectedCfg := factory.CreateDefaultConfig().(*Config)
+	expectedCfg.ExporterSettings = config.NewExporterSettings(config.NewComponentIDWithName(typeStr, "allsettings"))
+	expectedCfg.Endpoint = "siem:6514"
+	expectedCfg.Timeout = 10 * time.Second
+	expectedCfg.TLSSetting = &configtls.TLSClientSetting{
+		TLSSetting: configtls.TLSSetting{CAFile: "/etc/ssl/ca.pem"},
+	}
+	expectedCfg.Protocol = ProtocolRFC3164
+	expectedCfg.EnableOctetCounting = false
+	expectedCfg.Facility = 16
+	expectedCfg.StructuredDataID = "attributes@12345"
+	assert.Equal(t, expectedCfg, e1)
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(cfg *Config)
+		wantErr string
+	}{
+		{
+			name:    "no endpoint",
+			modify:  func(cfg *Config) { cfg.Endpoint = "" },
+			wantErr: `requires a non-empty "endpoint"`,
+		},
+		{
+			name:    "unsupported network",
+			modify:  func(cfg *Config) { cfg.Network = "unix" },
+			wantErr: `unsupported "network" "unix", must be "tcp" or "udp"`,
+		},
+		{
+			name: "tls over udp",
+			modify: func(cfg *Config) {
+				cfg.Network = "udp"
+				cfg.TLSSetting = &configtls.TLSClientSetting{}
+			},
+			wantErr: `"tls" is not supported with the "udp" network`,
+		},
+		{
+			name:    "unsupported protocol",
+			modify:  func(cfg *Config) { cfg.Protocol = "rfc6587" },
+			wantErr: `unsupported "protocol" "rfc6587", must be "rfc5424" or "rfc3164"`,
+		},
+		{
+			name:    "invalid facility",
+			modify:  func(cfg *Config) { cfg.Facility = 24 },
+			wantErr: `"facility" must be between 0 and 23, got 24`,
+		},
+		{
+			name:    "invalid structured data ID",
+			modify:  func(cfg *Config) { cfg.StructuredDataID = "my attributes" },
+			wantErr: `invalid "structured_data_id" "my attributes"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Endpoint = "localhost:514"
+			require.NoError(t, cfg.Validate())
+			tt.modify(cfg)
+			assert.EqualError(t, cfg.Validate(), tt.wantErr)
+		})
+	}
+}
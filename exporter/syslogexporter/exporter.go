@@ -0,0 +1,161 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslogexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/syslogexporter"
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+)
+
+type syslogExporter struct {
+	config    *Config
+	logger    *zap.Logger
+	tlsConfig *tls.Config
+	hostname  string
+
+	// The messages of concurrent pushes are written one push at a time on a
+	// single connection, dialed by the first push and again after a failed
+	// write over TCP.
+	connLock sync.Mutex
+	conn     net.Conn
+}
+
+func newExporter(config *Config, logger *zap.Logger) *syslogExporter {
+	hostname, _ := os.Hostname()
+	return &syslogExporter{
+		config:   config,
+		logger:   logger,
+		hostname: hostname,
+	}
+}
+
+func (e *syslogExporter) start(context.Context, component.Host) error {
+	if e.config.TLSSetting == nil {
+		return nil
+	}
+	// No TLS configuration is returned when "insecure" is set, the messages
+	// are then sent in plaintext.
+	tlsConfig, err := e.config.TLSSetting.LoadTLSConfig()
+	if err != nil {
+		return err
+	}
+	e.tlsConfig = tlsConfig
+	return nil
+}
+
+func (e *syslogExporter) shutdown(context.Context) error {
+	e.connLock.Lock()
+	defer e.connLock.Unlock()
+	e.closeConn()
+	return nil
+}
+
+// pushLogsData sends the log records as syslog messages, in a single write
+// over TCP and in a datagram per message over UDP.
+func (e *syslogExporter) pushLogsData(ctx context.Context, ld pdata.Logs) error {
+	messages := e.formatMessages(ld)
+	if len(messages) == 0 {
+		return nil
+	}
+
+	e.connLock.Lock()
+	defer e.connLock.Unlock()
+
+	err := e.send(ctx, messages)
+	if err != nil && e.config.Network == networkTCP {
+		// A failed write may have left part of a frame on the stream, the
+		// next messages would be read as its continuation.
+		e.closeConn()
+	}
+	return err
+}
+
+func (e *syslogExporter) formatMessages(ld pdata.Logs) [][]byte {
+	var messages [][]byte
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		ills := rl.InstrumentationLibraryLogs()
+		for j := 0; j < ills.Len(); j++ {
+			logs := ills.At(j).Logs()
+			for k := 0; k < logs.Len(); k++ {
+				msg := newSyslogMessage(rl.Resource(), logs.At(k), e.config.Facility, e.config.StructuredDataID, e.hostname)
+				if e.config.Protocol == ProtocolRFC3164 {
+					messages = append(messages, msg.appendRFC3164(nil))
+				} else {
+					messages = append(messages, msg.appendRFC5424(nil))
+				}
+			}
+		}
+	}
+	return messages
+}
+
+func (e *syslogExporter) send(ctx context.Context, messages [][]byte) error {
+	if e.conn == nil {
+		conn, err := e.dial(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to connect to %s: %w", e.config.Endpoint, err)
+		}
+		e.conn = conn
+	}
+
+	deadline, _ := ctx.Deadline()
+	if err := e.conn.SetWriteDeadline(deadline); err != nil {
+		return err
+	}
+
+	if e.config.Network == networkUDP {
+		for _, msg := range messages {
+			if _, err := e.conn.Write(msg); err != nil {
+				return fmt.Errorf("failed to send message: %w", err)
+			}
+		}
+		return nil
+	}
+
+	var frames []byte
+	for _, msg := range messages {
+		frames = appendFrame(frames, msg, e.config.EnableOctetCounting)
+	}
+	if _, err := e.conn.Write(frames); err != nil {
+		return fmt.Errorf("failed to send messages: %w", err)
+	}
+	return nil
+}
+
+func (e *syslogExporter) dial(ctx context.Context) (net.Conn, error) {
+	if e.tlsConfig != nil {
+		dialer := &tls.Dialer{Config: e.tlsConfig}
+		return dialer.DialContext(ctx, e.config.Network, e.config.Endpoint)
+	}
+	var dialer net.Dialer
+	return dialer.DialContext(ctx, e.config.Network, e.config.Endpoint)
+}
+
+func (e *syslogExporter) closeConn() {
+	if e.conn != nil {
+		e.conn.Close()
+		e.conn = nil
+	}
+}
@@ -0,0 +1,336 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslogexporter
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/testutil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/stanza"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/syslogreceiver"
+)
+
+// startReceiver starts a syslog receiver as the server of the exporter.
+func startReceiver(t *testing.T, network string, protocol string, tlsSettings map[string]interface{}) (string, *consumertest.LogsSink) {
+	endpoint := testutil.GetAvailableLocalAddress(t)
+	input := map[string]interface{}{"listen_address": endpoint}
+	if tlsSettings != nil {
+		input["tls"] = tlsSettings
+	}
+
+	factory := syslogreceiver.NewFactory()
+	cfg := factory.CreateDefaultConfig().(*syslogreceiver.SysLogConfig)
+	cfg.Converter = stanza.ConverterConfig{FlushInterval: 10 * time.Millisecond, WorkerCount: 1}
+	cfg.Input = stanza.InputConfig{
+		network:    input,
+		"protocol": protocol,
+	}
+
+	sink := new(consumertest.LogsSink)
+	rcv, err := factory.CreateLogsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, rcv.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, rcv.Shutdown(context.Background()))
+	})
+	return endpoint, sink
+}
+
+func newTestExporter(t *testing.T, endpoint string, modify func(cfg *Config)) *syslogExporter {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = endpoint
+	if modify != nil {
+		modify(cfg)
+	}
+	require.NoError(t, cfg.Validate())
+
+	exp := newExporter(cfg, zap.NewNop())
+	require.NoError(t, exp.start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, exp.shutdown(context.Background()))
+	})
+	return exp
+}
+
+func pushLogs(t *testing.T, exp *syslogExporter, ld pdata.Logs) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return exp.pushLogsData(ctx, ld)
+}
+
+func testLogs(ts time.Time, count int) pdata.Logs {
+	ld := pdata.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().InsertString("host.name", "host1")
+	rl.Resource().Attributes().InsertString("service.name", "app")
+	rl.Resource().Attributes().InsertInt("process.pid", 42)
+	logs := rl.InstrumentationLibraryLogs().AppendEmpty().Logs()
+	for i := 0; i < count; i++ {
+		lr := logs.AppendEmpty()
+		lr.SetTimestamp(pdata.NewTimestampFromTime(ts.Add(time.Duration(i) * time.Second)))
+		lr.SetSeverityNumber(pdata.SeverityNumberERROR)
+		lr.Body().SetStringVal("test msg " + strconv.Itoa(i))
+		lr.Attributes().InsertString("user", "alice")
+	}
+	return ld
+}
+
+// receivedRecords returns the log records received by the sink in order.
+func receivedRecords(t *testing.T, sink *consumertest.LogsSink, count int) []pdata.LogRecord {
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() >= count
+	}, 5*time.Second, 10*time.Millisecond)
+
+	var records []pdata.LogRecord
+	for _, ld := range sink.AllLogs() {
+		rls := ld.ResourceLogs()
+		for i := 0; i < rls.Len(); i++ {
+			ills := rls.At(i).InstrumentationLibraryLogs()
+			for j := 0; j < ills.Len(); j++ {
+				logs := ills.At(j).Logs()
+				for k := 0; k < logs.Len(); k++ {
+					records = append(records, logs.At(k))
+				}
+			}
+		}
+	}
+	require.Len(t, records, count)
+	return records
+}
+
+func bodyField(t *testing.T, lr pdata.LogRecord, key string) pdata.AttributeValue {
+	v, ok := lr.Body().MapVal().Get(key)
+	require.True(t, ok, "missing %s", key)
+	return v
+}
+
+func TestPushLogsData(t *testing.T) {
+	tests := []struct {
+		name     string
+		network  string
+		protocol string
+	}{
+		{name: "rfc5424 over tcp", network: networkTCP, protocol: ProtocolRFC5424},
+		{name: "rfc5424 over udp", network: networkUDP, protocol: ProtocolRFC5424},
+		{name: "rfc3164 over tcp", network: networkTCP, protocol: ProtocolRFC3164},
+		{name: "rfc3164 over udp", network: networkUDP, protocol: ProtocolRFC3164},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint, sink := startReceiver(t, tt.network, tt.protocol, nil)
+			exp := newTestExporter(t, endpoint, func(cfg *Config) {
+				cfg.Network = tt.network
+				cfg.Protocol = tt.protocol
+				// The syslog receiver only supports the non-transparent framing.
+				cfg.EnableOctetCounting = false
+			})
+
+			// RFC3164 timestamps have no year nor sub-second precision.
+			ts := time.Now().UTC().Truncate(time.Second)
+			require.NoError(t, pushLogs(t, exp, testLogs(ts, 3)))
+
+			records := receivedRecords(t, sink, 3)
+			for i, lr := range records {
+				assert.Equal(t, pdata.NewTimestampFromTime(ts.Add(time.Duration(i)*time.Second)), lr.Timestamp())
+				assert.Equal(t, pdata.SeverityNumberERROR, lr.SeverityNumber())
+				assert.Equal(t, "test msg "+strconv.Itoa(i), bodyField(t, lr, "message").StringVal())
+				assert.Equal(t, "host1", bodyField(t, lr, "hostname").StringVal())
+				assert.Equal(t, "app", bodyField(t, lr, "appname").StringVal())
+				assert.Equal(t, "42", bodyField(t, lr, "proc_id").StringVal())
+				assert.EqualValues(t, 1, bodyField(t, lr, "facility").IntVal())
+				// The receiver does not convert the structured data, see
+				// TestAppendRFC5424 for its formatting.
+			}
+		})
+	}
+}
+
+func TestPushLogsData_TLS(t *testing.T) {
+	certFile, keyFile := generateCertificate(t)
+	serverTLS := map[string]interface{}{
+		"cert_file": certFile,
+		"key_file":  keyFile,
+	}
+	tests := []struct {
+		name      string
+		serverTLS map[string]interface{}
+		clientTLS configtls.TLSClientSetting
+	}{
+		{
+			name:      "verified",
+			serverTLS: serverTLS,
+			clientTLS: configtls.TLSClientSetting{
+				TLSSetting: configtls.TLSSetting{CAFile: certFile},
+				ServerName: "localhost",
+			},
+		},
+		{
+			name:      "insecure_skip_verify",
+			serverTLS: serverTLS,
+			clientTLS: configtls.TLSClientSetting{InsecureSkipVerify: true},
+		},
+		{
+			name:      "insecure",
+			clientTLS: configtls.TLSClientSetting{Insecure: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint, sink := startReceiver(t, networkTCP, ProtocolRFC5424, tt.serverTLS)
+			exp := newTestExporter(t, endpoint, func(cfg *Config) {
+				tlsSetting := tt.clientTLS
+				cfg.TLSSetting = &tlsSetting
+				cfg.EnableOctetCounting = false
+			})
+
+			ts := time.Now().UTC()
+			require.NoError(t, pushLogs(t, exp, testLogs(ts, 2)))
+			records := receivedRecords(t, sink, 2)
+			assert.Equal(t, "test msg 0", bodyField(t, records[0], "message").StringVal())
+			assert.Equal(t, "test msg 1", bodyField(t, records[1], "message").StringVal())
+		})
+	}
+}
+
+func TestPushLogsData_OctetCounting(t *testing.T) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	frames := make(chan string, 10)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		reader := bufio.NewReader(conn)
+		for {
+			length, err := reader.ReadString(' ')
+			if err != nil {
+				return
+			}
+			n, err := strconv.Atoi(length[:len(length)-1])
+			if err != nil {
+				return
+			}
+			frame := make([]byte, n)
+			if _, err = io.ReadFull(reader, frame); err != nil {
+				return
+			}
+			frames <- string(frame)
+		}
+	}()
+
+	exp := newTestExporter(t, listener.Addr().String(), nil)
+	ts := time.Date(2021, 12, 1, 10, 20, 30, 0, time.UTC)
+	require.NoError(t, pushLogs(t, exp, testLogs(ts, 2)))
+	// The connection is reused by the next push.
+	require.NoError(t, pushLogs(t, exp, testLogs(ts, 1)))
+
+	for _, want := range []string{
+		`<11>1 2021-12-01T10:20:30.000000Z host1 app 42 - [otel@32473 user="alice"] test msg 0`,
+		`<11>1 2021-12-01T10:20:31.000000Z host1 app 42 - [otel@32473 user="alice"] test msg 1`,
+		`<11>1 2021-12-01T10:20:30.000000Z host1 app 42 - [otel@32473 user="alice"] test msg 0`,
+	} {
+		select {
+		case frame := <-frames:
+			assert.Equal(t, want, frame)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for frame")
+		}
+	}
+}
+
+func TestPushLogsData_Reconnect(t *testing.T) {
+	endpoint, sink := startReceiver(t, networkTCP, ProtocolRFC5424, nil)
+	exp := newTestExporter(t, endpoint, func(cfg *Config) {
+		cfg.EnableOctetCounting = false
+	})
+
+	ts := time.Now().UTC()
+	require.NoError(t, pushLogs(t, exp, testLogs(ts, 1)))
+	receivedRecords(t, sink, 1)
+
+	// A failed write closes the connection, the next push reconnects.
+	exp.connLock.Lock()
+	require.NoError(t, exp.conn.Close())
+	exp.connLock.Unlock()
+	require.Error(t, pushLogs(t, exp, testLogs(ts, 1)))
+	require.Nil(t, exp.conn)
+
+	require.NoError(t, pushLogs(t, exp, testLogs(ts, 1)))
+	receivedRecords(t, sink, 2)
+}
+
+func TestPushLogsData_ConnectionRefused(t *testing.T) {
+	exp := newTestExporter(t, testutil.GetAvailableLocalAddress(t), nil)
+	err := pushLogs(t, exp, testLogs(time.Now(), 1))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to connect to")
+}
+
+// generateCertificate writes a self-signed certificate for localhost and its
+// key, returning their paths.
+func generateCertificate(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}), 0600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}), 0600))
+	return certFile, keyFile
+}
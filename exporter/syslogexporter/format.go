@@ -0,0 +1,305 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslogexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/syslogexporter"
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/model/pdata"
+	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
+)
+
+// nilValue is the value of the empty header fields of RFC5424 messages.
+const nilValue = "-"
+
+// escapedNewline replaces the newlines of the messages framed by a newline.
+const escapedNewline = "#012"
+
+const (
+	rfc5424Timestamp = "2006-01-02T15:04:05.000000Z07:00"
+	rfc3164Timestamp = "Jan _2 15:04:05"
+)
+
+// Syslog severities, see https://datatracker.ietf.org/doc/html/rfc5424#section-6.2.1.
+const (
+	severityEmergency = iota
+	severityAlert
+	severityCritical
+	severityError
+	severityWarning
+	severityNotice
+	severityInformational
+	severityDebug
+)
+
+// Keys of the body of the log records created by the syslog receiver.
+const (
+	bodyMessage        = "message"
+	bodyHostname       = "hostname"
+	bodyAppname        = "appname"
+	bodyProcID         = "proc_id"
+	bodyMsgID          = "msg_id"
+	bodyFacility       = "facility"
+	bodyStructuredData = "structured_data"
+)
+
+// syslogMessage holds the fields of a syslog message.
+type syslogMessage struct {
+	facility       int
+	severity       int
+	timestamp      time.Time
+	hostname       string
+	appname        string
+	procID         string
+	msgID          string
+	structuredData []sdElement
+	message        string
+}
+
+type sdElement struct {
+	id     string
+	params []sdParam
+}
+
+type sdParam struct {
+	name  string
+	value string
+}
+
+// newSyslogMessage maps the log record to a syslog message. The fields of the
+// log records created by the syslog receiver are taken from their body, the
+// hostname, app name and process ID of the others from the attributes of
+// their resource. The attributes of the log record are added as a structured
+// data element of ID sdID if not empty.
+func newSyslogMessage(resource pdata.Resource, lr pdata.LogRecord, facility int, sdID string, defaultHostname string) *syslogMessage {
+	msg := &syslogMessage{
+		facility:  facility,
+		severity:  toSyslogSeverity(lr.SeverityNumber()),
+		timestamp: lr.Timestamp().AsTime(),
+		hostname:  defaultHostname,
+	}
+	if lr.Timestamp() == 0 {
+		msg.timestamp = time.Now()
+	}
+
+	resourceAttrs := resource.Attributes()
+	if v, ok := resourceAttrs.Get(conventions.AttributeHostName); ok {
+		msg.hostname = v.AsString()
+	}
+	if v, ok := resourceAttrs.Get(conventions.AttributeServiceName); ok {
+		msg.appname = v.AsString()
+	}
+	if v, ok := resourceAttrs.Get(conventions.AttributeProcessPID); ok {
+		msg.procID = v.AsString()
+	}
+
+	body := lr.Body()
+	if body.Type() == pdata.AttributeValueTypeMap {
+		fields := body.MapVal()
+		setString(fields, bodyMessage, &msg.message)
+		setString(fields, bodyHostname, &msg.hostname)
+		setString(fields, bodyAppname, &msg.appname)
+		setString(fields, bodyProcID, &msg.procID)
+		setString(fields, bodyMsgID, &msg.msgID)
+		if v, ok := fields.Get(bodyFacility); ok && v.Type() == pdata.AttributeValueTypeInt && v.IntVal() >= 0 && v.IntVal() <= 23 {
+			msg.facility = int(v.IntVal())
+		}
+		if v, ok := fields.Get(bodyStructuredData); ok && v.Type() == pdata.AttributeValueTypeMap {
+			v.MapVal().Range(func(id string, params pdata.AttributeValue) bool {
+				if params.Type() == pdata.AttributeValueTypeMap {
+					msg.structuredData = append(msg.structuredData, newSDElement(id, params.MapVal()))
+				}
+				return true
+			})
+		}
+	} else if body.Type() != pdata.AttributeValueTypeEmpty {
+		msg.message = body.AsString()
+	}
+
+	if sdID != "" && lr.Attributes().Len() > 0 {
+		msg.structuredData = append(msg.structuredData, newSDElement(sdID, lr.Attributes()))
+	}
+	return msg
+}
+
+func setString(fields pdata.AttributeMap, key string, dest *string) {
+	if v, ok := fields.Get(key); ok {
+		*dest = v.AsString()
+	}
+}
+
+func newSDElement(id string, params pdata.AttributeMap) sdElement {
+	element := sdElement{id: id}
+	params.Range(func(k string, v pdata.AttributeValue) bool {
+		element.params = append(element.params, sdParam{name: k, value: v.AsString()})
+		return true
+	})
+	return element
+}
+
+// toSyslogSeverity maps the severity number of a log record to a syslog
+// severity, the inverse of the mapping of the syslog receiver. Log records
+// without severity are informational.
+func toSyslogSeverity(sn pdata.SeverityNumber) int {
+	switch {
+	case sn == pdata.SeverityNumberUNDEFINED:
+		return severityInformational
+	case sn < pdata.SeverityNumberINFO:
+		return severityDebug
+	case sn == pdata.SeverityNumberINFO:
+		return severityInformational
+	case sn < pdata.SeverityNumberWARN:
+		return severityNotice
+	case sn < pdata.SeverityNumberERROR:
+		return severityWarning
+	case sn == pdata.SeverityNumberERROR:
+		return severityError
+	case sn == pdata.SeverityNumberERROR2:
+		return severityCritical
+	case sn < pdata.SeverityNumberFATAL:
+		return severityAlert
+	default:
+		return severityEmergency
+	}
+}
+
+func (m *syslogMessage) priority() int {
+	return m.facility*8 + m.severity
+}
+
+// appendRFC5424 appends the message formatted as per RFC5424.
+func (m *syslogMessage) appendRFC5424(b []byte) []byte {
+	b = append(b, '<')
+	b = strconv.AppendInt(b, int64(m.priority()), 10)
+	b = append(b, ">1 "...)
+	b = m.timestamp.AppendFormat(b, rfc5424Timestamp)
+	b = append(b, ' ')
+	b = append(b, headerField(m.hostname, 255)...)
+	b = append(b, ' ')
+	b = append(b, headerField(m.appname, 48)...)
+	b = append(b, ' ')
+	b = append(b, headerField(m.procID, 128)...)
+	b = append(b, ' ')
+	b = append(b, headerField(m.msgID, 32)...)
+	b = append(b, ' ')
+	if len(m.structuredData) == 0 {
+		b = append(b, nilValue...)
+	}
+	for _, element := range m.structuredData {
+		b = append(b, '[')
+		b = append(b, sanitizeSDName(element.id)...)
+		for _, param := range element.params {
+			b = append(b, ' ')
+			b = append(b, sanitizeSDName(param.name)...)
+			b = append(b, `="`...)
+			b = appendEscapedParamValue(b, param.value)
+			b = append(b, '"')
+		}
+		b = append(b, ']')
+	}
+	if m.message != "" {
+		b = append(b, ' ')
+		b = append(b, m.message...)
+	}
+	return b
+}
+
+// appendRFC3164 appends the message formatted as per RFC3164. The timestamp is
+// in UTC since the format has no time zone.
+func (m *syslogMessage) appendRFC3164(b []byte) []byte {
+	b = append(b, '<')
+	b = strconv.AppendInt(b, int64(m.priority()), 10)
+	b = append(b, '>')
+	b = m.timestamp.UTC().AppendFormat(b, rfc3164Timestamp)
+	b = append(b, ' ')
+	b = append(b, headerField(m.hostname, 255)...)
+	b = append(b, ' ')
+	if tag := sanitize(m.appname, 32, ":["); tag != "" {
+		b = append(b, tag...)
+		if procID := sanitize(m.procID, 128, "]"); procID != "" {
+			b = append(b, '[')
+			b = append(b, procID...)
+			b = append(b, ']')
+		}
+		b = append(b, ": "...)
+	}
+	return append(b, m.message...)
+}
+
+// appendFrame appends the message framed for a TCP stream as per RFC6587,
+// either by octet counting or by a trailing newline. With the latter, the
+// newlines of the message are escaped as "#012", as done by rsyslog, so that
+// they do not end the frame early.
+func appendFrame(b []byte, msg []byte, octetCounting bool) []byte {
+	if octetCounting {
+		b = strconv.AppendInt(b, int64(len(msg)), 10)
+		b = append(b, ' ')
+		return append(b, msg...)
+	}
+	for {
+		i := bytes.IndexByte(msg, '\n')
+		if i < 0 {
+			break
+		}
+		b = append(b, msg[:i]...)
+		b = append(b, escapedNewline...)
+		msg = msg[i+1:]
+	}
+	b = append(b, msg...)
+	return append(b, '\n')
+}
+
+// headerField returns the value of a header field, which is the nil value if
+// empty.
+func headerField(value string, maxLen int) string {
+	if value = sanitize(value, maxLen, ""); value == "" {
+		return nilValue
+	}
+	return value
+}
+
+// sanitizeSDName returns the name as a valid SD-ID or PARAM-NAME.
+func sanitizeSDName(name string) string {
+	return sanitize(name, 32, `="]`)
+}
+
+// sanitize replaces the characters of value that are not printable US-ASCII
+// or in invalid by underscores and truncates it to maxLen characters.
+func sanitize(value string, maxLen int, invalid string) string {
+	if len(value) > maxLen {
+		value = value[:maxLen]
+	}
+	return strings.Map(func(r rune) rune {
+		if r <= ' ' || r > '~' || strings.ContainsRune(invalid, r) {
+			return '_'
+		}
+		return r
+	}, value)
+}
+
+func appendEscapedParamValue(b []byte, value string) []byte {
+	for i := 0; i < len(value); i++ {
+		switch c := value[i]; c {
+		case '"', '\\', ']':
+			b = append(b, '\\', c)
+		default:
+			b = append(b, c)
+		}
+	}
+	return b
+}
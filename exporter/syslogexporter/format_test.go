@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslogexporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/model/pdata"
+	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
+)
+
+func testLogRecord() (pdata.Resource, pdata.LogRecord) {
+	resource := pdata.NewResource()
+	resource.Attributes().InsertString(conventions.AttributeHostName, "host1")
+	resource.Attributes().InsertString(conventions.AttributeServiceName, "app")
+	resource.Attributes().InsertInt(conventions.AttributeProcessPID, 42)
+
+	lr := pdata.NewLogRecord()
+	lr.SetTimestamp(pdata.NewTimestampFromTime(time.Date(2021, 12, 1, 10, 20, 30, 123456789, time.UTC)))
+	lr.SetSeverityNumber(pdata.SeverityNumberWARN)
+	lr.Body().SetStringVal("something happened")
+	lr.Attributes().InsertString("user", "alice")
+	lr.Attributes().InsertString("quote", `a "b" [c] \d`)
+	return resource, lr
+}
+
+func TestAppendRFC5424(t *testing.T) {
+	resource, lr := testLogRecord()
+	msg := newSyslogMessage(resource, lr, 1, "otel@32473", "default")
+	assert.Equal(t,
+		`<12>1 2021-12-01T10:20:30.123456Z host1 app 42 - [otel@32473 user="alice" quote="a \"b\" [c\] \\d"] something happened`,
+		string(msg.appendRFC5424(nil)))
+
+	msg = newSyslogMessage(pdata.NewResource(), lr, 16, "", "default")
+	assert.Equal(t,
+		`<132>1 2021-12-01T10:20:30.123456Z default - - - - something happened`,
+		string(msg.appendRFC5424(nil)))
+}
+
+func TestAppendRFC5424_SyslogReceiverBody(t *testing.T) {
+	lr := pdata.NewLogRecord()
+	lr.SetTimestamp(pdata.NewTimestampFromTime(time.Date(2021, 2, 28, 0, 1, 2, 3000000, time.UTC)))
+	lr.SetSeverityNumber(pdata.SeverityNumberINFO2)
+	body := pdata.NewAttributeValueMap()
+	fields := body.MapVal()
+	fields.InsertString("message", "test msg")
+	fields.InsertString("hostname", "192.168.1.1")
+	fields.InsertString("appname", "SecureAuth0")
+	fields.InsertString("proc_id", "23108")
+	fields.InsertString("msg_id", "ID52020")
+	fields.InsertInt("facility", 10)
+	sd := pdata.NewAttributeValueMap()
+	params := pdata.NewAttributeValueMap()
+	params.MapVal().InsertString("UserHostAddress", "192.168.2.132")
+	sd.MapVal().Insert("SecureAuth@27389", params)
+	fields.Insert("structured_data", sd)
+	body.CopyTo(lr.Body())
+
+	msg := newSyslogMessage(pdata.NewResource(), lr, 1, "otel@32473", "default")
+	assert.Equal(t,
+		`<85>1 2021-02-28T00:01:02.003000Z 192.168.1.1 SecureAuth0 23108 ID52020 [SecureAuth@27389 UserHostAddress="192.168.2.132"] test msg`,
+		string(msg.appendRFC5424(nil)))
+}
+
+func TestAppendRFC3164(t *testing.T) {
+	resource, lr := testLogRecord()
+	msg := newSyslogMessage(resource, lr, 1, "otel@32473", "default")
+	assert.Equal(t, `<12>Dec  1 10:20:30 host1 app[42]: something happened`, string(msg.appendRFC3164(nil)))
+
+	msg = newSyslogMessage(pdata.NewResource(), lr, 1, "", "default")
+	assert.Equal(t, `<12>Dec  1 10:20:30 default something happened`, string(msg.appendRFC3164(nil)))
+}
+
+func TestToSyslogSeverity(t *testing.T) {
+	tests := []struct {
+		severity pdata.SeverityNumber
+		want     int
+	}{
+		{pdata.SeverityNumberUNDEFINED, severityInformational},
+		{pdata.SeverityNumberTRACE, severityDebug},
+		{pdata.SeverityNumberDEBUG4, severityDebug},
+		{pdata.SeverityNumberINFO, severityInformational},
+		{pdata.SeverityNumberINFO2, severityNotice},
+		{pdata.SeverityNumberWARN, severityWarning},
+		{pdata.SeverityNumberERROR, severityError},
+		{pdata.SeverityNumberERROR2, severityCritical},
+		{pdata.SeverityNumberERROR3, severityAlert},
+		{pdata.SeverityNumberFATAL, severityEmergency},
+		{pdata.SeverityNumberFATAL4, severityEmergency},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, toSyslogSeverity(tt.severity), tt.severity.String())
+	}
+}
+
+func TestSanitize(t *testing.T) {
+	assert.Equal(t, "-", headerField("", 10))
+	assert.Equal(t, "my_host", headerField("my host", 10))
+	assert.Equal(t, "abc", headerField("abcdef", 3))
+	assert.Equal(t, "a_b_c_d_", sanitizeSDName(`a=b]c"dé`))
+}
+
+func TestAppendFrame(t *testing.T) {
+	b := appendFrame(nil, []byte("<12>1 first"), true)
+	b = appendFrame(b, []byte("<12>1 second"), true)
+	assert.Equal(t, "11 <12>1 first12 <12>1 second", string(b))
+
+	b = appendFrame(nil, []byte("<12>1 first"), false)
+	assert.Equal(t, "<12>1 first\n", string(b))
+
+	// Newlines only end the frame when the message is not octet counted.
+	b = appendFrame(nil, []byte("<12>1 multi\nline\n"), false)
+	assert.Equal(t, "<12>1 multi#012line#012\n", string(b))
+	b = appendFrame(nil, []byte("<12>1 multi\nline"), true)
+	assert.Equal(t, "16 <12>1 multi\nline", string(b))
+}
@@ -39,6 +39,8 @@ const (
 	HostPortType EndpointType = "hostport"
 	// ContainerType is a container endpoint.
 	ContainerType EndpointType = "container"
+	// ProcessType is a process endpoint.
+	ProcessType EndpointType = "process"
 )
 
 var (
@@ -47,6 +49,7 @@ var (
 	_ EndpointDetails = (*K8sNode)(nil)
 	_ EndpointDetails = (*HostPort)(nil)
 	_ EndpointDetails = (*Container)(nil)
+	_ EndpointDetails = (*Process)(nil)
 )
 
 // EndpointDetails provides additional context about an endpoint such as a Pod or Port.
@@ -206,6 +209,39 @@ func (c *Container) Type() EndpointType {
 	return ContainerType
 }
 
+// Process is a process running on a host, whether or not it listens on a port.
+type Process struct {
+	// PID is the process ID.
+	PID int32
+	// Name of the process, usually the base name of its executable.
+	Name string
+	// Executable is the path of the executable of the process. It is an empty
+	// string if the observer is not allowed to resolve it.
+	Executable string
+	// Command used to invoke the process, including its arguments.
+	Command string
+	// Username of the owner of the process.
+	Username string
+	// Cgroup is the path of the process in the cgroup hierarchy, e.g.
+	// "/system.slice/docker.service". Empty if cgroups are not available.
+	Cgroup string
+}
+
+func (p *Process) Env() EndpointEnv {
+	return map[string]interface{}{
+		"pid":        p.PID,
+		"name":       p.Name,
+		"executable": p.Executable,
+		"command":    p.Command,
+		"username":   p.Username,
+		"cgroup":     p.Cgroup,
+	}
+}
+
+func (p *Process) Type() EndpointType {
+	return ProcessType
+}
+
 // K8sNode represents a Kubernetes Node object:
 // https://github.com/open-telemetry/opentelemetry-specification/blob/main/specification/resource/semantic_conventions/k8s.md#node
 type K8sNode struct {
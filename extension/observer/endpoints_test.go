@@ -124,6 +124,32 @@ func TestEndpointEnv(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Process",
+			endpoint: Endpoint{
+				ID:     EndpointID("process_id"),
+				Target: "localhost",
+				Details: &Process{
+					PID:        1234,
+					Name:       "java",
+					Executable: "/usr/bin/java",
+					Command:    "/usr/bin/java -jar app.jar",
+					Username:   "app",
+					Cgroup:     "/system.slice/app.service",
+				},
+			},
+			want: EndpointEnv{
+				"type":       "process",
+				"endpoint":   "localhost",
+				"pid":        int32(1234),
+				"name":       "java",
+				"executable": "/usr/bin/java",
+				"command":    "/usr/bin/java -jar app.jar",
+				"username":   "app",
+				"cgroup":     "/system.slice/app.service",
+			},
+			wantErr: false,
+		},
 		{
 			name: "Container",
 			endpoint: Endpoint{
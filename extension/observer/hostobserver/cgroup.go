@@ -0,0 +1,29 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hostobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/hostobserver"
+
+import (
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/cgroup"
+)
+
+// readCgroup returns the cgroup path of the process, or an empty string if it
+// can't be determined, e.g. on systems other than Linux.
+func readCgroup(pid int32) string {
+	path, err := cgroup.ReadProcessPath(pid)
+	if err != nil {
+		return ""
+	}
+	return path
+}
@@ -27,4 +27,8 @@ type Config struct {
 	// RefreshInterval determines how frequency at which the observer
 	// needs to poll for collecting information about new processes.
 	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+
+	// ProcessEndpoints enables reporting an endpoint for every running process,
+	// in addition to the endpoints of listening sockets.
+	ProcessEndpoints bool `mapstructure:"process_endpoints"`
 }
@@ -47,6 +47,7 @@ func TestLoadConfig(t *testing.T) {
 		&Config{
 			ExtensionSettings: config.NewExtensionSettings(config.NewComponentIDWithName(typeStr, "all_settings")),
 			RefreshInterval:   20 * time.Second,
+			ProcessEndpoints:  true,
 		},
 		ext1)
 }
@@ -33,11 +33,13 @@ type hostObserver struct {
 }
 
 type endpointsLister struct {
-	logger       *zap.Logger
-	observerName string
+	logger           *zap.Logger
+	observerName     string
+	processEndpoints bool
 
 	// For testing
 	getConnections        func() ([]net.ConnectionStat, error)
+	getProcesses          func() ([]*process.Process, error)
 	getProcess            func(pid int32) (*process.Process, error)
 	collectProcessDetails func(proc *process.Process) (*processDetails, error)
 }
@@ -51,7 +53,9 @@ func newObserver(logger *zap.Logger, config *Config) (component.Extension, error
 			Endpointslister: endpointsLister{
 				logger:                logger,
 				observerName:          config.ID().String(),
+				processEndpoints:      config.ProcessEndpoints,
 				getConnections:        getConnections,
+				getProcesses:          process.Processes,
 				getProcess:            process.NewProcess,
 				collectProcessDetails: collectProcessDetails,
 			},
@@ -71,13 +75,25 @@ func (h *hostObserver) Shutdown(context.Context) error {
 }
 
 func (e endpointsLister) ListEndpoints() []observer.Endpoint {
+	var endpoints []observer.Endpoint
+
 	conns, err := e.getConnections()
 	if err != nil {
 		e.logger.Error("Could not get local network listeners", zap.Error(err))
-		return nil
+	} else {
+		endpoints = e.collectEndpoints(conns)
+	}
+
+	if e.processEndpoints {
+		procs, err := e.getProcesses()
+		if err != nil {
+			e.logger.Error("Could not get processes", zap.Error(err))
+			return endpoints
+		}
+		endpoints = append(endpoints, e.collectProcessEndpoints(procs)...)
 	}
 
-	return e.collectEndpoints(conns)
+	return endpoints
 }
 
 func getConnections() (conns []net.ConnectionStat, err error) {
@@ -183,6 +199,45 @@ func (e endpointsLister) collectEndpoints(conns []net.ConnectionStat) []observer
 	return endpoints
 }
 
+func (e endpointsLister) collectProcessEndpoints(procs []*process.Process) []observer.Endpoint {
+	endpoints := make([]observer.Endpoint, 0, len(procs))
+	for _, proc := range procs {
+		pd, err := e.collectProcessDetails(proc)
+		if err != nil {
+			e.logger.Debug("Failed collecting process details (skipping)",
+				zap.Int32("pid", proc.Pid), zap.Error(err),
+			)
+			continue
+		}
+
+		// Kernel threads have no command line, there is nothing to monitor there.
+		if pd.args == "" {
+			continue
+		}
+
+		// The creation time tells apart processes reusing the PID of a
+		// terminated one.
+		id := observer.EndpointID(
+			fmt.Sprintf("(%s)process-%d-%d", e.observerName, proc.Pid, pd.createTime),
+		)
+
+		endpoints = append(endpoints, observer.Endpoint{
+			ID:     id,
+			Target: "localhost",
+			Details: &observer.Process{
+				PID:        proc.Pid,
+				Name:       pd.name,
+				Executable: pd.executable,
+				Command:    pd.args,
+				Username:   pd.username,
+				Cgroup:     pd.cgroup,
+			},
+		})
+	}
+
+	return endpoints
+}
+
 type connectionDetails struct {
 	ip        string
 	isIPv6    bool
@@ -221,8 +276,12 @@ func collectConnectionDetails(c *net.ConnectionStat) connectionDetails {
 }
 
 type processDetails struct {
-	name string
-	args string
+	name       string
+	args       string
+	executable string
+	username   string
+	cgroup     string
+	createTime int64
 }
 
 func collectProcessDetails(proc *process.Process) (*processDetails, error) {
@@ -236,9 +295,19 @@ func collectProcessDetails(proc *process.Process) (*processDetails, error) {
 		return nil, fmt.Errorf("could not get process args: %v", err)
 	}
 
+	// The following details are best effort, they may not be accessible
+	// depending on the privileges of the collector.
+	executable, _ := proc.Exe()
+	username, _ := proc.Username()
+	createTime, _ := proc.CreateTime()
+
 	return &processDetails{
-		name: name,
-		args: args,
+		name:       name,
+		args:       args,
+		executable: executable,
+		username:   username,
+		cgroup:     readCgroup(proc.Pid),
+		createTime: createTime,
 	}, nil
 }
 
@@ -555,3 +555,45 @@ func TestCollectEndpoints(t *testing.T) {
 		})
 	}
 }
+
+func TestListProcessEndpoints(t *testing.T) {
+	details := map[int32]*processDetails{
+		1: {name: "java", args: "/usr/bin/java -jar app.jar", executable: "/usr/bin/java", username: "app", cgroup: "/system.slice/app.service", createTime: 100},
+		2: {name: "kthreadd"},
+	}
+	e := endpointsLister{
+		logger:           zap.NewNop(),
+		observerName:     "host_observer",
+		processEndpoints: true,
+		getConnections: func() ([]psnet.ConnectionStat, error) {
+			return nil, errors.New("always fail")
+		},
+		getProcesses: func() ([]*process.Process, error) {
+			return []*process.Process{{Pid: 1}, {Pid: 2}, {Pid: 3}}, nil
+		},
+		collectProcessDetails: func(proc *process.Process) (*processDetails, error) {
+			if pd, ok := details[proc.Pid]; ok {
+				return pd, nil
+			}
+			return nil, errors.New("process terminated")
+		},
+	}
+
+	assert.Equal(t, []observer.Endpoint{
+		{
+			ID:     observer.EndpointID("(host_observer)process-1-100"),
+			Target: "localhost",
+			Details: &observer.Process{
+				PID:        1,
+				Name:       "java",
+				Executable: "/usr/bin/java",
+				Command:    "/usr/bin/java -jar app.jar",
+				Username:   "app",
+				Cgroup:     "/system.slice/app.service",
+			},
+		},
+	}, e.ListEndpoints())
+
+	e.processEndpoints = false
+	assert.Empty(t, e.ListEndpoints())
+}
@@ -15,6 +15,7 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticexporter v0.41.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/f5cloudexporter v0.41.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter v0.41.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fluentforwardexporter v0.41.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/googlecloudexporter v0.41.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/honeycombexporter v0.41.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/humioexporter v0.41.0
@@ -37,6 +38,7 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/splunkhecexporter v0.41.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/stackdriverexporter v0.41.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sumologicexporter v0.41.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/syslogexporter v0.41.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/tanzuobservabilityexporter v0.41.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/tencentcloudlogserviceexporter v0.41.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/zipkinexporter v0.41.0
@@ -61,8 +63,11 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbyattrsprocessor v0.41.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbytraceprocessor v0.41.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor v0.41.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/processor/logdedupprocessor v0.41.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/processor/logmetricsprocessor v0.41.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricsgenerationprocessor v0.41.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricstransformprocessor v0.41.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/processor/multilineprocessor v0.41.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/probabilisticsamplerprocessor v0.41.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor v0.41.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourceprocessor v0.41.0
@@ -75,6 +80,7 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsxrayreceiver v0.41.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/carbonreceiver v0.41.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/collectdreceiver v0.41.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerlogsreceiver v0.41.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver v0.41.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dotnetdiagnosticsreceiver v0.41.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/filelogreceiver v0.41.0
@@ -300,6 +306,7 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/xray v0.41.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/common v0.41.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/docker v0.41.0 // indirect
+	github.com/open-telemetry/opentelemetry-collector-contrib/internal/fluentforward v0.41.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig v0.41.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/kubelet v0.41.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent v0.41.0 // indirect
@@ -451,6 +458,8 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/share
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk => ./internal/splunk
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/fluentforward => ./internal/fluentforward
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig => ./internal/k8sconfig
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/kubelet => ./internal/kubelet
@@ -495,6 +504,8 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/f5clo
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter => ./exporter/fileexporter
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fluentforwardexporter => ./exporter/fluentforwardexporter
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/honeycombexporter => ./exporter/honeycombexporter
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/humioexporter => ./exporter/humioexporter
@@ -537,6 +548,8 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/splun
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sumologicexporter => ./exporter/sumologicexporter
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/syslogexporter => ./exporter/syslogexporter
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/tanzuobservabilityexporter => ./exporter/tanzuobservabilityexporter
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/tencentcloudlogserviceexporter => ./exporter/tencentcloudlogserviceexporter
@@ -633,6 +646,8 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/podma
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/wavefrontreceiver => ./receiver/wavefrontreceiver
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerlogsreceiver => ./receiver/dockerlogsreceiver
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver => ./receiver/dockerstatsreceiver
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/windowsperfcountersreceiver => ./receiver/windowsperfcountersreceiver
@@ -677,6 +692,10 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/grou
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor => ./processor/k8sattributesprocessor/
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/logdedupprocessor => ./processor/logdedupprocessor/
+
+replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/logmetricsprocessor => ./processor/logmetricsprocessor/
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor => ./processor/resourcedetectionprocessor/
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourceprocessor => ./processor/resourceprocessor/
@@ -685,6 +704,8 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/metr
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricsgenerationprocessor => ./processor/metricsgenerationprocessor/
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/multilineprocessor => ./processor/multilineprocessor/
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/probabilisticsamplerprocessor => ./processor/probabilisticsamplerprocessor/
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatorateprocessor => ./processor/deltatorateprocessor/
@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cgroup reads the cgroup of the processes from the proc filesystem.
+package cgroup // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/cgroup"
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ReadProcessPath returns the path of the process in the cgroup hierarchy, read from
+// /proc/<pid>/cgroup. The root of the proc filesystem can be overridden with the HOST_PROC
+// environment variable, like gopsutil does.
+func ReadProcessPath(pid int32) (string, error) {
+	procRoot := os.Getenv("HOST_PROC")
+	if procRoot == "" {
+		procRoot = "/proc"
+	}
+
+	contents, err := ioutil.ReadFile(filepath.Join(procRoot, strconv.Itoa(int(pid)), "cgroup"))
+	if err != nil {
+		return "", err
+	}
+	return ParseProcessPath(string(contents)), nil
+}
+
+// ParseProcessPath extracts the cgroup path from the content of /proc/<pid>/cgroup.
+// Each line is formatted as hierarchy-ID:controller-list:cgroup-path. The path
+// of the unified (v2) hierarchy is preferred, then the one of the systemd
+// hierarchy and finally the first one listed.
+func ParseProcessPath(content string) string {
+	var systemd, first string
+	for _, line := range strings.Split(strings.TrimSpace(content), "\n") {
+		parts := strings.SplitN(line, ":", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		id, controllers, path := parts[0], parts[1], parts[2]
+		switch {
+		case id == "0" && controllers == "":
+			return path
+		case controllers == "name=systemd":
+			systemd = path
+		case first == "":
+			first = path
+		}
+	}
+	if systemd != "" {
+		return systemd
+	}
+	return first
+}
@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cgroup
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProcessPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "unified hierarchy",
+			content: "0::/system.slice/docker.service\n",
+			want:    "/system.slice/docker.service",
+		},
+		{
+			name:    "hybrid hierarchy",
+			content: "12:pids:/user.slice\n1:name=systemd:/user.slice/session-1.scope\n0::/user.slice/session-1.scope\n",
+			want:    "/user.slice/session-1.scope",
+		},
+		{
+			name:    "legacy hierarchy with systemd",
+			content: "11:cpu,cpuacct:/docker/abc\n1:name=systemd:/docker/def\n",
+			want:    "/docker/def",
+		},
+		{
+			name:    "legacy hierarchy",
+			content: "11:cpu,cpuacct:/docker/abc\n10:memory:/docker/def\n",
+			want:    "/docker/abc",
+		},
+		{
+			name:    "invalid",
+			content: "invalid",
+			want:    "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseProcessPath(tt.content))
+		})
+	}
+}
+
+func TestReadProcessPath(t *testing.T) {
+	procRoot := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(procRoot, "42"), 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(procRoot, "42", "cgroup"), []byte("0::/system.slice/docker.service\n"), 0600))
+	t.Setenv("HOST_PROC", procRoot)
+
+	path, err := ReadProcessPath(42)
+	require.NoError(t, err)
+	assert.Equal(t, "/system.slice/docker.service", path)
+
+	_, err = ReadProcessPath(43)
+	assert.Error(t, err)
+}
@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package logsutil provides helpers to regroup log records by resource and instrumentation library.
+package logsutil // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/logsutil"
+
+import (
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// AttributesKey returns a string identifying the attributes, whatever their order.
+func AttributesKey(attrs pdata.AttributeMap) string {
+	keys := make([]string, 0, attrs.Len())
+	attrs.Range(func(k string, _ pdata.AttributeValue) bool {
+		keys = append(keys, k)
+		return true
+	})
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		v, _ := attrs.Get(k)
+		b.WriteString(k)
+		b.WriteByte(0)
+		WriteAttributeValue(&b, v)
+		b.WriteByte(0)
+	}
+	b.WriteByte(1)
+	return b.String()
+}
+
+// WriteAttributeValue writes the type and the value of the attribute to b, telling apart values
+// of different types with the same string representation.
+func WriteAttributeValue(b *strings.Builder, v pdata.AttributeValue) {
+	b.WriteString(v.Type().String())
+	b.WriteByte(':')
+	b.WriteString(v.AsString())
+}
+
+// Builder builds logs grouping the log records by resource and instrumentation library.
+type Builder struct {
+	logs pdata.Logs
+	rls  map[string]pdata.ResourceLogs
+	ills map[string]pdata.InstrumentationLibraryLogs
+}
+
+// NewBuilder returns an empty Builder.
+func NewBuilder() *Builder {
+	return &Builder{
+		logs: pdata.NewLogs(),
+		rls:  make(map[string]pdata.ResourceLogs),
+		ills: make(map[string]pdata.InstrumentationLibraryLogs),
+	}
+}
+
+// Append appends a copy of the log record under the given resource and instrumentation library,
+// and returns it.
+func (b *Builder) Append(resource pdata.Resource, library pdata.InstrumentationLibrary, lr pdata.LogRecord) pdata.LogRecord {
+	resourceKey := AttributesKey(resource.Attributes())
+	rl, ok := b.rls[resourceKey]
+	if !ok {
+		rl = b.logs.ResourceLogs().AppendEmpty()
+		resource.CopyTo(rl.Resource())
+		b.rls[resourceKey] = rl
+	}
+
+	libraryKey := resourceKey + library.Name() + "\x00" + library.Version()
+	ill, ok := b.ills[libraryKey]
+	if !ok {
+		ill = rl.InstrumentationLibraryLogs().AppendEmpty()
+		library.CopyTo(ill.InstrumentationLibrary())
+		b.ills[libraryKey] = ill
+	}
+
+	dest := ill.Logs().AppendEmpty()
+	lr.CopyTo(dest)
+	return dest
+}
+
+// Logs returns the logs built so far.
+func (b *Builder) Logs() pdata.Logs {
+	return b.logs
+}
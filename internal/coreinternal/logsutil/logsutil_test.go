@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logsutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+func TestAttributesKey(t *testing.T) {
+	a := pdata.NewAttributeMap()
+	a.InsertString("x", "1")
+	a.InsertInt("y", 2)
+	b := pdata.NewAttributeMap()
+	b.InsertInt("y", 2)
+	b.InsertString("x", "1")
+	assert.Equal(t, AttributesKey(a), AttributesKey(b))
+
+	c := pdata.NewAttributeMap()
+	c.InsertString("x", "1")
+	c.InsertString("y", "2")
+	assert.NotEqual(t, AttributesKey(a), AttributesKey(c))
+}
+
+func TestBuilder(t *testing.T) {
+	newResource := func(host string) pdata.Resource {
+		r := pdata.NewResource()
+		r.Attributes().InsertString("host.name", host)
+		return r
+	}
+	newLibrary := func(name string) pdata.InstrumentationLibrary {
+		l := pdata.NewInstrumentationLibrary()
+		l.SetName(name)
+		return l
+	}
+	newLogRecord := func(body string) pdata.LogRecord {
+		lr := pdata.NewLogRecord()
+		lr.Body().SetStringVal(body)
+		return lr
+	}
+
+	b := NewBuilder()
+	b.Append(newResource("a"), newLibrary("x"), newLogRecord("1"))
+	b.Append(newResource("b"), newLibrary("x"), newLogRecord("2"))
+	b.Append(newResource("a"), newLibrary("y"), newLogRecord("3"))
+	lr := b.Append(newResource("a"), newLibrary("x"), newLogRecord("4"))
+	lr.Attributes().InsertBool("appended", true)
+
+	rls := b.Logs().ResourceLogs()
+	require.Equal(t, 2, rls.Len())
+
+	host, _ := rls.At(0).Resource().Attributes().Get("host.name")
+	assert.Equal(t, "a", host.StringVal())
+	ills := rls.At(0).InstrumentationLibraryLogs()
+	require.Equal(t, 2, ills.Len())
+	assert.Equal(t, "x", ills.At(0).InstrumentationLibrary().Name())
+	require.Equal(t, 2, ills.At(0).Logs().Len())
+	assert.Equal(t, "1", ills.At(0).Logs().At(0).Body().StringVal())
+	assert.Equal(t, "4", ills.At(0).Logs().At(1).Body().StringVal())
+	_, ok := ills.At(0).Logs().At(1).Attributes().Get("appended")
+	assert.True(t, ok)
+	assert.Equal(t, "y", ills.At(1).InstrumentationLibrary().Name())
+	assert.Equal(t, "3", ills.At(1).Logs().At(0).Body().StringVal())
+
+	host, _ = rls.At(1).Resource().Attributes().Get("host.name")
+	assert.Equal(t, "b", host.StringVal())
+	assert.Equal(t, "2", rls.At(1).InstrumentationLibraryLogs().At(0).Logs().At(0).Body().StringVal())
+}
@@ -0,0 +1,15 @@
+module github.com/open-telemetry/opentelemetry-collector-contrib/internal/fluentforward
+
+go 1.17
+
+require (
+	github.com/stretchr/testify v1.7.0
+	github.com/tinylib/msgp v1.1.6
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/philhofer/fwd v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c // indirect
+)
@@ -0,0 +1,222 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fluentforward holds the messages and helpers of the handshake of the
+// forward protocol shared by the Fluent Forward receiver and exporter.
+package fluentforward // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/fluentforward"
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/tinylib/msgp/msgp"
+)
+
+// Digest returns the hex encoded SHA-512 digest of the concatenated parts.
+func Digest(parts ...[]byte) string {
+	h := sha512.New()
+	for _, p := range parts {
+		h.Write(p)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// DigestsEqual compares the digests in constant time.
+func DigestsEqual(a string, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
+
+// RandomBytes returns 16 random bytes, used as nonces and salts.
+func RandomBytes() ([]byte, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// ReadStringOrBytes reads a field that implementations encode either as a
+// string or as binary.
+func ReadStringOrBytes(reader *msgp.Reader) ([]byte, error) {
+	t, err := reader.NextType()
+	if err != nil {
+		return nil, err
+	}
+	if t == msgp.BinType {
+		return reader.ReadBytes(nil)
+	}
+	return reader.ReadStringAsBytes(nil)
+}
+
+// Helo is the HELO message the server starts the handshake with.
+type Helo struct {
+	Nonce []byte
+	// AuthSalt is only set when the server requires a username and a password.
+	AuthSalt []byte
+}
+
+// Ping is the PING message the client authenticates with.
+type Ping struct {
+	Hostname        string
+	SharedKeySalt   []byte
+	SharedKeyDigest string
+	Username        string
+	PasswordDigest  string
+}
+
+// Pong is the PONG message holding the result of the authentication of the
+// client. The hostname and digest of the server are only set on success.
+type Pong struct {
+	Authenticated   bool
+	Reason          string
+	Hostname        string
+	SharedKeyDigest string
+}
+
+// AppendHelo appends the HELO message to b.
+func AppendHelo(b []byte, helo *Helo) []byte {
+	b = msgp.AppendArrayHeader(b, 2)
+	b = msgp.AppendString(b, "HELO")
+	b = msgp.AppendMapHeader(b, 3)
+	b = msgp.AppendString(b, "nonce")
+	b = msgp.AppendBytes(b, helo.Nonce)
+	b = msgp.AppendString(b, "auth")
+	b = msgp.AppendBytes(b, helo.AuthSalt)
+	b = msgp.AppendString(b, "keepalive")
+	return msgp.AppendBool(b, true)
+}
+
+// ReadHelo reads a HELO message, unknown options are skipped.
+func ReadHelo(reader *msgp.Reader) (*Helo, error) {
+	if err := readMessageType(reader, 2, "HELO"); err != nil {
+		return nil, err
+	}
+	size, err := reader.ReadMapHeader()
+	if err != nil {
+		return nil, msgp.WrapError(err, "options")
+	}
+
+	var helo Helo
+	for ; size > 0; size-- {
+		key, err := reader.ReadString()
+		if err != nil {
+			return nil, msgp.WrapError(err, "options")
+		}
+		switch key {
+		case "nonce":
+			helo.Nonce, err = ReadStringOrBytes(reader)
+		case "auth":
+			helo.AuthSalt, err = ReadStringOrBytes(reader)
+		default:
+			err = reader.Skip()
+		}
+		if err != nil {
+			return nil, msgp.WrapError(err, "options", key)
+		}
+	}
+	return &helo, nil
+}
+
+// AppendPing appends the PING message to b.
+func AppendPing(b []byte, ping *Ping) []byte {
+	b = msgp.AppendArrayHeader(b, 6)
+	b = msgp.AppendString(b, "PING")
+	b = msgp.AppendString(b, ping.Hostname)
+	b = msgp.AppendBytes(b, ping.SharedKeySalt)
+	b = msgp.AppendString(b, ping.SharedKeyDigest)
+	b = msgp.AppendString(b, ping.Username)
+	return msgp.AppendString(b, ping.PasswordDigest)
+}
+
+// ReadPing reads a PING message.
+func ReadPing(reader *msgp.Reader) (*Ping, error) {
+	if err := readMessageType(reader, 6, "PING"); err != nil {
+		return nil, err
+	}
+
+	var ping Ping
+	var err error
+	if ping.Hostname, err = reader.ReadString(); err != nil {
+		return nil, msgp.WrapError(err, "hostname")
+	}
+	if ping.SharedKeySalt, err = ReadStringOrBytes(reader); err != nil {
+		return nil, msgp.WrapError(err, "shared_key_salt")
+	}
+	if ping.SharedKeyDigest, err = reader.ReadString(); err != nil {
+		return nil, msgp.WrapError(err, "shared_key_hexdigest")
+	}
+	if ping.Username, err = reader.ReadString(); err != nil {
+		return nil, msgp.WrapError(err, "username")
+	}
+	if ping.PasswordDigest, err = reader.ReadString(); err != nil {
+		return nil, msgp.WrapError(err, "password")
+	}
+	return &ping, nil
+}
+
+// AppendPong appends the PONG message to b.
+func AppendPong(b []byte, pong *Pong) []byte {
+	b = msgp.AppendArrayHeader(b, 5)
+	b = msgp.AppendString(b, "PONG")
+	b = msgp.AppendBool(b, pong.Authenticated)
+	b = msgp.AppendString(b, pong.Reason)
+	b = msgp.AppendString(b, pong.Hostname)
+	return msgp.AppendString(b, pong.SharedKeyDigest)
+}
+
+// ReadPong reads a PONG message.
+func ReadPong(reader *msgp.Reader) (*Pong, error) {
+	if err := readMessageType(reader, 5, "PONG"); err != nil {
+		return nil, err
+	}
+
+	var pong Pong
+	var err error
+	if pong.Authenticated, err = reader.ReadBool(); err != nil {
+		return nil, msgp.WrapError(err, "auth_result")
+	}
+	if pong.Reason, err = reader.ReadString(); err != nil {
+		return nil, msgp.WrapError(err, "reason")
+	}
+	if pong.Hostname, err = reader.ReadString(); err != nil {
+		return nil, msgp.WrapError(err, "server_hostname")
+	}
+	if pong.SharedKeyDigest, err = reader.ReadString(); err != nil {
+		return nil, msgp.WrapError(err, "shared_key_hexdigest")
+	}
+	return &pong, nil
+}
+
+// readMessageType reads the header of a handshake message and checks its size
+// and type.
+func readMessageType(reader *msgp.Reader, wantSize uint32, wantType string) error {
+	size, err := reader.ReadArrayHeader()
+	if err != nil {
+		return err
+	}
+	if size != wantSize {
+		return fmt.Errorf("expected %d elements, got %d", wantSize, size)
+	}
+	msgType, err := reader.ReadString()
+	if err != nil {
+		return err
+	}
+	if msgType != wantType {
+		return fmt.Errorf("unexpected message type %q", msgType)
+	}
+	return nil
+}
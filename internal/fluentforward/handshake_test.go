@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentforward
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tinylib/msgp/msgp"
+)
+
+func TestDigest(t *testing.T) {
+	d := Digest([]byte("salt"), []byte("host"), []byte("nonce"), []byte("key"))
+	assert.Len(t, d, 128)
+	assert.Equal(t, d, Digest([]byte("salthost"), []byte("noncekey")))
+	assert.True(t, DigestsEqual(d, Digest([]byte("salthostnoncekey"))))
+	assert.False(t, DigestsEqual(d, Digest([]byte("salthostnonce"))))
+}
+
+func TestRandomBytes(t *testing.T) {
+	a, err := RandomBytes()
+	require.NoError(t, err)
+	b, err := RandomBytes()
+	require.NoError(t, err)
+	assert.Len(t, a, 16)
+	assert.NotEqual(t, a, b)
+}
+
+func TestReadStringOrBytes(t *testing.T) {
+	var b []byte
+	b = msgp.AppendString(b, "string")
+	b = msgp.AppendBytes(b, []byte("binary"))
+	reader := msgp.NewReader(bytes.NewReader(b))
+
+	got, err := ReadStringOrBytes(reader)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("string"), got)
+	got, err = ReadStringOrBytes(reader)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("binary"), got)
+
+	_, err = ReadStringOrBytes(reader)
+	assert.Error(t, err)
+}
+
+func TestHandshakeMessages(t *testing.T) {
+	helo := &Helo{Nonce: []byte("nonce"), AuthSalt: []byte("salt")}
+	ping := &Ping{Hostname: "client", SharedKeySalt: []byte("salt"), SharedKeyDigest: "digest", Username: "alice", PasswordDigest: "password"}
+	pong := &Pong{Authenticated: true, Hostname: "server", SharedKeyDigest: "digest"}
+
+	var b []byte
+	b = AppendHelo(b, helo)
+	b = AppendPing(b, ping)
+	b = AppendPong(b, pong)
+	reader := msgp.NewReader(bytes.NewReader(b))
+
+	gotHelo, err := ReadHelo(reader)
+	require.NoError(t, err)
+	assert.Equal(t, helo, gotHelo)
+	gotPing, err := ReadPing(reader)
+	require.NoError(t, err)
+	assert.Equal(t, ping, gotPing)
+	gotPong, err := ReadPong(reader)
+	require.NoError(t, err)
+	assert.Equal(t, pong, gotPong)
+}
+
+func TestReadMessageTypeErrors(t *testing.T) {
+	b := AppendPong(nil, &Pong{})
+	_, err := ReadPing(msgp.NewReader(bytes.NewReader(b)))
+	assert.EqualError(t, err, "expected 6 elements, got 5")
+
+	b = AppendHelo(nil, &Helo{})
+	b[1+1] = 'X'
+	_, err = ReadHelo(msgp.NewReader(bytes.NewReader(b)))
+	assert.EqualError(t, err, `unexpected message type "XELO"`)
+}
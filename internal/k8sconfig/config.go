@@ -21,6 +21,7 @@ import (
 	"os"
 
 	quotaclientset "github.com/openshift/client-go/quota/clientset/versioned"
+	"k8s.io/client-go/dynamic"
 	k8s "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -157,3 +158,23 @@ func MakeOpenShiftQuotaClient(apiConf APIConfig) (quotaclientset.Interface, erro
 
 	return client, nil
 }
+
+// MakeDynamicClient can take configuration if needed for other types of auth
+// and return a dynamic client able to access arbitrary resources such as CRDs
+func MakeDynamicClient(apiConf APIConfig) (dynamic.Interface, error) {
+	if err := apiConf.Validate(); err != nil {
+		return nil, err
+	}
+
+	authConf, err := createRestConfig(apiConf)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := dynamic.NewForConfig(authConf)
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
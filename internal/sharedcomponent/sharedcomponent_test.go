@@ -49,6 +49,24 @@ func TestSharedComponents_GetOrAdd(t *testing.T) {
 	assert.NotSame(t, got, comps.GetOrAdd(id, createNop))
 }
 
+func TestSharedComponents_GetOrTryAdd(t *testing.T) {
+	wantErr := errors.New("my error")
+	comps := NewSharedComponents()
+	got, err := comps.GetOrTryAdd(id, func() (component.Component, error) { return nil, wantErr })
+	assert.Equal(t, wantErr, err)
+	assert.Nil(t, got)
+	assert.Len(t, comps.comps, 0)
+
+	nop := componenthelper.New()
+	got, err = comps.GetOrTryAdd(id, func() (component.Component, error) { return nop, nil })
+	assert.NoError(t, err)
+	assert.Same(t, nop, got.Unwrap())
+	assert.Len(t, comps.comps, 1)
+	same, err := comps.GetOrTryAdd(id, func() (component.Component, error) { return nil, wantErr })
+	assert.NoError(t, err)
+	assert.Same(t, got, same)
+}
+
 func TestSharedComponent(t *testing.T) {
 	wantErr := errors.New("my error")
 	calledStart := 0
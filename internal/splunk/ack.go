@@ -0,0 +1,50 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package splunk // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
+
+// Constants of the HEC indexer acknowledgement protocol:
+// https://docs.splunk.com/Documentation/Splunk/latest/Data/AboutHECIDXAck
+const (
+	// HECChannelHeader is the header holding the channel of the requests
+	// when indexer acknowledgement is enabled. The channel can also be
+	// passed in the HECChannelQueryParam query parameter.
+	HECChannelHeader     = "X-Splunk-Request-Channel"
+	HECChannelQueryParam = "channel"
+	DefaultAckPath       = "/services/collector/ack"
+
+	// HECResponseCodeSuccess and HECResponseCodeDataChannelMissing are the
+	// codes of the HEC responses.
+	HECResponseCodeSuccess            = 0
+	HECResponseCodeDataChannelMissing = 10
+)
+
+// HECResponse is the body of the HEC responses. AckID is set on the responses
+// to events sent on a channel with indexer acknowledgement enabled.
+type HECResponse struct {
+	Text  string  `json:"text"`
+	Code  int     `json:"code"`
+	AckID *uint64 `json:"ackId,omitempty"`
+}
+
+// AckRequest is the body of the requests querying the status of ack IDs.
+type AckRequest struct {
+	Acks []uint64 `json:"acks"`
+}
+
+// AckResponse is the body of the responses to AckRequest, reporting
+// whether each of the queried ack IDs, formatted as a string, was indexed.
+type AckResponse struct {
+	Acks map[string]bool `json:"acks"`
+}
@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logdedupprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/logdedupprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/config"
+)
+
+const (
+	// ActionDrop drops the log records exceeding the rate limit.
+	ActionDrop = "drop"
+	// ActionSample keeps one out of SampleRatio log records exceeding the rate limit.
+	ActionSample = "sample"
+)
+
+// Config defines configuration for the log deduplication processor.
+type Config struct {
+	config.ProcessorSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+
+	// Dedup configures the deduplication of identical log records.
+	Dedup DedupConfig `mapstructure:"dedup"`
+
+	// RateLimit configures the rate limiting of log records.
+	RateLimit RateLimitConfig `mapstructure:"rate_limit"`
+}
+
+// DedupConfig defines the deduplication of identical log records.
+type DedupConfig struct {
+	// Enabled enables the deduplication.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Interval is the time window in which identical log records are collapsed. The collapsed
+	// log records are emitted at the end of each window.
+	Interval time.Duration `mapstructure:"interval"`
+
+	// Attributes are the log record attributes which, along with the resource, the body and the
+	// severity, identify identical log records.
+	Attributes []string `mapstructure:"attributes"`
+
+	// CountAttribute is the attribute holding the number of collapsed log records.
+	CountAttribute string `mapstructure:"count_attribute"`
+
+	// MaxLogs is the number of distinct log records held in the time window at which they are
+	// emitted before the end of the window, bounding the memory used by the deduplication.
+	MaxLogs int `mapstructure:"max_logs"`
+}
+
+// RateLimitConfig defines the rate limiting of log records.
+type RateLimitConfig struct {
+	// Enabled enables the rate limiting.
+	Enabled bool `mapstructure:"enabled"`
+
+	// KeyAttributes are the log record attributes, or resource attributes, identifying the
+	// token bucket of a log record. All the log records share a single bucket when empty.
+	KeyAttributes []string `mapstructure:"key_attributes"`
+
+	// Rate is the number of log records per second allowed for each key.
+	Rate float64 `mapstructure:"rate"`
+
+	// Burst is the number of log records allowed to exceed the rate at once.
+	Burst int `mapstructure:"burst"`
+
+	// Action is what happens to the log records exceeding the rate limit: "drop" or "sample".
+	Action string `mapstructure:"action"`
+
+	// SampleRatio is the number of log records exceeding the rate limit out of which one is kept
+	// when Action is "sample".
+	SampleRatio int `mapstructure:"sample_ratio"`
+}
+
+var _ config.Processor = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid.
+func (c *Config) Validate() error {
+	if !c.Dedup.Enabled && !c.RateLimit.Enabled {
+		return errors.New("at least one of \"dedup\" or \"rate_limit\" must be enabled")
+	}
+
+	if c.Dedup.Enabled {
+		if c.Dedup.Interval <= 0 {
+			return errors.New("\"dedup.interval\" must be positive")
+		}
+		if c.Dedup.CountAttribute == "" {
+			return errors.New("\"dedup.count_attribute\" must be set")
+		}
+		if c.Dedup.MaxLogs < 1 {
+			return errors.New("\"dedup.max_logs\" must be at least 1")
+		}
+	}
+
+	if c.RateLimit.Enabled {
+		if c.RateLimit.Rate <= 0 {
+			return errors.New("\"rate_limit.rate\" must be positive")
+		}
+		if c.RateLimit.Burst < 1 {
+			return errors.New("\"rate_limit.burst\" must be at least 1")
+		}
+		switch c.RateLimit.Action {
+		case ActionDrop:
+		case ActionSample:
+			if c.RateLimit.SampleRatio < 1 {
+				return errors.New("\"rate_limit.sample_ratio\" must be at least 1")
+			}
+		default:
+			return fmt.Errorf("\"rate_limit.action\" must be %q or %q", ActionDrop, ActionSample)
+		}
+	}
+	return nil
+}
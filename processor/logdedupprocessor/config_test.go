@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logdedupprocessor
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configtest"
+)
+
+func TestLoadConfig(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	require.NoError(t, err)
+
+	factories.Processors[typeStr] = NewFactory()
+
+	cfg, err := configtest.LoadConfigAndValidate(path.Join(".", "testdata", "config.yaml"), factories)
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	assert.Equal(t, createDefaultConfig(), cfg.Processors[config.NewComponentID(typeStr)])
+
+	assert.Equal(t, &Config{
+		ProcessorSettings: config.NewProcessorSettings(config.NewComponentIDWithName(typeStr, "custom")),
+		Dedup: DedupConfig{
+			Enabled:        true,
+			Interval:       30 * time.Second,
+			Attributes:     []string{"http.status_code"},
+			CountAttribute: "repeated",
+			MaxLogs:        500,
+		},
+		RateLimit: RateLimitConfig{
+			Enabled:       true,
+			KeyAttributes: []string{"service.name"},
+			Rate:          50.5,
+			Burst:         200,
+			Action:        ActionSample,
+			SampleRatio:   5,
+		},
+	}, cfg.Processors[config.NewComponentIDWithName(typeStr, "custom")])
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(cfg *Config)
+		wantErr string
+	}{
+		{
+			name:   "default",
+			modify: func(cfg *Config) {},
+		},
+		{
+			name:   "rate limit only",
+			modify: func(cfg *Config) { cfg.Dedup.Enabled = false; cfg.RateLimit.Enabled = true },
+		},
+		{
+			name:    "nothing enabled",
+			modify:  func(cfg *Config) { cfg.Dedup.Enabled = false },
+			wantErr: `at least one of "dedup" or "rate_limit" must be enabled`,
+		},
+		{
+			name:    "invalid interval",
+			modify:  func(cfg *Config) { cfg.Dedup.Interval = 0 },
+			wantErr: `"dedup.interval" must be positive`,
+		},
+		{
+			name:    "missing count attribute",
+			modify:  func(cfg *Config) { cfg.Dedup.CountAttribute = "" },
+			wantErr: `"dedup.count_attribute" must be set`,
+		},
+		{
+			name:    "invalid max logs",
+			modify:  func(cfg *Config) { cfg.Dedup.MaxLogs = 0 },
+			wantErr: `"dedup.max_logs" must be at least 1`,
+		},
+		{
+			name:    "invalid rate",
+			modify:  func(cfg *Config) { cfg.RateLimit.Enabled = true; cfg.RateLimit.Rate = 0 },
+			wantErr: `"rate_limit.rate" must be positive`,
+		},
+		{
+			name:    "invalid burst",
+			modify:  func(cfg *Config) { cfg.RateLimit.Enabled = true; cfg.RateLimit.Burst = 0 },
+			wantErr: `"rate_limit.burst" must be at least 1`,
+		},
+		{
+			name:    "invalid action",
+			modify:  func(cfg *Config) { cfg.RateLimit.Enabled = true; cfg.RateLimit.Action = "block" },
+			wantErr: `"rate_limit.action" must be "drop" or "sample"`,
+		},
+		{
+			name: "invalid sample ratio",
+			modify: func(cfg *Config) {
+				cfg.RateLimit.Enabled = true
+				cfg.RateLimit.Action = ActionSample
+				cfg.RateLimit.SampleRatio = 0
+			},
+			wantErr: `"rate_limit.sample_ratio" must be at least 1`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(cfg)
+
+			err := cfg.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
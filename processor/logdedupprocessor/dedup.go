@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logdedupprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/logdedupprocessor"
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/model/pdata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/logsutil"
+)
+
+const (
+	firstObservedAttribute = "first_observed_timestamp"
+	lastObservedAttribute  = "last_observed_timestamp"
+)
+
+// aggregatedLog is the first log record of a set of identical log records.
+type aggregatedLog struct {
+	resource pdata.Resource
+	library  pdata.InstrumentationLibrary
+	record   pdata.LogRecord
+	count    int64
+	first    pdata.Timestamp
+	last     pdata.Timestamp
+}
+
+// aggregator collapses identical log records until flushed.
+type aggregator struct {
+	attributes     []string
+	countAttribute string
+
+	logs map[string]*aggregatedLog
+	// order keeps the log records in the order they were first received.
+	order []*aggregatedLog
+}
+
+func newAggregator(cfg DedupConfig) *aggregator {
+	return &aggregator{
+		attributes:     cfg.Attributes,
+		countAttribute: cfg.CountAttribute,
+		logs:           make(map[string]*aggregatedLog),
+	}
+}
+
+// add adds the log records and returns the number of them that were identical to a previous log record.
+func (a *aggregator) add(ld pdata.Logs, now time.Time) int64 {
+	var duplicates int64
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		resource := rl.Resource()
+		resourceKey := logsutil.AttributesKey(resource.Attributes())
+		ills := rl.InstrumentationLibraryLogs()
+		for j := 0; j < ills.Len(); j++ {
+			ill := ills.At(j)
+			library := ill.InstrumentationLibrary()
+			logs := ill.Logs()
+			for k := 0; k < logs.Len(); k++ {
+				lr := logs.At(k)
+				ts := lr.Timestamp()
+				if ts == 0 {
+					ts = pdata.NewTimestampFromTime(now)
+				}
+
+				key := a.key(resourceKey, library, lr)
+				if al, ok := a.logs[key]; ok {
+					al.count++
+					if ts < al.first {
+						al.first = ts
+					}
+					if ts > al.last {
+						al.last = ts
+					}
+					duplicates++
+					continue
+				}
+
+				al := &aggregatedLog{
+					resource: pdata.NewResource(),
+					library:  pdata.NewInstrumentationLibrary(),
+					record:   pdata.NewLogRecord(),
+					count:    1,
+					first:    ts,
+					last:     ts,
+				}
+				resource.CopyTo(al.resource)
+				library.CopyTo(al.library)
+				lr.CopyTo(al.record)
+				a.logs[key] = al
+				a.order = append(a.order, al)
+			}
+		}
+	}
+	return duplicates
+}
+
+// len returns the number of distinct log records held by the aggregator.
+func (a *aggregator) len() int {
+	return len(a.logs)
+}
+
+// flush returns the collapsed log records, grouped by resource and instrumentation library, and resets the aggregator.
+func (a *aggregator) flush() pdata.Logs {
+	b := logsutil.NewBuilder()
+	for _, al := range a.order {
+		lr := b.Append(al.resource, al.library, al.record)
+		lr.SetTimestamp(al.first)
+		lr.Attributes().UpsertInt(a.countAttribute, al.count)
+		lr.Attributes().UpsertString(firstObservedAttribute, al.first.AsTime().Format(time.RFC3339Nano))
+		lr.Attributes().UpsertString(lastObservedAttribute, al.last.AsTime().Format(time.RFC3339Nano))
+	}
+
+	a.logs = make(map[string]*aggregatedLog)
+	a.order = nil
+	return b.Logs()
+}
+
+// key identifies identical log records by their resource, instrumentation library, body, severity
+// and configured attributes.
+func (a *aggregator) key(resourceKey string, library pdata.InstrumentationLibrary, lr pdata.LogRecord) string {
+	var b strings.Builder
+	b.WriteString(resourceKey)
+	b.WriteString(library.Name())
+	b.WriteByte(0)
+	b.WriteString(library.Version())
+	b.WriteByte(0)
+	b.WriteString(lr.Body().Type().String())
+	b.WriteByte(0)
+	b.WriteString(lr.Body().AsString())
+	b.WriteByte(0)
+	b.WriteString(strconv.Itoa(int(lr.SeverityNumber())))
+	b.WriteByte(0)
+	b.WriteString(lr.SeverityText())
+	b.WriteByte(0)
+	for _, name := range a.attributes {
+		if v, ok := lr.Attributes().Get(name); ok {
+			logsutil.WriteAttributeValue(&b, v)
+		}
+		b.WriteByte(0)
+	}
+	return b.String()
+}
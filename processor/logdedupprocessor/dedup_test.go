@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logdedupprocessor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+type testLog struct {
+	body       string
+	severity   pdata.SeverityNumber
+	attributes map[string]string
+	timestamp  time.Time
+}
+
+func newTestLogs(resource map[string]string, logs ...testLog) pdata.Logs {
+	ld := pdata.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	for k, v := range resource {
+		rl.Resource().Attributes().InsertString(k, v)
+	}
+	ill := rl.InstrumentationLibraryLogs().AppendEmpty()
+	ill.InstrumentationLibrary().SetName("lib")
+	for _, l := range logs {
+		lr := ill.Logs().AppendEmpty()
+		lr.Body().SetStringVal(l.body)
+		lr.SetSeverityNumber(l.severity)
+		for k, v := range l.attributes {
+			lr.Attributes().InsertString(k, v)
+		}
+		if !l.timestamp.IsZero() {
+			lr.SetTimestamp(pdata.NewTimestampFromTime(l.timestamp))
+		}
+	}
+	return ld
+}
+
+func TestAggregator(t *testing.T) {
+	a := newAggregator(DedupConfig{
+		Attributes:     []string{"status"},
+		CountAttribute: "log.count",
+	})
+
+	t0 := time.Date(2021, 12, 10, 10, 0, 0, 0, time.UTC)
+	resource := map[string]string{"service.name": "api"}
+	duplicates := a.add(newTestLogs(resource,
+		testLog{body: "timeout", severity: pdata.SeverityNumberERROR, attributes: map[string]string{"status": "504", "id": "1"}, timestamp: t0.Add(time.Second)},
+		testLog{body: "timeout", severity: pdata.SeverityNumberERROR, attributes: map[string]string{"status": "504", "id": "2"}, timestamp: t0},
+		// A different status, severity or body is a different log.
+		testLog{body: "timeout", severity: pdata.SeverityNumberERROR, attributes: map[string]string{"status": "503"}, timestamp: t0},
+		testLog{body: "timeout", severity: pdata.SeverityNumberWARN, attributes: map[string]string{"status": "504"}, timestamp: t0},
+		testLog{body: "retrying", severity: pdata.SeverityNumberERROR, attributes: map[string]string{"status": "504"}, timestamp: t0},
+	), t0)
+	assert.Equal(t, int64(1), duplicates)
+
+	// Identical log of another batch.
+	duplicates = a.add(newTestLogs(resource,
+		testLog{body: "timeout", severity: pdata.SeverityNumberERROR, attributes: map[string]string{"status": "504"}, timestamp: t0.Add(5 * time.Second)},
+	), t0)
+	assert.Equal(t, int64(1), duplicates)
+
+	// Identical log of another resource.
+	duplicates = a.add(newTestLogs(map[string]string{"service.name": "web"},
+		testLog{body: "timeout", severity: pdata.SeverityNumberERROR, attributes: map[string]string{"status": "504"}, timestamp: t0},
+	), t0)
+	assert.Equal(t, int64(0), duplicates)
+
+	ld := a.flush()
+	require.Equal(t, 2, ld.ResourceLogs().Len())
+	assert.Equal(t, 5, ld.LogRecordCount())
+
+	rl := ld.ResourceLogs().At(0)
+	assert.Equal(t, map[string]interface{}{"service.name": "api"}, rl.Resource().Attributes().AsRaw())
+	require.Equal(t, 1, rl.InstrumentationLibraryLogs().Len())
+	assert.Equal(t, "lib", rl.InstrumentationLibraryLogs().At(0).InstrumentationLibrary().Name())
+	logs := rl.InstrumentationLibraryLogs().At(0).Logs()
+	require.Equal(t, 4, logs.Len())
+
+	collapsed := logs.At(0)
+	assert.Equal(t, "timeout", collapsed.Body().StringVal())
+	assert.Equal(t, pdata.NewTimestampFromTime(t0), collapsed.Timestamp())
+	assert.Equal(t, map[string]interface{}{
+		"status":               "504",
+		"id":                   "1",
+		"log.count":            int64(3),
+		firstObservedAttribute: "2021-12-10T10:00:00Z",
+		lastObservedAttribute:  "2021-12-10T10:00:05Z",
+	}, collapsed.Attributes().AsRaw())
+
+	for i := 1; i < logs.Len(); i++ {
+		count, _ := logs.At(i).Attributes().Get("log.count")
+		assert.Equal(t, int64(1), count.IntVal())
+	}
+
+	assert.Equal(t, 0, a.flush().ResourceLogs().Len())
+}
+
+func TestAggregatorWithoutTimestamp(t *testing.T) {
+	a := newAggregator(DedupConfig{CountAttribute: "log.count"})
+
+	now := time.Date(2021, 12, 10, 10, 0, 0, 0, time.UTC)
+	a.add(newTestLogs(nil, testLog{body: "message"}), now)
+
+	ld := a.flush()
+	require.Equal(t, 1, ld.LogRecordCount())
+	lr := ld.ResourceLogs().At(0).InstrumentationLibraryLogs().At(0).Logs().At(0)
+	assert.Equal(t, pdata.NewTimestampFromTime(now), lr.Timestamp())
+}
@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logdedupprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/logdedupprocessor"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats/view"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+)
+
+const (
+	// typeStr is the value of "type" for this processor in the configuration.
+	typeStr = "logdedup"
+
+	defaultInterval       = 10 * time.Second
+	defaultCountAttribute = "log.count"
+	defaultMaxLogs        = 10000
+	defaultRate           = 100
+	defaultBurst          = 100
+	defaultSampleRatio    = 10
+)
+
+var once sync.Once
+
+// NewFactory returns a new factory for the log deduplication processor.
+func NewFactory() component.ProcessorFactory {
+	once.Do(func() {
+		// TODO: as with other -contrib factories registering metrics, this is causing the error being ignored
+		_ = view.Register(MetricViews()...)
+	})
+
+	return processorhelper.NewFactory(
+		typeStr,
+		createDefaultConfig,
+		processorhelper.WithLogs(createLogsProcessor))
+}
+
+// createDefaultConfig creates the default configuration for the processor.
+func createDefaultConfig() config.Processor {
+	return &Config{
+		ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+		Dedup: DedupConfig{
+			Enabled:        true,
+			Interval:       defaultInterval,
+			CountAttribute: defaultCountAttribute,
+			MaxLogs:        defaultMaxLogs,
+		},
+		RateLimit: RateLimitConfig{
+			Rate:        defaultRate,
+			Burst:       defaultBurst,
+			Action:      ActionDrop,
+			SampleRatio: defaultSampleRatio,
+		},
+	}
+}
+
+func createLogsProcessor(_ context.Context, params component.ProcessorCreateSettings, cfg config.Processor, nextConsumer consumer.Logs) (component.LogsProcessor, error) {
+	return newLogDedupProcessor(params.Logger, cfg.(*Config), nextConsumer), nil
+}
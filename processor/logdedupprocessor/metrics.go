@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logdedupprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/logdedupprocessor"
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opentelemetry.io/collector/obsreport"
+)
+
+var (
+	mNumDeduplicatedLogs = stats.Int64("num_deduplicated_logs", "Number of logs collapsed into an identical log", stats.UnitDimensionless)
+	mNumRateLimitedLogs  = stats.Int64("num_rate_limited_logs", "Number of logs dropped by the rate limiter", stats.UnitDimensionless)
+	mNumDroppedLogs      = stats.Int64("num_dropped_logs", "Number of deduplicated logs dropped because the next consumer failed", stats.UnitDimensionless)
+)
+
+// MetricViews return the metrics views of the processor.
+func MetricViews() []*view.View {
+	return []*view.View{
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(typeStr, mNumDeduplicatedLogs.Name()),
+			Measure:     mNumDeduplicatedLogs,
+			Description: mNumDeduplicatedLogs.Description(),
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(typeStr, mNumRateLimitedLogs.Name()),
+			Measure:     mNumRateLimitedLogs,
+			Description: mNumRateLimitedLogs.Description(),
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(typeStr, mNumDroppedLogs.Name()),
+			Measure:     mNumDroppedLogs,
+			Description: mNumDroppedLogs.Description(),
+			Aggregation: view.Sum(),
+		},
+	}
+}
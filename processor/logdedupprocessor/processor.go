@@ -0,0 +1,199 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logdedupprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/logdedupprocessor"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+)
+
+type logDedupProcessor struct {
+	logger       *zap.Logger
+	config       *Config
+	nextConsumer consumer.Logs
+
+	// mu guards the rate limiter and the aggregator.
+	mu         sync.Mutex
+	limiter    *rateLimiter
+	aggregator *aggregator
+	now        func() time.Time
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+var _ component.LogsProcessor = (*logDedupProcessor)(nil)
+
+func newLogDedupProcessor(logger *zap.Logger, cfg *Config, nextConsumer consumer.Logs) *logDedupProcessor {
+	p := &logDedupProcessor{
+		logger:       logger,
+		config:       cfg,
+		nextConsumer: nextConsumer,
+		now:          time.Now,
+		done:         make(chan struct{}),
+	}
+	if cfg.RateLimit.Enabled {
+		p.limiter = newRateLimiter(cfg.RateLimit)
+	}
+	if cfg.Dedup.Enabled {
+		p.aggregator = newAggregator(cfg.Dedup)
+	}
+	return p
+}
+
+func (p *logDedupProcessor) Start(context.Context, component.Host) error {
+	if p.aggregator != nil {
+		p.wg.Add(1)
+		go p.flushLoop()
+	}
+	if p.limiter != nil {
+		p.wg.Add(1)
+		go p.pruneLoop()
+	}
+	return nil
+}
+
+func (p *logDedupProcessor) Shutdown(ctx context.Context) error {
+	close(p.done)
+	p.wg.Wait()
+
+	if p.aggregator != nil {
+		return p.flush(ctx)
+	}
+	return nil
+}
+
+func (p *logDedupProcessor) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: true}
+}
+
+// ConsumeLogs rate limits the log records and, when the deduplication is enabled, collapses them
+// into the aggregator and returns right away. The collapsed log records are sent to the next
+// consumer at the end of the time window, or earlier when the aggregator holds MaxLogs of them:
+// the errors of the next consumer are then logged, not returned, as the log records are no longer
+// tied to the batches they were received in.
+func (p *logDedupProcessor) ConsumeLogs(ctx context.Context, ld pdata.Logs) error {
+	p.mu.Lock()
+	now := p.now()
+
+	if p.limiter != nil {
+		if rateLimited := p.rateLimit(ld, now); rateLimited > 0 {
+			stats.Record(ctx, mNumRateLimitedLogs.M(rateLimited))
+		}
+	}
+
+	if p.aggregator == nil {
+		p.mu.Unlock()
+		if ld.ResourceLogs().Len() == 0 {
+			return nil
+		}
+		return p.nextConsumer.ConsumeLogs(ctx, ld)
+	}
+
+	duplicates := p.aggregator.add(ld, now)
+	full := p.aggregator.len() >= p.config.Dedup.MaxLogs
+	p.mu.Unlock()
+
+	if duplicates > 0 {
+		stats.Record(ctx, mNumDeduplicatedLogs.M(duplicates))
+	}
+	if full {
+		if err := p.flush(ctx); err != nil {
+			p.logger.Error("failed to send the deduplicated logs", zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// rateLimit removes the log records exceeding the rate limit and returns their number.
+func (p *logDedupProcessor) rateLimit(ld pdata.Logs, now time.Time) int64 {
+	var dropped int64
+	ld.ResourceLogs().RemoveIf(func(rl pdata.ResourceLogs) bool {
+		resource := rl.Resource()
+		rl.InstrumentationLibraryLogs().RemoveIf(func(ill pdata.InstrumentationLibraryLogs) bool {
+			ill.Logs().RemoveIf(func(lr pdata.LogRecord) bool {
+				if p.limiter.allow(lr, resource, now) {
+					return false
+				}
+				dropped++
+				return true
+			})
+			return ill.Logs().Len() == 0
+		})
+		return rl.InstrumentationLibraryLogs().Len() == 0
+	})
+	return dropped
+}
+
+func (p *logDedupProcessor) flushLoop() {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.config.Dedup.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			// The flush is not tied to any incoming request, hence neither to its context.
+			if err := p.flush(context.Background()); err != nil {
+				p.logger.Error("failed to send the deduplicated logs", zap.Error(err))
+			}
+		case <-p.done:
+			return
+		}
+	}
+}
+
+// pruneLoop periodically removes the idle buckets of the rate limiter, bounding its memory to the
+// keys recently seen.
+func (p *logDedupProcessor) pruneLoop() {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.limiter.pruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.mu.Lock()
+			p.limiter.prune(p.now())
+			p.mu.Unlock()
+		case <-p.done:
+			return
+		}
+	}
+}
+
+// flush sends the log records collapsed since the previous flush. They are dropped if the next
+// consumer fails.
+func (p *logDedupProcessor) flush(ctx context.Context) error {
+	p.mu.Lock()
+	ld := p.aggregator.flush()
+	p.mu.Unlock()
+
+	if ld.ResourceLogs().Len() == 0 {
+		return nil
+	}
+	if err := p.nextConsumer.ConsumeLogs(ctx, ld); err != nil {
+		stats.Record(ctx, mNumDroppedLogs.M(int64(ld.LogRecordCount())))
+		return err
+	}
+	return nil
+}
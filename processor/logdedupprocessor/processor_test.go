@@ -0,0 +1,212 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logdedupprocessor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+)
+
+func TestProcessorDedup(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Dedup.Interval = 10 * time.Millisecond
+	sink := new(consumertest.LogsSink)
+	p := newLogDedupProcessor(zap.NewNop(), cfg, sink)
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, p.ConsumeLogs(context.Background(), newTestLogs(nil, testLog{body: "repeated"})))
+	}
+
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 1
+	}, time.Second, 5*time.Millisecond)
+
+	lr := sink.AllLogs()[0].ResourceLogs().At(0).InstrumentationLibraryLogs().At(0).Logs().At(0)
+	count, ok := lr.Attributes().Get("log.count")
+	require.True(t, ok)
+	assert.Equal(t, int64(3), count.IntVal())
+
+	require.NoError(t, p.Shutdown(context.Background()))
+	assert.Equal(t, 1, sink.LogRecordCount())
+}
+
+func TestProcessorShutdownFlushes(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Dedup.Interval = time.Hour
+	sink := new(consumertest.LogsSink)
+	p := newLogDedupProcessor(zap.NewNop(), cfg, sink)
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newTestLogs(nil, testLog{body: "a"}, testLog{body: "b"}, testLog{body: "a"})))
+	assert.Equal(t, 0, sink.LogRecordCount())
+
+	require.NoError(t, p.Shutdown(context.Background()))
+	assert.Equal(t, 2, sink.LogRecordCount())
+}
+
+func TestProcessorMaxLogsFlushes(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Dedup.Interval = time.Hour
+	cfg.Dedup.MaxLogs = 2
+	sink := new(consumertest.LogsSink)
+	p := newLogDedupProcessor(zap.NewNop(), cfg, sink)
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newTestLogs(nil, testLog{body: "a"}, testLog{body: "a"})))
+	assert.Equal(t, 0, sink.LogRecordCount())
+
+	// The second distinct log record fills the aggregator, which is flushed without waiting for the interval.
+	require.NoError(t, p.ConsumeLogs(context.Background(), newTestLogs(nil, testLog{body: "b"})))
+	assert.Equal(t, 2, sink.LogRecordCount())
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newTestLogs(nil, testLog{body: "a"})))
+	require.NoError(t, p.Shutdown(context.Background()))
+	assert.Equal(t, 3, sink.LogRecordCount())
+}
+
+func TestProcessorMaxLogsFlushError(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Dedup.Interval = time.Hour
+	cfg.Dedup.MaxLogs = 1
+	p := newLogDedupProcessor(zap.NewNop(), cfg, consumertest.NewErr(errors.New("consumer failed")))
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+
+	// The errors of the next consumer are not returned to the sender of unrelated log records.
+	require.NoError(t, p.ConsumeLogs(context.Background(), newTestLogs(nil, testLog{body: "a"})))
+	require.NoError(t, p.Shutdown(context.Background()))
+}
+
+func TestProcessorShutdownError(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Dedup.Interval = time.Hour
+	p := newLogDedupProcessor(zap.NewNop(), cfg, consumertest.NewErr(errors.New("consumer failed")))
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newTestLogs(nil, testLog{body: "a"})))
+	assert.EqualError(t, p.Shutdown(context.Background()), "consumer failed")
+}
+
+func TestProcessorFlushErrorDroppedLogs(t *testing.T) {
+	views := MetricViews()
+	view.Unregister(views...)
+	require.NoError(t, view.Register(views...))
+	defer view.Unregister(views...)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Dedup.Interval = time.Hour
+	p := newLogDedupProcessor(zap.NewNop(), cfg, consumertest.NewErr(errors.New("consumer failed")))
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newTestLogs(nil, testLog{body: "a"}, testLog{body: "b"}, testLog{body: "a"})))
+	assert.Error(t, p.Shutdown(context.Background()))
+
+	viewData, err := view.RetrieveData("processor/logdedup/" + mNumDroppedLogs.Name())
+	require.NoError(t, err)
+	require.Len(t, viewData, 1)
+	assert.Equal(t, float64(2), viewData[0].Data.(*view.SumData).Value)
+}
+
+func TestProcessorRateLimitPrunesIdleBuckets(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Dedup.Enabled = false
+	cfg.RateLimit.Enabled = true
+	cfg.RateLimit.KeyAttributes = []string{"service.name"}
+
+	p := newLogDedupProcessor(zap.NewNop(), cfg, consumertest.NewNop())
+	now := time.Now()
+	p.now = func() time.Time { return now }
+	p.limiter.pruneInterval = time.Millisecond
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newTestLogs(map[string]string{"service.name": "api"}, testLog{body: "1"})))
+	p.mu.Lock()
+	require.Len(t, p.limiter.buckets, 1)
+	// The bucket is idle long enough to be full again.
+	now = now.Add(p.limiter.idleTimeout)
+	p.mu.Unlock()
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	require.Eventually(t, func() bool {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		return len(p.limiter.buckets) == 0
+	}, time.Second, time.Millisecond)
+	require.NoError(t, p.Shutdown(context.Background()))
+}
+
+func TestProcessorRateLimitOnly(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Dedup.Enabled = false
+	cfg.RateLimit.Enabled = true
+	cfg.RateLimit.KeyAttributes = []string{"service.name"}
+	cfg.RateLimit.Rate = 1
+	cfg.RateLimit.Burst = 2
+
+	sink := new(consumertest.LogsSink)
+	p := newLogDedupProcessor(zap.NewNop(), cfg, sink)
+	now := time.Now()
+	p.now = func() time.Time { return now }
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+
+	api := map[string]string{"service.name": "api"}
+	web := map[string]string{"service.name": "web"}
+	require.NoError(t, p.ConsumeLogs(context.Background(), newTestLogs(api, testLog{body: "1"}, testLog{body: "2"}, testLog{body: "3"})))
+	require.NoError(t, p.ConsumeLogs(context.Background(), newTestLogs(web, testLog{body: "1"})))
+	// Every log record of the batch exceeds the rate limit, nothing is sent.
+	require.NoError(t, p.ConsumeLogs(context.Background(), newTestLogs(api, testLog{body: "4"})))
+
+	require.Len(t, sink.AllLogs(), 2)
+	assert.Equal(t, 2, sink.AllLogs()[0].LogRecordCount())
+	assert.Equal(t, 1, sink.AllLogs()[1].LogRecordCount())
+
+	require.NoError(t, p.Shutdown(context.Background()))
+}
+
+func TestProcessorRateLimitBeforeDedup(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Dedup.Interval = time.Hour
+	cfg.RateLimit.Enabled = true
+	cfg.RateLimit.Rate = 1
+	cfg.RateLimit.Burst = 2
+
+	sink := new(consumertest.LogsSink)
+	p := newLogDedupProcessor(zap.NewNop(), cfg, sink)
+	now := time.Now()
+	p.now = func() time.Time { return now }
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+
+	logs := make([]testLog, 5)
+	for i := range logs {
+		logs[i] = testLog{body: "repeated"}
+	}
+	require.NoError(t, p.ConsumeLogs(context.Background(), newTestLogs(nil, logs...)))
+	require.NoError(t, p.Shutdown(context.Background()))
+
+	require.Equal(t, 1, sink.LogRecordCount())
+	lr := sink.AllLogs()[0].ResourceLogs().At(0).InstrumentationLibraryLogs().At(0).Logs().At(0)
+	count, _ := lr.Attributes().Get("log.count")
+	assert.Equal(t, int64(2), count.IntVal())
+	assert.Equal(t, pdata.NewTimestampFromTime(now), lr.Timestamp())
+}
@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logdedupprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/logdedupprocessor"
+
+import (
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/model/pdata"
+	"golang.org/x/time/rate"
+)
+
+// pruneThreshold is the number of buckets from which the idle buckets are removed.
+// minPruneInterval bounds the frequency at which the idle buckets are removed.
+const minPruneInterval = time.Second
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+	// excess is the number of log records which exceeded the rate limit.
+	excess uint64
+}
+
+// rateLimiter is a token bucket rate limiter of the log records, with a bucket per key.
+type rateLimiter struct {
+	keyAttributes []string
+	limit         rate.Limit
+	burst         int
+	// sampleRatio is zero when the log records exceeding the rate limit are dropped.
+	sampleRatio uint64
+	// idleTimeout is the time a bucket takes to be full again, after which it can be removed.
+	idleTimeout time.Duration
+	// pruneInterval is the interval at which the idle buckets are removed.
+	pruneInterval time.Duration
+	buckets       map[string]*bucket
+}
+
+func newRateLimiter(cfg RateLimitConfig) *rateLimiter {
+	r := &rateLimiter{
+		keyAttributes: cfg.KeyAttributes,
+		limit:         rate.Limit(cfg.Rate),
+		burst:         cfg.Burst,
+		idleTimeout:   time.Duration(float64(cfg.Burst) / cfg.Rate * float64(time.Second)),
+		buckets:       make(map[string]*bucket),
+	}
+	r.pruneInterval = r.idleTimeout
+	if r.pruneInterval < minPruneInterval {
+		r.pruneInterval = minPruneInterval
+	}
+	if cfg.Action == ActionSample {
+		r.sampleRatio = uint64(cfg.SampleRatio)
+	}
+	return r
+}
+
+// allow tells whether the log record is kept.
+func (r *rateLimiter) allow(lr pdata.LogRecord, resource pdata.Resource, now time.Time) bool {
+	key := r.key(lr, resource)
+	b, ok := r.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(r.limit, r.burst)}
+		r.buckets[key] = b
+	}
+	b.lastSeen = now
+
+	if b.limiter.AllowN(now, 1) {
+		return true
+	}
+	b.excess++
+	// Keep the first log record exceeding the rate limit, then one out of sampleRatio.
+	return r.sampleRatio > 0 && (b.excess-1)%r.sampleRatio == 0
+}
+
+// prune removes the buckets which are full again, they are identical to new buckets.
+// prune removes the buckets idle long enough to be full again, which are identical to new ones.
+func (r *rateLimiter) prune(now time.Time) {
+	for key, b := range r.buckets {
+		if now.Sub(b.lastSeen) >= r.idleTimeout {
+			delete(r.buckets, key)
+		}
+	}
+}
+
+// key returns the values of the key attributes, read from the log record attributes first and
+// then the resource attributes, delimited by a null character.
+func (r *rateLimiter) key(lr pdata.LogRecord, resource pdata.Resource) string {
+	if len(r.keyAttributes) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	for _, name := range r.keyAttributes {
+		v, ok := lr.Attributes().Get(name)
+		if !ok {
+			v, ok = resource.Attributes().Get(name)
+		}
+		if ok {
+			b.WriteString(v.AsString())
+		}
+		b.WriteByte(0)
+	}
+	return b.String()
+}
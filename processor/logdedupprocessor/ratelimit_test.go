@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logdedupprocessor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+func TestRateLimiterDrop(t *testing.T) {
+	r := newRateLimiter(RateLimitConfig{
+		KeyAttributes: []string{"service.name"},
+		Rate:          1,
+		Burst:         2,
+		Action:        ActionDrop,
+	})
+
+	api := pdata.NewResource()
+	api.Attributes().InsertString("service.name", "api")
+	lr := pdata.NewLogRecord()
+
+	now := time.Now()
+	assert.True(t, r.allow(lr, api, now))
+	assert.True(t, r.allow(lr, api, now))
+	assert.False(t, r.allow(lr, api, now))
+
+	// Another key has its own bucket, the record attribute taking precedence over the resource attribute.
+	web := pdata.NewLogRecord()
+	web.Attributes().InsertString("service.name", "web")
+	assert.True(t, r.allow(web, api, now))
+
+	// The bucket is refilled over time.
+	assert.True(t, r.allow(lr, api, now.Add(time.Second)))
+	assert.False(t, r.allow(lr, api, now.Add(time.Second)))
+}
+
+func TestRateLimiterSample(t *testing.T) {
+	r := newRateLimiter(RateLimitConfig{
+		Rate:        1,
+		Burst:       1,
+		Action:      ActionSample,
+		SampleRatio: 3,
+	})
+
+	lr := pdata.NewLogRecord()
+	res := pdata.NewResource()
+	now := time.Now()
+
+	var allowed []bool
+	for i := 0; i < 8; i++ {
+		allowed = append(allowed, r.allow(lr, res, now))
+	}
+	assert.Equal(t, []bool{true, true, false, false, true, false, false, true}, allowed)
+}
+
+func TestRateLimiterPrune(t *testing.T) {
+	r := newRateLimiter(RateLimitConfig{
+		KeyAttributes: []string{"id"},
+		Rate:          10,
+		Burst:         10,
+		Action:        ActionDrop,
+	})
+	assert.Equal(t, time.Second, r.idleTimeout)
+
+	now := time.Now()
+	res := pdata.NewResource()
+	r.allow(newKeyedRecord("idle"), res, now)
+	r.allow(newKeyedRecord("active"), res, now.Add(time.Second))
+
+	r.prune(now.Add(1500 * time.Millisecond))
+	assert.Len(t, r.buckets, 1)
+	assert.Contains(t, r.buckets, "active\x00")
+}
+
+func newKeyedRecord(id string) pdata.LogRecord {
+	lr := pdata.NewLogRecord()
+	lr.Attributes().InsertString("id", id)
+	return lr
+}
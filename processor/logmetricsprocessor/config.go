@@ -0,0 +1,203 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logmetricsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/logmetricsprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/model/pdata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterlog"
+)
+
+const (
+	delta      = "AGGREGATION_TEMPORALITY_DELTA"
+	cumulative = "AGGREGATION_TEMPORALITY_CUMULATIVE"
+)
+
+// MetricType is the type of a metric derived from log records.
+type MetricType string
+
+const (
+	// MetricTypeCounter counts the matching log records, or sums the extracted values when a value is configured.
+	MetricTypeCounter MetricType = "counter"
+	// MetricTypeHistogram records the distribution of the extracted values.
+	MetricTypeHistogram MetricType = "histogram"
+	// MetricTypeGauge records the last extracted value.
+	MetricTypeGauge MetricType = "gauge"
+)
+
+// Dimension defines a dimension name and an optional default value if the dimension is missing
+// from the log record.
+type Dimension struct {
+	Name    string  `mapstructure:"name"`
+	Default *string `mapstructure:"default"`
+}
+
+// MetricConfig defines a metric derived from the log records.
+type MetricConfig struct {
+	// Name is the name of the metric.
+	Name string `mapstructure:"name"`
+
+	// Description is the description of the metric.
+	Description string `mapstructure:"description"`
+
+	// Unit is the unit of the metric.
+	Unit string `mapstructure:"unit"`
+
+	// Type is the type of the metric: "counter", "histogram" or "gauge".
+	Type MetricType `mapstructure:"type"`
+
+	// MatchConfig selects the log records the metric is derived from. All log records are
+	// selected if neither include nor exclude are set.
+	filterconfig.MatchConfig `mapstructure:",squash"`
+
+	// Pattern is a regular expression the body of the log records must match. The named capture
+	// groups of the pattern can be used as value and as dimensions.
+	Pattern string `mapstructure:"pattern"`
+
+	// Value is the name of the capture group of Pattern, of the log record attribute or of the
+	// resource attribute holding the numeric value of the metric, in this order of precedence.
+	// It is required by histograms and gauges.
+	Value string `mapstructure:"value"`
+
+	// Dimensions are the attributes of the data points. Their value is read from the capture
+	// groups of Pattern, the log record attributes or the resource attributes, in this order of
+	// precedence, falling back to the default value. Dimensions without value nor default are omitted.
+	Dimensions []Dimension `mapstructure:"dimensions"`
+
+	// Buckets are the explicit bounds of the histogram buckets.
+	// See defaultHistogramBuckets in processor.go for the default value.
+	Buckets []float64 `mapstructure:"buckets"`
+}
+
+// Config defines the configuration for the log metrics processor.
+type Config struct {
+	config.ProcessorSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+
+	// MetricsExporter is the name of the metrics exporter to use to ship metrics.
+	MetricsExporter string `mapstructure:"metrics_exporter"`
+
+	// Metrics are the metrics derived from the log records.
+	Metrics []MetricConfig `mapstructure:"metrics"`
+
+	AggregationTemporality string `mapstructure:"aggregation_temporality"`
+
+	// DimensionsCacheSize is the maximum number of distinct sets of dimensions, hence of data points, kept for each
+	// metric. It bounds the memory used by cumulative metrics with high cardinality dimensions, e.g. extracted by
+	// Pattern: the log records with new sets of dimensions are skipped once it is reached.
+	// Optional. See defaultDimensionsCacheSize in processor.go for the default value.
+	DimensionsCacheSize int `mapstructure:"dimensions_cache_size"`
+
+	// SeriesExpiration is the time after which the data points of cumulative metrics no longer
+	// updated by log records are removed, so that gauges do not report their last value forever.
+	// Zero never removes them.
+	// Optional. See defaultSeriesExpiration in processor.go for the default value.
+	SeriesExpiration time.Duration `mapstructure:"series_expiration"`
+}
+
+var _ config.Processor = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid.
+func (c *Config) Validate() error {
+	if c.MetricsExporter == "" {
+		return errors.New("\"metrics_exporter\" must be set")
+	}
+	if c.AggregationTemporality != delta && c.AggregationTemporality != cumulative {
+		return fmt.Errorf("\"aggregation_temporality\" must be %s or %s", delta, cumulative)
+	}
+	if c.DimensionsCacheSize <= 0 {
+		return errors.New("\"dimensions_cache_size\" must be positive")
+	}
+	if c.SeriesExpiration < 0 {
+		return errors.New("\"series_expiration\" must not be negative")
+	}
+	if len(c.Metrics) == 0 {
+		return errors.New("at least one metric must be configured")
+	}
+
+	names := make(map[string]struct{}, len(c.Metrics))
+	for i := range c.Metrics {
+		m := &c.Metrics[i]
+		if m.Name == "" {
+			return fmt.Errorf("metric #%d: \"name\" must be set", i)
+		}
+		if _, ok := names[m.Name]; ok {
+			return fmt.Errorf("duplicate metric name %s", m.Name)
+		}
+		names[m.Name] = struct{}{}
+
+		if err := m.validate(); err != nil {
+			return fmt.Errorf("metric %s: %w", m.Name, err)
+		}
+	}
+	return nil
+}
+
+func (m *MetricConfig) validate() error {
+	switch m.Type {
+	case MetricTypeCounter:
+	case MetricTypeHistogram, MetricTypeGauge:
+		if m.Value == "" {
+			return fmt.Errorf("\"value\" must be set for %s metrics", m.Type)
+		}
+	default:
+		return fmt.Errorf("\"type\" must be %s, %s or %s", MetricTypeCounter, MetricTypeHistogram, MetricTypeGauge)
+	}
+
+	if m.Pattern != "" {
+		if _, err := regexp.Compile(m.Pattern); err != nil {
+			return fmt.Errorf("invalid \"pattern\": %w", err)
+		}
+	}
+
+	if _, err := filterlog.NewMatcher(m.Include); err != nil {
+		return fmt.Errorf("invalid \"include\": %w", err)
+	}
+	if _, err := filterlog.NewMatcher(m.Exclude); err != nil {
+		return fmt.Errorf("invalid \"exclude\": %w", err)
+	}
+
+	dimensions := make(map[string]struct{}, len(m.Dimensions))
+	for _, d := range m.Dimensions {
+		if d.Name == "" {
+			return errors.New("dimension \"name\" must be set")
+		}
+		if _, ok := dimensions[d.Name]; ok {
+			return fmt.Errorf("duplicate dimension name %s", d.Name)
+		}
+		dimensions[d.Name] = struct{}{}
+	}
+
+	if len(m.Buckets) > 0 && !sort.Float64sAreSorted(m.Buckets) {
+		return errors.New("\"buckets\" must be sorted in increasing order")
+	}
+	return nil
+}
+
+// GetAggregationTemporality converts the string value given in the config into a MetricAggregationTemporality.
+// Returns cumulative, unless delta is correctly specified.
+func (c Config) GetAggregationTemporality() pdata.MetricAggregationTemporality {
+	if c.AggregationTemporality == delta {
+		return pdata.MetricAggregationTemporalityDelta
+	}
+	return pdata.MetricAggregationTemporalityCumulative
+}
@@ -0,0 +1,188 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logmetricsprocessor
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configtest"
+	"go.opentelemetry.io/collector/model/pdata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+)
+
+func TestLoadConfig(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	require.NoError(t, err)
+
+	factories.Processors[typeStr] = NewFactory()
+
+	cfg, err := configtest.LoadConfigAndValidate(path.Join(".", "testdata", "config.yaml"), factories)
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	unknown := "unknown"
+	accessLog := `"(?P<method>[A-Z]+) \S+ HTTP/[\d.]+" (?P<status>\d{3}) (?P<bytes>\d+)`
+	expected := &Config{
+		ProcessorSettings:      config.NewProcessorSettings(config.NewComponentID(typeStr)),
+		MetricsExporter:        "nop",
+		AggregationTemporality: delta,
+		DimensionsCacheSize:    500,
+		SeriesExpiration:       10 * time.Minute,
+		Metrics: []MetricConfig{
+			{
+				Name:        "http.server.requests",
+				Description: "Number of HTTP requests",
+				Type:        MetricTypeCounter,
+				MatchConfig: filterconfig.MatchConfig{
+					Include: &filterconfig.MatchProperties{
+						Config: filterset.Config{MatchType: filterset.Strict},
+						Attributes: []filterconfig.Attribute{
+							{Key: "component", Value: "nginx"},
+						},
+					},
+				},
+				Pattern:    accessLog,
+				Dimensions: []Dimension{{Name: "method"}, {Name: "status"}},
+			},
+			{
+				Name:       "http.server.response.size",
+				Unit:       "By",
+				Type:       MetricTypeHistogram,
+				Pattern:    accessLog,
+				Value:      "bytes",
+				Buckets:    []float64{100, 1000, 10000},
+				Dimensions: []Dimension{{Name: "status"}},
+			},
+			{
+				Name:  "queue.depth",
+				Type:  MetricTypeGauge,
+				Value: "queue.depth",
+				MatchConfig: filterconfig.MatchConfig{
+					Exclude: &filterconfig.MatchProperties{
+						Config:   filterset.Config{MatchType: filterset.Strict},
+						LogNames: []string{"debug"},
+					},
+				},
+				Dimensions: []Dimension{{Name: "host.name", Default: &unknown}},
+			},
+		},
+	}
+	assert.Equal(t, expected, cfg.Processors[config.NewComponentID(typeStr)])
+	assert.Equal(t, pdata.MetricAggregationTemporalityDelta, expected.GetAggregationTemporality())
+}
+
+func TestConfigValidate(t *testing.T) {
+	counter := MetricConfig{Name: "records", Type: MetricTypeCounter}
+	tests := []struct {
+		name    string
+		modify  func(cfg *Config)
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			modify: func(cfg *Config) {},
+		},
+		{
+			name:    "missing metrics exporter",
+			modify:  func(cfg *Config) { cfg.MetricsExporter = "" },
+			wantErr: `"metrics_exporter" must be set`,
+		},
+		{
+			name:    "invalid aggregation temporality",
+			modify:  func(cfg *Config) { cfg.AggregationTemporality = "AGGREGATION_TEMPORALITY_UNSPECIFIED" },
+			wantErr: `"aggregation_temporality" must be AGGREGATION_TEMPORALITY_DELTA or AGGREGATION_TEMPORALITY_CUMULATIVE`,
+		},
+		{
+			name:    "invalid dimensions cache size",
+			modify:  func(cfg *Config) { cfg.DimensionsCacheSize = 0 },
+			wantErr: `"dimensions_cache_size" must be positive`,
+		},
+		{
+			name:    "negative series expiration",
+			modify:  func(cfg *Config) { cfg.SeriesExpiration = -time.Minute },
+			wantErr: `"series_expiration" must not be negative`,
+		},
+		{
+			name:    "no metrics",
+			modify:  func(cfg *Config) { cfg.Metrics = nil },
+			wantErr: "at least one metric must be configured",
+		},
+		{
+			name:    "missing name",
+			modify:  func(cfg *Config) { cfg.Metrics[0].Name = "" },
+			wantErr: `metric #0: "name" must be set`,
+		},
+		{
+			name:    "duplicate name",
+			modify:  func(cfg *Config) { cfg.Metrics = append(cfg.Metrics, counter) },
+			wantErr: "duplicate metric name records",
+		},
+		{
+			name:    "invalid type",
+			modify:  func(cfg *Config) { cfg.Metrics[0].Type = "summary" },
+			wantErr: `metric records: "type" must be counter, histogram or gauge`,
+		},
+		{
+			name:    "histogram without value",
+			modify:  func(cfg *Config) { cfg.Metrics[0].Type = MetricTypeHistogram },
+			wantErr: `metric records: "value" must be set for histogram metrics`,
+		},
+		{
+			name:    "invalid pattern",
+			modify:  func(cfg *Config) { cfg.Metrics[0].Pattern = "(" },
+			wantErr: "metric records: invalid \"pattern\": error parsing regexp: missing closing ): `(`",
+		},
+		{
+			name: "invalid include",
+			modify: func(cfg *Config) {
+				cfg.Metrics[0].Include = &filterconfig.MatchProperties{Config: filterset.Config{MatchType: filterset.Strict}}
+			},
+			wantErr: `metric records: invalid "include": at least one of "log_names", "attributes", "libraries" or "resources" field must be specified`,
+		},
+		{
+			name:    "duplicate dimension",
+			modify:  func(cfg *Config) { cfg.Metrics[0].Dimensions = []Dimension{{Name: "a"}, {Name: "a"}} },
+			wantErr: "metric records: duplicate dimension name a",
+		},
+		{
+			name:    "unsorted buckets",
+			modify:  func(cfg *Config) { cfg.Metrics[0].Buckets = []float64{10, 1} },
+			wantErr: `metric records: "buckets" must be sorted in increasing order`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.MetricsExporter = "otlp"
+			cfg.Metrics = []MetricConfig{counter}
+			tt.modify(cfg)
+
+			err := cfg.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
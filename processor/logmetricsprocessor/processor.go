@@ -0,0 +1,467 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logmetricsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/logmetricsprocessor"
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterlog"
+)
+
+const (
+	metricKeySeparator = string(byte(0))
+
+	defaultDimensionsCacheSize = 1000
+	defaultSeriesExpiration    = 5 * time.Minute
+)
+
+var defaultHistogramBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+type metricKey string
+
+// dataPoint holds the aggregated values of a metric for a set of dimensions.
+type dataPoint struct {
+	attributes   pdata.AttributeMap
+	count        uint64
+	sum          float64
+	bucketCounts []uint64
+	last         float64
+	// startTime is when the data point was created, the start of its cumulative values.
+	startTime time.Time
+	// lastSeen is when a log record last updated the data point.
+	lastSeen time.Time
+}
+
+// metric is a configured metric along with its matchers and aggregated data points.
+type metric struct {
+	config     MetricConfig
+	include    filterlog.Matcher
+	exclude    filterlog.Matcher
+	pattern    *regexp.Regexp
+	bounds     []float64
+	dataPoints map[metricKey]*dataPoint
+}
+
+type processorImp struct {
+	lock   sync.Mutex
+	logger *zap.Logger
+	config Config
+
+	metricsExporter component.MetricsExporter
+	nextConsumer    consumer.Logs
+
+	// The starting time of the data points.
+	startTime time.Time
+
+	metrics []*metric
+}
+
+func newProcessor(logger *zap.Logger, cfg *Config, nextConsumer consumer.Logs) (*processorImp, error) {
+	logger.Info("Building logmetricsprocessor")
+
+	metrics := make([]*metric, 0, len(cfg.Metrics))
+	for _, mc := range cfg.Metrics {
+		m, err := newMetric(mc)
+		if err != nil {
+			return nil, fmt.Errorf("metric %s: %w", mc.Name, err)
+		}
+		metrics = append(metrics, m)
+	}
+
+	return &processorImp{
+		logger:       logger,
+		config:       *cfg,
+		nextConsumer: nextConsumer,
+		startTime:    time.Now(),
+		metrics:      metrics,
+	}, nil
+}
+
+func newMetric(cfg MetricConfig) (*metric, error) {
+	include, err := filterlog.NewMatcher(cfg.Include)
+	if err != nil {
+		return nil, err
+	}
+	exclude, err := filterlog.NewMatcher(cfg.Exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	var pattern *regexp.Regexp
+	if cfg.Pattern != "" {
+		if pattern, err = regexp.Compile(cfg.Pattern); err != nil {
+			return nil, err
+		}
+	}
+
+	bounds := defaultHistogramBuckets
+	if len(cfg.Buckets) > 0 {
+		bounds = cfg.Buckets
+	}
+
+	return &metric{
+		config:     cfg,
+		include:    include,
+		exclude:    exclude,
+		pattern:    pattern,
+		bounds:     bounds,
+		dataPoints: make(map[metricKey]*dataPoint),
+	}, nil
+}
+
+func (p *processorImp) Start(ctx context.Context, host component.Host) error {
+	p.logger.Info("Starting logmetricsprocessor")
+	exporters := host.GetExporters()
+
+	var availableMetricsExporters []string
+
+	// The available list of exporters come from any configured metrics pipelines' exporters.
+	for k, exp := range exporters[config.MetricsDataType] {
+		metricsExp, ok := exp.(component.MetricsExporter)
+		if !ok {
+			return fmt.Errorf("the exporter %q isn't a metrics exporter", k.String())
+		}
+
+		availableMetricsExporters = append(availableMetricsExporters, k.String())
+
+		if k.String() == p.config.MetricsExporter {
+			p.metricsExporter = metricsExp
+			p.logger.Info("Found exporter", zap.String("logmetrics-exporter", p.config.MetricsExporter))
+			break
+		}
+	}
+	if p.metricsExporter == nil {
+		return fmt.Errorf("failed to find metrics exporter: '%s'; please configure metrics_exporter from one of: %+v",
+			p.config.MetricsExporter, availableMetricsExporters)
+	}
+	p.logger.Info("Started logmetricsprocessor")
+	return nil
+}
+
+func (p *processorImp) Shutdown(ctx context.Context) error {
+	p.logger.Info("Shutting down logmetricsprocessor")
+	return nil
+}
+
+func (p *processorImp) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+func (p *processorImp) ConsumeLogs(ctx context.Context, ld pdata.Logs) error {
+	m := p.aggregateAndBuildMetrics(ld)
+
+	// Firstly, export metrics to avoid being impacted by downstream log processor errors/latency.
+	// A failure to export the metrics does not fail the logs, which would be retried and
+	// aggregated twice.
+	if m.MetricCount() > 0 {
+		if err := p.metricsExporter.ConsumeMetrics(ctx, m); err != nil {
+			p.logger.Error("Failed to export the metrics derived from the logs", zap.Error(err))
+		}
+	}
+
+	// Forward log data unmodified.
+	return p.nextConsumer.ConsumeLogs(ctx, ld)
+}
+
+func (p *processorImp) aggregateAndBuildMetrics(ld pdata.Logs) pdata.Metrics {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	now := time.Now()
+	p.aggregateMetrics(ld, now)
+	if p.config.GetAggregationTemporality() == pdata.MetricAggregationTemporalityCumulative {
+		p.expireDataPoints(now)
+	}
+	m := p.buildMetrics()
+
+	// If delta metrics, reset accumulated data
+	if p.config.GetAggregationTemporality() == pdata.MetricAggregationTemporalityDelta {
+		for _, m := range p.metrics {
+			m.dataPoints = make(map[metricKey]*dataPoint)
+		}
+		p.startTime = time.Now()
+	}
+	return m
+}
+
+// expireDataPoints removes the data points no log record updated during the series expiration,
+// so that the series no longer seen stop being exported with their last values.
+func (p *processorImp) expireDataPoints(now time.Time) {
+	if p.config.SeriesExpiration <= 0 {
+		return
+	}
+	for _, m := range p.metrics {
+		for key, dp := range m.dataPoints {
+			if now.Sub(dp.lastSeen) >= p.config.SeriesExpiration {
+				delete(m.dataPoints, key)
+			}
+		}
+	}
+}
+
+// aggregateMetrics updates the data points of the metrics matching the log records.
+func (p *processorImp) aggregateMetrics(ld pdata.Logs, now time.Time) {
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		resource := rl.Resource()
+		ills := rl.InstrumentationLibraryLogs()
+		for j := 0; j < ills.Len(); j++ {
+			ill := ills.At(j)
+			library := ill.InstrumentationLibrary()
+			logs := ill.Logs()
+			for k := 0; k < logs.Len(); k++ {
+				lr := logs.At(k)
+				for _, m := range p.metrics {
+					p.aggregateLogRecord(m, lr, resource, library, now)
+				}
+			}
+		}
+	}
+}
+
+func (p *processorImp) aggregateLogRecord(m *metric, lr pdata.LogRecord, resource pdata.Resource, library pdata.InstrumentationLibrary, now time.Time) {
+	captures, ok := m.match(lr, resource, library)
+	if !ok {
+		return
+	}
+
+	var value float64
+	if m.config.Value != "" {
+		if value, ok = getValue(m.config.Value, captures, lr.Attributes(), resource.Attributes()); !ok {
+			p.logger.Debug("Log record has no numeric value, skipping it",
+				zap.String("metric", m.config.Name),
+				zap.String("value", m.config.Value))
+			return
+		}
+		// Counters are monotonic sums, which negative values would decrease.
+		if m.config.Type == MetricTypeCounter && value < 0 {
+			p.logger.Debug("Log record has a negative value for a counter, skipping it",
+				zap.String("metric", m.config.Name),
+				zap.String("value", m.config.Value),
+				zap.Float64("number", value))
+			return
+		}
+	}
+
+	key, attributes := buildDimensions(m.config.Dimensions, captures, lr.Attributes(), resource.Attributes())
+	dp, ok := m.dataPoints[key]
+	if !ok {
+		if len(m.dataPoints) >= p.config.DimensionsCacheSize {
+			p.logger.Debug("Too many sets of dimensions for the metric, skipping the log record",
+				zap.String("metric", m.config.Name),
+				zap.Int("dimensions_cache_size", p.config.DimensionsCacheSize))
+			return
+		}
+		dp = &dataPoint{attributes: attributes, startTime: now}
+		if m.config.Type == MetricTypeHistogram {
+			dp.bucketCounts = make([]uint64, len(m.bounds)+1)
+		}
+		m.dataPoints[key] = dp
+	}
+
+	dp.count++
+	dp.sum += value
+	dp.last = value
+	dp.lastSeen = now
+	if m.config.Type == MetricTypeHistogram {
+		// Binary search to find the bucket index, the bounds being inclusive upper bounds.
+		dp.bucketCounts[sort.SearchFloat64s(m.bounds, value)]++
+	}
+}
+
+// match tells whether the log record is selected by the metric and returns the named capture
+// groups of the pattern.
+func (m *metric) match(lr pdata.LogRecord, resource pdata.Resource, library pdata.InstrumentationLibrary) (map[string]string, bool) {
+	if m.include != nil && !m.include.MatchLogRecord(lr, resource, library) {
+		return nil, false
+	}
+	if m.exclude != nil && m.exclude.MatchLogRecord(lr, resource, library) {
+		return nil, false
+	}
+	if m.pattern == nil {
+		return nil, true
+	}
+
+	submatches := m.pattern.FindStringSubmatch(lr.Body().AsString())
+	if submatches == nil {
+		return nil, false
+	}
+	captures := make(map[string]string)
+	for i, name := range m.pattern.SubexpNames() {
+		if name != "" && i < len(submatches) {
+			captures[name] = submatches[i]
+		}
+	}
+	return captures, true
+}
+
+// getValue gets the numeric value of the named capture group, log record attribute or resource attribute.
+func getValue(name string, captures map[string]string, logAttr pdata.AttributeMap, resourceAttr pdata.AttributeMap) (float64, bool) {
+	if s, ok := captures[name]; ok {
+		return parseFloat(s)
+	}
+	attr, ok := logAttr.Get(name)
+	if !ok {
+		if attr, ok = resourceAttr.Get(name); !ok {
+			return 0, false
+		}
+	}
+	switch attr.Type() {
+	case pdata.AttributeValueTypeInt:
+		return float64(attr.IntVal()), true
+	case pdata.AttributeValueTypeDouble:
+		return attr.DoubleVal(), true
+	case pdata.AttributeValueTypeString:
+		return parseFloat(attr.StringVal())
+	}
+	return 0, false
+}
+
+func parseFloat(s string) (float64, bool) {
+	v, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	return v, err == nil
+}
+
+// getDimensionValue gets the dimension value from the named capture groups first, then the log
+// record attributes, being more specific than the resource attributes. Finally, falls back to the
+// configured default value if provided.
+//
+// The ok flag indicates if a dimension value was fetched in order to differentiate
+// an empty string value from a state where no value was found.
+func getDimensionValue(d Dimension, captures map[string]string, logAttr pdata.AttributeMap, resourceAttr pdata.AttributeMap) (v pdata.AttributeValue, ok bool) {
+	if s, exists := captures[d.Name]; exists {
+		return pdata.NewAttributeValueString(s), true
+	}
+	if attr, exists := logAttr.Get(d.Name); exists {
+		return attr, true
+	}
+	if attr, exists := resourceAttr.Get(d.Name); exists {
+		return attr, true
+	}
+	if d.Default != nil {
+		return pdata.NewAttributeValueString(*d.Default), true
+	}
+	return v, ok
+}
+
+// buildDimensions returns the attributes of the data point along with the key identifying them,
+// a concatenation of the dimension names and values delimited by a null character.
+func buildDimensions(dimensions []Dimension, captures map[string]string, logAttr pdata.AttributeMap, resourceAttr pdata.AttributeMap) (metricKey, pdata.AttributeMap) {
+	var keyBuilder strings.Builder
+	attributes := pdata.NewAttributeMap()
+	for _, d := range dimensions {
+		v, ok := getDimensionValue(d, captures, logAttr, resourceAttr)
+		if !ok {
+			continue
+		}
+		attributes.Upsert(d.Name, v)
+		keyBuilder.WriteString(d.Name)
+		keyBuilder.WriteString(metricKeySeparator)
+		keyBuilder.WriteString(v.AsString())
+		keyBuilder.WriteString(metricKeySeparator)
+	}
+	return metricKey(keyBuilder.String()), attributes
+}
+
+// buildMetrics builds the metrics from the aggregated data points.
+func (p *processorImp) buildMetrics() pdata.Metrics {
+	md := pdata.NewMetrics()
+	ilm := md.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty()
+	ilm.InstrumentationLibrary().SetName("logmetricsprocessor")
+
+	timestamp := pdata.NewTimestampFromTime(time.Now())
+	temporality := p.config.GetAggregationTemporality()
+	// The cumulative data points start when they are created, as they may expire and be created
+	// again, the delta ones at the previous export.
+	startTimestamp := func(dp *dataPoint) pdata.Timestamp {
+		if temporality == pdata.MetricAggregationTemporalityCumulative {
+			return pdata.NewTimestampFromTime(dp.startTime)
+		}
+		return pdata.NewTimestampFromTime(p.startTime)
+	}
+
+	for _, m := range p.metrics {
+		if len(m.dataPoints) == 0 {
+			continue
+		}
+		out := ilm.Metrics().AppendEmpty()
+		out.SetName(m.config.Name)
+		out.SetDescription(m.config.Description)
+		out.SetUnit(m.config.Unit)
+
+		keys := make([]string, 0, len(m.dataPoints))
+		for key := range m.dataPoints {
+			keys = append(keys, string(key))
+		}
+		sort.Strings(keys)
+
+		switch m.config.Type {
+		case MetricTypeCounter:
+			out.SetDataType(pdata.MetricDataTypeSum)
+			out.Sum().SetIsMonotonic(true)
+			out.Sum().SetAggregationTemporality(temporality)
+			for _, key := range keys {
+				dp := m.dataPoints[metricKey(key)]
+				ndp := out.Sum().DataPoints().AppendEmpty()
+				ndp.SetStartTimestamp(startTimestamp(dp))
+				ndp.SetTimestamp(timestamp)
+				if m.config.Value == "" {
+					ndp.SetIntVal(int64(dp.count))
+				} else {
+					ndp.SetDoubleVal(dp.sum)
+				}
+				dp.attributes.CopyTo(ndp.Attributes())
+			}
+		case MetricTypeHistogram:
+			out.SetDataType(pdata.MetricDataTypeHistogram)
+			out.Histogram().SetAggregationTemporality(temporality)
+			for _, key := range keys {
+				dp := m.dataPoints[metricKey(key)]
+				ndp := out.Histogram().DataPoints().AppendEmpty()
+				ndp.SetStartTimestamp(startTimestamp(dp))
+				ndp.SetTimestamp(timestamp)
+				ndp.SetExplicitBounds(m.bounds)
+				ndp.SetBucketCounts(append([]uint64(nil), dp.bucketCounts...))
+				ndp.SetCount(dp.count)
+				ndp.SetSum(dp.sum)
+				dp.attributes.CopyTo(ndp.Attributes())
+			}
+		case MetricTypeGauge:
+			out.SetDataType(pdata.MetricDataTypeGauge)
+			for _, key := range keys {
+				dp := m.dataPoints[metricKey(key)]
+				ndp := out.Gauge().DataPoints().AppendEmpty()
+				ndp.SetTimestamp(timestamp)
+				ndp.SetDoubleVal(dp.last)
+				dp.attributes.CopyTo(ndp.Attributes())
+			}
+		}
+	}
+	return md
+}
@@ -0,0 +1,411 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logmetricsprocessor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+)
+
+const accessLogPattern = `"(?P<method>[A-Z]+) \S+ HTTP/[\d.]+" (?P<status>\d{3}) (?P<bytes>\d+)`
+
+type testHost struct {
+	component.Host
+	exporters map[config.DataType]map[config.ComponentID]component.Exporter
+}
+
+func (h *testHost) GetExporters() map[config.DataType]map[config.ComponentID]component.Exporter {
+	return h.exporters
+}
+
+type metricsSinkExporter struct {
+	consumertest.MetricsSink
+	err error
+}
+
+func (e *metricsSinkExporter) Start(context.Context, component.Host) error {
+	return nil
+}
+
+func (e *metricsSinkExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+func (e *metricsSinkExporter) ConsumeMetrics(ctx context.Context, md pdata.Metrics) error {
+	if e.err != nil {
+		return e.err
+	}
+	return e.MetricsSink.ConsumeMetrics(ctx, md)
+}
+
+type logsSinkExporter struct {
+	consumertest.LogsSink
+}
+
+func (e *logsSinkExporter) Start(context.Context, component.Host) error {
+	return nil
+}
+
+func (e *logsSinkExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+func newTestProcessor(t *testing.T, temporality string, metrics ...MetricConfig) (*processorImp, *metricsSinkExporter, *consumertest.LogsSink) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.MetricsExporter = "otlp"
+	cfg.AggregationTemporality = temporality
+	cfg.Metrics = metrics
+	require.NoError(t, cfg.Validate())
+
+	logsSink := new(consumertest.LogsSink)
+	p, err := newProcessor(zap.NewNop(), cfg, logsSink)
+	require.NoError(t, err)
+
+	mexp := &metricsSinkExporter{}
+	host := &testHost{
+		Host: componenttest.NewNopHost(),
+		exporters: map[config.DataType]map[config.ComponentID]component.Exporter{
+			config.MetricsDataType: {config.NewComponentID("otlp"): mexp},
+		},
+	}
+	require.NoError(t, p.Start(context.Background(), host))
+	return p, mexp, logsSink
+}
+
+func newAccessLogs(lines ...string) pdata.Logs {
+	ld := pdata.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().InsertString("host.name", "web-1")
+	logs := rl.InstrumentationLibraryLogs().AppendEmpty().Logs()
+	for _, line := range lines {
+		lr := logs.AppendEmpty()
+		lr.Body().SetStringVal(line)
+		lr.Attributes().InsertString("component", "nginx")
+	}
+	return ld
+}
+
+func TestProcessorStart(t *testing.T) {
+	for _, tc := range []struct {
+		name            string
+		exporter        component.Exporter
+		metricsExporter string
+		wantErrorMsg    string
+	}{
+		{"export to active otlp metrics exporter", &metricsSinkExporter{}, "otlp", ""},
+		{"unable to find configured exporter in active exporter list", &metricsSinkExporter{}, "prometheus", "failed to find metrics exporter: 'prometheus'; please configure metrics_exporter from one of: [otlp]"},
+		{"export to active otlp logs exporter should error", &logsSinkExporter{}, "otlp", "the exporter \"otlp\" isn't a metrics exporter"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			host := &testHost{
+				Host: componenttest.NewNopHost(),
+				exporters: map[config.DataType]map[config.ComponentID]component.Exporter{
+					config.MetricsDataType: {config.NewComponentID("otlp"): tc.exporter},
+				},
+			}
+
+			cfg := createDefaultConfig().(*Config)
+			cfg.MetricsExporter = tc.metricsExporter
+			p, err := newProcessor(zap.NewNop(), cfg, consumertest.NewNop())
+			require.NoError(t, err)
+
+			err = p.Start(context.Background(), host)
+			if tc.wantErrorMsg != "" {
+				assert.EqualError(t, err, tc.wantErrorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, p.Shutdown(context.Background()))
+		})
+	}
+}
+
+func TestProcessorCounter(t *testing.T) {
+	p, mexp, logsSink := newTestProcessor(t, cumulative, MetricConfig{
+		Name: "http.server.requests",
+		Type: MetricTypeCounter,
+		MatchConfig: filterconfig.MatchConfig{
+			Include: &filterconfig.MatchProperties{
+				Config:     filterset.Config{MatchType: filterset.Strict},
+				Attributes: []filterconfig.Attribute{{Key: "component", Value: "nginx"}},
+			},
+		},
+		Pattern:    accessLogPattern,
+		Dimensions: []Dimension{{Name: "method"}, {Name: "status"}, {Name: "host.name"}},
+	})
+
+	ld := newAccessLogs(
+		`"GET /index.html HTTP/1.1" 200 512`,
+		`"GET /missing HTTP/1.1" 404 12`,
+		`"GET /index.html HTTP/1.1" 200 1024`,
+		`not an access log`,
+	)
+	require.NoError(t, p.ConsumeLogs(context.Background(), ld))
+	require.NoError(t, p.ConsumeLogs(context.Background(), newAccessLogs(`"POST /form HTTP/1.1" 200 10`)))
+
+	// Logs are forwarded unmodified.
+	require.Len(t, logsSink.AllLogs(), 2)
+	assert.Equal(t, ld, logsSink.AllLogs()[0])
+
+	require.Len(t, mexp.AllMetrics(), 2)
+	ilm := mexp.AllMetrics()[1].ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0)
+	assert.Equal(t, "logmetricsprocessor", ilm.InstrumentationLibrary().Name())
+	require.Equal(t, 1, ilm.Metrics().Len())
+	m := ilm.Metrics().At(0)
+	assert.Equal(t, "http.server.requests", m.Name())
+	assert.Equal(t, pdata.MetricDataTypeSum, m.DataType())
+	assert.True(t, m.Sum().IsMonotonic())
+	assert.Equal(t, pdata.MetricAggregationTemporalityCumulative, m.Sum().AggregationTemporality())
+
+	dps := m.Sum().DataPoints()
+	require.Equal(t, 3, dps.Len())
+	got := map[string]int64{}
+	for i := 0; i < dps.Len(); i++ {
+		attrs := dps.At(i).Attributes().AsRaw()
+		assert.Equal(t, "web-1", attrs["host.name"])
+		got[attrs["method"].(string)+" "+attrs["status"].(string)] = dps.At(i).IntVal()
+	}
+	assert.Equal(t, map[string]int64{"GET 200": 2, "GET 404": 1, "POST 200": 1}, got)
+}
+
+func TestProcessorCounterWithValue(t *testing.T) {
+	p, mexp, _ := newTestProcessor(t, delta, MetricConfig{
+		Name:    "http.server.response.bytes",
+		Type:    MetricTypeCounter,
+		Pattern: accessLogPattern,
+		Value:   "bytes",
+	})
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newAccessLogs(
+		`"GET /index.html HTTP/1.1" 200 512`,
+		`"GET /missing HTTP/1.1" 404 12`,
+	)))
+
+	require.Len(t, mexp.AllMetrics(), 1)
+	m := mexp.AllMetrics()[0].ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, pdata.MetricAggregationTemporalityDelta, m.Sum().AggregationTemporality())
+	require.Equal(t, 1, m.Sum().DataPoints().Len())
+	assert.Equal(t, pdata.MetricValueTypeDouble, m.Sum().DataPoints().At(0).Type())
+	assert.Equal(t, 524.0, m.Sum().DataPoints().At(0).DoubleVal())
+}
+
+func TestProcessorCounterSkipsNegativeValues(t *testing.T) {
+	p, mexp, _ := newTestProcessor(t, delta, MetricConfig{
+		Name:  "queue.dequeued",
+		Type:  MetricTypeCounter,
+		Value: "dequeued",
+	})
+
+	ld := pdata.NewLogs()
+	logs := ld.ResourceLogs().AppendEmpty().InstrumentationLibraryLogs().AppendEmpty().Logs()
+	for _, v := range []int64{3, -2, 4} {
+		logs.AppendEmpty().Attributes().InsertInt("dequeued", v)
+	}
+	require.NoError(t, p.ConsumeLogs(context.Background(), ld))
+
+	require.Len(t, mexp.AllMetrics(), 1)
+	m := mexp.AllMetrics()[0].ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics().At(0)
+	assert.True(t, m.Sum().IsMonotonic())
+	require.Equal(t, 1, m.Sum().DataPoints().Len())
+	assert.Equal(t, 7.0, m.Sum().DataPoints().At(0).DoubleVal())
+}
+
+func TestProcessorDimensionsCacheSize(t *testing.T) {
+	p, mexp, logsSink := newTestProcessor(t, cumulative, MetricConfig{
+		Name:       "http.server.requests",
+		Type:       MetricTypeCounter,
+		Pattern:    accessLogPattern,
+		Dimensions: []Dimension{{Name: "status"}},
+	})
+	p.config.DimensionsCacheSize = 2
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newAccessLogs(
+		`"GET /index.html HTTP/1.1" 200 512`,
+		`"GET /missing HTTP/1.1" 404 12`,
+		`"GET /error HTTP/1.1" 500 0`,
+	)))
+	require.NoError(t, p.ConsumeLogs(context.Background(), newAccessLogs(
+		`"GET /redirect HTTP/1.1" 302 0`,
+		`"GET /index.html HTTP/1.1" 200 512`,
+	)))
+
+	// The log records are forwarded even if they are not counted.
+	assert.Len(t, logsSink.AllLogs(), 2)
+
+	require.Len(t, mexp.AllMetrics(), 2)
+	dps := mexp.AllMetrics()[1].ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics().At(0).Sum().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	got := map[string]int64{}
+	for i := 0; i < dps.Len(); i++ {
+		got[dps.At(i).Attributes().AsRaw()["status"].(string)] = dps.At(i).IntVal()
+	}
+	assert.Equal(t, map[string]int64{"200": 2, "404": 1}, got)
+}
+
+func TestProcessorHistogram(t *testing.T) {
+	p, mexp, _ := newTestProcessor(t, delta, MetricConfig{
+		Name:       "http.server.response.size",
+		Type:       MetricTypeHistogram,
+		Pattern:    accessLogPattern,
+		Value:      "bytes",
+		Buckets:    []float64{100, 1000},
+		Dimensions: []Dimension{{Name: "status"}},
+	})
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newAccessLogs(
+		`"GET /index.html HTTP/1.1" 200 512`,
+		`"GET /index.html HTTP/1.1" 200 100`,
+		`"GET /large HTTP/1.1" 200 4096`,
+	)))
+	// Delta temporality: nothing is exported once the data points were reset and no log matches.
+	require.NoError(t, p.ConsumeLogs(context.Background(), newAccessLogs(`not an access log`)))
+
+	require.Len(t, mexp.AllMetrics(), 1)
+	m := mexp.AllMetrics()[0].ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, pdata.MetricDataTypeHistogram, m.DataType())
+	assert.Equal(t, pdata.MetricAggregationTemporalityDelta, m.Histogram().AggregationTemporality())
+	require.Equal(t, 1, m.Histogram().DataPoints().Len())
+	dp := m.Histogram().DataPoints().At(0)
+	assert.Equal(t, uint64(3), dp.Count())
+	assert.Equal(t, 4708.0, dp.Sum())
+	assert.Equal(t, []float64{100, 1000}, dp.ExplicitBounds())
+	assert.Equal(t, []uint64{1, 1, 1}, dp.BucketCounts())
+	assert.Equal(t, map[string]interface{}{"status": "200"}, dp.Attributes().AsRaw())
+}
+
+func TestProcessorGauge(t *testing.T) {
+	defaultQueue := "default"
+	p, mexp, _ := newTestProcessor(t, cumulative, MetricConfig{
+		Name:  "queue.depth",
+		Type:  MetricTypeGauge,
+		Value: "queue.depth",
+		MatchConfig: filterconfig.MatchConfig{
+			Exclude: &filterconfig.MatchProperties{
+				Config:   filterset.Config{MatchType: filterset.Strict},
+				LogNames: []string{"debug"},
+			},
+		},
+		Dimensions: []Dimension{{Name: "queue", Default: &defaultQueue}},
+	})
+
+	ld := pdata.NewLogs()
+	logs := ld.ResourceLogs().AppendEmpty().InstrumentationLibraryLogs().AppendEmpty().Logs()
+	lr := logs.AppendEmpty()
+	lr.Attributes().InsertInt("queue.depth", 3)
+	lr = logs.AppendEmpty()
+	lr.Attributes().InsertString("queue.depth", "7.5")
+	lr = logs.AppendEmpty()
+	lr.SetName("debug")
+	lr.Attributes().InsertDouble("queue.depth", 100)
+	lr = logs.AppendEmpty()
+	lr.Attributes().InsertString("queue.depth", "not a number")
+	lr = logs.AppendEmpty()
+	lr.Attributes().InsertInt("queue.depth", 1)
+	lr.Attributes().InsertString("queue", "priority")
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), ld))
+
+	require.Len(t, mexp.AllMetrics(), 1)
+	m := mexp.AllMetrics()[0].ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, pdata.MetricDataTypeGauge, m.DataType())
+	dps := m.Gauge().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	got := map[string]float64{}
+	for i := 0; i < dps.Len(); i++ {
+		got[dps.At(i).Attributes().AsRaw()["queue"].(string)] = dps.At(i).DoubleVal()
+	}
+	assert.Equal(t, map[string]float64{"default": 7.5, "priority": 1}, got)
+}
+
+func TestProcessorSeriesExpiration(t *testing.T) {
+	p, mexp, _ := newTestProcessor(t, cumulative, MetricConfig{
+		Name:       "queue.depth",
+		Type:       MetricTypeGauge,
+		Value:      "queue.depth",
+		Dimensions: []Dimension{{Name: "queue"}},
+	})
+
+	queueLogs := func(queue string, depth int64) pdata.Logs {
+		ld := pdata.NewLogs()
+		lr := ld.ResourceLogs().AppendEmpty().InstrumentationLibraryLogs().AppendEmpty().Logs().AppendEmpty()
+		lr.Attributes().InsertString("queue", queue)
+		lr.Attributes().InsertInt("queue.depth", depth)
+		return ld
+	}
+	queues := func(md pdata.Metrics) map[string]float64 {
+		dps := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics().At(0).Gauge().DataPoints()
+		got := map[string]float64{}
+		for i := 0; i < dps.Len(); i++ {
+			got[dps.At(i).Attributes().AsRaw()["queue"].(string)] = dps.At(i).DoubleVal()
+		}
+		return got
+	}
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), queueLogs("a", 3)))
+	require.NoError(t, p.ConsumeLogs(context.Background(), queueLogs("b", 5)))
+	require.Len(t, mexp.AllMetrics(), 2)
+	assert.Equal(t, map[string]float64{"a": 3, "b": 5}, queues(mexp.AllMetrics()[1]))
+
+	// The series of the queue no longer seen expires.
+	p.metrics[0].dataPoints[metricKey("queue\x00a\x00")].lastSeen = time.Now().Add(-defaultSeriesExpiration)
+	require.NoError(t, p.ConsumeLogs(context.Background(), queueLogs("b", 2)))
+	require.Len(t, mexp.AllMetrics(), 3)
+	assert.Equal(t, map[string]float64{"b": 2}, queues(mexp.AllMetrics()[2]))
+}
+
+func TestProcessorConsumeLogsExporterError(t *testing.T) {
+	p, mexp, logsSink := newTestProcessor(t, cumulative, MetricConfig{Name: "records", Type: MetricTypeCounter})
+	mexp.err = errors.New("export failed")
+
+	// The logs are forwarded despite the failure to export the metrics.
+	require.NoError(t, p.ConsumeLogs(context.Background(), newAccessLogs("line")))
+	assert.Len(t, logsSink.AllLogs(), 1)
+}
+
+func TestBuildDimensions(t *testing.T) {
+	defaultValue := "none"
+	dimensions := []Dimension{{Name: "a"}, {Name: "b", Default: &defaultValue}}
+	logAttr := pdata.NewAttributeMap()
+	resourceAttr := pdata.NewAttributeMap()
+
+	// The key includes the dimension names so that omitted dimensions don't collide.
+	logAttr.InsertString("a", "x")
+	k1, attrs := buildDimensions(dimensions, nil, logAttr, resourceAttr)
+	assert.Equal(t, map[string]interface{}{"a": "x", "b": "none"}, attrs.AsRaw())
+
+	logAttr = pdata.NewAttributeMap()
+	resourceAttr.InsertString("b", "x")
+	k2, attrs := buildDimensions(dimensions, nil, logAttr, resourceAttr)
+	assert.Equal(t, map[string]interface{}{"b": "x"}, attrs.AsRaw())
+	assert.NotEqual(t, k1, k2)
+
+	// Capture groups take precedence over the attributes.
+	_, attrs = buildDimensions(dimensions, map[string]string{"b": "captured"}, logAttr, resourceAttr)
+	assert.Equal(t, map[string]interface{}{"b": "captured"}, attrs.AsRaw())
+}
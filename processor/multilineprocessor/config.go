@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multilineprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/multilineprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/config"
+)
+
+// Config defines configuration for the multiline processor.
+type Config struct {
+	config.ProcessorSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+
+	// Presets are the languages whose stack traces are grouped: "java", "python", "go" and "dotnet".
+	// All of them are used if none is configured.
+	Presets []string `mapstructure:"presets"`
+
+	// StreamAttributes are the log record attributes which, along with the resource, identify a
+	// stream of lines, e.g. the file the lines were read from.
+	StreamAttributes []string `mapstructure:"stream_attributes"`
+
+	// FlushTimeout is the time after which a stack trace is sent when no new line of its stream was received.
+	FlushTimeout time.Duration `mapstructure:"flush_timeout"`
+
+	// MaxLines is the maximum number of lines of a stack trace, after which it is sent.
+	MaxLines int `mapstructure:"max_lines"`
+
+	// ParseJSON parses the string bodies holding a JSON object into map bodies.
+	ParseJSON bool `mapstructure:"parse_json"`
+}
+
+var _ config.Processor = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid.
+func (c *Config) Validate() error {
+	for _, preset := range c.Presets {
+		if _, ok := presets[preset]; !ok {
+			return fmt.Errorf("unknown preset %q, must be one of %v", preset, presetNames())
+		}
+	}
+	if c.FlushTimeout <= 0 {
+		return errors.New("\"flush_timeout\" must be positive")
+	}
+	if c.MaxLines < 1 {
+		return errors.New("\"max_lines\" must be at least 1")
+	}
+	return nil
+}
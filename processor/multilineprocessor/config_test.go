@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multilineprocessor
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configtest"
+)
+
+func TestLoadConfig(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	require.NoError(t, err)
+
+	factories.Processors[typeStr] = NewFactory()
+
+	cfg, err := configtest.LoadConfigAndValidate(path.Join(".", "testdata", "config.yaml"), factories)
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	assert.Equal(t, createDefaultConfig(), cfg.Processors[config.NewComponentID(typeStr)])
+
+	assert.Equal(t, &Config{
+		ProcessorSettings: config.NewProcessorSettings(config.NewComponentIDWithName(typeStr, "custom")),
+		Presets:           []string{"java", "python"},
+		StreamAttributes:  []string{"log.file.path", "container.id"},
+		FlushTimeout:      5 * time.Second,
+		MaxLines:          200,
+		ParseJSON:         true,
+	}, cfg.Processors[config.NewComponentIDWithName(typeStr, "custom")])
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(cfg *Config)
+		wantErr string
+	}{
+		{
+			name:   "default",
+			modify: func(cfg *Config) {},
+		},
+		{
+			name:   "some presets",
+			modify: func(cfg *Config) { cfg.Presets = []string{"go", "python"} },
+		},
+		{
+			name:    "unknown preset",
+			modify:  func(cfg *Config) { cfg.Presets = []string{"java", "ruby"} },
+			wantErr: `unknown preset "ruby", must be one of [dotnet go java python]`,
+		},
+		{
+			name:    "invalid flush timeout",
+			modify:  func(cfg *Config) { cfg.FlushTimeout = 0 },
+			wantErr: `"flush_timeout" must be positive`,
+		},
+		{
+			name:    "invalid max lines",
+			modify:  func(cfg *Config) { cfg.MaxLines = 0 },
+			wantErr: `"max_lines" must be at least 1`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(cfg)
+
+			err := cfg.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
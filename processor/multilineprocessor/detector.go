@@ -0,0 +1,167 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multilineprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/multilineprocessor"
+
+import (
+	"regexp"
+	"sort"
+)
+
+// The stack traces are detected by a state machine whose transitions are triggered by the lines
+// matching a rule of the current state, the same way as the exception detector of
+// https://github.com/GoogleCloudPlatform/fluent-plugin-detect-exceptions.
+
+const (
+	presetJava   = "java"
+	presetPython = "python"
+	presetGo     = "go"
+	presetDotnet = "dotnet"
+
+	startState = "start_state"
+)
+
+type rule struct {
+	from    []string
+	pattern *regexp.Regexp
+	to      string
+}
+
+func newRule(from []string, pattern string, to string) rule {
+	return rule{from: from, pattern: regexp.MustCompile(pattern), to: to}
+}
+
+// The rules of java and dotnet share their states, a line like "   at ..." being a frame of both.
+var presets = map[string][]rule{
+	presetJava: {
+		newRule([]string{startState, "java_start_exception"}, `(?:Exception|Error|Throwable|V8 errors stack trace)(?:[:\r\n]|$)`, "after_exception"),
+		newRule([]string{"after_exception"}, `^[\t ]*nested exception is:[\t ]*`, "java_start_exception"),
+		newRule([]string{"after_exception"}, `^[\r\n]*$`, "after_exception"),
+		newRule([]string{"after_exception", "frames"}, `^[\t ]+(?:eval )?at `, "frames"),
+		newRule([]string{"after_exception", "frames"}, `^[\t ]*(?:Caused by|Suppressed):`, "after_exception"),
+		newRule([]string{"after_exception", "frames"}, `^[\t ]*\.\.\. \d+ (?:more|common frames omitted)`, "frames"),
+	},
+	presetPython: {
+		newRule([]string{startState}, `^Traceback \(most recent call last\):$`, "python"),
+		newRule([]string{"python"}, `^[\t ]+File `, "python_code"),
+		newRule([]string{"python_code"}, `[^\t ]`, "python"),
+		newRule([]string{"python"}, `^(?:[^\s.():]+\.)*[^\s.():]+:`, startState),
+	},
+	presetGo: {
+		newRule([]string{startState}, `\bpanic: `, "go_after_panic"),
+		newRule([]string{startState}, `http: panic serving`, "go_goroutine"),
+		newRule([]string{"go_after_panic", "go_after_signal", "go_frame_1"}, `^$`, "go_goroutine"),
+		newRule([]string{"go_after_panic"}, `^\[signal `, "go_after_signal"),
+		newRule([]string{"go_goroutine"}, `^goroutine \d+ \[[^\]]+\]:$`, "go_frame_1"),
+		newRule([]string{"go_frame_1"}, `^(?:[^\s.:]+\.)*[^\s.():]+\(|^created by `, "go_frame_2"),
+		newRule([]string{"go_frame_2"}, `^\s`, "go_frame_1"),
+	},
+	presetDotnet: {
+		newRule([]string{startState}, `(?:Exception|Error)(?::|$)`, "after_exception"),
+		newRule([]string{"after_exception", "frames"}, `^[\t ]+at `, "frames"),
+		newRule([]string{"after_exception", "frames"}, `^[\t ]*---> `, "after_exception"),
+		newRule([]string{"after_exception", "frames"}, `^[\t ]+--- End of inner exception stack trace ---$`, "frames"),
+		newRule([]string{"after_exception", "frames"}, `^--- End of stack trace from previous location(?: where exception was thrown)? ---$`, "frames"),
+	},
+}
+
+func presetNames() []string {
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// detection is the position of a line in a stack trace.
+type detection int
+
+const (
+	noTrace detection = iota
+	startTrace
+	insideTrace
+	endTrace
+)
+
+// ruleSet holds the rules of the presets by state they apply to.
+type ruleSet map[string][]rule
+
+// newRuleSet returns the rules of the presets, or of all the presets if none is given. It is
+// built once and shared by the detectors of all the streams.
+func newRuleSet(names []string) ruleSet {
+	if len(names) == 0 {
+		names = presetNames()
+	}
+	rules := make(ruleSet)
+	for _, name := range names {
+		for _, r := range presets[name] {
+			for _, from := range r.from {
+				rules[from] = append(rules[from], r)
+			}
+		}
+	}
+	return rules
+}
+
+// detector detects the stack traces in a stream of lines.
+type detector struct {
+	rules ruleSet
+	state string
+}
+
+func newDetector(rules ruleSet) *detector {
+	return &detector{rules: rules, state: startState}
+}
+
+// update moves the state machine with the line and returns the position of the line in a stack trace.
+func (d *detector) update(line string) detection {
+	inTrace := d.state != startState
+	matched := d.transition(line)
+	// If the state machine fell back to the start state because there is no transition for the
+	// line, the line may be the beginning of another stack trace.
+	if !matched {
+		d.transition(line)
+	}
+	traceSeenBefore := inTrace && matched
+	traceSeenAfter := d.state != startState
+
+	switch {
+	case traceSeenBefore && traceSeenAfter:
+		return insideTrace
+	case traceSeenBefore:
+		return endTrace
+	case traceSeenAfter:
+		return startTrace
+	default:
+		return noTrace
+	}
+}
+
+// transition moves to the state of the first matching rule of the current state, or back to the
+// start state if none matches. It returns whether a rule matched.
+func (d *detector) transition(line string) bool {
+	for _, r := range d.rules[d.state] {
+		if r.pattern.MatchString(line) {
+			d.state = r.to
+			return true
+		}
+	}
+	d.state = startState
+	return false
+}
+
+func (d *detector) reset() {
+	d.state = startState
+}
@@ -0,0 +1,129 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multilineprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetector(t *testing.T) {
+	tests := []struct {
+		name    string
+		presets []string
+		lines   []string
+		want    []detection
+	}{
+		{
+			name:    "java",
+			presets: []string{presetJava},
+			lines: []string{
+				"starting",
+				"java.lang.IllegalStateException: failed to start",
+				"\tat com.example.App.start(App.java:21)",
+				"\tat com.example.App.main(App.java:10)",
+				"Caused by: java.io.IOException: permission denied",
+				"\tat com.example.Config.load(Config.java:42)",
+				"\t... 2 more",
+				"stopped",
+			},
+			want: []detection{noTrace, startTrace, insideTrace, insideTrace, insideTrace, insideTrace, insideTrace, noTrace},
+		},
+		{
+			name:    "python",
+			presets: []string{presetPython},
+			lines: []string{
+				"Traceback (most recent call last):",
+				`  File "app.py", line 3, in <module>`,
+				"    main()",
+				`  File "app.py", line 2, in main`,
+				"    raise ValueError('bad value')",
+				"ValueError: bad value",
+				"done",
+			},
+			want: []detection{startTrace, insideTrace, insideTrace, insideTrace, insideTrace, endTrace, noTrace},
+		},
+		{
+			name:    "go",
+			presets: []string{presetGo},
+			lines: []string{
+				"panic: runtime error: index out of range [1] with length 1",
+				"",
+				"goroutine 1 [running]:",
+				"main.main()",
+				"\t/app/main.go:5 +0x1d",
+				"exit status 2",
+			},
+			want: []detection{startTrace, insideTrace, insideTrace, insideTrace, insideTrace, noTrace},
+		},
+		{
+			name:    "dotnet",
+			presets: []string{presetDotnet},
+			lines: []string{
+				"System.InvalidOperationException: failed to start",
+				" ---> System.IO.IOException: permission denied",
+				"   at Example.Config.Load() in /app/Config.cs:line 42",
+				"   --- End of inner exception stack trace ---",
+				"   at Example.App.Main() in /app/App.cs:line 10",
+				"stopped",
+			},
+			want: []detection{startTrace, insideTrace, insideTrace, insideTrace, insideTrace, noTrace},
+		},
+		{
+			name:    "disabled preset",
+			presets: []string{presetJava},
+			lines: []string{
+				"Traceback (most recent call last):",
+				`  File "app.py", line 3, in <module>`,
+			},
+			want: []detection{noTrace, noTrace},
+		},
+		{
+			name: "all presets",
+			lines: []string{
+				"panic: boom",
+				"",
+				"goroutine 1 [running]:",
+			},
+			want: []detection{startTrace, insideTrace, insideTrace},
+		},
+		{
+			name:    "consecutive traces",
+			presets: []string{presetJava, presetPython},
+			lines: []string{
+				"java.lang.NullPointerException",
+				"\tat com.example.App.main(App.java:10)",
+				"Traceback (most recent call last):",
+				`  File "app.py", line 3, in <module>`,
+			},
+			want: []detection{startTrace, insideTrace, startTrace, insideTrace},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newDetector(newRuleSet(tt.presets))
+			got := make([]detection, 0, len(tt.lines))
+			for _, line := range tt.lines {
+				got = append(got, d.update(line))
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPresetNames(t *testing.T) {
+	assert.Equal(t, []string{"dotnet", "go", "java", "python"}, presetNames())
+}
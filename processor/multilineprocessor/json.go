@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multilineprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/multilineprocessor"
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// parseJSONBody parses a body holding a JSON object into a map body.
+func parseJSONBody(body string) (pdata.AttributeValue, bool) {
+	if !strings.HasPrefix(strings.TrimSpace(body), "{") {
+		return pdata.AttributeValue{}, false
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+	var object map[string]interface{}
+	if err := decoder.Decode(&object); err != nil {
+		return pdata.AttributeValue{}, false
+	}
+	// The body must not hold anything after the object.
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return pdata.AttributeValue{}, false
+	}
+
+	value := pdata.NewAttributeValueMap()
+	insertJSONObject(object, value.MapVal())
+	return value, true
+}
+
+func insertJSONObject(object map[string]interface{}, dest pdata.AttributeMap) {
+	dest.EnsureCapacity(len(object))
+	for k, v := range object {
+		value := pdata.NewAttributeValueEmpty()
+		setJSONValue(v, value)
+		dest.Insert(k, value)
+	}
+}
+
+func setJSONValue(v interface{}, dest pdata.AttributeValue) {
+	switch t := v.(type) {
+	case bool:
+		dest.SetBoolVal(t)
+	case string:
+		dest.SetStringVal(t)
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			dest.SetIntVal(i)
+		} else if f, err := t.Float64(); err == nil {
+			dest.SetDoubleVal(f)
+		} else {
+			dest.SetStringVal(t.String())
+		}
+	case map[string]interface{}:
+		value := pdata.NewAttributeValueMap()
+		insertJSONObject(t, value.MapVal())
+		value.CopyTo(dest)
+	case []interface{}:
+		value := pdata.NewAttributeValueArray()
+		values := value.SliceVal()
+		values.EnsureCapacity(len(t))
+		for _, e := range t {
+			setJSONValue(e, values.AppendEmpty())
+		}
+		value.CopyTo(dest)
+	}
+	// JSON null is left as an empty value.
+}
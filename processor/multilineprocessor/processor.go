@@ -0,0 +1,260 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multilineprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/multilineprocessor"
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/logsutil"
+)
+
+type multilineProcessor struct {
+	logger       *zap.Logger
+	config       *Config
+	nextConsumer consumer.Logs
+
+	rules ruleSet
+
+	// mu guards the streams.
+	mu      sync.Mutex
+	streams map[string]*stream
+	now     func() time.Time
+
+	done     chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+var _ component.LogsProcessor = (*multilineProcessor)(nil)
+
+func newMultilineProcessor(logger *zap.Logger, cfg *Config, nextConsumer consumer.Logs) *multilineProcessor {
+	return &multilineProcessor{
+		logger:       logger,
+		config:       cfg,
+		nextConsumer: nextConsumer,
+		rules:        newRuleSet(cfg.Presets),
+		streams:      make(map[string]*stream),
+		now:          time.Now,
+		done:         make(chan struct{}),
+	}
+}
+
+func (p *multilineProcessor) Start(context.Context, component.Host) error {
+	p.wg.Add(1)
+	go p.flushLoop()
+	return nil
+}
+
+func (p *multilineProcessor) Shutdown(ctx context.Context) error {
+	p.stopOnce.Do(func() { close(p.done) })
+	p.wg.Wait()
+
+	p.mu.Lock()
+	out := logsutil.NewBuilder()
+	for key, s := range p.streams {
+		s.flush(out)
+		delete(p.streams, key)
+	}
+	p.mu.Unlock()
+
+	return p.send(ctx, out.Logs())
+}
+
+func (p *multilineProcessor) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+func (p *multilineProcessor) ConsumeLogs(ctx context.Context, ld pdata.Logs) error {
+	out := logsutil.NewBuilder()
+
+	p.mu.Lock()
+	now := p.now()
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		resource := rl.Resource()
+		resourceKey := logsutil.AttributesKey(resource.Attributes())
+		ills := rl.InstrumentationLibraryLogs()
+		for j := 0; j < ills.Len(); j++ {
+			ill := ills.At(j)
+			library := ill.InstrumentationLibrary()
+			logs := ill.Logs()
+			for k := 0; k < logs.Len(); k++ {
+				p.consumeLogRecord(out, resource, resourceKey, library, logs.At(k), now)
+			}
+		}
+	}
+	p.mu.Unlock()
+
+	return p.send(ctx, out.Logs())
+}
+
+// consumeLogRecord adds the log record to its stream, and appends to out the log records that are
+// not part of a stack trace and the stack traces that are complete.
+func (p *multilineProcessor) consumeLogRecord(out *logsutil.Builder, resource pdata.Resource, resourceKey string, library pdata.InstrumentationLibrary, lr pdata.LogRecord, now time.Time) {
+	key := p.streamKey(resourceKey, lr)
+	s, ok := p.streams[key]
+	if !ok {
+		s = &stream{detector: newDetector(p.rules)}
+		p.streams[key] = s
+	}
+	s.lastUpdate = now
+
+	if lr.Body().Type() != pdata.AttributeValueTypeString {
+		s.flush(out)
+		out.Append(resource, library, lr)
+		delete(p.streams, key)
+		return
+	}
+	line := lr.Body().StringVal()
+
+	if p.config.ParseJSON {
+		if body, ok := parseJSONBody(line); ok {
+			s.flush(out)
+			body.CopyTo(out.Append(resource, library, lr).Body())
+			delete(p.streams, key)
+			return
+		}
+	}
+
+	switch s.detector.update(line) {
+	case noTrace:
+		s.flush(out)
+		out.Append(resource, library, lr)
+	case startTrace:
+		s.flush(out)
+		s.start(resource, library, lr, line)
+	case insideTrace:
+		s.add(resource, library, lr, line)
+	case endTrace:
+		s.add(resource, library, lr, line)
+		s.flush(out)
+	}
+
+	if len(s.lines) >= p.config.MaxLines {
+		s.flush(out)
+	}
+	if len(s.lines) == 0 {
+		delete(p.streams, key)
+	}
+}
+
+// streamKey identifies the stream of the log record by its resource and stream attributes.
+func (p *multilineProcessor) streamKey(resourceKey string, lr pdata.LogRecord) string {
+	var b strings.Builder
+	b.WriteString(resourceKey)
+	for _, name := range p.config.StreamAttributes {
+		if v, ok := lr.Attributes().Get(name); ok {
+			logsutil.WriteAttributeValue(&b, v)
+		}
+		b.WriteByte(0)
+	}
+	return b.String()
+}
+
+func (p *multilineProcessor) flushLoop() {
+	defer p.wg.Done()
+
+	interval := p.config.FlushTimeout / 2
+	if interval <= 0 {
+		interval = p.config.FlushTimeout
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := p.flushExpired(context.Background()); err != nil {
+				p.logger.Error("failed to send the stack traces", zap.Error(err))
+			}
+		case <-p.done:
+			return
+		}
+	}
+}
+
+// flushExpired sends the stack traces of the streams which received no line for the flush timeout.
+func (p *multilineProcessor) flushExpired(ctx context.Context) error {
+	out := logsutil.NewBuilder()
+
+	p.mu.Lock()
+	expiration := p.now().Add(-p.config.FlushTimeout)
+	for key, s := range p.streams {
+		if s.lastUpdate.After(expiration) {
+			continue
+		}
+		s.flush(out)
+		delete(p.streams, key)
+	}
+	p.mu.Unlock()
+
+	return p.send(ctx, out.Logs())
+}
+
+func (p *multilineProcessor) send(ctx context.Context, ld pdata.Logs) error {
+	if ld.ResourceLogs().Len() == 0 {
+		return nil
+	}
+	return p.nextConsumer.ConsumeLogs(ctx, ld)
+}
+
+// stream holds the stack trace being received from a stream of lines.
+type stream struct {
+	detector   *detector
+	lastUpdate time.Time
+
+	// resource, library and record are copied from the first log record of the stack trace.
+	resource pdata.Resource
+	library  pdata.InstrumentationLibrary
+	record   pdata.LogRecord
+	lines    []string
+}
+
+func (s *stream) start(resource pdata.Resource, library pdata.InstrumentationLibrary, lr pdata.LogRecord, line string) {
+	s.resource = pdata.NewResource()
+	s.library = pdata.NewInstrumentationLibrary()
+	s.record = pdata.NewLogRecord()
+	resource.CopyTo(s.resource)
+	library.CopyTo(s.library)
+	lr.CopyTo(s.record)
+	s.lines = []string{line}
+}
+
+func (s *stream) add(resource pdata.Resource, library pdata.InstrumentationLibrary, lr pdata.LogRecord, line string) {
+	if len(s.lines) == 0 {
+		s.start(resource, library, lr, line)
+		return
+	}
+	s.lines = append(s.lines, line)
+}
+
+// flush appends the stack trace to out, as a copy of its first log record with the lines as body.
+func (s *stream) flush(out *logsutil.Builder) {
+	if len(s.lines) == 0 {
+		return
+	}
+	lr := out.Append(s.resource, s.library, s.record)
+	lr.Body().SetStringVal(strings.Join(s.lines, "\n"))
+	s.lines = nil
+	s.detector.reset()
+}
@@ -0,0 +1,285 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multilineprocessor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+)
+
+var javaTrace = []string{
+	"java.lang.IllegalStateException: failed to start",
+	"\tat com.example.App.start(App.java:21)",
+	"\tat com.example.App.main(App.java:10)",
+}
+
+func newTestLogs(attributes map[string]string, bodies ...string) pdata.Logs {
+	ld := pdata.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().InsertString("service.name", "app")
+	ill := rl.InstrumentationLibraryLogs().AppendEmpty()
+	ill.InstrumentationLibrary().SetName("lib")
+	for i, body := range bodies {
+		lr := ill.Logs().AppendEmpty()
+		lr.Body().SetStringVal(body)
+		lr.SetTimestamp(pdata.Timestamp(i + 1))
+		for k, v := range attributes {
+			lr.Attributes().InsertString(k, v)
+		}
+	}
+	return ld
+}
+
+func logRecords(sink *consumertest.LogsSink) []pdata.LogRecord {
+	var records []pdata.LogRecord
+	for _, ld := range sink.AllLogs() {
+		rls := ld.ResourceLogs()
+		for i := 0; i < rls.Len(); i++ {
+			ills := rls.At(i).InstrumentationLibraryLogs()
+			for j := 0; j < ills.Len(); j++ {
+				logs := ills.At(j).Logs()
+				for k := 0; k < logs.Len(); k++ {
+					records = append(records, logs.At(k))
+				}
+			}
+		}
+	}
+	return records
+}
+
+func bodies(records []pdata.LogRecord) []string {
+	result := make([]string, 0, len(records))
+	for _, lr := range records {
+		result = append(result, lr.Body().AsString())
+	}
+	return result
+}
+
+func TestProcessorGroupsStackTrace(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	p := newMultilineProcessor(zap.NewNop(), createDefaultConfig().(*Config), sink)
+
+	lines := append([]string{"starting"}, javaTrace...)
+	lines = append(lines, "stopped")
+	require.NoError(t, p.ConsumeLogs(context.Background(), newTestLogs(nil, lines...)))
+
+	records := logRecords(sink)
+	assert.Equal(t, []string{
+		"starting",
+		"java.lang.IllegalStateException: failed to start\n\tat com.example.App.start(App.java:21)\n\tat com.example.App.main(App.java:10)",
+		"stopped",
+	}, bodies(records))
+	// The stack trace keeps the fields of its first line.
+	assert.Equal(t, pdata.Timestamp(2), records[1].Timestamp())
+	assert.Empty(t, p.streams)
+}
+
+func TestProcessorAcrossBatches(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	p := newMultilineProcessor(zap.NewNop(), createDefaultConfig().(*Config), sink)
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newTestLogs(nil, javaTrace[0])))
+	require.NoError(t, p.ConsumeLogs(context.Background(), newTestLogs(nil, javaTrace[1:]...)))
+	assert.Equal(t, 0, sink.LogRecordCount())
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newTestLogs(nil, "stopped")))
+	assert.Equal(t, []string{
+		"java.lang.IllegalStateException: failed to start\n\tat com.example.App.start(App.java:21)\n\tat com.example.App.main(App.java:10)",
+		"stopped",
+	}, bodies(logRecords(sink)))
+}
+
+func TestProcessorStreams(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	p := newMultilineProcessor(zap.NewNop(), createDefaultConfig().(*Config), sink)
+
+	ld := newTestLogs(map[string]string{"log.file.name": "a.log"}, javaTrace[0], javaTrace[1])
+	newTestLogs(map[string]string{"log.file.name": "b.log"}, "request served").ResourceLogs().MoveAndAppendTo(ld.ResourceLogs())
+	newTestLogs(map[string]string{"log.file.name": "a.log"}, javaTrace[2], "stopped").ResourceLogs().MoveAndAppendTo(ld.ResourceLogs())
+	require.NoError(t, p.ConsumeLogs(context.Background(), ld))
+
+	assert.Equal(t, []string{
+		"request served",
+		"java.lang.IllegalStateException: failed to start\n\tat com.example.App.start(App.java:21)\n\tat com.example.App.main(App.java:10)",
+		"stopped",
+	}, bodies(logRecords(sink)))
+}
+
+func TestProcessorMaxLines(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.MaxLines = 2
+	sink := new(consumertest.LogsSink)
+	p := newMultilineProcessor(zap.NewNop(), cfg, sink)
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newTestLogs(nil, javaTrace...)))
+
+	assert.Equal(t, []string{
+		"java.lang.IllegalStateException: failed to start\n\tat com.example.App.start(App.java:21)",
+		"\tat com.example.App.main(App.java:10)",
+	}, bodies(logRecords(sink)))
+	assert.Empty(t, p.streams)
+}
+
+func TestProcessorFlushTimeout(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.FlushTimeout = 10 * time.Millisecond
+	sink := new(consumertest.LogsSink)
+	p := newMultilineProcessor(zap.NewNop(), cfg, sink)
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newTestLogs(nil, javaTrace...)))
+
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 1
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{
+		"java.lang.IllegalStateException: failed to start\n\tat com.example.App.start(App.java:21)\n\tat com.example.App.main(App.java:10)",
+	}, bodies(logRecords(sink)))
+
+	require.NoError(t, p.Shutdown(context.Background()))
+	assert.Equal(t, 1, sink.LogRecordCount())
+}
+
+func TestProcessorFlushExpired(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	sink := new(consumertest.LogsSink)
+	p := newMultilineProcessor(zap.NewNop(), cfg, sink)
+	now := time.Date(2021, 12, 10, 10, 0, 0, 0, time.UTC)
+	var mu sync.Mutex
+	p.now = func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newTestLogs(map[string]string{"log.file.name": "a.log"}, javaTrace...)))
+	mu.Lock()
+	now = now.Add(cfg.FlushTimeout / 2)
+	mu.Unlock()
+	require.NoError(t, p.ConsumeLogs(context.Background(), newTestLogs(map[string]string{"log.file.name": "b.log"}, javaTrace...)))
+
+	require.NoError(t, p.flushExpired(context.Background()))
+	assert.Equal(t, 0, sink.LogRecordCount())
+
+	mu.Lock()
+	now = now.Add(cfg.FlushTimeout / 2)
+	mu.Unlock()
+	require.NoError(t, p.flushExpired(context.Background()))
+	records := logRecords(sink)
+	require.Len(t, records, 1)
+	file, _ := records[0].Attributes().Get("log.file.name")
+	assert.Equal(t, "a.log", file.StringVal())
+	assert.Len(t, p.streams, 1)
+}
+
+func TestProcessorShutdownFlushes(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	p := newMultilineProcessor(zap.NewNop(), createDefaultConfig().(*Config), sink)
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newTestLogs(nil, javaTrace...)))
+	assert.Equal(t, 0, sink.LogRecordCount())
+
+	require.NoError(t, p.Shutdown(context.Background()))
+	assert.Equal(t, 1, sink.LogRecordCount())
+
+	// Shutting down again is a no-op.
+	require.NoError(t, p.Shutdown(context.Background()))
+	assert.Equal(t, 1, sink.LogRecordCount())
+}
+
+func TestProcessorShutdownError(t *testing.T) {
+	p := newMultilineProcessor(zap.NewNop(), createDefaultConfig().(*Config), consumertest.NewErr(errors.New("consumer failed")))
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newTestLogs(nil, javaTrace...)))
+	assert.EqualError(t, p.Shutdown(context.Background()), "consumer failed")
+}
+
+func TestProcessorNonStringBody(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	p := newMultilineProcessor(zap.NewNop(), createDefaultConfig().(*Config), sink)
+
+	ld := newTestLogs(nil, javaTrace[0], "")
+	ld.ResourceLogs().At(0).InstrumentationLibraryLogs().At(0).Logs().At(1).Body().SetIntVal(42)
+	require.NoError(t, p.ConsumeLogs(context.Background(), ld))
+
+	assert.Equal(t, []string{"java.lang.IllegalStateException: failed to start", "42"}, bodies(logRecords(sink)))
+	assert.Empty(t, p.streams)
+}
+
+func TestProcessorParseJSON(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.ParseJSON = true
+	sink := new(consumertest.LogsSink)
+	p := newMultilineProcessor(zap.NewNop(), cfg, sink)
+
+	ld := newTestLogs(nil, `{"message":"failed","status":500,"ratio":0.5,"tags":["a",1],"user":{"admin":true},"trace":null}`, `{"message":`, "not json")
+	require.NoError(t, p.ConsumeLogs(context.Background(), ld))
+
+	records := logRecords(sink)
+	require.Len(t, records, 3)
+	body := records[0].Body()
+	require.Equal(t, pdata.AttributeValueTypeMap, body.Type())
+	expected := pdata.NewAttributeMap()
+	expected.InsertString("message", "failed")
+	expected.InsertInt("status", 500)
+	expected.InsertDouble("ratio", 0.5)
+	tags := pdata.NewAttributeValueArray()
+	tags.SliceVal().AppendEmpty().SetStringVal("a")
+	tags.SliceVal().AppendEmpty().SetIntVal(1)
+	expected.Insert("tags", tags)
+	user := pdata.NewAttributeValueMap()
+	user.MapVal().InsertBool("admin", true)
+	expected.Insert("user", user)
+	expected.InsertNull("trace")
+	assert.Equal(t, expected.Sort(), body.MapVal().Sort())
+
+	assert.Equal(t, []string{`{"message":`, "not json"}, bodies(records[1:]))
+
+	// The input is left unchanged.
+	assert.Equal(t, pdata.AttributeValueTypeString, ld.ResourceLogs().At(0).InstrumentationLibraryLogs().At(0).Logs().At(0).Body().Type())
+}
+
+func TestParseJSONBody(t *testing.T) {
+	tests := []struct {
+		body string
+		ok   bool
+	}{
+		{body: `{"a":1}`, ok: true},
+		{body: ` {"a":1} `, ok: true},
+		{body: `[1,2]`},
+		{body: `"a"`},
+		{body: `{"a":1} trailing`},
+		{body: `{"a":1}{"b":2}`},
+		{body: `{"a":`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.body, func(t *testing.T) {
+			_, ok := parseJSONBody(tt.body)
+			assert.Equal(t, tt.ok, ok)
+		})
+	}
+}
@@ -41,7 +41,15 @@ func (m *metricImpl) Init(metric pdata.Metric) {
 }
 
 type metricStruct struct {
+	ApacheConnectionsAsync   MetricIntf
+	ApacheCPULoad            MetricIntf
+	ApacheCPUTime            MetricIntf
 	ApacheCurrentConnections MetricIntf
+	ApacheLoad1              MetricIntf
+	ApacheLoad15             MetricIntf
+	ApacheLoad5              MetricIntf
+	ApacheRequestSize        MetricIntf
+	ApacheRequestTime        MetricIntf
 	ApacheRequests           MetricIntf
 	ApacheScoreboard         MetricIntf
 	ApacheTraffic            MetricIntf
@@ -52,7 +60,15 @@ type metricStruct struct {
 // Names returns a list of all the metric name strings.
 func (m *metricStruct) Names() []string {
 	return []string{
+		"apache.connections.async",
+		"apache.cpu.load",
+		"apache.cpu.time",
 		"apache.current_connections",
+		"apache.load.1",
+		"apache.load.15",
+		"apache.load.5",
+		"apache.request.size",
+		"apache.request.time",
 		"apache.requests",
 		"apache.scoreboard",
 		"apache.traffic",
@@ -62,7 +78,15 @@ func (m *metricStruct) Names() []string {
 }
 
 var metricsByName = map[string]MetricIntf{
+	"apache.connections.async":   Metrics.ApacheConnectionsAsync,
+	"apache.cpu.load":            Metrics.ApacheCPULoad,
+	"apache.cpu.time":            Metrics.ApacheCPUTime,
 	"apache.current_connections": Metrics.ApacheCurrentConnections,
+	"apache.load.1":              Metrics.ApacheLoad1,
+	"apache.load.15":             Metrics.ApacheLoad15,
+	"apache.load.5":              Metrics.ApacheLoad5,
+	"apache.request.size":        Metrics.ApacheRequestSize,
+	"apache.request.time":        Metrics.ApacheRequestTime,
 	"apache.requests":            Metrics.ApacheRequests,
 	"apache.scoreboard":          Metrics.ApacheScoreboard,
 	"apache.traffic":             Metrics.ApacheTraffic,
@@ -77,6 +101,37 @@ func (m *metricStruct) ByName(n string) MetricIntf {
 // Metrics contains a set of methods for each metric that help with
 // manipulating those metrics.
 var Metrics = &metricStruct{
+	&metricImpl{
+		"apache.connections.async",
+		func(metric pdata.Metric) {
+			metric.SetName("apache.connections.async")
+			metric.SetDescription("The number of asynchronous connections by state. Only reported by the event MPM.")
+			metric.SetUnit("connections")
+			metric.SetDataType(pdata.MetricDataTypeSum)
+			metric.Sum().SetIsMonotonic(false)
+			metric.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+		},
+	},
+	&metricImpl{
+		"apache.cpu.load",
+		func(metric pdata.Metric) {
+			metric.SetName("apache.cpu.load")
+			metric.SetDescription("The average CPU usage of the server since it started.")
+			metric.SetUnit("%")
+			metric.SetDataType(pdata.MetricDataTypeGauge)
+		},
+	},
+	&metricImpl{
+		"apache.cpu.time",
+		func(metric pdata.Metric) {
+			metric.SetName("apache.cpu.time")
+			metric.SetDescription("The CPU time used by the server processes and by their children, in user and system mode.")
+			metric.SetUnit("s")
+			metric.SetDataType(pdata.MetricDataTypeSum)
+			metric.Sum().SetIsMonotonic(true)
+			metric.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+		},
+	},
 	&metricImpl{
 		"apache.current_connections",
 		func(metric pdata.Metric) {
@@ -88,6 +143,53 @@ var Metrics = &metricStruct{
 			metric.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
 		},
 	},
+	&metricImpl{
+		"apache.load.1",
+		func(metric pdata.Metric) {
+			metric.SetName("apache.load.1")
+			metric.SetDescription("The system load average during the last minute.")
+			metric.SetUnit("1")
+			metric.SetDataType(pdata.MetricDataTypeGauge)
+		},
+	},
+	&metricImpl{
+		"apache.load.15",
+		func(metric pdata.Metric) {
+			metric.SetName("apache.load.15")
+			metric.SetDescription("The system load average during the last 15 minutes.")
+			metric.SetUnit("1")
+			metric.SetDataType(pdata.MetricDataTypeGauge)
+		},
+	},
+	&metricImpl{
+		"apache.load.5",
+		func(metric pdata.Metric) {
+			metric.SetName("apache.load.5")
+			metric.SetDescription("The system load average during the last 5 minutes.")
+			metric.SetUnit("1")
+			metric.SetDataType(pdata.MetricDataTypeGauge)
+		},
+	},
+	&metricImpl{
+		"apache.request.size",
+		func(metric pdata.Metric) {
+			metric.SetName("apache.request.size")
+			metric.SetDescription("The average number of bytes sent per request since the server started.")
+			metric.SetUnit("By")
+			metric.SetDataType(pdata.MetricDataTypeGauge)
+		},
+	},
+	&metricImpl{
+		"apache.request.time",
+		func(metric pdata.Metric) {
+			metric.SetName("apache.request.time")
+			metric.SetDescription("Total time spent on handling requests.")
+			metric.SetUnit("ms")
+			metric.SetDataType(pdata.MetricDataTypeSum)
+			metric.Sum().SetIsMonotonic(true)
+			metric.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+		},
+	},
 	&metricImpl{
 		"apache.requests",
 		func(metric pdata.Metric) {
@@ -151,6 +253,12 @@ var M = Metrics
 
 // Attributes contains the possible metric attributes that can be used.
 var Attributes = struct {
+	// ConnectionState (The state of an asynchronous connection.)
+	ConnectionState string
+	// CPULevel (Whether the CPU time is used by the server processes or by their children.)
+	CPULevel string
+	// CPUMode (The CPU mode.)
+	CPUMode string
 	// ScoreboardState (The state of a connection.)
 	ScoreboardState string
 	// ServerName (The name of the Apache HTTP server.)
@@ -158,6 +266,9 @@ var Attributes = struct {
 	// WorkersState (The state of workers.)
 	WorkersState string
 }{
+	"state",
+	"level",
+	"mode",
 	"state",
 	"server_name",
 	"state",
@@ -166,6 +277,35 @@ var Attributes = struct {
 // A is an alias for Attributes.
 var A = Attributes
 
+// AttributeConnectionState are the possible values that the attribute "connection_state" can have.
+var AttributeConnectionState = struct {
+	Writing   string
+	Keepalive string
+	Closing   string
+}{
+	"writing",
+	"keepalive",
+	"closing",
+}
+
+// AttributeCPULevel are the possible values that the attribute "cpu_level" can have.
+var AttributeCPULevel = struct {
+	Self     string
+	Children string
+}{
+	"self",
+	"children",
+}
+
+// AttributeCPUMode are the possible values that the attribute "cpu_mode" can have.
+var AttributeCPUMode = struct {
+	System string
+	User   string
+}{
+	"system",
+	"user",
+}
+
 // AttributeScoreboardState are the possible values that the attribute "scoreboard_state" can have.
 var AttributeScoreboardState = struct {
 	Open        string
@@ -179,6 +319,7 @@ var AttributeScoreboardState = struct {
 	Logging     string
 	Finishing   string
 	IdleCleanup string
+	Unknown     string
 }{
 	"open",
 	"waiting",
@@ -191,6 +332,7 @@ var AttributeScoreboardState = struct {
 	"logging",
 	"finishing",
 	"idle_cleanup",
+	"unknown",
 }
 
 // AttributeWorkersState are the possible values that the attribute "workers_state" can have.
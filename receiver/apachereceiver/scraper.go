@@ -70,6 +70,15 @@ func addToIntMetric(metric pdata.NumberDataPointSlice, labels pdata.AttributeMap
 	}
 }
 
+func addToDoubleMetric(metric pdata.NumberDataPointSlice, labels pdata.AttributeMap, value float64, ts pdata.Timestamp) {
+	dataPoint := metric.AppendEmpty()
+	dataPoint.SetTimestamp(ts)
+	dataPoint.SetDoubleVal(value)
+	if labels.Len() > 0 {
+		labels.CopyTo(dataPoint.Attributes())
+	}
+}
+
 func (r *apacheScraper) scrape(context.Context) (pdata.Metrics, error) {
 	if r.httpClient == nil {
 		return pdata.Metrics{}, errors.New("failed to connect to Apache HTTPd")
@@ -91,6 +100,14 @@ func (r *apacheScraper) scrape(context.Context) (pdata.Metrics, error) {
 	requests := initMetric(ilm.Metrics(), metadata.M.ApacheRequests).Sum().DataPoints()
 	traffic := initMetric(ilm.Metrics(), metadata.M.ApacheTraffic).Sum().DataPoints()
 	scoreboard := initMetric(ilm.Metrics(), metadata.M.ApacheScoreboard).Sum().DataPoints()
+	cpuTime := initMetric(ilm.Metrics(), metadata.M.ApacheCPUTime).Sum().DataPoints()
+	cpuLoad := initMetric(ilm.Metrics(), metadata.M.ApacheCPULoad).Gauge().DataPoints()
+	load1 := initMetric(ilm.Metrics(), metadata.M.ApacheLoad1).Gauge().DataPoints()
+	load5 := initMetric(ilm.Metrics(), metadata.M.ApacheLoad5).Gauge().DataPoints()
+	load15 := initMetric(ilm.Metrics(), metadata.M.ApacheLoad15).Gauge().DataPoints()
+	requestTime := initMetric(ilm.Metrics(), metadata.M.ApacheRequestTime).Sum().DataPoints()
+	requestSize := initMetric(ilm.Metrics(), metadata.M.ApacheRequestSize).Gauge().DataPoints()
+	asyncConnections := initMetric(ilm.Metrics(), metadata.M.ApacheConnectionsAsync).Sum().DataPoints()
 
 	for metricKey, metricValue := range parseStats(stats) {
 		labels := pdata.NewAttributeMap()
@@ -130,9 +147,57 @@ func (r *apacheScraper) scrape(context.Context) (pdata.Metrics, error) {
 				labels.Upsert(metadata.A.ScoreboardState, pdata.NewAttributeValueString(identifier))
 				addToIntMetric(scoreboard, labels, score, now)
 			}
+		case "CPUUser", "CPUSystem", "CPUChildrenUser", "CPUChildrenSystem":
+			if f, ok := r.parseFloat(metricKey, metricValue); ok {
+				level, mode := cpuTimeAttributes(metricKey)
+				labels.Insert(metadata.A.CPULevel, pdata.NewAttributeValueString(level))
+				labels.Insert(metadata.A.CPUMode, pdata.NewAttributeValueString(mode))
+				addToDoubleMetric(cpuTime, labels, f, now)
+			}
+		case "CPULoad":
+			if f, ok := r.parseFloat(metricKey, metricValue); ok {
+				addToDoubleMetric(cpuLoad, labels, f, now)
+			}
+		case "Load1":
+			if f, ok := r.parseFloat(metricKey, metricValue); ok {
+				addToDoubleMetric(load1, labels, f, now)
+			}
+		case "Load5":
+			if f, ok := r.parseFloat(metricKey, metricValue); ok {
+				addToDoubleMetric(load5, labels, f, now)
+			}
+		case "Load15":
+			if f, ok := r.parseFloat(metricKey, metricValue); ok {
+				addToDoubleMetric(load15, labels, f, now)
+			}
+		case "Total Duration":
+			if i, ok := r.parseInt(metricKey, metricValue); ok {
+				addToIntMetric(requestTime, labels, i, now)
+			}
+		case "BytesPerReq":
+			if f, ok := r.parseFloat(metricKey, metricValue); ok {
+				addToDoubleMetric(requestSize, labels, f, now)
+			}
+		case "ConnsAsyncWriting", "ConnsAsyncKeepAlive", "ConnsAsyncClosing":
+			if i, ok := r.parseInt(metricKey, metricValue); ok {
+				labels.Insert(metadata.A.ConnectionState, pdata.NewAttributeValueString(asyncConnectionState(metricKey)))
+				addToIntMetric(asyncConnections, labels, i, now)
+			}
 		}
 	}
 
+	// Some of the fields are only reported when ExtendedStatus is enabled or by
+	// some MPMs, the metrics of the missing ones are not emitted.
+	ilm.Metrics().RemoveIf(func(m pdata.Metric) bool {
+		switch m.DataType() {
+		case pdata.MetricDataTypeGauge:
+			return m.Gauge().DataPoints().Len() == 0
+		case pdata.MetricDataTypeSum:
+			return m.Sum().DataPoints().Len() == 0
+		}
+		return false
+	})
+
 	return md, nil
 }
 
@@ -177,6 +242,16 @@ func (r *apacheScraper) parseInt(key, value string) (int64, bool) {
 	return i, true
 }
 
+// parseFloat converts string to float64.
+func (r *apacheScraper) parseFloat(key, value string) (float64, bool) {
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		r.logInvalid("float", key, value)
+		return 0, false
+	}
+	return f, true
+}
+
 func (r *apacheScraper) logInvalid(expectedType, key, value string) {
 	r.logger.Info(
 		"invalid value",
@@ -235,6 +310,31 @@ func parseScoreboard(values string) scoreboardCountsByLabel {
 	return scoreboard
 }
 
+// cpuTimeAttributes returns the level and the mode of a CPU time field.
+func cpuTimeAttributes(key string) (level string, mode string) {
+	level = metadata.AttributeCPULevel.Self
+	if strings.HasPrefix(key, "CPUChildren") {
+		level = metadata.AttributeCPULevel.Children
+	}
+	mode = metadata.AttributeCPUMode.User
+	if strings.HasSuffix(key, "System") {
+		mode = metadata.AttributeCPUMode.System
+	}
+	return level, mode
+}
+
+// asyncConnectionState returns the state of an asynchronous connections field.
+func asyncConnectionState(key string) string {
+	switch key {
+	case "ConnsAsyncWriting":
+		return metadata.AttributeConnectionState.Writing
+	case "ConnsAsyncKeepAlive":
+		return metadata.AttributeConnectionState.Keepalive
+	default:
+		return metadata.AttributeConnectionState.Closing
+	}
+}
+
 // kbytesToBytes converts 1 Kibibyte to 1024 bytes.
 func kbytesToBytes(i int64) int64 {
 	return 1024 * i
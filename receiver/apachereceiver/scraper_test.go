@@ -59,6 +59,50 @@ func TestScraper(t *testing.T) {
 	require.NoError(t, scrapertest.CompareMetricSlices(eMetricSlice, aMetricSlice))
 }
 
+func TestScraperWithoutExtendedStatus(t *testing.T) {
+	apacheMock := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(200)
+		_, err := rw.Write([]byte(`ServerUptimeSeconds: 410
+Total Accesses: 14169
+Total kBytes: 20910
+BusyWorkers: 13
+IdleWorkers: 227
+ConnsTotal: 110
+Scoreboard: S_DD_L_GGG_____W__IIII_C___
+`))
+		require.NoError(t, err)
+	}))
+	defer apacheMock.Close()
+
+	cfg := &Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: fmt.Sprintf("%s%s", apacheMock.URL, "/server-status?auto"),
+		},
+	}
+	require.NoError(t, cfg.Validate())
+
+	scraper := newApacheScraper(zap.NewNop(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	// The metrics of the fields only reported with ExtendedStatus are not emitted.
+	ms := actualMetrics.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+	var names []string
+	for i := 0; i < ms.Len(); i++ {
+		names = append(names, ms.At(i).Name())
+	}
+	require.ElementsMatch(t, []string{
+		"apache.uptime",
+		"apache.current_connections",
+		"apache.workers",
+		"apache.requests",
+		"apache.traffic",
+		"apache.scoreboard",
+	}, names)
+}
+
 func TestScraperFailedStart(t *testing.T) {
 	sc := newApacheScraper(zap.NewNop(), &Config{
 		HTTPClientSettings: confighttp.HTTPClientSettings{
@@ -171,12 +215,40 @@ func newMockServer(t *testing.T) *httptest.Server {
 	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		if req.URL.String() == "/server-status?auto" {
 			rw.WriteHeader(200)
-			_, err := rw.Write([]byte(`ServerUptimeSeconds: 410
+			_, err := rw.Write([]byte(`127.0.0.1
+ServerVersion: Apache/2.4.51 (Unix)
+ServerMPM: event
+Server Built: Oct  7 2021 20:08:42
+CurrentTime: Friday, 24-Sep-2021 14:58:38 UTC
+RestartTime: Friday, 24-Sep-2021 14:51:48 UTC
+ParentServerConfigGeneration: 1
+ParentServerMPMGeneration: 0
+ServerUptimeSeconds: 410
+ServerUptime: 6 minutes 50 seconds
+Load1: 0.52
+Load5: 0.41
+Load15: 0.36
 Total Accesses: 14169
 Total kBytes: 20910
+Total Duration: 1643
+CPUUser: 2.31
+CPUSystem: 1.84
+CPUChildrenUser: 0.05
+CPUChildrenSystem: 0.02
+CPULoad: 1.02927
+Uptime: 410
+ReqPerSec: 34.5585
+BytesPerSec: 52224
+BytesPerReq: 1511.17
+DurationPerReq: .115957
 BusyWorkers: 13
 IdleWorkers: 227
+Processes: 4
+Stopping: 0
 ConnsTotal: 110
+ConnsAsyncWriting: 3
+ConnsAsyncKeepAlive: 98
+ConnsAsyncClosing: 9
 Scoreboard: S_DD_L_GGG_____W__IIII_C________________W__________________________________.........................____WR______W____W________________________C______________________________________W_W____W______________R_________R________C_________WK_W________K_____W__C__________W___R______.............................................................................................................................
 `))
 			require.NoError(t, err)
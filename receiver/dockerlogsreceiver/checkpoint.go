@@ -0,0 +1,148 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerlogsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerlogsreceiver"
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+const (
+	eventsCheckpointKey       = "events"
+	containerCheckpointPrefix = "container."
+)
+
+func getStorageClient(ctx context.Context, host component.Host, id config.ComponentID) (storage.Client, error) {
+	var storageExtension storage.Extension
+	for _, ext := range host.GetExtensions() {
+		if se, ok := ext.(storage.Extension); ok {
+			if storageExtension != nil {
+				return nil, errors.New("multiple storage extensions found")
+			}
+			storageExtension = se
+		}
+	}
+
+	if storageExtension == nil {
+		return storage.NewNopClient(), nil
+	}
+
+	return storageExtension.GetClient(ctx, component.KindReceiver, id, "")
+}
+
+// checkpoints keeps track of the timestamp of the last log record read for
+// each container and of the last event. Checkpoints are updated in memory and
+// written to the storage by flush, to avoid a write per log record.
+type checkpoints struct {
+	client storage.Client
+
+	// writeMu serializes the writes to the storage, so that a checkpoint
+	// deleted while being flushed is not written back.
+	writeMu sync.Mutex
+
+	mu    sync.Mutex
+	dirty map[string]time.Time
+}
+
+func newCheckpoints(client storage.Client) *checkpoints {
+	return &checkpoints{client: client, dirty: map[string]time.Time{}}
+}
+
+func containerCheckpointKey(cid string) string {
+	return containerCheckpointPrefix + cid
+}
+
+// get returns the checkpoint stored for the key, if any.
+func (c *checkpoints) get(ctx context.Context, key string) (time.Time, bool, error) {
+	c.mu.Lock()
+	ts, ok := c.dirty[key]
+	c.mu.Unlock()
+	if ok {
+		return ts, true, nil
+	}
+
+	value, err := c.client.Get(ctx, key)
+	if err != nil || value == nil {
+		return time.Time{}, false, err
+	}
+	if err = ts.UnmarshalText(value); err != nil {
+		return time.Time{}, false, err
+	}
+	return ts, true, nil
+}
+
+// set updates the checkpoint of the key, it is written to the storage on the next flush.
+func (c *checkpoints) set(key string, ts time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.dirty[key] = ts
+}
+
+// delete removes the checkpoint of the key from the storage.
+func (c *checkpoints) delete(ctx context.Context, key string) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	c.mu.Lock()
+	delete(c.dirty, key)
+	c.mu.Unlock()
+	return c.client.Delete(ctx, key)
+}
+
+// flush writes the checkpoints updated since the last flush to the storage.
+// The checkpoints are kept in memory until they are written successfully.
+func (c *checkpoints) flush(ctx context.Context) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	c.mu.Lock()
+	dirty := make(map[string]time.Time, len(c.dirty))
+	for key, ts := range c.dirty {
+		dirty[key] = ts
+	}
+	c.mu.Unlock()
+
+	if len(dirty) == 0 {
+		return nil
+	}
+
+	ops := make([]storage.Operation, 0, len(dirty))
+	for key, ts := range dirty {
+		value, err := ts.MarshalText()
+		if err != nil {
+			return err
+		}
+		ops = append(ops, storage.SetOperation(key, value))
+	}
+	if err := c.client.Batch(ctx, ops...); err != nil {
+		return err
+	}
+
+	// Checkpoints updated during the write are left for the next flush.
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, ts := range dirty {
+		if current, ok := c.dirty[key]; ok && current.Equal(ts) {
+			delete(c.dirty, key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerlogsreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+// failingClient is a storage client whose batches fail while err is set.
+type failingClient struct {
+	storage.Client
+	err    error
+	values map[string][]byte
+}
+
+func (c *failingClient) Get(_ context.Context, key string) ([]byte, error) {
+	return c.values[key], nil
+}
+
+func (c *failingClient) Batch(_ context.Context, ops ...storage.Operation) error {
+	if c.err != nil {
+		return c.err
+	}
+	for _, op := range ops {
+		c.values[op.Key] = op.Value
+	}
+	return nil
+}
+
+func TestCheckpointsFlushError(t *testing.T) {
+	ctx := context.Background()
+	client := &failingClient{Client: storage.NewNopClient(), err: errors.New("disk full"), values: map[string][]byte{}}
+	c := newCheckpoints(client)
+
+	ts := time.Date(2021, 12, 10, 18, 47, 7, 0, time.UTC)
+	c.set("container.c1", ts)
+	require.EqualError(t, c.flush(ctx), "disk full")
+
+	// The checkpoint is kept until it is written.
+	client.err = nil
+	require.NoError(t, c.flush(ctx))
+	assert.Empty(t, c.dirty)
+
+	stored := newCheckpoints(client)
+	got, ok, err := stored.get(ctx, "container.c1")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, ts.Equal(got))
+}
@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerlogsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerlogsreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/config"
+)
+
+const (
+	startAtBeginning = "beginning"
+	startAtEnd       = "end"
+)
+
+var _ config.Receiver = (*Config)(nil)
+
+type Config struct {
+	config.ReceiverSettings `mapstructure:",squash"`
+
+	// The URL of the docker server.  Default is "unix:///var/run/docker.sock"
+	Endpoint string `mapstructure:"endpoint"`
+
+	// The maximum amount of time to wait for docker API responses.  Default is 5s
+	Timeout time.Duration `mapstructure:"timeout"`
+
+	// A mapping of container label names to resource attribute keys, as for the
+	// docker_stats receiver. E.g. `io.kubernetes.container.name: container_spec_name`
+	// would result in a `container_spec_name` resource attribute whose value is
+	// the value of the `io.kubernetes.container.name` container label.
+	ContainerLabelsToMetricLabels map[string]string `mapstructure:"container_labels_to_metric_labels"`
+
+	// A mapping of container environment variable names to resource attribute
+	// keys, as for the docker_stats receiver. E.g. `APP_VERSION: version` would
+	// result in a `version` resource attribute whose value is the value of the
+	// `APP_VERSION` environment variable of the container, if present.
+	EnvVarsToMetricLabels map[string]string `mapstructure:"env_vars_to_metric_labels"`
+
+	// A list of filters whose matching images are to be excluded.  Supports literals, globs, and regex.
+	ExcludedImages []string `mapstructure:"excluded_images"`
+
+	// Docker client API version. Default is 1.22
+	DockerAPIVersion float64 `mapstructure:"api_version"`
+
+	// Logs configures the collection of the stdout and stderr of the containers.
+	Logs LogsConfig `mapstructure:"logs"`
+
+	// Events configures the collection of the Docker container events.
+	Events EventsConfig `mapstructure:"events"`
+}
+
+// LogsConfig configures the collection of container logs.
+type LogsConfig struct {
+	// Enabled turns the collection of container logs on. Default is true.
+	Enabled bool `mapstructure:"enabled"`
+
+	// StartAt defines where to start reading the logs of the containers that are
+	// already running when the receiver starts and have no checkpoint: "beginning"
+	// or "end". The logs of containers started later are always read from the
+	// beginning. Default is "end".
+	StartAt string `mapstructure:"start_at"`
+}
+
+// EventsConfig configures the collection of Docker container events.
+type EventsConfig struct {
+	// Enabled turns the collection of container events on. Default is true.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Actions lists the container event actions to report, e.g. "start", "die",
+	// "oom" or "health_status". All of the actions listed in the README are
+	// reported when empty.
+	Actions []string `mapstructure:"actions"`
+}
+
+func (config Config) Validate() error {
+	if config.Endpoint == "" {
+		return errors.New("endpoint must be specified")
+	}
+	if config.DockerAPIVersion < minimalRequiredDockerAPIVersion {
+		return fmt.Errorf("api_version must be at least %v", minimalRequiredDockerAPIVersion)
+	}
+	if !config.Logs.Enabled && !config.Events.Enabled {
+		return errors.New("at least one of logs or events must be enabled")
+	}
+	if config.Logs.StartAt != startAtBeginning && config.Logs.StartAt != startAtEnd {
+		return fmt.Errorf("logs::start_at must be %q or %q, got %q", startAtBeginning, startAtEnd, config.Logs.StartAt)
+	}
+	return nil
+}
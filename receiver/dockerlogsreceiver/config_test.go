@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerlogsreceiver
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configtest"
+)
+
+func TestLoadConfig(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	assert.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Receivers[typeStr] = factory
+	cfg, err := configtest.LoadConfigAndValidate(path.Join(".", "testdata", "config.yaml"), factories)
+
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+	assert.Equal(t, 2, len(cfg.Receivers))
+
+	defaultConfig := cfg.Receivers[config.NewComponentID(typeStr)]
+	assert.Equal(t, factory.CreateDefaultConfig(), defaultConfig)
+
+	ascfg := cfg.Receivers[config.NewComponentIDWithName(typeStr, "allsettings")].(*Config)
+	assert.Equal(t, &Config{
+		ReceiverSettings:              config.NewReceiverSettings(config.NewComponentIDWithName(typeStr, "allsettings")),
+		Endpoint:                      "http://example.com/",
+		Timeout:                       20 * time.Second,
+		DockerAPIVersion:              1.24,
+		ContainerLabelsToMetricLabels: map[string]string{"my.container.label": "my-label"},
+		EnvVarsToMetricLabels:         map[string]string{"MY_ENVIRONMENT_VARIABLE": "my-env-label"},
+		ExcludedImages:                []string{"undesired-container", "another-*-container"},
+		Logs: LogsConfig{
+			Enabled: true,
+			StartAt: startAtBeginning,
+		},
+		Events: EventsConfig{
+			Enabled: true,
+			Actions: []string{"die", "oom", "health_status"},
+		},
+	}, ascfg)
+}
+
+func TestValidateErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(cfg *Config)
+		err    string
+	}{
+		{
+			name:   "no endpoint",
+			modify: func(cfg *Config) { cfg.Endpoint = "" },
+			err:    "endpoint must be specified",
+		},
+		{
+			name:   "api version",
+			modify: func(cfg *Config) { cfg.DockerAPIVersion = 1.21 },
+			err:    "api_version must be at least 1.22",
+		},
+		{
+			name: "nothing enabled",
+			modify: func(cfg *Config) {
+				cfg.Logs.Enabled = false
+				cfg.Events.Enabled = false
+			},
+			err: "at least one of logs or events must be enabled",
+		},
+		{
+			name:   "start_at",
+			modify: func(cfg *Config) { cfg.Logs.StartAt = "middle" },
+			err:    `logs::start_at must be "beginning" or "end", got "middle"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			test.modify(cfg)
+			assert.EqualError(t, cfg.Validate(), test.err)
+		})
+	}
+}
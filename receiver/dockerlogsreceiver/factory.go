@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerlogsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerlogsreceiver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+)
+
+const (
+	typeStr = "docker_logs"
+
+	defaultDockerAPIVersion         = 1.22
+	minimalRequiredDockerAPIVersion = 1.22
+)
+
+// defaultEventActions are the container event actions reported by default.
+var defaultEventActions = []string{
+	"create",
+	"start",
+	"restart",
+	"stop",
+	"kill",
+	"die",
+	"oom",
+	"pause",
+	"unpause",
+	"destroy",
+	"health_status",
+}
+
+func NewFactory() component.ReceiverFactory {
+	return receiverhelper.NewFactory(
+		typeStr,
+		createDefaultConfig,
+		receiverhelper.WithLogs(createLogsReceiver))
+}
+
+func createDefaultConfig() config.Receiver {
+	return &Config{
+		ReceiverSettings: config.NewReceiverSettings(config.NewComponentID(typeStr)),
+		Endpoint:         "unix:///var/run/docker.sock",
+		Timeout:          5 * time.Second,
+		DockerAPIVersion: defaultDockerAPIVersion,
+		Logs: LogsConfig{
+			Enabled: true,
+			StartAt: startAtEnd,
+		},
+		Events: EventsConfig{
+			Enabled: true,
+		},
+	}
+}
+
+func createLogsReceiver(
+	_ context.Context,
+	params component.ReceiverCreateSettings,
+	config config.Receiver,
+	consumer consumer.Logs,
+) (component.LogsReceiver, error) {
+	return newReceiver(params, config.(*Config), consumer), nil
+}
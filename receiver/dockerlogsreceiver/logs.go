@@ -0,0 +1,158 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerlogsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerlogsreceiver"
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	streamStdout = "stdout"
+	streamStderr = "stderr"
+
+	// Stream identifiers of the multiplexed log stream, see
+	// https://docs.docker.com/engine/api/v1.22/#attach-to-a-container
+	stdoutStreamID = 1
+	stderrStreamID = 2
+	systemStreamID = 3
+
+	frameHeaderLen = 8
+)
+
+// errInvalidTimestamp is returned for lines that are not prefixed with a valid timestamp.
+var errInvalidTimestamp = errors.New("invalid log line timestamp")
+
+// logLine is a timestamped line read from the logs of a container.
+type logLine struct {
+	stream    string
+	timestamp time.Time
+	body      string
+}
+
+// logReader reads the lines of a container log stream requested with timestamps.
+// Unless the container has a TTY, stdout and stderr are multiplexed in a single
+// stream of frames, each one prefixed with an 8 bytes header holding the stream
+// identifier and the big endian size of the frame.
+type logReader struct {
+	r   *bufio.Reader
+	tty bool
+
+	// pending holds the lines of the last frame that were not returned yet.
+	pending [][]byte
+	stream  string
+}
+
+func newLogReader(r io.Reader, tty bool) *logReader {
+	return &logReader{r: bufio.NewReader(r), tty: tty}
+}
+
+// buffered reports whether a line can be returned without blocking on the
+// underlying stream.
+func (lr *logReader) buffered() bool {
+	return len(lr.pending) > 0 || lr.r.Buffered() > 0
+}
+
+// next returns the next line of the stream, io.EOF is returned once the stream ends.
+func (lr *logReader) next() (logLine, error) {
+	raw, stream, err := lr.nextRaw()
+	if err != nil {
+		return logLine{}, err
+	}
+	return parseLogLine(raw, stream)
+}
+
+func (lr *logReader) nextRaw() ([]byte, string, error) {
+	if lr.tty {
+		line, err := lr.r.ReadBytes('\n')
+		if len(line) > 0 && (err == nil || errors.Is(err, io.EOF)) {
+			return line, streamStdout, nil
+		}
+		return nil, "", err
+	}
+
+	for len(lr.pending) == 0 {
+		if err := lr.readFrame(); err != nil {
+			return nil, "", err
+		}
+	}
+	line := lr.pending[0]
+	lr.pending = lr.pending[1:]
+	return line, lr.stream, nil
+}
+
+func (lr *logReader) readFrame() error {
+	var header [frameHeaderLen]byte
+	if _, err := io.ReadFull(lr.r, header[:]); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return fmt.Errorf("truncated log frame header: %w", err)
+		}
+		return err
+	}
+
+	payload := make([]byte, binary.BigEndian.Uint32(header[4:]))
+	if _, err := io.ReadFull(lr.r, payload); err != nil {
+		return fmt.Errorf("truncated log frame: %w", err)
+	}
+
+	switch header[0] {
+	case stdoutStreamID:
+		lr.stream = streamStdout
+	case stderrStreamID:
+		lr.stream = streamStderr
+	case systemStreamID:
+		return fmt.Errorf("docker daemon error: %s", bytes.TrimSpace(payload))
+	default:
+		return fmt.Errorf("unexpected log stream identifier %d", header[0])
+	}
+
+	for len(payload) > 0 {
+		i := bytes.IndexByte(payload, '\n')
+		if i < 0 {
+			lr.pending = append(lr.pending, payload)
+			break
+		}
+		lr.pending = append(lr.pending, payload[:i+1])
+		payload = payload[i+1:]
+	}
+	return nil
+}
+
+// parseLogLine splits the RFC3339Nano timestamp that prefixes the lines of the
+// logs requested with timestamps from the message.
+func parseLogLine(raw []byte, stream string) (logLine, error) {
+	raw = bytes.TrimRight(raw, "\r\n")
+	i := bytes.IndexByte(raw, ' ')
+	if i < 0 {
+		// Empty lines have no trailing space after the timestamp.
+		i = len(raw)
+	}
+
+	ts, err := time.Parse(time.RFC3339Nano, string(raw[:i]))
+	if err != nil {
+		return logLine{}, fmt.Errorf("%w: %v", errInvalidTimestamp, err)
+	}
+
+	line := logLine{stream: stream, timestamp: ts}
+	if i < len(raw) {
+		line.body = string(raw[i+1:])
+	}
+	return line, nil
+}
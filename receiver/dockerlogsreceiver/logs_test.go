@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerlogsreceiver
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func frame(streamID byte, payload string) []byte {
+	header := make([]byte, frameHeaderLen)
+	header[0] = streamID
+	binary.BigEndian.PutUint32(header[4:], uint32(len(payload)))
+	return append(header, payload...)
+}
+
+func readAll(t *testing.T, lr *logReader) []logLine {
+	var lines []logLine
+	for {
+		line, err := lr.next()
+		if err == io.EOF {
+			return lines
+		}
+		require.NoError(t, err)
+		lines = append(lines, line)
+	}
+}
+
+func TestLogReaderMultiplexed(t *testing.T) {
+	var stream bytes.Buffer
+	stream.Write(frame(stdoutStreamID, "2021-12-10T18:47:07.000000001Z first line\n"))
+	stream.Write(frame(stderrStreamID, "2021-12-10T18:47:08Z an error\n2021-12-10T18:47:09Z another one\n"))
+	stream.Write(frame(stdoutStreamID, "2021-12-10T18:47:10Z\n"))
+
+	lines := readAll(t, newLogReader(&stream, false))
+	assert.Equal(t, []logLine{
+		{stream: streamStdout, timestamp: time.Date(2021, 12, 10, 18, 47, 7, 1, time.UTC), body: "first line"},
+		{stream: streamStderr, timestamp: time.Date(2021, 12, 10, 18, 47, 8, 0, time.UTC), body: "an error"},
+		{stream: streamStderr, timestamp: time.Date(2021, 12, 10, 18, 47, 9, 0, time.UTC), body: "another one"},
+		{stream: streamStdout, timestamp: time.Date(2021, 12, 10, 18, 47, 10, 0, time.UTC), body: ""},
+	}, lines)
+}
+
+func TestLogReaderTTY(t *testing.T) {
+	stream := strings.NewReader("2021-12-10T18:47:07Z first line\r\n2021-12-10T18:47:08Z last line without newline")
+
+	lines := readAll(t, newLogReader(stream, true))
+	assert.Equal(t, []logLine{
+		{stream: streamStdout, timestamp: time.Date(2021, 12, 10, 18, 47, 7, 0, time.UTC), body: "first line"},
+		{stream: streamStdout, timestamp: time.Date(2021, 12, 10, 18, 47, 8, 0, time.UTC), body: "last line without newline"},
+	}, lines)
+}
+
+func TestLogReaderErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		stream []byte
+		err    string
+	}{
+		{
+			name:   "truncated header",
+			stream: frame(stdoutStreamID, "")[:4],
+			err:    "truncated log frame header",
+		},
+		{
+			name:   "truncated frame",
+			stream: frame(stdoutStreamID, "2021-12-10T18:47:07Z line\n")[:12],
+			err:    "truncated log frame",
+		},
+		{
+			name:   "daemon error",
+			stream: frame(systemStreamID, "something went wrong\n"),
+			err:    "docker daemon error: something went wrong",
+		},
+		{
+			name:   "unknown stream",
+			stream: frame(7, "line\n"),
+			err:    "unexpected log stream identifier 7",
+		},
+		{
+			name:   "no timestamp",
+			stream: frame(stdoutStreamID, "line\n"),
+			err:    "invalid log line timestamp",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := newLogReader(bytes.NewReader(test.stream), false).next()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
@@ -0,0 +1,517 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerlogsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerlogsreceiver"
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	dtypes "github.com/docker/docker/api/types"
+	devents "github.com/docker/docker/api/types/events"
+	dfilters "github.com/docker/docker/api/types/filters"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/model/pdata"
+	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/docker"
+)
+
+const (
+	transport = "http"
+
+	// maxBatchSize is the maximum number of log records of a container sent in a single batch.
+	maxBatchSize = 100
+
+	checkpointFlushInterval = time.Second
+	eventsRetryInterval     = 3 * time.Second
+)
+
+var _ component.LogsReceiver = (*receiver)(nil)
+
+type receiver struct {
+	config   *Config
+	settings component.ReceiverCreateSettings
+	consumer consumer.Logs
+	obsrecv  *obsreport.Receiver
+
+	client               *docker.Client
+	excludedImageMatcher *docker.StringMatcher
+	storageClient        storage.Client
+	checkpoints          *checkpoints
+	eventActions         map[string]bool
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	followersLock sync.Mutex
+	followers     map[string]*follower
+}
+
+// follower tracks the goroutine following the logs of a container.
+type follower struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newReceiver(set component.ReceiverCreateSettings, config *Config, nextConsumer consumer.Logs) *receiver {
+	eventActions := map[string]bool{}
+	if config.Events.Enabled {
+		actions := config.Events.Actions
+		if len(actions) == 0 {
+			actions = defaultEventActions
+		}
+		for _, action := range actions {
+			eventActions[action] = true
+		}
+	}
+
+	return &receiver{
+		config:   config,
+		settings: set,
+		consumer: nextConsumer,
+		obsrecv: obsreport.NewReceiver(obsreport.ReceiverSettings{
+			ReceiverID:             config.ID(),
+			Transport:              transport,
+			LongLivedCtx:           true,
+			ReceiverCreateSettings: set,
+		}),
+		eventActions: eventActions,
+		followers:    map[string]*follower{},
+	}
+}
+
+func (r *receiver) Start(ctx context.Context, host component.Host) error {
+	dConfig, err := docker.NewConfig(r.config.Endpoint, r.config.Timeout, r.config.ExcludedImages, r.config.DockerAPIVersion)
+	if err != nil {
+		return err
+	}
+
+	r.client, err = docker.NewDockerClient(dConfig, r.settings.Logger)
+	if err != nil {
+		return err
+	}
+
+	r.excludedImageMatcher, err = docker.NewStringMatcher(r.config.ExcludedImages)
+	if err != nil {
+		return err
+	}
+
+	r.storageClient, err = getStorageClient(ctx, host, r.config.ID())
+	if err != nil {
+		return err
+	}
+	r.checkpoints = newCheckpoints(r.storageClient)
+
+	// Events are watched from the last one reported before a restart, or from
+	// now on so that no container started after listing them is missed.
+	since, ok, err := r.checkpoints.get(ctx, eventsCheckpointKey)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		since = time.Now()
+	}
+
+	if err = r.client.LoadContainerList(ctx); err != nil {
+		return err
+	}
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	if r.config.Logs.Enabled {
+		for _, container := range r.client.Containers() {
+			r.follow(runCtx, container, true)
+		}
+	}
+
+	r.wg.Add(2)
+	go r.eventLoop(runCtx, since)
+	go r.flushLoop(runCtx)
+	return nil
+}
+
+func (r *receiver) Shutdown(ctx context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+		r.wg.Wait()
+	}
+	if r.storageClient == nil {
+		return nil
+	}
+
+	return multierr.Combine(
+		r.checkpoints.flush(ctx),
+		r.storageClient.Close(ctx),
+	)
+}
+
+func (r *receiver) flushLoop(ctx context.Context) {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(checkpointFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.checkpoints.flush(ctx); err != nil && ctx.Err() == nil {
+				r.settings.Logger.Error("Failed to write checkpoints", zap.Error(err))
+			}
+		}
+	}
+}
+
+// follow starts following the logs of the container. A running follower of
+// the same container is stopped first, the new one resumes from its checkpoint.
+func (r *receiver) follow(ctx context.Context, container docker.Container, initial bool) {
+	followCtx, cancel := context.WithCancel(ctx)
+	f := &follower{cancel: cancel, done: make(chan struct{})}
+
+	r.followersLock.Lock()
+	prev := r.followers[container.ID]
+	r.followers[container.ID] = f
+	r.followersLock.Unlock()
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer close(f.done)
+		if prev != nil {
+			prev.cancel()
+			<-prev.done
+		}
+		if err := r.readLogs(followCtx, container, initial); err != nil && followCtx.Err() == nil {
+			r.settings.Logger.Warn("Error following docker container logs", zap.String("id", container.ID), zap.Error(err))
+		}
+	}()
+}
+
+// unfollow stops following the logs of the container without waiting for the
+// follower, the returned channel is closed once it has stopped.
+func (r *receiver) unfollow(cid string) <-chan struct{} {
+	r.followersLock.Lock()
+	f, ok := r.followers[cid]
+	delete(r.followers, cid)
+	r.followersLock.Unlock()
+
+	if !ok {
+		done := make(chan struct{})
+		close(done)
+		return done
+	}
+	f.cancel()
+	return f.done
+}
+
+func (r *receiver) readLogs(ctx context.Context, container docker.Container, initial bool) error {
+	checkpointKey := containerCheckpointKey(container.ID)
+	after, hasCheckpoint, err := r.checkpoints.get(ctx, checkpointKey)
+	if err != nil {
+		return err
+	}
+
+	options := dtypes.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     true,
+		Timestamps: true,
+	}
+	switch {
+	case hasCheckpoint:
+		options.Since = after.Format(time.RFC3339Nano)
+	case initial && r.config.Logs.StartAt == startAtEnd:
+		options.Tail = "0"
+	}
+
+	stream, err := r.client.ContainerLogs(ctx, container.ID, options)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	r.settings.Logger.Debug("Following docker container logs", zap.String("id", container.ID))
+	lr := newLogReader(stream, container.Config.Tty)
+
+	var logs pdata.Logs
+	var records pdata.LogSlice
+	var count int
+	var last time.Time
+	consume := func() {
+		if count == 0 {
+			return
+		}
+		// The checkpoint only moves past the logs accepted by the next consumer.
+		if r.consume(ctx, logs, count) == nil {
+			r.checkpoints.set(checkpointKey, last)
+		}
+		count = 0
+	}
+
+	for {
+		line, err := lr.next()
+		if errors.Is(err, errInvalidTimestamp) {
+			r.settings.Logger.Warn("Skipping docker container log line", zap.String("id", container.ID), zap.Error(err))
+			if !lr.buffered() {
+				consume()
+			}
+			continue
+		}
+		if err != nil {
+			consume()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		if hasCheckpoint && !line.timestamp.After(after) {
+			continue
+		}
+
+		if count == 0 {
+			logs, records = r.newContainerLogs(&container, nil)
+		}
+		count++
+		record := records.AppendEmpty()
+		record.SetTimestamp(pdata.NewTimestampFromTime(line.timestamp))
+		record.Body().SetStringVal(line.body)
+		record.Attributes().InsertString("log.iostream", line.stream)
+		last = line.timestamp
+
+		if count >= maxBatchSize || !lr.buffered() {
+			consume()
+		}
+	}
+}
+
+func (r *receiver) consume(ctx context.Context, logs pdata.Logs, count int) error {
+	obsCtx := r.obsrecv.StartLogsOp(ctx)
+	err := r.consumer.ConsumeLogs(obsCtx, logs)
+	if err != nil {
+		r.settings.Logger.Error("Failed to consume docker logs", zap.Error(err))
+	}
+	r.obsrecv.EndLogsOp(obsCtx, typeStr, count, err)
+	return err
+}
+
+func (r *receiver) eventLoop(ctx context.Context, since time.Time) {
+	defer r.wg.Done()
+
+	filters := dfilters.NewArgs(dfilters.Arg("type", "container"))
+	for action := range r.watchedActions() {
+		filters.Add("event", action)
+	}
+
+EVENT_LOOP:
+	for {
+		options := dtypes.EventsOptions{
+			Filters: filters,
+			Since:   since.Format(time.RFC3339Nano),
+		}
+		eventCh, errCh := r.client.Events(ctx, options)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventCh:
+				ts := time.Unix(0, event.TimeNano)
+				if !ts.After(since) {
+					continue
+				}
+				since = ts
+				// The checkpoint only moves past the events accepted by the next consumer.
+				if r.handleEvent(ctx, event) == nil {
+					r.checkpoints.set(eventsCheckpointKey, since)
+				}
+			case err := <-errCh:
+				// Requests made with a canceled context are guaranteed to fail.
+				if ctx.Err() != nil {
+					return
+				}
+				r.settings.Logger.Error("Error watching docker container events", zap.Error(err))
+				select {
+				case <-time.After(eventsRetryInterval):
+					continue EVENT_LOOP
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}
+}
+
+// watchedActions returns the container event actions the receiver needs to
+// follow the logs of new containers and to report the configured events.
+func (r *receiver) watchedActions() map[string]bool {
+	actions := map[string]bool{}
+	if r.config.Logs.Enabled {
+		actions["start"] = true
+		actions["destroy"] = true
+	}
+	for action := range r.eventActions {
+		actions[action] = true
+	}
+	return actions
+}
+
+// handleEvent follows the logs of started containers, stops following destroyed
+// ones and reports the event when configured. It returns the error of the next
+// consumer, if any.
+func (r *receiver) handleEvent(ctx context.Context, event devents.Message) error {
+	// Some actions carry a status, e.g. "health_status: healthy".
+	action, status := event.Action, ""
+	if i := strings.Index(action, ":"); i >= 0 {
+		action, status = action[:i], strings.TrimSpace(action[i+1:])
+	}
+
+	if r.excludedImageMatcher.Matches(event.Actor.Attributes["image"]) {
+		return nil
+	}
+
+	var container *docker.Container
+	switch action {
+	case "start":
+		// Only started containers are inspected, the others are described by
+		// the cached container or by the event attributes.
+		if cjson, ok := r.client.InspectAndPersistContainer(ctx, event.ID); ok {
+			container = &docker.Container{
+				ContainerJSON: cjson,
+				EnvMap:        docker.ContainerEnvToMap(cjson.Config.Env),
+			}
+			if r.config.Logs.Enabled && cjson.State.Running {
+				r.follow(ctx, *container, false)
+			}
+		}
+	case "destroy":
+		r.settings.Logger.Debug("Docker container was destroyed", zap.String("id", event.ID))
+		r.client.RemoveContainer(event.ID)
+		r.deleteCheckpoint(ctx, event.ID, r.unfollow(event.ID))
+	default:
+		container = r.cachedContainer(event.ID)
+	}
+
+	if !r.eventActions[action] {
+		return nil
+	}
+	return r.consume(ctx, r.eventToLogs(event, action, status, container), 1)
+}
+
+// deleteCheckpoint deletes the checkpoint of the container once its follower
+// has stopped, so that it is not written back by the follower.
+func (r *receiver) deleteCheckpoint(ctx context.Context, cid string, stopped <-chan struct{}) {
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		<-stopped
+		if err := r.checkpoints.delete(ctx, containerCheckpointKey(cid)); err != nil && ctx.Err() == nil {
+			r.settings.Logger.Warn("Failed to delete docker container checkpoint", zap.String("id", cid), zap.Error(err))
+		}
+	}()
+}
+
+// cachedContainer returns the container known to the client, if any.
+func (r *receiver) cachedContainer(cid string) *docker.Container {
+	for _, container := range r.client.Containers() {
+		if container.ID == cid {
+			return &container
+		}
+	}
+	return nil
+}
+
+func (r *receiver) eventToLogs(event devents.Message, action, status string, container *docker.Container) pdata.Logs {
+	logs, records := r.newContainerLogs(container, &event.Actor)
+	record := records.AppendEmpty()
+	record.SetTimestamp(pdata.NewTimestampFromTime(time.Unix(0, event.TimeNano)))
+	record.SetSeverityNumber(pdata.SeverityNumberINFO)
+	record.Body().SetStringVal(event.Action)
+
+	attrs := record.Attributes()
+	attrs.InsertString("docker.event.type", event.Type)
+	attrs.InsertString("docker.event.action", action)
+
+	switch action {
+	case "oom":
+		record.SetSeverityNumber(pdata.SeverityNumberERROR)
+	case "die":
+		if exitCode, err := strconv.ParseInt(event.Actor.Attributes["exitCode"], 10, 64); err == nil {
+			attrs.InsertInt("docker.event.exit_code", exitCode)
+			if exitCode != 0 {
+				record.SetSeverityNumber(pdata.SeverityNumberWARN)
+			}
+		}
+	case "kill":
+		if signal := event.Actor.Attributes["signal"]; signal != "" {
+			attrs.InsertString("docker.event.signal", signal)
+		}
+	case "health_status":
+		attrs.InsertString("docker.event.health_status", status)
+		if status == "unhealthy" {
+			record.SetSeverityNumber(pdata.SeverityNumberWARN)
+		}
+	}
+	return logs
+}
+
+// newContainerLogs returns new logs with the resource attributes of the
+// container. When the container could not be inspected, as for removed
+// containers, the attributes are taken from the event actor instead.
+func (r *receiver) newContainerLogs(container *docker.Container, actor *devents.Actor) (pdata.Logs, pdata.LogSlice) {
+	logs := pdata.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	resourceAttr := rl.Resource().Attributes()
+
+	if container != nil {
+		resourceAttr.UpsertString(conventions.AttributeContainerID, container.ID)
+		resourceAttr.UpsertString(conventions.AttributeContainerImageName, container.Config.Image)
+		resourceAttr.UpsertString(conventions.AttributeContainerName, strings.TrimPrefix(container.Name, "/"))
+		resourceAttr.UpsertString("container.hostname", container.Config.Hostname)
+		for k, label := range r.config.EnvVarsToMetricLabels {
+			if v := container.EnvMap[k]; v != "" {
+				resourceAttr.UpsertString(label, v)
+			}
+		}
+		for k, label := range r.config.ContainerLabelsToMetricLabels {
+			if v := container.Config.Labels[k]; v != "" {
+				resourceAttr.UpsertString(label, v)
+			}
+		}
+	} else if actor != nil {
+		// The attributes of container events hold the image, the name and the labels of the container.
+		resourceAttr.UpsertString(conventions.AttributeContainerID, actor.ID)
+		resourceAttr.UpsertString(conventions.AttributeContainerImageName, actor.Attributes["image"])
+		resourceAttr.UpsertString(conventions.AttributeContainerName, actor.Attributes["name"])
+		for k, label := range r.config.ContainerLabelsToMetricLabels {
+			if v := actor.Attributes[k]; v != "" {
+				resourceAttr.UpsertString(label, v)
+			}
+		}
+	}
+
+	return logs, rl.InstrumentationLibraryLogs().AppendEmpty().Logs()
+}
@@ -0,0 +1,448 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerlogsreceiver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	dtypes "github.com/docker/docker/api/types"
+	dcontainer "github.com/docker/docker/api/types/container"
+	devents "github.com/docker/docker/api/types/events"
+	dtime "github.com/docker/docker/api/types/time"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+)
+
+type fakeLogLine struct {
+	streamID  byte
+	timestamp time.Time
+	body      string
+}
+
+// fakeDocker serves the parts of the Docker API used by the receiver.
+type fakeDocker struct {
+	t      *testing.T
+	server *httptest.Server
+	events chan devents.Message
+
+	mu         sync.Mutex
+	containers map[string]dtypes.ContainerJSON
+	logs       map[string][]fakeLogLine
+}
+
+func newFakeDocker(t *testing.T) *fakeDocker {
+	api := &fakeDocker{
+		t:          t,
+		events:     make(chan devents.Message, 10),
+		containers: map[string]dtypes.ContainerJSON{},
+		logs:       map[string][]fakeLogLine{},
+	}
+	api.server = httptest.NewServer(http.HandlerFunc(api.handle))
+	t.Cleanup(api.server.Close)
+	return api
+}
+
+func (api *fakeDocker) endpoint() string {
+	return "tcp://" + api.server.Listener.Addr().String()
+}
+
+func (api *fakeDocker) addContainer(id, name, image string, tty bool, labels map[string]string, env []string) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.containers[id] = dtypes.ContainerJSON{
+		ContainerJSONBase: &dtypes.ContainerJSONBase{
+			ID:    id,
+			Name:  "/" + name,
+			State: &dtypes.ContainerState{Running: true},
+		},
+		Config: &dcontainer.Config{
+			Hostname: id[:4],
+			Image:    image,
+			Tty:      tty,
+			Labels:   labels,
+			Env:      env,
+		},
+	}
+}
+
+func (api *fakeDocker) addLogs(id string, lines ...fakeLogLine) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.logs[id] = append(api.logs[id], lines...)
+}
+
+func (api *fakeDocker) handle(w http.ResponseWriter, req *http.Request) {
+	path := strings.TrimPrefix(req.URL.Path, "/v1.22")
+	switch {
+	case path == "/containers/json":
+		api.mu.Lock()
+		var list []dtypes.Container
+		for _, c := range api.containers {
+			list = append(list, dtypes.Container{ID: c.ID, Image: c.Config.Image})
+		}
+		api.mu.Unlock()
+		api.writeJSON(w, list)
+	case path == "/events":
+		api.serveEvents(w, req)
+	case strings.HasSuffix(path, "/json"):
+		api.mu.Lock()
+		c, ok := api.containers[strings.TrimSuffix(strings.TrimPrefix(path, "/containers/"), "/json")]
+		api.mu.Unlock()
+		if !ok {
+			http.NotFound(w, req)
+			return
+		}
+		api.writeJSON(w, c)
+	case strings.HasSuffix(path, "/logs"):
+		api.serveLogs(w, req, strings.TrimSuffix(strings.TrimPrefix(path, "/containers/"), "/logs"))
+	default:
+		http.NotFound(w, req)
+	}
+}
+
+func (api *fakeDocker) writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(api.t, json.NewEncoder(w).Encode(v))
+}
+
+// serveLogs writes the logs of the container and ends the stream, as when the container stops.
+func (api *fakeDocker) serveLogs(w http.ResponseWriter, req *http.Request, id string) {
+	api.mu.Lock()
+	c, ok := api.containers[id]
+	lines := api.logs[id]
+	api.mu.Unlock()
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+
+	if req.URL.Query().Get("tail") == "0" {
+		return
+	}
+	var since time.Time
+	if value := req.URL.Query().Get("since"); value != "" {
+		sec, nsec, err := dtime.ParseTimestamps(value, 0)
+		require.NoError(api.t, err)
+		since = time.Unix(sec, nsec)
+	}
+
+	for _, line := range lines {
+		if line.timestamp.Before(since) {
+			continue
+		}
+		payload := line.body + "\n"
+		if !line.timestamp.IsZero() {
+			payload = line.timestamp.Format(time.RFC3339Nano) + " " + payload
+		}
+		if c.Config.Tty {
+			_, _ = w.Write([]byte(payload))
+		} else {
+			_, _ = w.Write(frame(line.streamID, payload))
+		}
+	}
+}
+
+func (api *fakeDocker) serveEvents(w http.ResponseWriter, req *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.(http.Flusher).Flush()
+	for {
+		select {
+		case <-req.Context().Done():
+			return
+		case event := <-api.events:
+			require.NoError(api.t, json.NewEncoder(w).Encode(event))
+			w.(http.Flusher).Flush()
+		}
+	}
+}
+
+func (api *fakeDocker) sendEvent(action, id string, attributes map[string]string) {
+	now := time.Now()
+	api.events <- devents.Message{
+		Type:     devents.ContainerEventType,
+		Action:   action,
+		ID:       id,
+		Actor:    devents.Actor{ID: id, Attributes: attributes},
+		Time:     now.Unix(),
+		TimeNano: now.UnixNano(),
+	}
+}
+
+type record struct {
+	resource map[string]interface{}
+	pdata.LogRecord
+}
+
+func records(sink *consumertest.LogsSink) map[string]record {
+	out := map[string]record{}
+	for _, logs := range sink.AllLogs() {
+		for i := 0; i < logs.ResourceLogs().Len(); i++ {
+			rl := logs.ResourceLogs().At(i)
+			for j := 0; j < rl.InstrumentationLibraryLogs().Len(); j++ {
+				lrs := rl.InstrumentationLibraryLogs().At(j).Logs()
+				for k := 0; k < lrs.Len(); k++ {
+					out[lrs.At(k).Body().StringVal()] = record{
+						resource:  rl.Resource().Attributes().AsRaw(),
+						LogRecord: lrs.At(k),
+					}
+				}
+			}
+		}
+	}
+	return out
+}
+
+func TestReceiver(t *testing.T) {
+	base := time.Date(2021, 12, 10, 18, 47, 7, 0, time.UTC)
+	api := newFakeDocker(t)
+	api.addContainer("c1c1c1", "web", "nginx", false, map[string]string{"app.label": "frontend"}, []string{"APP_VERSION=1.2"})
+	api.addLogs("c1c1c1",
+		fakeLogLine{stdoutStreamID, base, "listening"},
+		fakeLogLine{stderrStreamID, base.Add(time.Second), "upstream timed out"},
+	)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = api.endpoint()
+	cfg.Logs.StartAt = startAtBeginning
+	cfg.ExcludedImages = []string{"excluded"}
+	cfg.ContainerLabelsToMetricLabels = map[string]string{"app.label": "app"}
+	cfg.EnvVarsToMetricLabels = map[string]string{"APP_VERSION": "version"}
+
+	ctx := context.Background()
+	storageDir := t.TempDir()
+	sink := new(consumertest.LogsSink)
+	rcvr := newReceiver(componenttest.NewNopReceiverCreateSettings(), cfg, sink)
+	require.NoError(t, rcvr.Start(ctx, storagetest.NewStorageHost(t, storageDir, "test")))
+
+	require.Eventually(t, func() bool { return sink.LogRecordCount() == 2 }, 5*time.Second, 10*time.Millisecond)
+	got := records(sink)
+	webResource := map[string]interface{}{
+		"container.id":         "c1c1c1",
+		"container.name":       "web",
+		"container.image.name": "nginx",
+		"container.hostname":   "c1c1",
+		"app":                  "frontend",
+		"version":              "1.2",
+	}
+	assert.Equal(t, webResource, got["listening"].resource)
+	assert.Equal(t, pdata.NewTimestampFromTime(base), got["listening"].Timestamp())
+	assert.Equal(t, map[string]interface{}{"log.iostream": "stdout"}, got["listening"].Attributes().AsRaw())
+	assert.Equal(t, map[string]interface{}{"log.iostream": "stderr"}, got["upstream timed out"].Attributes().AsRaw())
+
+	// A container started after the receiver is followed from the beginning of its logs.
+	api.addContainer("c2c2c2", "worker", "busybox", true, nil, nil)
+	api.addLogs("c2c2c2", fakeLogLine{stdoutStreamID, base, "working"})
+	api.sendEvent("start", "c2c2c2", map[string]string{"image": "busybox", "name": "worker"})
+	api.sendEvent("die", "c1c1c1", map[string]string{"image": "nginx", "name": "web", "exitCode": "1", "app.label": "frontend"})
+	api.sendEvent("oom", "c4c4c4", map[string]string{"image": "excluded", "name": "skipped"})
+	api.sendEvent("health_status: unhealthy", "c2c2c2", map[string]string{"image": "busybox", "name": "worker"})
+	api.sendEvent("destroy", "c3c3c3", map[string]string{"image": "alpine", "name": "gone", "app.label": "batch"})
+
+	require.Eventually(t, func() bool { return sink.LogRecordCount() == 7 }, 5*time.Second, 10*time.Millisecond)
+	got = records(sink)
+
+	assert.Equal(t, "c2c2c2", got["working"].resource["container.id"])
+	assert.Equal(t, map[string]interface{}{"log.iostream": "stdout"}, got["working"].Attributes().AsRaw())
+
+	assert.Equal(t, map[string]interface{}{
+		"docker.event.type":   "container",
+		"docker.event.action": "start",
+	}, got["start"].Attributes().AsRaw())
+	assert.Equal(t, pdata.SeverityNumberINFO, got["start"].SeverityNumber())
+
+	assert.Equal(t, webResource, got["die"].resource)
+	assert.Equal(t, map[string]interface{}{
+		"docker.event.type":      "container",
+		"docker.event.action":    "die",
+		"docker.event.exit_code": int64(1),
+	}, got["die"].Attributes().AsRaw())
+	assert.Equal(t, pdata.SeverityNumberWARN, got["die"].SeverityNumber())
+
+	assert.Equal(t, map[string]interface{}{
+		"docker.event.type":          "container",
+		"docker.event.action":        "health_status",
+		"docker.event.health_status": "unhealthy",
+	}, got["health_status: unhealthy"].Attributes().AsRaw())
+	assert.Equal(t, pdata.SeverityNumberWARN, got["health_status: unhealthy"].SeverityNumber())
+
+	// Removed containers are described by the event attributes.
+	assert.Equal(t, map[string]interface{}{
+		"container.id":         "c3c3c3",
+		"container.name":       "gone",
+		"container.image.name": "alpine",
+		"app":                  "batch",
+	}, got["destroy"].resource)
+
+	require.NoError(t, rcvr.Shutdown(ctx))
+
+	// After a restart the logs are read from the checkpoints.
+	api.addLogs("c1c1c1", fakeLogLine{stdoutStreamID, base.Add(2 * time.Second), "reloaded"})
+	sink.Reset()
+	rcvr = newReceiver(componenttest.NewNopReceiverCreateSettings(), cfg, sink)
+	require.NoError(t, rcvr.Start(ctx, storagetest.NewStorageHost(t, storageDir, "test")))
+	require.Eventually(t, func() bool { return sink.LogRecordCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, rcvr.Shutdown(ctx))
+
+	got = records(sink)
+	require.Len(t, got, 1)
+	assert.Contains(t, got, "reloaded")
+}
+
+func TestStartAtEnd(t *testing.T) {
+	api := newFakeDocker(t)
+	api.addContainer("c1c1c1", "web", "nginx", false, nil, nil)
+	api.addLogs("c1c1c1", fakeLogLine{stdoutStreamID, time.Now(), "old line"})
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = api.endpoint()
+	cfg.Events.Enabled = false
+
+	sink := new(consumertest.LogsSink)
+	rcvr := newReceiver(componenttest.NewNopReceiverCreateSettings(), cfg, sink)
+	require.NoError(t, rcvr.Start(context.Background(), componenttest.NewNopHost()))
+
+	// The start event of a new container is still watched to follow its logs.
+	api.addContainer("c2c2c2", "worker", "busybox", false, nil, nil)
+	api.addLogs("c2c2c2", fakeLogLine{stdoutStreamID, time.Now(), "new line"})
+	api.sendEvent("start", "c2c2c2", nil)
+
+	require.Eventually(t, func() bool { return sink.LogRecordCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, rcvr.Shutdown(context.Background()))
+	assert.Equal(t, []string{"new line"}, keys(records(sink)))
+}
+
+func keys(m map[string]record) []string {
+	var out []string
+	for k := range m {
+		out = append(out, k)
+	}
+	return out
+}
+
+func TestReceiverSkipsInvalidLines(t *testing.T) {
+	base := time.Date(2021, 12, 10, 18, 47, 7, 0, time.UTC)
+	api := newFakeDocker(t)
+	api.addContainer("c1c1c1", "web", "nginx", false, nil, nil)
+	api.addLogs("c1c1c1",
+		fakeLogLine{stdoutStreamID, base, "first"},
+		fakeLogLine{stdoutStreamID, time.Time{}, "no timestamp"},
+		fakeLogLine{stdoutStreamID, base.Add(time.Second), "second"},
+	)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = api.endpoint()
+	cfg.Logs.StartAt = startAtBeginning
+	cfg.Events.Enabled = false
+
+	sink := new(consumertest.LogsSink)
+	rcvr := newReceiver(componenttest.NewNopReceiverCreateSettings(), cfg, sink)
+	require.NoError(t, rcvr.Start(context.Background(), componenttest.NewNopHost()))
+	require.Eventually(t, func() bool { return sink.LogRecordCount() == 2 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, rcvr.Shutdown(context.Background()))
+
+	assert.ElementsMatch(t, []string{"first", "second"}, keys(records(sink)))
+}
+
+func TestReceiverConsumeError(t *testing.T) {
+	api := newFakeDocker(t)
+	api.addContainer("c1c1c1", "web", "nginx", false, nil, nil)
+	api.addLogs("c1c1c1", fakeLogLine{stdoutStreamID, time.Now(), "rejected"})
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = api.endpoint()
+	cfg.Logs.StartAt = startAtBeginning
+	cfg.Events.Enabled = false
+
+	ctx := context.Background()
+	storageDir := t.TempDir()
+	rcvr := newReceiver(componenttest.NewNopReceiverCreateSettings(), cfg, consumertest.NewErr(errors.New("rejected")))
+	require.NoError(t, rcvr.Start(ctx, storagetest.NewStorageHost(t, storageDir, "test")))
+	require.Eventually(t, func() bool {
+		rcvr.followersLock.Lock()
+		f := rcvr.followers["c1c1c1"]
+		rcvr.followersLock.Unlock()
+		select {
+		case <-f.done:
+			return true
+		default:
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, rcvr.Shutdown(ctx))
+
+	// The rejected logs are read again after a restart.
+	sink := new(consumertest.LogsSink)
+	rcvr = newReceiver(componenttest.NewNopReceiverCreateSettings(), cfg, sink)
+	require.NoError(t, rcvr.Start(ctx, storagetest.NewStorageHost(t, storageDir, "test")))
+	require.Eventually(t, func() bool { return sink.LogRecordCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, rcvr.Shutdown(ctx))
+}
+
+func TestReceiverEventConsumeError(t *testing.T) {
+	api := newFakeDocker(t)
+	api.addContainer("c1c1c1", "web", "nginx", false, nil, nil)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = api.endpoint()
+	cfg.Logs.Enabled = false
+
+	core, observed := observer.New(zap.ErrorLevel)
+	set := componenttest.NewNopReceiverCreateSettings()
+	set.Logger = zap.New(core)
+	ctx := context.Background()
+	rcvr := newReceiver(set, cfg, consumertest.NewErr(errors.New("rejected")))
+	require.NoError(t, rcvr.Start(ctx, componenttest.NewNopHost()))
+
+	api.sendEvent("die", "c1c1c1", map[string]string{"image": "nginx", "name": "web", "exitCode": "0"})
+	require.Eventually(t, func() bool {
+		return observed.FilterMessage("Failed to consume docker logs").Len() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, rcvr.Shutdown(ctx))
+
+	// The checkpoint does not move past the rejected event.
+	_, ok, err := rcvr.checkpoints.get(ctx, eventsCheckpointKey)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestErrorsInStart(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "..not/a/valid/endpoint"
+	rcvr := newReceiver(componenttest.NewNopReceiverCreateSettings(), cfg, consumertest.NewNop())
+	err := rcvr.Start(context.Background(), componenttest.NewNopHost())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to parse docker host")
+
+	cfg.Endpoint = "unix:///not/a/thing.sock"
+	cfg.Timeout = 100 * time.Millisecond
+	assert.Error(t, rcvr.Start(context.Background(), componenttest.NewNopHost()))
+
+	require.NoError(t, rcvr.Shutdown(context.Background()))
+}
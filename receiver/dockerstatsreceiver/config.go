@@ -21,6 +21,8 @@ import (
 
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver/internal/metadata"
 )
 
 var _ config.Receiver = (*Config)(nil)
@@ -52,10 +54,14 @@ type Config struct {
 	ExcludedImages []string `mapstructure:"excluded_images"`
 
 	// Whether to report all CPU metrics.  Default is false
+	// Deprecated: enable the container.cpu.usage.percpu metric in Metrics instead.
 	ProvidePerCoreCPUMetrics bool `mapstructure:"provide_per_core_cpu_metrics"`
 
 	// Docker client API version. Default is 1.22
 	DockerAPIVersion float64 `mapstructure:"api_version"`
+
+	// Metrics allows customizing the metrics emitted by the receiver.
+	Metrics metadata.MetricsSettings `mapstructure:"metrics"`
 }
 
 func (config Config) Validate() error {
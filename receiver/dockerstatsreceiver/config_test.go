@@ -25,6 +25,8 @@ import (
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/configtest"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver/internal/metadata"
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -78,6 +80,11 @@ func TestLoadConfig(t *testing.T) {
 	}, ascfg.EnvVarsToMetricLabels)
 
 	assert.True(t, ascfg.ProvidePerCoreCPUMetrics)
+
+	expectedMetrics := metadata.DefaultMetricsSettings()
+	expectedMetrics.ContainerCPUUsageSystem.Enabled = false
+	expectedMetrics.ContainerPidsCount.Enabled = true
+	assert.Equal(t, expectedMetrics, ascfg.Metrics)
 }
 
 func TestValidateErrors(t *testing.T) {
@@ -10,7 +10,6 @@ require (
 	go.opentelemetry.io/collector v0.41.1-0.20211210184707-4dcb3388a168
 	go.opentelemetry.io/collector/model v0.41.1-0.20211210184707-4dcb3388a168
 	go.uber.org/zap v1.19.1
-
 )
 
 require go.uber.org/multierr v1.7.0
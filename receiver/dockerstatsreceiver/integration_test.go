@@ -92,7 +92,7 @@ func TestAllMetricsIntegration(t *testing.T) {
 
 	consumer := new(consumertest.MetricsSink)
 	f, config := factory()
-	config.ProvidePerCoreCPUMetrics = true
+	config.Metrics.ContainerCPUUsagePercpu.Enabled = true
 
 	params, ctx, cancel := paramsAndContext(t)
 	defer cancel()
@@ -0,0 +1,6147 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// MetricSettings provides common settings for a particular metric.
+type MetricSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// MetricsSettings provides settings for dockerstatsreceiver metrics.
+type MetricsSettings struct {
+	ContainerBlockioIoMergedRecursive          MetricSettings `mapstructure:"container.blockio.io_merged_recursive"`
+	ContainerBlockioIoQueuedRecursive          MetricSettings `mapstructure:"container.blockio.io_queued_recursive"`
+	ContainerBlockioIoServiceBytesRecursive    MetricSettings `mapstructure:"container.blockio.io_service_bytes_recursive"`
+	ContainerBlockioIoServiceTimeRecursive     MetricSettings `mapstructure:"container.blockio.io_service_time_recursive"`
+	ContainerBlockioIoServicedRecursive        MetricSettings `mapstructure:"container.blockio.io_serviced_recursive"`
+	ContainerBlockioIoTimeRecursive            MetricSettings `mapstructure:"container.blockio.io_time_recursive"`
+	ContainerBlockioIoWaitTimeRecursive        MetricSettings `mapstructure:"container.blockio.io_wait_time_recursive"`
+	ContainerBlockioSectorsRecursive           MetricSettings `mapstructure:"container.blockio.sectors_recursive"`
+	ContainerCPUPercent                        MetricSettings `mapstructure:"container.cpu.percent"`
+	ContainerCPUThrottlingDataPeriods          MetricSettings `mapstructure:"container.cpu.throttling_data.periods"`
+	ContainerCPUThrottlingDataThrottledPeriods MetricSettings `mapstructure:"container.cpu.throttling_data.throttled_periods"`
+	ContainerCPUThrottlingDataThrottledTime    MetricSettings `mapstructure:"container.cpu.throttling_data.throttled_time"`
+	ContainerCPUUsageKernelmode                MetricSettings `mapstructure:"container.cpu.usage.kernelmode"`
+	ContainerCPUUsagePercpu                    MetricSettings `mapstructure:"container.cpu.usage.percpu"`
+	ContainerCPUUsageSystem                    MetricSettings `mapstructure:"container.cpu.usage.system"`
+	ContainerCPUUsageTotal                     MetricSettings `mapstructure:"container.cpu.usage.total"`
+	ContainerCPUUsageUsermode                  MetricSettings `mapstructure:"container.cpu.usage.usermode"`
+	ContainerMemoryActiveAnon                  MetricSettings `mapstructure:"container.memory.active_anon"`
+	ContainerMemoryActiveFile                  MetricSettings `mapstructure:"container.memory.active_file"`
+	ContainerMemoryAnon                        MetricSettings `mapstructure:"container.memory.anon"`
+	ContainerMemoryAnonThp                     MetricSettings `mapstructure:"container.memory.anon_thp"`
+	ContainerMemoryCache                       MetricSettings `mapstructure:"container.memory.cache"`
+	ContainerMemoryDirty                       MetricSettings `mapstructure:"container.memory.dirty"`
+	ContainerMemoryFile                        MetricSettings `mapstructure:"container.memory.file"`
+	ContainerMemoryFileDirty                   MetricSettings `mapstructure:"container.memory.file_dirty"`
+	ContainerMemoryFileMapped                  MetricSettings `mapstructure:"container.memory.file_mapped"`
+	ContainerMemoryFileThp                     MetricSettings `mapstructure:"container.memory.file_thp"`
+	ContainerMemoryFileWriteback               MetricSettings `mapstructure:"container.memory.file_writeback"`
+	ContainerMemoryHierarchicalMemoryLimit     MetricSettings `mapstructure:"container.memory.hierarchical_memory_limit"`
+	ContainerMemoryHierarchicalMemswLimit      MetricSettings `mapstructure:"container.memory.hierarchical_memsw_limit"`
+	ContainerMemoryInactiveAnon                MetricSettings `mapstructure:"container.memory.inactive_anon"`
+	ContainerMemoryInactiveFile                MetricSettings `mapstructure:"container.memory.inactive_file"`
+	ContainerMemoryKernelStack                 MetricSettings `mapstructure:"container.memory.kernel_stack"`
+	ContainerMemoryMappedFile                  MetricSettings `mapstructure:"container.memory.mapped_file"`
+	ContainerMemoryPagetables                  MetricSettings `mapstructure:"container.memory.pagetables"`
+	ContainerMemoryPercent                     MetricSettings `mapstructure:"container.memory.percent"`
+	ContainerMemoryPercpu                      MetricSettings `mapstructure:"container.memory.percpu"`
+	ContainerMemoryPgactivate                  MetricSettings `mapstructure:"container.memory.pgactivate"`
+	ContainerMemoryPgdeactivate                MetricSettings `mapstructure:"container.memory.pgdeactivate"`
+	ContainerMemoryPgfault                     MetricSettings `mapstructure:"container.memory.pgfault"`
+	ContainerMemoryPglazyfree                  MetricSettings `mapstructure:"container.memory.pglazyfree"`
+	ContainerMemoryPglazyfreed                 MetricSettings `mapstructure:"container.memory.pglazyfreed"`
+	ContainerMemoryPgmajfault                  MetricSettings `mapstructure:"container.memory.pgmajfault"`
+	ContainerMemoryPgpgin                      MetricSettings `mapstructure:"container.memory.pgpgin"`
+	ContainerMemoryPgpgout                     MetricSettings `mapstructure:"container.memory.pgpgout"`
+	ContainerMemoryPgrefill                    MetricSettings `mapstructure:"container.memory.pgrefill"`
+	ContainerMemoryPgscan                      MetricSettings `mapstructure:"container.memory.pgscan"`
+	ContainerMemoryPgsteal                     MetricSettings `mapstructure:"container.memory.pgsteal"`
+	ContainerMemoryRss                         MetricSettings `mapstructure:"container.memory.rss"`
+	ContainerMemoryRssHuge                     MetricSettings `mapstructure:"container.memory.rss_huge"`
+	ContainerMemoryShmem                       MetricSettings `mapstructure:"container.memory.shmem"`
+	ContainerMemoryShmemThp                    MetricSettings `mapstructure:"container.memory.shmem_thp"`
+	ContainerMemorySlab                        MetricSettings `mapstructure:"container.memory.slab"`
+	ContainerMemorySlabReclaimable             MetricSettings `mapstructure:"container.memory.slab_reclaimable"`
+	ContainerMemorySlabUnreclaimable           MetricSettings `mapstructure:"container.memory.slab_unreclaimable"`
+	ContainerMemorySock                        MetricSettings `mapstructure:"container.memory.sock"`
+	ContainerMemoryThpCollapseAlloc            MetricSettings `mapstructure:"container.memory.thp_collapse_alloc"`
+	ContainerMemoryThpFaultAlloc               MetricSettings `mapstructure:"container.memory.thp_fault_alloc"`
+	ContainerMemoryTotalActiveAnon             MetricSettings `mapstructure:"container.memory.total_active_anon"`
+	ContainerMemoryTotalActiveFile             MetricSettings `mapstructure:"container.memory.total_active_file"`
+	ContainerMemoryTotalCache                  MetricSettings `mapstructure:"container.memory.total_cache"`
+	ContainerMemoryTotalDirty                  MetricSettings `mapstructure:"container.memory.total_dirty"`
+	ContainerMemoryTotalInactiveAnon           MetricSettings `mapstructure:"container.memory.total_inactive_anon"`
+	ContainerMemoryTotalInactiveFile           MetricSettings `mapstructure:"container.memory.total_inactive_file"`
+	ContainerMemoryTotalMappedFile             MetricSettings `mapstructure:"container.memory.total_mapped_file"`
+	ContainerMemoryTotalPgfault                MetricSettings `mapstructure:"container.memory.total_pgfault"`
+	ContainerMemoryTotalPgmajfault             MetricSettings `mapstructure:"container.memory.total_pgmajfault"`
+	ContainerMemoryTotalPgpgin                 MetricSettings `mapstructure:"container.memory.total_pgpgin"`
+	ContainerMemoryTotalPgpgout                MetricSettings `mapstructure:"container.memory.total_pgpgout"`
+	ContainerMemoryTotalRss                    MetricSettings `mapstructure:"container.memory.total_rss"`
+	ContainerMemoryTotalRssHuge                MetricSettings `mapstructure:"container.memory.total_rss_huge"`
+	ContainerMemoryTotalUnevictable            MetricSettings `mapstructure:"container.memory.total_unevictable"`
+	ContainerMemoryTotalWriteback              MetricSettings `mapstructure:"container.memory.total_writeback"`
+	ContainerMemoryUnevictable                 MetricSettings `mapstructure:"container.memory.unevictable"`
+	ContainerMemoryUsageLimit                  MetricSettings `mapstructure:"container.memory.usage.limit"`
+	ContainerMemoryUsageMax                    MetricSettings `mapstructure:"container.memory.usage.max"`
+	ContainerMemoryUsageTotal                  MetricSettings `mapstructure:"container.memory.usage.total"`
+	ContainerMemoryWorkingsetActivateAnon      MetricSettings `mapstructure:"container.memory.workingset_activate_anon"`
+	ContainerMemoryWorkingsetActivateFile      MetricSettings `mapstructure:"container.memory.workingset_activate_file"`
+	ContainerMemoryWorkingsetNodereclaim       MetricSettings `mapstructure:"container.memory.workingset_nodereclaim"`
+	ContainerMemoryWorkingsetRefaultAnon       MetricSettings `mapstructure:"container.memory.workingset_refault_anon"`
+	ContainerMemoryWorkingsetRefaultFile       MetricSettings `mapstructure:"container.memory.workingset_refault_file"`
+	ContainerMemoryWorkingsetRestoreAnon       MetricSettings `mapstructure:"container.memory.workingset_restore_anon"`
+	ContainerMemoryWorkingsetRestoreFile       MetricSettings `mapstructure:"container.memory.workingset_restore_file"`
+	ContainerMemoryWriteback                   MetricSettings `mapstructure:"container.memory.writeback"`
+	ContainerNetworkIoUsageRxBytes             MetricSettings `mapstructure:"container.network.io.usage.rx_bytes"`
+	ContainerNetworkIoUsageRxDropped           MetricSettings `mapstructure:"container.network.io.usage.rx_dropped"`
+	ContainerNetworkIoUsageRxErrors            MetricSettings `mapstructure:"container.network.io.usage.rx_errors"`
+	ContainerNetworkIoUsageRxPackets           MetricSettings `mapstructure:"container.network.io.usage.rx_packets"`
+	ContainerNetworkIoUsageTxBytes             MetricSettings `mapstructure:"container.network.io.usage.tx_bytes"`
+	ContainerNetworkIoUsageTxDropped           MetricSettings `mapstructure:"container.network.io.usage.tx_dropped"`
+	ContainerNetworkIoUsageTxErrors            MetricSettings `mapstructure:"container.network.io.usage.tx_errors"`
+	ContainerNetworkIoUsageTxPackets           MetricSettings `mapstructure:"container.network.io.usage.tx_packets"`
+	ContainerPidsCount                         MetricSettings `mapstructure:"container.pids.count"`
+	ContainerPidsLimit                         MetricSettings `mapstructure:"container.pids.limit"`
+	ContainerRestarts                          MetricSettings `mapstructure:"container.restarts"`
+	ContainerUptime                            MetricSettings `mapstructure:"container.uptime"`
+}
+
+func DefaultMetricsSettings() MetricsSettings {
+	return MetricsSettings{
+		ContainerBlockioIoMergedRecursive: MetricSettings{
+			Enabled: true,
+		},
+		ContainerBlockioIoQueuedRecursive: MetricSettings{
+			Enabled: true,
+		},
+		ContainerBlockioIoServiceBytesRecursive: MetricSettings{
+			Enabled: true,
+		},
+		ContainerBlockioIoServiceTimeRecursive: MetricSettings{
+			Enabled: true,
+		},
+		ContainerBlockioIoServicedRecursive: MetricSettings{
+			Enabled: true,
+		},
+		ContainerBlockioIoTimeRecursive: MetricSettings{
+			Enabled: true,
+		},
+		ContainerBlockioIoWaitTimeRecursive: MetricSettings{
+			Enabled: true,
+		},
+		ContainerBlockioSectorsRecursive: MetricSettings{
+			Enabled: true,
+		},
+		ContainerCPUPercent: MetricSettings{
+			Enabled: true,
+		},
+		ContainerCPUThrottlingDataPeriods: MetricSettings{
+			Enabled: true,
+		},
+		ContainerCPUThrottlingDataThrottledPeriods: MetricSettings{
+			Enabled: true,
+		},
+		ContainerCPUThrottlingDataThrottledTime: MetricSettings{
+			Enabled: true,
+		},
+		ContainerCPUUsageKernelmode: MetricSettings{
+			Enabled: true,
+		},
+		ContainerCPUUsagePercpu: MetricSettings{
+			Enabled: false,
+		},
+		ContainerCPUUsageSystem: MetricSettings{
+			Enabled: true,
+		},
+		ContainerCPUUsageTotal: MetricSettings{
+			Enabled: true,
+		},
+		ContainerCPUUsageUsermode: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryActiveAnon: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryActiveFile: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryAnon: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryAnonThp: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryCache: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryDirty: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryFile: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryFileDirty: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryFileMapped: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryFileThp: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryFileWriteback: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryHierarchicalMemoryLimit: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryHierarchicalMemswLimit: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryInactiveAnon: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryInactiveFile: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryKernelStack: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryMappedFile: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryPagetables: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryPercent: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryPercpu: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryPgactivate: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryPgdeactivate: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryPgfault: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryPglazyfree: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryPglazyfreed: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryPgmajfault: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryPgpgin: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryPgpgout: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryPgrefill: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryPgscan: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryPgsteal: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryRss: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryRssHuge: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryShmem: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryShmemThp: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemorySlab: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemorySlabReclaimable: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemorySlabUnreclaimable: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemorySock: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryThpCollapseAlloc: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryThpFaultAlloc: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryTotalActiveAnon: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryTotalActiveFile: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryTotalCache: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryTotalDirty: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryTotalInactiveAnon: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryTotalInactiveFile: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryTotalMappedFile: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryTotalPgfault: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryTotalPgmajfault: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryTotalPgpgin: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryTotalPgpgout: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryTotalRss: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryTotalRssHuge: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryTotalUnevictable: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryTotalWriteback: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryUnevictable: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryUsageLimit: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryUsageMax: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryUsageTotal: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryWorkingsetActivateAnon: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryWorkingsetActivateFile: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryWorkingsetNodereclaim: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryWorkingsetRefaultAnon: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryWorkingsetRefaultFile: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryWorkingsetRestoreAnon: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryWorkingsetRestoreFile: MetricSettings{
+			Enabled: true,
+		},
+		ContainerMemoryWriteback: MetricSettings{
+			Enabled: true,
+		},
+		ContainerNetworkIoUsageRxBytes: MetricSettings{
+			Enabled: true,
+		},
+		ContainerNetworkIoUsageRxDropped: MetricSettings{
+			Enabled: true,
+		},
+		ContainerNetworkIoUsageRxErrors: MetricSettings{
+			Enabled: true,
+		},
+		ContainerNetworkIoUsageRxPackets: MetricSettings{
+			Enabled: true,
+		},
+		ContainerNetworkIoUsageTxBytes: MetricSettings{
+			Enabled: true,
+		},
+		ContainerNetworkIoUsageTxDropped: MetricSettings{
+			Enabled: true,
+		},
+		ContainerNetworkIoUsageTxErrors: MetricSettings{
+			Enabled: true,
+		},
+		ContainerNetworkIoUsageTxPackets: MetricSettings{
+			Enabled: true,
+		},
+		ContainerPidsCount: MetricSettings{
+			Enabled: false,
+		},
+		ContainerPidsLimit: MetricSettings{
+			Enabled: false,
+		},
+		ContainerRestarts: MetricSettings{
+			Enabled: false,
+		},
+		ContainerUptime: MetricSettings{
+			Enabled: false,
+		},
+	}
+}
+
+type metricContainerBlockioIoMergedRecursive struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.blockio.io_merged_recursive metric with initial data.
+func (m *metricContainerBlockioIoMergedRecursive) init() {
+	m.data.SetName("container.blockio.io_merged_recursive")
+	m.data.SetDescription("Number of bios/requests merged into requests belonging to this cgroup and its descendant cgroups (Only available with cgroups v1).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricContainerBlockioIoMergedRecursive) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, deviceMajorAttributeValue string, deviceMinorAttributeValue string, operationAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.DeviceMajor, pdata.NewAttributeValueString(deviceMajorAttributeValue))
+	dp.Attributes().Insert(A.DeviceMinor, pdata.NewAttributeValueString(deviceMinorAttributeValue))
+	dp.Attributes().Insert(A.Operation, pdata.NewAttributeValueString(operationAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerBlockioIoMergedRecursive) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerBlockioIoMergedRecursive) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerBlockioIoMergedRecursive(settings MetricSettings) metricContainerBlockioIoMergedRecursive {
+	m := metricContainerBlockioIoMergedRecursive{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerBlockioIoQueuedRecursive struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.blockio.io_queued_recursive metric with initial data.
+func (m *metricContainerBlockioIoQueuedRecursive) init() {
+	m.data.SetName("container.blockio.io_queued_recursive")
+	m.data.SetDescription("Number of requests queued up for this cgroup and its descendant cgroups (Only available with cgroups v1).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricContainerBlockioIoQueuedRecursive) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, deviceMajorAttributeValue string, deviceMinorAttributeValue string, operationAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.DeviceMajor, pdata.NewAttributeValueString(deviceMajorAttributeValue))
+	dp.Attributes().Insert(A.DeviceMinor, pdata.NewAttributeValueString(deviceMinorAttributeValue))
+	dp.Attributes().Insert(A.Operation, pdata.NewAttributeValueString(operationAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerBlockioIoQueuedRecursive) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerBlockioIoQueuedRecursive) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerBlockioIoQueuedRecursive(settings MetricSettings) metricContainerBlockioIoQueuedRecursive {
+	m := metricContainerBlockioIoQueuedRecursive{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerBlockioIoServiceBytesRecursive struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.blockio.io_service_bytes_recursive metric with initial data.
+func (m *metricContainerBlockioIoServiceBytesRecursive) init() {
+	m.data.SetName("container.blockio.io_service_bytes_recursive")
+	m.data.SetDescription("Number of bytes transferred to/from the disk by the group and descendant groups.")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricContainerBlockioIoServiceBytesRecursive) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, deviceMajorAttributeValue string, deviceMinorAttributeValue string, operationAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.DeviceMajor, pdata.NewAttributeValueString(deviceMajorAttributeValue))
+	dp.Attributes().Insert(A.DeviceMinor, pdata.NewAttributeValueString(deviceMinorAttributeValue))
+	dp.Attributes().Insert(A.Operation, pdata.NewAttributeValueString(operationAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerBlockioIoServiceBytesRecursive) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerBlockioIoServiceBytesRecursive) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerBlockioIoServiceBytesRecursive(settings MetricSettings) metricContainerBlockioIoServiceBytesRecursive {
+	m := metricContainerBlockioIoServiceBytesRecursive{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerBlockioIoServiceTimeRecursive struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.blockio.io_service_time_recursive metric with initial data.
+func (m *metricContainerBlockioIoServiceTimeRecursive) init() {
+	m.data.SetName("container.blockio.io_service_time_recursive")
+	m.data.SetDescription("Total amount of time in nanoseconds between request dispatch and request completion for the IOs done by this cgroup and descendant cgroups (Only available with cgroups v1).")
+	m.data.SetUnit("ns")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricContainerBlockioIoServiceTimeRecursive) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, deviceMajorAttributeValue string, deviceMinorAttributeValue string, operationAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.DeviceMajor, pdata.NewAttributeValueString(deviceMajorAttributeValue))
+	dp.Attributes().Insert(A.DeviceMinor, pdata.NewAttributeValueString(deviceMinorAttributeValue))
+	dp.Attributes().Insert(A.Operation, pdata.NewAttributeValueString(operationAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerBlockioIoServiceTimeRecursive) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerBlockioIoServiceTimeRecursive) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerBlockioIoServiceTimeRecursive(settings MetricSettings) metricContainerBlockioIoServiceTimeRecursive {
+	m := metricContainerBlockioIoServiceTimeRecursive{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerBlockioIoServicedRecursive struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.blockio.io_serviced_recursive metric with initial data.
+func (m *metricContainerBlockioIoServicedRecursive) init() {
+	m.data.SetName("container.blockio.io_serviced_recursive")
+	m.data.SetDescription("Number of IOs (bio) issued to the disk by the group and descendant groups.")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricContainerBlockioIoServicedRecursive) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, deviceMajorAttributeValue string, deviceMinorAttributeValue string, operationAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.DeviceMajor, pdata.NewAttributeValueString(deviceMajorAttributeValue))
+	dp.Attributes().Insert(A.DeviceMinor, pdata.NewAttributeValueString(deviceMinorAttributeValue))
+	dp.Attributes().Insert(A.Operation, pdata.NewAttributeValueString(operationAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerBlockioIoServicedRecursive) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerBlockioIoServicedRecursive) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerBlockioIoServicedRecursive(settings MetricSettings) metricContainerBlockioIoServicedRecursive {
+	m := metricContainerBlockioIoServicedRecursive{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerBlockioIoTimeRecursive struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.blockio.io_time_recursive metric with initial data.
+func (m *metricContainerBlockioIoTimeRecursive) init() {
+	m.data.SetName("container.blockio.io_time_recursive")
+	m.data.SetDescription("Disk time allocated to cgroup (and descendant cgroups) per device in milliseconds (Only available with cgroups v1).")
+	m.data.SetUnit("ms")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricContainerBlockioIoTimeRecursive) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, deviceMajorAttributeValue string, deviceMinorAttributeValue string, operationAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.DeviceMajor, pdata.NewAttributeValueString(deviceMajorAttributeValue))
+	dp.Attributes().Insert(A.DeviceMinor, pdata.NewAttributeValueString(deviceMinorAttributeValue))
+	dp.Attributes().Insert(A.Operation, pdata.NewAttributeValueString(operationAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerBlockioIoTimeRecursive) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerBlockioIoTimeRecursive) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerBlockioIoTimeRecursive(settings MetricSettings) metricContainerBlockioIoTimeRecursive {
+	m := metricContainerBlockioIoTimeRecursive{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerBlockioIoWaitTimeRecursive struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.blockio.io_wait_time_recursive metric with initial data.
+func (m *metricContainerBlockioIoWaitTimeRecursive) init() {
+	m.data.SetName("container.blockio.io_wait_time_recursive")
+	m.data.SetDescription("Total amount of time the IOs for this cgroup (and descendant cgroups) spent waiting in the scheduler queues for service (Only available with cgroups v1).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricContainerBlockioIoWaitTimeRecursive) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, deviceMajorAttributeValue string, deviceMinorAttributeValue string, operationAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.DeviceMajor, pdata.NewAttributeValueString(deviceMajorAttributeValue))
+	dp.Attributes().Insert(A.DeviceMinor, pdata.NewAttributeValueString(deviceMinorAttributeValue))
+	dp.Attributes().Insert(A.Operation, pdata.NewAttributeValueString(operationAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerBlockioIoWaitTimeRecursive) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerBlockioIoWaitTimeRecursive) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerBlockioIoWaitTimeRecursive(settings MetricSettings) metricContainerBlockioIoWaitTimeRecursive {
+	m := metricContainerBlockioIoWaitTimeRecursive{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerBlockioSectorsRecursive struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.blockio.sectors_recursive metric with initial data.
+func (m *metricContainerBlockioSectorsRecursive) init() {
+	m.data.SetName("container.blockio.sectors_recursive")
+	m.data.SetDescription("Number of sectors transferred to/from disk by the group and descendant groups (Only available with cgroups v1).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricContainerBlockioSectorsRecursive) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, deviceMajorAttributeValue string, deviceMinorAttributeValue string, operationAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.DeviceMajor, pdata.NewAttributeValueString(deviceMajorAttributeValue))
+	dp.Attributes().Insert(A.DeviceMinor, pdata.NewAttributeValueString(deviceMinorAttributeValue))
+	dp.Attributes().Insert(A.Operation, pdata.NewAttributeValueString(operationAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerBlockioSectorsRecursive) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerBlockioSectorsRecursive) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerBlockioSectorsRecursive(settings MetricSettings) metricContainerBlockioSectorsRecursive {
+	m := metricContainerBlockioSectorsRecursive{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerCPUPercent struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.cpu.percent metric with initial data.
+func (m *metricContainerCPUPercent) init() {
+	m.data.SetName("container.cpu.percent")
+	m.data.SetDescription("Percent of CPU used by the container.")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerCPUPercent) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerCPUPercent) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerCPUPercent) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerCPUPercent(settings MetricSettings) metricContainerCPUPercent {
+	m := metricContainerCPUPercent{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerCPUThrottlingDataPeriods struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.cpu.throttling_data.periods metric with initial data.
+func (m *metricContainerCPUThrottlingDataPeriods) init() {
+	m.data.SetName("container.cpu.throttling_data.periods")
+	m.data.SetDescription("Number of periods with throttling active.")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerCPUThrottlingDataPeriods) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerCPUThrottlingDataPeriods) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerCPUThrottlingDataPeriods) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerCPUThrottlingDataPeriods(settings MetricSettings) metricContainerCPUThrottlingDataPeriods {
+	m := metricContainerCPUThrottlingDataPeriods{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerCPUThrottlingDataThrottledPeriods struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.cpu.throttling_data.throttled_periods metric with initial data.
+func (m *metricContainerCPUThrottlingDataThrottledPeriods) init() {
+	m.data.SetName("container.cpu.throttling_data.throttled_periods")
+	m.data.SetDescription("Number of periods when the container hits its throttling limit.")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerCPUThrottlingDataThrottledPeriods) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerCPUThrottlingDataThrottledPeriods) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerCPUThrottlingDataThrottledPeriods) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerCPUThrottlingDataThrottledPeriods(settings MetricSettings) metricContainerCPUThrottlingDataThrottledPeriods {
+	m := metricContainerCPUThrottlingDataThrottledPeriods{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerCPUThrottlingDataThrottledTime struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.cpu.throttling_data.throttled_time metric with initial data.
+func (m *metricContainerCPUThrottlingDataThrottledTime) init() {
+	m.data.SetName("container.cpu.throttling_data.throttled_time")
+	m.data.SetDescription("Aggregate time the container was throttled.")
+	m.data.SetUnit("ns")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerCPUThrottlingDataThrottledTime) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerCPUThrottlingDataThrottledTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerCPUThrottlingDataThrottledTime) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerCPUThrottlingDataThrottledTime(settings MetricSettings) metricContainerCPUThrottlingDataThrottledTime {
+	m := metricContainerCPUThrottlingDataThrottledTime{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerCPUUsageKernelmode struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.cpu.usage.kernelmode metric with initial data.
+func (m *metricContainerCPUUsageKernelmode) init() {
+	m.data.SetName("container.cpu.usage.kernelmode")
+	m.data.SetDescription("Time spent by tasks of the cgroup in kernel mode (Linux). Time spent by all container processes in kernel mode (Windows).")
+	m.data.SetUnit("ns")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerCPUUsageKernelmode) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerCPUUsageKernelmode) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerCPUUsageKernelmode) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerCPUUsageKernelmode(settings MetricSettings) metricContainerCPUUsageKernelmode {
+	m := metricContainerCPUUsageKernelmode{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerCPUUsagePercpu struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.cpu.usage.percpu metric with initial data.
+func (m *metricContainerCPUUsagePercpu) init() {
+	m.data.SetName("container.cpu.usage.percpu")
+	m.data.SetDescription("Per-core CPU usage by the container.")
+	m.data.SetUnit("ns")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricContainerCPUUsagePercpu) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, coreAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Core, pdata.NewAttributeValueString(coreAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerCPUUsagePercpu) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerCPUUsagePercpu) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerCPUUsagePercpu(settings MetricSettings) metricContainerCPUUsagePercpu {
+	m := metricContainerCPUUsagePercpu{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerCPUUsageSystem struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.cpu.usage.system metric with initial data.
+func (m *metricContainerCPUUsageSystem) init() {
+	m.data.SetName("container.cpu.usage.system")
+	m.data.SetDescription("System CPU usage, as reported by docker.")
+	m.data.SetUnit("ns")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerCPUUsageSystem) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerCPUUsageSystem) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerCPUUsageSystem) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerCPUUsageSystem(settings MetricSettings) metricContainerCPUUsageSystem {
+	m := metricContainerCPUUsageSystem{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerCPUUsageTotal struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.cpu.usage.total metric with initial data.
+func (m *metricContainerCPUUsageTotal) init() {
+	m.data.SetName("container.cpu.usage.total")
+	m.data.SetDescription("Total CPU time consumed.")
+	m.data.SetUnit("ns")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerCPUUsageTotal) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerCPUUsageTotal) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerCPUUsageTotal) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerCPUUsageTotal(settings MetricSettings) metricContainerCPUUsageTotal {
+	m := metricContainerCPUUsageTotal{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerCPUUsageUsermode struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.cpu.usage.usermode metric with initial data.
+func (m *metricContainerCPUUsageUsermode) init() {
+	m.data.SetName("container.cpu.usage.usermode")
+	m.data.SetDescription("Time spent by tasks of the cgroup in user mode (Linux). Time spent by all container processes in user mode (Windows).")
+	m.data.SetUnit("ns")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerCPUUsageUsermode) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerCPUUsageUsermode) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerCPUUsageUsermode) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerCPUUsageUsermode(settings MetricSettings) metricContainerCPUUsageUsermode {
+	m := metricContainerCPUUsageUsermode{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryActiveAnon struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.active_anon metric with initial data.
+func (m *metricContainerMemoryActiveAnon) init() {
+	m.data.SetName("container.memory.active_anon")
+	m.data.SetDescription("The amount of anonymous memory that has been identified as active by the kernel.")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryActiveAnon) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryActiveAnon) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryActiveAnon) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryActiveAnon(settings MetricSettings) metricContainerMemoryActiveAnon {
+	m := metricContainerMemoryActiveAnon{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryActiveFile struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.active_file metric with initial data.
+func (m *metricContainerMemoryActiveFile) init() {
+	m.data.SetName("container.memory.active_file")
+	m.data.SetDescription("Cache memory that has been identified as active by the kernel.")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryActiveFile) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryActiveFile) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryActiveFile) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryActiveFile(settings MetricSettings) metricContainerMemoryActiveFile {
+	m := metricContainerMemoryActiveFile{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryAnon struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.anon metric with initial data.
+func (m *metricContainerMemoryAnon) init() {
+	m.data.SetName("container.memory.anon")
+	m.data.SetDescription("Amount of memory used in anonymous mappings (Only available with cgroups v2).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryAnon) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryAnon) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryAnon) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryAnon(settings MetricSettings) metricContainerMemoryAnon {
+	m := metricContainerMemoryAnon{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryAnonThp struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.anon_thp metric with initial data.
+func (m *metricContainerMemoryAnonThp) init() {
+	m.data.SetName("container.memory.anon_thp")
+	m.data.SetDescription("Amount of memory used in anonymous mappings backed by transparent hugepages (Only available with cgroups v2).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryAnonThp) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryAnonThp) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryAnonThp) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryAnonThp(settings MetricSettings) metricContainerMemoryAnonThp {
+	m := metricContainerMemoryAnonThp{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryCache struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.cache metric with initial data.
+func (m *metricContainerMemoryCache) init() {
+	m.data.SetName("container.memory.cache")
+	m.data.SetDescription("The amount of memory used by the processes of this control group that can be associated precisely with a block on a block device (Only available with cgroups v1).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryCache) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryCache) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryCache) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryCache(settings MetricSettings) metricContainerMemoryCache {
+	m := metricContainerMemoryCache{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryDirty struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.dirty metric with initial data.
+func (m *metricContainerMemoryDirty) init() {
+	m.data.SetName("container.memory.dirty")
+	m.data.SetDescription("Bytes that are waiting to get written back to the disk, from this cgroup (Only available with cgroups v1).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryDirty) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryDirty) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryDirty) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryDirty(settings MetricSettings) metricContainerMemoryDirty {
+	m := metricContainerMemoryDirty{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryFile struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.file metric with initial data.
+func (m *metricContainerMemoryFile) init() {
+	m.data.SetName("container.memory.file")
+	m.data.SetDescription("Amount of memory used to cache filesystem data, including tmpfs and shared memory (Only available with cgroups v2).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryFile) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryFile) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryFile) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryFile(settings MetricSettings) metricContainerMemoryFile {
+	m := metricContainerMemoryFile{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryFileDirty struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.file_dirty metric with initial data.
+func (m *metricContainerMemoryFileDirty) init() {
+	m.data.SetName("container.memory.file_dirty")
+	m.data.SetDescription("Amount of cached filesystem data that was modified but not yet written back to disk (Only available with cgroups v2).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryFileDirty) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryFileDirty) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryFileDirty) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryFileDirty(settings MetricSettings) metricContainerMemoryFileDirty {
+	m := metricContainerMemoryFileDirty{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryFileMapped struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.file_mapped metric with initial data.
+func (m *metricContainerMemoryFileMapped) init() {
+	m.data.SetName("container.memory.file_mapped")
+	m.data.SetDescription("Amount of cached filesystem data mapped with mmap() (Only available with cgroups v2).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryFileMapped) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryFileMapped) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryFileMapped) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryFileMapped(settings MetricSettings) metricContainerMemoryFileMapped {
+	m := metricContainerMemoryFileMapped{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryFileThp struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.file_thp metric with initial data.
+func (m *metricContainerMemoryFileThp) init() {
+	m.data.SetName("container.memory.file_thp")
+	m.data.SetDescription("Amount of cached filesystem data backed by transparent hugepages (Only available with cgroups v2).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryFileThp) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryFileThp) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryFileThp) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryFileThp(settings MetricSettings) metricContainerMemoryFileThp {
+	m := metricContainerMemoryFileThp{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryFileWriteback struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.file_writeback metric with initial data.
+func (m *metricContainerMemoryFileWriteback) init() {
+	m.data.SetName("container.memory.file_writeback")
+	m.data.SetDescription("Amount of cached filesystem data that was modified and is currently being written back to disk (Only available with cgroups v2).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryFileWriteback) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryFileWriteback) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryFileWriteback) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryFileWriteback(settings MetricSettings) metricContainerMemoryFileWriteback {
+	m := metricContainerMemoryFileWriteback{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryHierarchicalMemoryLimit struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.hierarchical_memory_limit metric with initial data.
+func (m *metricContainerMemoryHierarchicalMemoryLimit) init() {
+	m.data.SetName("container.memory.hierarchical_memory_limit")
+	m.data.SetDescription("The maximum amount of physical memory that can be used by the processes of this control group (Only available with cgroups v1).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryHierarchicalMemoryLimit) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryHierarchicalMemoryLimit) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryHierarchicalMemoryLimit) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryHierarchicalMemoryLimit(settings MetricSettings) metricContainerMemoryHierarchicalMemoryLimit {
+	m := metricContainerMemoryHierarchicalMemoryLimit{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryHierarchicalMemswLimit struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.hierarchical_memsw_limit metric with initial data.
+func (m *metricContainerMemoryHierarchicalMemswLimit) init() {
+	m.data.SetName("container.memory.hierarchical_memsw_limit")
+	m.data.SetDescription("The maximum amount of RAM + swap that can be used by the processes of this control group (Only available with cgroups v1).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryHierarchicalMemswLimit) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryHierarchicalMemswLimit) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryHierarchicalMemswLimit) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryHierarchicalMemswLimit(settings MetricSettings) metricContainerMemoryHierarchicalMemswLimit {
+	m := metricContainerMemoryHierarchicalMemswLimit{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryInactiveAnon struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.inactive_anon metric with initial data.
+func (m *metricContainerMemoryInactiveAnon) init() {
+	m.data.SetName("container.memory.inactive_anon")
+	m.data.SetDescription("The amount of anonymous memory that has been identified as inactive by the kernel.")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryInactiveAnon) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryInactiveAnon) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryInactiveAnon) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryInactiveAnon(settings MetricSettings) metricContainerMemoryInactiveAnon {
+	m := metricContainerMemoryInactiveAnon{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryInactiveFile struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.inactive_file metric with initial data.
+func (m *metricContainerMemoryInactiveFile) init() {
+	m.data.SetName("container.memory.inactive_file")
+	m.data.SetDescription("Cache memory that has been identified as inactive by the kernel.")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryInactiveFile) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryInactiveFile) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryInactiveFile) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryInactiveFile(settings MetricSettings) metricContainerMemoryInactiveFile {
+	m := metricContainerMemoryInactiveFile{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryKernelStack struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.kernel_stack metric with initial data.
+func (m *metricContainerMemoryKernelStack) init() {
+	m.data.SetName("container.memory.kernel_stack")
+	m.data.SetDescription("Amount of memory allocated to kernel stacks (Only available with cgroups v2).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryKernelStack) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryKernelStack) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryKernelStack) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryKernelStack(settings MetricSettings) metricContainerMemoryKernelStack {
+	m := metricContainerMemoryKernelStack{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryMappedFile struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.mapped_file metric with initial data.
+func (m *metricContainerMemoryMappedFile) init() {
+	m.data.SetName("container.memory.mapped_file")
+	m.data.SetDescription("Indicates the amount of memory mapped by the processes in the control group (Only available with cgroups v1).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryMappedFile) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryMappedFile) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryMappedFile) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryMappedFile(settings MetricSettings) metricContainerMemoryMappedFile {
+	m := metricContainerMemoryMappedFile{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryPagetables struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.pagetables metric with initial data.
+func (m *metricContainerMemoryPagetables) init() {
+	m.data.SetName("container.memory.pagetables")
+	m.data.SetDescription("Amount of memory allocated for page tables (Only available with cgroups v2).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryPagetables) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryPagetables) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryPagetables) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryPagetables(settings MetricSettings) metricContainerMemoryPagetables {
+	m := metricContainerMemoryPagetables{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryPercent struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.percent metric with initial data.
+func (m *metricContainerMemoryPercent) init() {
+	m.data.SetName("container.memory.percent")
+	m.data.SetDescription("Percentage of memory used.")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryPercent) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryPercent) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryPercent) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryPercent(settings MetricSettings) metricContainerMemoryPercent {
+	m := metricContainerMemoryPercent{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryPercpu struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.percpu metric with initial data.
+func (m *metricContainerMemoryPercpu) init() {
+	m.data.SetName("container.memory.percpu")
+	m.data.SetDescription("Amount of memory used for storing per-cpu kernel data structures (Only available with cgroups v2).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryPercpu) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryPercpu) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryPercpu) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryPercpu(settings MetricSettings) metricContainerMemoryPercpu {
+	m := metricContainerMemoryPercpu{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryPgactivate struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.pgactivate metric with initial data.
+func (m *metricContainerMemoryPgactivate) init() {
+	m.data.SetName("container.memory.pgactivate")
+	m.data.SetDescription("Number of pages moved to the active LRU lists (Only available with cgroups v2).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerMemoryPgactivate) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryPgactivate) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryPgactivate) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryPgactivate(settings MetricSettings) metricContainerMemoryPgactivate {
+	m := metricContainerMemoryPgactivate{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryPgdeactivate struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.pgdeactivate metric with initial data.
+func (m *metricContainerMemoryPgdeactivate) init() {
+	m.data.SetName("container.memory.pgdeactivate")
+	m.data.SetDescription("Number of pages moved to the inactive LRU lists (Only available with cgroups v2).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerMemoryPgdeactivate) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryPgdeactivate) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryPgdeactivate) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryPgdeactivate(settings MetricSettings) metricContainerMemoryPgdeactivate {
+	m := metricContainerMemoryPgdeactivate{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryPgfault struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.pgfault metric with initial data.
+func (m *metricContainerMemoryPgfault) init() {
+	m.data.SetName("container.memory.pgfault")
+	m.data.SetDescription("Indicate the number of times that a process of the cgroup triggered a page fault.")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerMemoryPgfault) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryPgfault) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryPgfault) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryPgfault(settings MetricSettings) metricContainerMemoryPgfault {
+	m := metricContainerMemoryPgfault{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryPglazyfree struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.pglazyfree metric with initial data.
+func (m *metricContainerMemoryPglazyfree) init() {
+	m.data.SetName("container.memory.pglazyfree")
+	m.data.SetDescription("Number of pages postponed to be freed under memory pressure (Only available with cgroups v2).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerMemoryPglazyfree) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryPglazyfree) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryPglazyfree) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryPglazyfree(settings MetricSettings) metricContainerMemoryPglazyfree {
+	m := metricContainerMemoryPglazyfree{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryPglazyfreed struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.pglazyfreed metric with initial data.
+func (m *metricContainerMemoryPglazyfreed) init() {
+	m.data.SetName("container.memory.pglazyfreed")
+	m.data.SetDescription("Number of reclaimed lazyfree pages (Only available with cgroups v2).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerMemoryPglazyfreed) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryPglazyfreed) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryPglazyfreed) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryPglazyfreed(settings MetricSettings) metricContainerMemoryPglazyfreed {
+	m := metricContainerMemoryPglazyfreed{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryPgmajfault struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.pgmajfault metric with initial data.
+func (m *metricContainerMemoryPgmajfault) init() {
+	m.data.SetName("container.memory.pgmajfault")
+	m.data.SetDescription("Indicate the number of times that a process of the cgroup triggered a major fault.")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerMemoryPgmajfault) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryPgmajfault) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryPgmajfault) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryPgmajfault(settings MetricSettings) metricContainerMemoryPgmajfault {
+	m := metricContainerMemoryPgmajfault{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryPgpgin struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.pgpgin metric with initial data.
+func (m *metricContainerMemoryPgpgin) init() {
+	m.data.SetName("container.memory.pgpgin")
+	m.data.SetDescription("Number of pages read from disk by the cgroup (Only available with cgroups v1).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerMemoryPgpgin) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryPgpgin) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryPgpgin) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryPgpgin(settings MetricSettings) metricContainerMemoryPgpgin {
+	m := metricContainerMemoryPgpgin{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryPgpgout struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.pgpgout metric with initial data.
+func (m *metricContainerMemoryPgpgout) init() {
+	m.data.SetName("container.memory.pgpgout")
+	m.data.SetDescription("Number of pages written to disk by the cgroup (Only available with cgroups v1).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerMemoryPgpgout) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryPgpgout) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryPgpgout) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryPgpgout(settings MetricSettings) metricContainerMemoryPgpgout {
+	m := metricContainerMemoryPgpgout{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryPgrefill struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.pgrefill metric with initial data.
+func (m *metricContainerMemoryPgrefill) init() {
+	m.data.SetName("container.memory.pgrefill")
+	m.data.SetDescription("Number of pages scanned in the active LRU lists (Only available with cgroups v2).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerMemoryPgrefill) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryPgrefill) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryPgrefill) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryPgrefill(settings MetricSettings) metricContainerMemoryPgrefill {
+	m := metricContainerMemoryPgrefill{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryPgscan struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.pgscan metric with initial data.
+func (m *metricContainerMemoryPgscan) init() {
+	m.data.SetName("container.memory.pgscan")
+	m.data.SetDescription("Number of pages scanned in the inactive LRU lists (Only available with cgroups v2).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerMemoryPgscan) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryPgscan) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryPgscan) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryPgscan(settings MetricSettings) metricContainerMemoryPgscan {
+	m := metricContainerMemoryPgscan{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryPgsteal struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.pgsteal metric with initial data.
+func (m *metricContainerMemoryPgsteal) init() {
+	m.data.SetName("container.memory.pgsteal")
+	m.data.SetDescription("Number of reclaimed pages (Only available with cgroups v2).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerMemoryPgsteal) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryPgsteal) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryPgsteal) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryPgsteal(settings MetricSettings) metricContainerMemoryPgsteal {
+	m := metricContainerMemoryPgsteal{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryRss struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.rss metric with initial data.
+func (m *metricContainerMemoryRss) init() {
+	m.data.SetName("container.memory.rss")
+	m.data.SetDescription("The amount of memory that doesn't correspond to anything on disk, i.e. stacks, heaps, and anonymous memory maps (Only available with cgroups v1).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryRss) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryRss) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryRss) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryRss(settings MetricSettings) metricContainerMemoryRss {
+	m := metricContainerMemoryRss{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryRssHuge struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.rss_huge metric with initial data.
+func (m *metricContainerMemoryRssHuge) init() {
+	m.data.SetName("container.memory.rss_huge")
+	m.data.SetDescription("Number of bytes of anonymous transparent hugepages in this cgroup (Only available with cgroups v1).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryRssHuge) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryRssHuge) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryRssHuge) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryRssHuge(settings MetricSettings) metricContainerMemoryRssHuge {
+	m := metricContainerMemoryRssHuge{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryShmem struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.shmem metric with initial data.
+func (m *metricContainerMemoryShmem) init() {
+	m.data.SetName("container.memory.shmem")
+	m.data.SetDescription("Amount of cached filesystem data that is swap-backed, such as tmpfs and shared memory (Only available with cgroups v2).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryShmem) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryShmem) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryShmem) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryShmem(settings MetricSettings) metricContainerMemoryShmem {
+	m := metricContainerMemoryShmem{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryShmemThp struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.shmem_thp metric with initial data.
+func (m *metricContainerMemoryShmemThp) init() {
+	m.data.SetName("container.memory.shmem_thp")
+	m.data.SetDescription("Amount of shared memory backed by transparent hugepages (Only available with cgroups v2).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryShmemThp) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryShmemThp) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryShmemThp) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryShmemThp(settings MetricSettings) metricContainerMemoryShmemThp {
+	m := metricContainerMemoryShmemThp{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemorySlab struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.slab metric with initial data.
+func (m *metricContainerMemorySlab) init() {
+	m.data.SetName("container.memory.slab")
+	m.data.SetDescription("Amount of memory used for storing in-kernel data structures (Only available with cgroups v2).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemorySlab) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemorySlab) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemorySlab) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemorySlab(settings MetricSettings) metricContainerMemorySlab {
+	m := metricContainerMemorySlab{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemorySlabReclaimable struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.slab_reclaimable metric with initial data.
+func (m *metricContainerMemorySlabReclaimable) init() {
+	m.data.SetName("container.memory.slab_reclaimable")
+	m.data.SetDescription("Part of the slab memory that might be reclaimed, such as dentries and inodes (Only available with cgroups v2).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemorySlabReclaimable) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemorySlabReclaimable) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemorySlabReclaimable) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemorySlabReclaimable(settings MetricSettings) metricContainerMemorySlabReclaimable {
+	m := metricContainerMemorySlabReclaimable{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemorySlabUnreclaimable struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.slab_unreclaimable metric with initial data.
+func (m *metricContainerMemorySlabUnreclaimable) init() {
+	m.data.SetName("container.memory.slab_unreclaimable")
+	m.data.SetDescription("Part of the slab memory that cannot be reclaimed on memory pressure (Only available with cgroups v2).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemorySlabUnreclaimable) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemorySlabUnreclaimable) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemorySlabUnreclaimable) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemorySlabUnreclaimable(settings MetricSettings) metricContainerMemorySlabUnreclaimable {
+	m := metricContainerMemorySlabUnreclaimable{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemorySock struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.sock metric with initial data.
+func (m *metricContainerMemorySock) init() {
+	m.data.SetName("container.memory.sock")
+	m.data.SetDescription("Amount of memory used in network transmission buffers (Only available with cgroups v2).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemorySock) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemorySock) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemorySock) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemorySock(settings MetricSettings) metricContainerMemorySock {
+	m := metricContainerMemorySock{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryThpCollapseAlloc struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.thp_collapse_alloc metric with initial data.
+func (m *metricContainerMemoryThpCollapseAlloc) init() {
+	m.data.SetName("container.memory.thp_collapse_alloc")
+	m.data.SetDescription("Number of transparent hugepages allocated to allow collapsing an existing range of pages (Only available with cgroups v2).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerMemoryThpCollapseAlloc) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryThpCollapseAlloc) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryThpCollapseAlloc) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryThpCollapseAlloc(settings MetricSettings) metricContainerMemoryThpCollapseAlloc {
+	m := metricContainerMemoryThpCollapseAlloc{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryThpFaultAlloc struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.thp_fault_alloc metric with initial data.
+func (m *metricContainerMemoryThpFaultAlloc) init() {
+	m.data.SetName("container.memory.thp_fault_alloc")
+	m.data.SetDescription("Number of transparent hugepages allocated to satisfy a page fault (Only available with cgroups v2).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerMemoryThpFaultAlloc) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryThpFaultAlloc) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryThpFaultAlloc) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryThpFaultAlloc(settings MetricSettings) metricContainerMemoryThpFaultAlloc {
+	m := metricContainerMemoryThpFaultAlloc{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryTotalActiveAnon struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.total_active_anon metric with initial data.
+func (m *metricContainerMemoryTotalActiveAnon) init() {
+	m.data.SetName("container.memory.total_active_anon")
+	m.data.SetDescription("The amount of anonymous memory that has been identified as active by the kernel, including all its descendants (Only available with cgroups v1).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryTotalActiveAnon) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryTotalActiveAnon) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryTotalActiveAnon) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryTotalActiveAnon(settings MetricSettings) metricContainerMemoryTotalActiveAnon {
+	m := metricContainerMemoryTotalActiveAnon{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryTotalActiveFile struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.total_active_file metric with initial data.
+func (m *metricContainerMemoryTotalActiveFile) init() {
+	m.data.SetName("container.memory.total_active_file")
+	m.data.SetDescription("Cache memory that has been identified as active by the kernel, including all its descendants (Only available with cgroups v1).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryTotalActiveFile) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryTotalActiveFile) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryTotalActiveFile) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryTotalActiveFile(settings MetricSettings) metricContainerMemoryTotalActiveFile {
+	m := metricContainerMemoryTotalActiveFile{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryTotalCache struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.total_cache metric with initial data.
+func (m *metricContainerMemoryTotalCache) init() {
+	m.data.SetName("container.memory.total_cache")
+	m.data.SetDescription("The amount of memory used by the processes of this control group that can be associated precisely with a block on a block device, including all its descendants (Only available with cgroups v1).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryTotalCache) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryTotalCache) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryTotalCache) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryTotalCache(settings MetricSettings) metricContainerMemoryTotalCache {
+	m := metricContainerMemoryTotalCache{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryTotalDirty struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.total_dirty metric with initial data.
+func (m *metricContainerMemoryTotalDirty) init() {
+	m.data.SetName("container.memory.total_dirty")
+	m.data.SetDescription("Bytes that are waiting to get written back to the disk, from this cgroup, including all its descendants (Only available with cgroups v1).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryTotalDirty) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryTotalDirty) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryTotalDirty) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryTotalDirty(settings MetricSettings) metricContainerMemoryTotalDirty {
+	m := metricContainerMemoryTotalDirty{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryTotalInactiveAnon struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.total_inactive_anon metric with initial data.
+func (m *metricContainerMemoryTotalInactiveAnon) init() {
+	m.data.SetName("container.memory.total_inactive_anon")
+	m.data.SetDescription("The amount of anonymous memory that has been identified as inactive by the kernel, including all its descendants (Only available with cgroups v1).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryTotalInactiveAnon) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryTotalInactiveAnon) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryTotalInactiveAnon) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryTotalInactiveAnon(settings MetricSettings) metricContainerMemoryTotalInactiveAnon {
+	m := metricContainerMemoryTotalInactiveAnon{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryTotalInactiveFile struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.total_inactive_file metric with initial data.
+func (m *metricContainerMemoryTotalInactiveFile) init() {
+	m.data.SetName("container.memory.total_inactive_file")
+	m.data.SetDescription("Cache memory that has been identified as inactive by the kernel, including all its descendants (Only available with cgroups v1).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryTotalInactiveFile) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryTotalInactiveFile) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryTotalInactiveFile) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryTotalInactiveFile(settings MetricSettings) metricContainerMemoryTotalInactiveFile {
+	m := metricContainerMemoryTotalInactiveFile{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryTotalMappedFile struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.total_mapped_file metric with initial data.
+func (m *metricContainerMemoryTotalMappedFile) init() {
+	m.data.SetName("container.memory.total_mapped_file")
+	m.data.SetDescription("Indicates the amount of memory mapped by the processes in the control group, including all its descendants (Only available with cgroups v1).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryTotalMappedFile) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryTotalMappedFile) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryTotalMappedFile) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryTotalMappedFile(settings MetricSettings) metricContainerMemoryTotalMappedFile {
+	m := metricContainerMemoryTotalMappedFile{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryTotalPgfault struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.total_pgfault metric with initial data.
+func (m *metricContainerMemoryTotalPgfault) init() {
+	m.data.SetName("container.memory.total_pgfault")
+	m.data.SetDescription("Indicate the number of times that a process of the cgroup triggered a page fault, including all its descendants (Only available with cgroups v1).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerMemoryTotalPgfault) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryTotalPgfault) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryTotalPgfault) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryTotalPgfault(settings MetricSettings) metricContainerMemoryTotalPgfault {
+	m := metricContainerMemoryTotalPgfault{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryTotalPgmajfault struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.total_pgmajfault metric with initial data.
+func (m *metricContainerMemoryTotalPgmajfault) init() {
+	m.data.SetName("container.memory.total_pgmajfault")
+	m.data.SetDescription("Indicate the number of times that a process of the cgroup triggered a major fault, including all its descendants (Only available with cgroups v1).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerMemoryTotalPgmajfault) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryTotalPgmajfault) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryTotalPgmajfault) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryTotalPgmajfault(settings MetricSettings) metricContainerMemoryTotalPgmajfault {
+	m := metricContainerMemoryTotalPgmajfault{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryTotalPgpgin struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.total_pgpgin metric with initial data.
+func (m *metricContainerMemoryTotalPgpgin) init() {
+	m.data.SetName("container.memory.total_pgpgin")
+	m.data.SetDescription("Number of pages read from disk by the cgroup, including all its descendants (Only available with cgroups v1).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerMemoryTotalPgpgin) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryTotalPgpgin) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryTotalPgpgin) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryTotalPgpgin(settings MetricSettings) metricContainerMemoryTotalPgpgin {
+	m := metricContainerMemoryTotalPgpgin{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryTotalPgpgout struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.total_pgpgout metric with initial data.
+func (m *metricContainerMemoryTotalPgpgout) init() {
+	m.data.SetName("container.memory.total_pgpgout")
+	m.data.SetDescription("Number of pages written to disk by the cgroup, including all its descendants (Only available with cgroups v1).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerMemoryTotalPgpgout) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryTotalPgpgout) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryTotalPgpgout) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryTotalPgpgout(settings MetricSettings) metricContainerMemoryTotalPgpgout {
+	m := metricContainerMemoryTotalPgpgout{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryTotalRss struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.total_rss metric with initial data.
+func (m *metricContainerMemoryTotalRss) init() {
+	m.data.SetName("container.memory.total_rss")
+	m.data.SetDescription("The amount of memory that doesn't correspond to anything on disk, i.e. stacks, heaps, and anonymous memory maps, including all its descendants (Only available with cgroups v1).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryTotalRss) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryTotalRss) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryTotalRss) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryTotalRss(settings MetricSettings) metricContainerMemoryTotalRss {
+	m := metricContainerMemoryTotalRss{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryTotalRssHuge struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.total_rss_huge metric with initial data.
+func (m *metricContainerMemoryTotalRssHuge) init() {
+	m.data.SetName("container.memory.total_rss_huge")
+	m.data.SetDescription("Number of bytes of anonymous transparent hugepages in this cgroup, including all its descendants (Only available with cgroups v1).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryTotalRssHuge) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryTotalRssHuge) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryTotalRssHuge) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryTotalRssHuge(settings MetricSettings) metricContainerMemoryTotalRssHuge {
+	m := metricContainerMemoryTotalRssHuge{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryTotalUnevictable struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.total_unevictable metric with initial data.
+func (m *metricContainerMemoryTotalUnevictable) init() {
+	m.data.SetName("container.memory.total_unevictable")
+	m.data.SetDescription("The amount of memory that cannot be reclaimed, including all its descendants (Only available with cgroups v1).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryTotalUnevictable) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryTotalUnevictable) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryTotalUnevictable) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryTotalUnevictable(settings MetricSettings) metricContainerMemoryTotalUnevictable {
+	m := metricContainerMemoryTotalUnevictable{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryTotalWriteback struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.total_writeback metric with initial data.
+func (m *metricContainerMemoryTotalWriteback) init() {
+	m.data.SetName("container.memory.total_writeback")
+	m.data.SetDescription("Number of bytes of file/anon cache that are queued for syncing to disk in this cgroup, including all its descendants (Only available with cgroups v1).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryTotalWriteback) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryTotalWriteback) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryTotalWriteback) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryTotalWriteback(settings MetricSettings) metricContainerMemoryTotalWriteback {
+	m := metricContainerMemoryTotalWriteback{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryUnevictable struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.unevictable metric with initial data.
+func (m *metricContainerMemoryUnevictable) init() {
+	m.data.SetName("container.memory.unevictable")
+	m.data.SetDescription("The amount of memory that cannot be reclaimed.")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryUnevictable) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryUnevictable) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryUnevictable) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryUnevictable(settings MetricSettings) metricContainerMemoryUnevictable {
+	m := metricContainerMemoryUnevictable{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryUsageLimit struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.usage.limit metric with initial data.
+func (m *metricContainerMemoryUsageLimit) init() {
+	m.data.SetName("container.memory.usage.limit")
+	m.data.SetDescription("Memory limit of the container.")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryUsageLimit) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryUsageLimit) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryUsageLimit) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryUsageLimit(settings MetricSettings) metricContainerMemoryUsageLimit {
+	m := metricContainerMemoryUsageLimit{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryUsageMax struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.usage.max metric with initial data.
+func (m *metricContainerMemoryUsageMax) init() {
+	m.data.SetName("container.memory.usage.max")
+	m.data.SetDescription("Maximum memory usage.")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryUsageMax) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryUsageMax) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryUsageMax) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryUsageMax(settings MetricSettings) metricContainerMemoryUsageMax {
+	m := metricContainerMemoryUsageMax{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryUsageTotal struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.usage.total metric with initial data.
+func (m *metricContainerMemoryUsageTotal) init() {
+	m.data.SetName("container.memory.usage.total")
+	m.data.SetDescription("Memory usage of the container. This excludes the page cache.")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryUsageTotal) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryUsageTotal) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryUsageTotal) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryUsageTotal(settings MetricSettings) metricContainerMemoryUsageTotal {
+	m := metricContainerMemoryUsageTotal{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryWorkingsetActivateAnon struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.workingset_activate_anon metric with initial data.
+func (m *metricContainerMemoryWorkingsetActivateAnon) init() {
+	m.data.SetName("container.memory.workingset_activate_anon")
+	m.data.SetDescription("Number of refaulted anonymous pages that were immediately activated (Only available with cgroups v2).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerMemoryWorkingsetActivateAnon) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryWorkingsetActivateAnon) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryWorkingsetActivateAnon) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryWorkingsetActivateAnon(settings MetricSettings) metricContainerMemoryWorkingsetActivateAnon {
+	m := metricContainerMemoryWorkingsetActivateAnon{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryWorkingsetActivateFile struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.workingset_activate_file metric with initial data.
+func (m *metricContainerMemoryWorkingsetActivateFile) init() {
+	m.data.SetName("container.memory.workingset_activate_file")
+	m.data.SetDescription("Number of refaulted file pages that were immediately activated (Only available with cgroups v2).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerMemoryWorkingsetActivateFile) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryWorkingsetActivateFile) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryWorkingsetActivateFile) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryWorkingsetActivateFile(settings MetricSettings) metricContainerMemoryWorkingsetActivateFile {
+	m := metricContainerMemoryWorkingsetActivateFile{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryWorkingsetNodereclaim struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.workingset_nodereclaim metric with initial data.
+func (m *metricContainerMemoryWorkingsetNodereclaim) init() {
+	m.data.SetName("container.memory.workingset_nodereclaim")
+	m.data.SetDescription("Number of times a shadow node has been reclaimed (Only available with cgroups v2).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerMemoryWorkingsetNodereclaim) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryWorkingsetNodereclaim) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryWorkingsetNodereclaim) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryWorkingsetNodereclaim(settings MetricSettings) metricContainerMemoryWorkingsetNodereclaim {
+	m := metricContainerMemoryWorkingsetNodereclaim{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryWorkingsetRefaultAnon struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.workingset_refault_anon metric with initial data.
+func (m *metricContainerMemoryWorkingsetRefaultAnon) init() {
+	m.data.SetName("container.memory.workingset_refault_anon")
+	m.data.SetDescription("Number of refaults of previously evicted anonymous pages (Only available with cgroups v2).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerMemoryWorkingsetRefaultAnon) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryWorkingsetRefaultAnon) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryWorkingsetRefaultAnon) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryWorkingsetRefaultAnon(settings MetricSettings) metricContainerMemoryWorkingsetRefaultAnon {
+	m := metricContainerMemoryWorkingsetRefaultAnon{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryWorkingsetRefaultFile struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.workingset_refault_file metric with initial data.
+func (m *metricContainerMemoryWorkingsetRefaultFile) init() {
+	m.data.SetName("container.memory.workingset_refault_file")
+	m.data.SetDescription("Number of refaults of previously evicted file pages (Only available with cgroups v2).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerMemoryWorkingsetRefaultFile) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryWorkingsetRefaultFile) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryWorkingsetRefaultFile) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryWorkingsetRefaultFile(settings MetricSettings) metricContainerMemoryWorkingsetRefaultFile {
+	m := metricContainerMemoryWorkingsetRefaultFile{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryWorkingsetRestoreAnon struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.workingset_restore_anon metric with initial data.
+func (m *metricContainerMemoryWorkingsetRestoreAnon) init() {
+	m.data.SetName("container.memory.workingset_restore_anon")
+	m.data.SetDescription("Number of restored anonymous pages which have been detected as an active workingset before they got reclaimed (Only available with cgroups v2).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerMemoryWorkingsetRestoreAnon) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryWorkingsetRestoreAnon) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryWorkingsetRestoreAnon) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryWorkingsetRestoreAnon(settings MetricSettings) metricContainerMemoryWorkingsetRestoreAnon {
+	m := metricContainerMemoryWorkingsetRestoreAnon{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryWorkingsetRestoreFile struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.workingset_restore_file metric with initial data.
+func (m *metricContainerMemoryWorkingsetRestoreFile) init() {
+	m.data.SetName("container.memory.workingset_restore_file")
+	m.data.SetDescription("Number of restored file pages which have been detected as an active workingset before they got reclaimed (Only available with cgroups v2).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerMemoryWorkingsetRestoreFile) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryWorkingsetRestoreFile) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryWorkingsetRestoreFile) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryWorkingsetRestoreFile(settings MetricSettings) metricContainerMemoryWorkingsetRestoreFile {
+	m := metricContainerMemoryWorkingsetRestoreFile{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerMemoryWriteback struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.writeback metric with initial data.
+func (m *metricContainerMemoryWriteback) init() {
+	m.data.SetName("container.memory.writeback")
+	m.data.SetDescription("Number of bytes of file/anon cache that are queued for syncing to disk in this cgroup (Only available with cgroups v1).")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerMemoryWriteback) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryWriteback) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryWriteback) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryWriteback(settings MetricSettings) metricContainerMemoryWriteback {
+	m := metricContainerMemoryWriteback{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerNetworkIoUsageRxBytes struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.network.io.usage.rx_bytes metric with initial data.
+func (m *metricContainerNetworkIoUsageRxBytes) init() {
+	m.data.SetName("container.network.io.usage.rx_bytes")
+	m.data.SetDescription("Bytes received by the container.")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricContainerNetworkIoUsageRxBytes) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, interfaceAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Interface, pdata.NewAttributeValueString(interfaceAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerNetworkIoUsageRxBytes) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerNetworkIoUsageRxBytes) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerNetworkIoUsageRxBytes(settings MetricSettings) metricContainerNetworkIoUsageRxBytes {
+	m := metricContainerNetworkIoUsageRxBytes{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerNetworkIoUsageRxDropped struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.network.io.usage.rx_dropped metric with initial data.
+func (m *metricContainerNetworkIoUsageRxDropped) init() {
+	m.data.SetName("container.network.io.usage.rx_dropped")
+	m.data.SetDescription("Incoming packets dropped.")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricContainerNetworkIoUsageRxDropped) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, interfaceAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Interface, pdata.NewAttributeValueString(interfaceAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerNetworkIoUsageRxDropped) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerNetworkIoUsageRxDropped) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerNetworkIoUsageRxDropped(settings MetricSettings) metricContainerNetworkIoUsageRxDropped {
+	m := metricContainerNetworkIoUsageRxDropped{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerNetworkIoUsageRxErrors struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.network.io.usage.rx_errors metric with initial data.
+func (m *metricContainerNetworkIoUsageRxErrors) init() {
+	m.data.SetName("container.network.io.usage.rx_errors")
+	m.data.SetDescription("Received errors.")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricContainerNetworkIoUsageRxErrors) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, interfaceAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Interface, pdata.NewAttributeValueString(interfaceAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerNetworkIoUsageRxErrors) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerNetworkIoUsageRxErrors) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerNetworkIoUsageRxErrors(settings MetricSettings) metricContainerNetworkIoUsageRxErrors {
+	m := metricContainerNetworkIoUsageRxErrors{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerNetworkIoUsageRxPackets struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.network.io.usage.rx_packets metric with initial data.
+func (m *metricContainerNetworkIoUsageRxPackets) init() {
+	m.data.SetName("container.network.io.usage.rx_packets")
+	m.data.SetDescription("Packets received.")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricContainerNetworkIoUsageRxPackets) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, interfaceAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Interface, pdata.NewAttributeValueString(interfaceAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerNetworkIoUsageRxPackets) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerNetworkIoUsageRxPackets) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerNetworkIoUsageRxPackets(settings MetricSettings) metricContainerNetworkIoUsageRxPackets {
+	m := metricContainerNetworkIoUsageRxPackets{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerNetworkIoUsageTxBytes struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.network.io.usage.tx_bytes metric with initial data.
+func (m *metricContainerNetworkIoUsageTxBytes) init() {
+	m.data.SetName("container.network.io.usage.tx_bytes")
+	m.data.SetDescription("Bytes sent.")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricContainerNetworkIoUsageTxBytes) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, interfaceAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Interface, pdata.NewAttributeValueString(interfaceAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerNetworkIoUsageTxBytes) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerNetworkIoUsageTxBytes) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerNetworkIoUsageTxBytes(settings MetricSettings) metricContainerNetworkIoUsageTxBytes {
+	m := metricContainerNetworkIoUsageTxBytes{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerNetworkIoUsageTxDropped struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.network.io.usage.tx_dropped metric with initial data.
+func (m *metricContainerNetworkIoUsageTxDropped) init() {
+	m.data.SetName("container.network.io.usage.tx_dropped")
+	m.data.SetDescription("Outgoing packets dropped.")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricContainerNetworkIoUsageTxDropped) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, interfaceAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Interface, pdata.NewAttributeValueString(interfaceAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerNetworkIoUsageTxDropped) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerNetworkIoUsageTxDropped) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerNetworkIoUsageTxDropped(settings MetricSettings) metricContainerNetworkIoUsageTxDropped {
+	m := metricContainerNetworkIoUsageTxDropped{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerNetworkIoUsageTxErrors struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.network.io.usage.tx_errors metric with initial data.
+func (m *metricContainerNetworkIoUsageTxErrors) init() {
+	m.data.SetName("container.network.io.usage.tx_errors")
+	m.data.SetDescription("Sent errors.")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricContainerNetworkIoUsageTxErrors) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, interfaceAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Interface, pdata.NewAttributeValueString(interfaceAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerNetworkIoUsageTxErrors) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerNetworkIoUsageTxErrors) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerNetworkIoUsageTxErrors(settings MetricSettings) metricContainerNetworkIoUsageTxErrors {
+	m := metricContainerNetworkIoUsageTxErrors{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerNetworkIoUsageTxPackets struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.network.io.usage.tx_packets metric with initial data.
+func (m *metricContainerNetworkIoUsageTxPackets) init() {
+	m.data.SetName("container.network.io.usage.tx_packets")
+	m.data.SetDescription("Packets sent.")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricContainerNetworkIoUsageTxPackets) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, interfaceAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Interface, pdata.NewAttributeValueString(interfaceAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerNetworkIoUsageTxPackets) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerNetworkIoUsageTxPackets) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerNetworkIoUsageTxPackets(settings MetricSettings) metricContainerNetworkIoUsageTxPackets {
+	m := metricContainerNetworkIoUsageTxPackets{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerPidsCount struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.pids.count metric with initial data.
+func (m *metricContainerPidsCount) init() {
+	m.data.SetName("container.pids.count")
+	m.data.SetDescription("Number of pids in the container's cgroup.")
+	m.data.SetUnit("{pids}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerPidsCount) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerPidsCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerPidsCount) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerPidsCount(settings MetricSettings) metricContainerPidsCount {
+	m := metricContainerPidsCount{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerPidsLimit struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.pids.limit metric with initial data.
+func (m *metricContainerPidsLimit) init() {
+	m.data.SetName("container.pids.limit")
+	m.data.SetDescription("Maximum number of pids in the container's cgroup.")
+	m.data.SetUnit("{pids}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerPidsLimit) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerPidsLimit) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerPidsLimit) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerPidsLimit(settings MetricSettings) metricContainerPidsLimit {
+	m := metricContainerPidsLimit{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerRestarts struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.restarts metric with initial data.
+func (m *metricContainerRestarts) init() {
+	m.data.SetName("container.restarts")
+	m.data.SetDescription("Number of times the container was restarted by the Docker daemon.")
+	m.data.SetUnit("{restarts}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricContainerRestarts) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerRestarts) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerRestarts) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerRestarts(settings MetricSettings) metricContainerRestarts {
+	m := metricContainerRestarts{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerUptime struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.uptime metric with initial data.
+func (m *metricContainerUptime) init() {
+	m.data.SetName("container.uptime")
+	m.data.SetDescription("Time elapsed since the container started.")
+	m.data.SetUnit("s")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricContainerUptime) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerUptime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerUptime) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerUptime(settings MetricSettings) metricContainerUptime {
+	m := metricContainerUptime{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+// MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
+// required to produce metric representation defined in metadata and user settings.
+type MetricsBuilder struct {
+	startTime                                        pdata.Timestamp
+	metricContainerBlockioIoMergedRecursive          metricContainerBlockioIoMergedRecursive
+	metricContainerBlockioIoQueuedRecursive          metricContainerBlockioIoQueuedRecursive
+	metricContainerBlockioIoServiceBytesRecursive    metricContainerBlockioIoServiceBytesRecursive
+	metricContainerBlockioIoServiceTimeRecursive     metricContainerBlockioIoServiceTimeRecursive
+	metricContainerBlockioIoServicedRecursive        metricContainerBlockioIoServicedRecursive
+	metricContainerBlockioIoTimeRecursive            metricContainerBlockioIoTimeRecursive
+	metricContainerBlockioIoWaitTimeRecursive        metricContainerBlockioIoWaitTimeRecursive
+	metricContainerBlockioSectorsRecursive           metricContainerBlockioSectorsRecursive
+	metricContainerCPUPercent                        metricContainerCPUPercent
+	metricContainerCPUThrottlingDataPeriods          metricContainerCPUThrottlingDataPeriods
+	metricContainerCPUThrottlingDataThrottledPeriods metricContainerCPUThrottlingDataThrottledPeriods
+	metricContainerCPUThrottlingDataThrottledTime    metricContainerCPUThrottlingDataThrottledTime
+	metricContainerCPUUsageKernelmode                metricContainerCPUUsageKernelmode
+	metricContainerCPUUsagePercpu                    metricContainerCPUUsagePercpu
+	metricContainerCPUUsageSystem                    metricContainerCPUUsageSystem
+	metricContainerCPUUsageTotal                     metricContainerCPUUsageTotal
+	metricContainerCPUUsageUsermode                  metricContainerCPUUsageUsermode
+	metricContainerMemoryActiveAnon                  metricContainerMemoryActiveAnon
+	metricContainerMemoryActiveFile                  metricContainerMemoryActiveFile
+	metricContainerMemoryAnon                        metricContainerMemoryAnon
+	metricContainerMemoryAnonThp                     metricContainerMemoryAnonThp
+	metricContainerMemoryCache                       metricContainerMemoryCache
+	metricContainerMemoryDirty                       metricContainerMemoryDirty
+	metricContainerMemoryFile                        metricContainerMemoryFile
+	metricContainerMemoryFileDirty                   metricContainerMemoryFileDirty
+	metricContainerMemoryFileMapped                  metricContainerMemoryFileMapped
+	metricContainerMemoryFileThp                     metricContainerMemoryFileThp
+	metricContainerMemoryFileWriteback               metricContainerMemoryFileWriteback
+	metricContainerMemoryHierarchicalMemoryLimit     metricContainerMemoryHierarchicalMemoryLimit
+	metricContainerMemoryHierarchicalMemswLimit      metricContainerMemoryHierarchicalMemswLimit
+	metricContainerMemoryInactiveAnon                metricContainerMemoryInactiveAnon
+	metricContainerMemoryInactiveFile                metricContainerMemoryInactiveFile
+	metricContainerMemoryKernelStack                 metricContainerMemoryKernelStack
+	metricContainerMemoryMappedFile                  metricContainerMemoryMappedFile
+	metricContainerMemoryPagetables                  metricContainerMemoryPagetables
+	metricContainerMemoryPercent                     metricContainerMemoryPercent
+	metricContainerMemoryPercpu                      metricContainerMemoryPercpu
+	metricContainerMemoryPgactivate                  metricContainerMemoryPgactivate
+	metricContainerMemoryPgdeactivate                metricContainerMemoryPgdeactivate
+	metricContainerMemoryPgfault                     metricContainerMemoryPgfault
+	metricContainerMemoryPglazyfree                  metricContainerMemoryPglazyfree
+	metricContainerMemoryPglazyfreed                 metricContainerMemoryPglazyfreed
+	metricContainerMemoryPgmajfault                  metricContainerMemoryPgmajfault
+	metricContainerMemoryPgpgin                      metricContainerMemoryPgpgin
+	metricContainerMemoryPgpgout                     metricContainerMemoryPgpgout
+	metricContainerMemoryPgrefill                    metricContainerMemoryPgrefill
+	metricContainerMemoryPgscan                      metricContainerMemoryPgscan
+	metricContainerMemoryPgsteal                     metricContainerMemoryPgsteal
+	metricContainerMemoryRss                         metricContainerMemoryRss
+	metricContainerMemoryRssHuge                     metricContainerMemoryRssHuge
+	metricContainerMemoryShmem                       metricContainerMemoryShmem
+	metricContainerMemoryShmemThp                    metricContainerMemoryShmemThp
+	metricContainerMemorySlab                        metricContainerMemorySlab
+	metricContainerMemorySlabReclaimable             metricContainerMemorySlabReclaimable
+	metricContainerMemorySlabUnreclaimable           metricContainerMemorySlabUnreclaimable
+	metricContainerMemorySock                        metricContainerMemorySock
+	metricContainerMemoryThpCollapseAlloc            metricContainerMemoryThpCollapseAlloc
+	metricContainerMemoryThpFaultAlloc               metricContainerMemoryThpFaultAlloc
+	metricContainerMemoryTotalActiveAnon             metricContainerMemoryTotalActiveAnon
+	metricContainerMemoryTotalActiveFile             metricContainerMemoryTotalActiveFile
+	metricContainerMemoryTotalCache                  metricContainerMemoryTotalCache
+	metricContainerMemoryTotalDirty                  metricContainerMemoryTotalDirty
+	metricContainerMemoryTotalInactiveAnon           metricContainerMemoryTotalInactiveAnon
+	metricContainerMemoryTotalInactiveFile           metricContainerMemoryTotalInactiveFile
+	metricContainerMemoryTotalMappedFile             metricContainerMemoryTotalMappedFile
+	metricContainerMemoryTotalPgfault                metricContainerMemoryTotalPgfault
+	metricContainerMemoryTotalPgmajfault             metricContainerMemoryTotalPgmajfault
+	metricContainerMemoryTotalPgpgin                 metricContainerMemoryTotalPgpgin
+	metricContainerMemoryTotalPgpgout                metricContainerMemoryTotalPgpgout
+	metricContainerMemoryTotalRss                    metricContainerMemoryTotalRss
+	metricContainerMemoryTotalRssHuge                metricContainerMemoryTotalRssHuge
+	metricContainerMemoryTotalUnevictable            metricContainerMemoryTotalUnevictable
+	metricContainerMemoryTotalWriteback              metricContainerMemoryTotalWriteback
+	metricContainerMemoryUnevictable                 metricContainerMemoryUnevictable
+	metricContainerMemoryUsageLimit                  metricContainerMemoryUsageLimit
+	metricContainerMemoryUsageMax                    metricContainerMemoryUsageMax
+	metricContainerMemoryUsageTotal                  metricContainerMemoryUsageTotal
+	metricContainerMemoryWorkingsetActivateAnon      metricContainerMemoryWorkingsetActivateAnon
+	metricContainerMemoryWorkingsetActivateFile      metricContainerMemoryWorkingsetActivateFile
+	metricContainerMemoryWorkingsetNodereclaim       metricContainerMemoryWorkingsetNodereclaim
+	metricContainerMemoryWorkingsetRefaultAnon       metricContainerMemoryWorkingsetRefaultAnon
+	metricContainerMemoryWorkingsetRefaultFile       metricContainerMemoryWorkingsetRefaultFile
+	metricContainerMemoryWorkingsetRestoreAnon       metricContainerMemoryWorkingsetRestoreAnon
+	metricContainerMemoryWorkingsetRestoreFile       metricContainerMemoryWorkingsetRestoreFile
+	metricContainerMemoryWriteback                   metricContainerMemoryWriteback
+	metricContainerNetworkIoUsageRxBytes             metricContainerNetworkIoUsageRxBytes
+	metricContainerNetworkIoUsageRxDropped           metricContainerNetworkIoUsageRxDropped
+	metricContainerNetworkIoUsageRxErrors            metricContainerNetworkIoUsageRxErrors
+	metricContainerNetworkIoUsageRxPackets           metricContainerNetworkIoUsageRxPackets
+	metricContainerNetworkIoUsageTxBytes             metricContainerNetworkIoUsageTxBytes
+	metricContainerNetworkIoUsageTxDropped           metricContainerNetworkIoUsageTxDropped
+	metricContainerNetworkIoUsageTxErrors            metricContainerNetworkIoUsageTxErrors
+	metricContainerNetworkIoUsageTxPackets           metricContainerNetworkIoUsageTxPackets
+	metricContainerPidsCount                         metricContainerPidsCount
+	metricContainerPidsLimit                         metricContainerPidsLimit
+	metricContainerRestarts                          metricContainerRestarts
+	metricContainerUptime                            metricContainerUptime
+}
+
+// metricBuilderOption applies changes to default metrics builder.
+type metricBuilderOption func(*MetricsBuilder)
+
+// WithStartTime sets startTime on the metrics builder.
+func WithStartTime(startTime pdata.Timestamp) metricBuilderOption {
+	return func(mb *MetricsBuilder) {
+		mb.startTime = startTime
+	}
+}
+
+func NewMetricsBuilder(settings MetricsSettings, options ...metricBuilderOption) *MetricsBuilder {
+	mb := &MetricsBuilder{
+		startTime:                                        pdata.NewTimestampFromTime(time.Now()),
+		metricContainerBlockioIoMergedRecursive:          newMetricContainerBlockioIoMergedRecursive(settings.ContainerBlockioIoMergedRecursive),
+		metricContainerBlockioIoQueuedRecursive:          newMetricContainerBlockioIoQueuedRecursive(settings.ContainerBlockioIoQueuedRecursive),
+		metricContainerBlockioIoServiceBytesRecursive:    newMetricContainerBlockioIoServiceBytesRecursive(settings.ContainerBlockioIoServiceBytesRecursive),
+		metricContainerBlockioIoServiceTimeRecursive:     newMetricContainerBlockioIoServiceTimeRecursive(settings.ContainerBlockioIoServiceTimeRecursive),
+		metricContainerBlockioIoServicedRecursive:        newMetricContainerBlockioIoServicedRecursive(settings.ContainerBlockioIoServicedRecursive),
+		metricContainerBlockioIoTimeRecursive:            newMetricContainerBlockioIoTimeRecursive(settings.ContainerBlockioIoTimeRecursive),
+		metricContainerBlockioIoWaitTimeRecursive:        newMetricContainerBlockioIoWaitTimeRecursive(settings.ContainerBlockioIoWaitTimeRecursive),
+		metricContainerBlockioSectorsRecursive:           newMetricContainerBlockioSectorsRecursive(settings.ContainerBlockioSectorsRecursive),
+		metricContainerCPUPercent:                        newMetricContainerCPUPercent(settings.ContainerCPUPercent),
+		metricContainerCPUThrottlingDataPeriods:          newMetricContainerCPUThrottlingDataPeriods(settings.ContainerCPUThrottlingDataPeriods),
+		metricContainerCPUThrottlingDataThrottledPeriods: newMetricContainerCPUThrottlingDataThrottledPeriods(settings.ContainerCPUThrottlingDataThrottledPeriods),
+		metricContainerCPUThrottlingDataThrottledTime:    newMetricContainerCPUThrottlingDataThrottledTime(settings.ContainerCPUThrottlingDataThrottledTime),
+		metricContainerCPUUsageKernelmode:                newMetricContainerCPUUsageKernelmode(settings.ContainerCPUUsageKernelmode),
+		metricContainerCPUUsagePercpu:                    newMetricContainerCPUUsagePercpu(settings.ContainerCPUUsagePercpu),
+		metricContainerCPUUsageSystem:                    newMetricContainerCPUUsageSystem(settings.ContainerCPUUsageSystem),
+		metricContainerCPUUsageTotal:                     newMetricContainerCPUUsageTotal(settings.ContainerCPUUsageTotal),
+		metricContainerCPUUsageUsermode:                  newMetricContainerCPUUsageUsermode(settings.ContainerCPUUsageUsermode),
+		metricContainerMemoryActiveAnon:                  newMetricContainerMemoryActiveAnon(settings.ContainerMemoryActiveAnon),
+		metricContainerMemoryActiveFile:                  newMetricContainerMemoryActiveFile(settings.ContainerMemoryActiveFile),
+		metricContainerMemoryAnon:                        newMetricContainerMemoryAnon(settings.ContainerMemoryAnon),
+		metricContainerMemoryAnonThp:                     newMetricContainerMemoryAnonThp(settings.ContainerMemoryAnonThp),
+		metricContainerMemoryCache:                       newMetricContainerMemoryCache(settings.ContainerMemoryCache),
+		metricContainerMemoryDirty:                       newMetricContainerMemoryDirty(settings.ContainerMemoryDirty),
+		metricContainerMemoryFile:                        newMetricContainerMemoryFile(settings.ContainerMemoryFile),
+		metricContainerMemoryFileDirty:                   newMetricContainerMemoryFileDirty(settings.ContainerMemoryFileDirty),
+		metricContainerMemoryFileMapped:                  newMetricContainerMemoryFileMapped(settings.ContainerMemoryFileMapped),
+		metricContainerMemoryFileThp:                     newMetricContainerMemoryFileThp(settings.ContainerMemoryFileThp),
+		metricContainerMemoryFileWriteback:               newMetricContainerMemoryFileWriteback(settings.ContainerMemoryFileWriteback),
+		metricContainerMemoryHierarchicalMemoryLimit:     newMetricContainerMemoryHierarchicalMemoryLimit(settings.ContainerMemoryHierarchicalMemoryLimit),
+		metricContainerMemoryHierarchicalMemswLimit:      newMetricContainerMemoryHierarchicalMemswLimit(settings.ContainerMemoryHierarchicalMemswLimit),
+		metricContainerMemoryInactiveAnon:                newMetricContainerMemoryInactiveAnon(settings.ContainerMemoryInactiveAnon),
+		metricContainerMemoryInactiveFile:                newMetricContainerMemoryInactiveFile(settings.ContainerMemoryInactiveFile),
+		metricContainerMemoryKernelStack:                 newMetricContainerMemoryKernelStack(settings.ContainerMemoryKernelStack),
+		metricContainerMemoryMappedFile:                  newMetricContainerMemoryMappedFile(settings.ContainerMemoryMappedFile),
+		metricContainerMemoryPagetables:                  newMetricContainerMemoryPagetables(settings.ContainerMemoryPagetables),
+		metricContainerMemoryPercent:                     newMetricContainerMemoryPercent(settings.ContainerMemoryPercent),
+		metricContainerMemoryPercpu:                      newMetricContainerMemoryPercpu(settings.ContainerMemoryPercpu),
+		metricContainerMemoryPgactivate:                  newMetricContainerMemoryPgactivate(settings.ContainerMemoryPgactivate),
+		metricContainerMemoryPgdeactivate:                newMetricContainerMemoryPgdeactivate(settings.ContainerMemoryPgdeactivate),
+		metricContainerMemoryPgfault:                     newMetricContainerMemoryPgfault(settings.ContainerMemoryPgfault),
+		metricContainerMemoryPglazyfree:                  newMetricContainerMemoryPglazyfree(settings.ContainerMemoryPglazyfree),
+		metricContainerMemoryPglazyfreed:                 newMetricContainerMemoryPglazyfreed(settings.ContainerMemoryPglazyfreed),
+		metricContainerMemoryPgmajfault:                  newMetricContainerMemoryPgmajfault(settings.ContainerMemoryPgmajfault),
+		metricContainerMemoryPgpgin:                      newMetricContainerMemoryPgpgin(settings.ContainerMemoryPgpgin),
+		metricContainerMemoryPgpgout:                     newMetricContainerMemoryPgpgout(settings.ContainerMemoryPgpgout),
+		metricContainerMemoryPgrefill:                    newMetricContainerMemoryPgrefill(settings.ContainerMemoryPgrefill),
+		metricContainerMemoryPgscan:                      newMetricContainerMemoryPgscan(settings.ContainerMemoryPgscan),
+		metricContainerMemoryPgsteal:                     newMetricContainerMemoryPgsteal(settings.ContainerMemoryPgsteal),
+		metricContainerMemoryRss:                         newMetricContainerMemoryRss(settings.ContainerMemoryRss),
+		metricContainerMemoryRssHuge:                     newMetricContainerMemoryRssHuge(settings.ContainerMemoryRssHuge),
+		metricContainerMemoryShmem:                       newMetricContainerMemoryShmem(settings.ContainerMemoryShmem),
+		metricContainerMemoryShmemThp:                    newMetricContainerMemoryShmemThp(settings.ContainerMemoryShmemThp),
+		metricContainerMemorySlab:                        newMetricContainerMemorySlab(settings.ContainerMemorySlab),
+		metricContainerMemorySlabReclaimable:             newMetricContainerMemorySlabReclaimable(settings.ContainerMemorySlabReclaimable),
+		metricContainerMemorySlabUnreclaimable:           newMetricContainerMemorySlabUnreclaimable(settings.ContainerMemorySlabUnreclaimable),
+		metricContainerMemorySock:                        newMetricContainerMemorySock(settings.ContainerMemorySock),
+		metricContainerMemoryThpCollapseAlloc:            newMetricContainerMemoryThpCollapseAlloc(settings.ContainerMemoryThpCollapseAlloc),
+		metricContainerMemoryThpFaultAlloc:               newMetricContainerMemoryThpFaultAlloc(settings.ContainerMemoryThpFaultAlloc),
+		metricContainerMemoryTotalActiveAnon:             newMetricContainerMemoryTotalActiveAnon(settings.ContainerMemoryTotalActiveAnon),
+		metricContainerMemoryTotalActiveFile:             newMetricContainerMemoryTotalActiveFile(settings.ContainerMemoryTotalActiveFile),
+		metricContainerMemoryTotalCache:                  newMetricContainerMemoryTotalCache(settings.ContainerMemoryTotalCache),
+		metricContainerMemoryTotalDirty:                  newMetricContainerMemoryTotalDirty(settings.ContainerMemoryTotalDirty),
+		metricContainerMemoryTotalInactiveAnon:           newMetricContainerMemoryTotalInactiveAnon(settings.ContainerMemoryTotalInactiveAnon),
+		metricContainerMemoryTotalInactiveFile:           newMetricContainerMemoryTotalInactiveFile(settings.ContainerMemoryTotalInactiveFile),
+		metricContainerMemoryTotalMappedFile:             newMetricContainerMemoryTotalMappedFile(settings.ContainerMemoryTotalMappedFile),
+		metricContainerMemoryTotalPgfault:                newMetricContainerMemoryTotalPgfault(settings.ContainerMemoryTotalPgfault),
+		metricContainerMemoryTotalPgmajfault:             newMetricContainerMemoryTotalPgmajfault(settings.ContainerMemoryTotalPgmajfault),
+		metricContainerMemoryTotalPgpgin:                 newMetricContainerMemoryTotalPgpgin(settings.ContainerMemoryTotalPgpgin),
+		metricContainerMemoryTotalPgpgout:                newMetricContainerMemoryTotalPgpgout(settings.ContainerMemoryTotalPgpgout),
+		metricContainerMemoryTotalRss:                    newMetricContainerMemoryTotalRss(settings.ContainerMemoryTotalRss),
+		metricContainerMemoryTotalRssHuge:                newMetricContainerMemoryTotalRssHuge(settings.ContainerMemoryTotalRssHuge),
+		metricContainerMemoryTotalUnevictable:            newMetricContainerMemoryTotalUnevictable(settings.ContainerMemoryTotalUnevictable),
+		metricContainerMemoryTotalWriteback:              newMetricContainerMemoryTotalWriteback(settings.ContainerMemoryTotalWriteback),
+		metricContainerMemoryUnevictable:                 newMetricContainerMemoryUnevictable(settings.ContainerMemoryUnevictable),
+		metricContainerMemoryUsageLimit:                  newMetricContainerMemoryUsageLimit(settings.ContainerMemoryUsageLimit),
+		metricContainerMemoryUsageMax:                    newMetricContainerMemoryUsageMax(settings.ContainerMemoryUsageMax),
+		metricContainerMemoryUsageTotal:                  newMetricContainerMemoryUsageTotal(settings.ContainerMemoryUsageTotal),
+		metricContainerMemoryWorkingsetActivateAnon:      newMetricContainerMemoryWorkingsetActivateAnon(settings.ContainerMemoryWorkingsetActivateAnon),
+		metricContainerMemoryWorkingsetActivateFile:      newMetricContainerMemoryWorkingsetActivateFile(settings.ContainerMemoryWorkingsetActivateFile),
+		metricContainerMemoryWorkingsetNodereclaim:       newMetricContainerMemoryWorkingsetNodereclaim(settings.ContainerMemoryWorkingsetNodereclaim),
+		metricContainerMemoryWorkingsetRefaultAnon:       newMetricContainerMemoryWorkingsetRefaultAnon(settings.ContainerMemoryWorkingsetRefaultAnon),
+		metricContainerMemoryWorkingsetRefaultFile:       newMetricContainerMemoryWorkingsetRefaultFile(settings.ContainerMemoryWorkingsetRefaultFile),
+		metricContainerMemoryWorkingsetRestoreAnon:       newMetricContainerMemoryWorkingsetRestoreAnon(settings.ContainerMemoryWorkingsetRestoreAnon),
+		metricContainerMemoryWorkingsetRestoreFile:       newMetricContainerMemoryWorkingsetRestoreFile(settings.ContainerMemoryWorkingsetRestoreFile),
+		metricContainerMemoryWriteback:                   newMetricContainerMemoryWriteback(settings.ContainerMemoryWriteback),
+		metricContainerNetworkIoUsageRxBytes:             newMetricContainerNetworkIoUsageRxBytes(settings.ContainerNetworkIoUsageRxBytes),
+		metricContainerNetworkIoUsageRxDropped:           newMetricContainerNetworkIoUsageRxDropped(settings.ContainerNetworkIoUsageRxDropped),
+		metricContainerNetworkIoUsageRxErrors:            newMetricContainerNetworkIoUsageRxErrors(settings.ContainerNetworkIoUsageRxErrors),
+		metricContainerNetworkIoUsageRxPackets:           newMetricContainerNetworkIoUsageRxPackets(settings.ContainerNetworkIoUsageRxPackets),
+		metricContainerNetworkIoUsageTxBytes:             newMetricContainerNetworkIoUsageTxBytes(settings.ContainerNetworkIoUsageTxBytes),
+		metricContainerNetworkIoUsageTxDropped:           newMetricContainerNetworkIoUsageTxDropped(settings.ContainerNetworkIoUsageTxDropped),
+		metricContainerNetworkIoUsageTxErrors:            newMetricContainerNetworkIoUsageTxErrors(settings.ContainerNetworkIoUsageTxErrors),
+		metricContainerNetworkIoUsageTxPackets:           newMetricContainerNetworkIoUsageTxPackets(settings.ContainerNetworkIoUsageTxPackets),
+		metricContainerPidsCount:                         newMetricContainerPidsCount(settings.ContainerPidsCount),
+		metricContainerPidsLimit:                         newMetricContainerPidsLimit(settings.ContainerPidsLimit),
+		metricContainerRestarts:                          newMetricContainerRestarts(settings.ContainerRestarts),
+		metricContainerUptime:                            newMetricContainerUptime(settings.ContainerUptime),
+	}
+	for _, op := range options {
+		op(mb)
+	}
+	return mb
+}
+
+// Emit appends generated metrics to a pdata.MetricsSlice and updates the internal state to be ready for recording
+// another set of data points. This function will be doing all transformations required to produce metric representation
+// defined in metadata and user settings, e.g. delta/cumulative translation.
+func (mb *MetricsBuilder) Emit(metrics pdata.MetricSlice) {
+	mb.metricContainerBlockioIoMergedRecursive.emit(metrics)
+	mb.metricContainerBlockioIoQueuedRecursive.emit(metrics)
+	mb.metricContainerBlockioIoServiceBytesRecursive.emit(metrics)
+	mb.metricContainerBlockioIoServiceTimeRecursive.emit(metrics)
+	mb.metricContainerBlockioIoServicedRecursive.emit(metrics)
+	mb.metricContainerBlockioIoTimeRecursive.emit(metrics)
+	mb.metricContainerBlockioIoWaitTimeRecursive.emit(metrics)
+	mb.metricContainerBlockioSectorsRecursive.emit(metrics)
+	mb.metricContainerCPUPercent.emit(metrics)
+	mb.metricContainerCPUThrottlingDataPeriods.emit(metrics)
+	mb.metricContainerCPUThrottlingDataThrottledPeriods.emit(metrics)
+	mb.metricContainerCPUThrottlingDataThrottledTime.emit(metrics)
+	mb.metricContainerCPUUsageKernelmode.emit(metrics)
+	mb.metricContainerCPUUsagePercpu.emit(metrics)
+	mb.metricContainerCPUUsageSystem.emit(metrics)
+	mb.metricContainerCPUUsageTotal.emit(metrics)
+	mb.metricContainerCPUUsageUsermode.emit(metrics)
+	mb.metricContainerMemoryActiveAnon.emit(metrics)
+	mb.metricContainerMemoryActiveFile.emit(metrics)
+	mb.metricContainerMemoryAnon.emit(metrics)
+	mb.metricContainerMemoryAnonThp.emit(metrics)
+	mb.metricContainerMemoryCache.emit(metrics)
+	mb.metricContainerMemoryDirty.emit(metrics)
+	mb.metricContainerMemoryFile.emit(metrics)
+	mb.metricContainerMemoryFileDirty.emit(metrics)
+	mb.metricContainerMemoryFileMapped.emit(metrics)
+	mb.metricContainerMemoryFileThp.emit(metrics)
+	mb.metricContainerMemoryFileWriteback.emit(metrics)
+	mb.metricContainerMemoryHierarchicalMemoryLimit.emit(metrics)
+	mb.metricContainerMemoryHierarchicalMemswLimit.emit(metrics)
+	mb.metricContainerMemoryInactiveAnon.emit(metrics)
+	mb.metricContainerMemoryInactiveFile.emit(metrics)
+	mb.metricContainerMemoryKernelStack.emit(metrics)
+	mb.metricContainerMemoryMappedFile.emit(metrics)
+	mb.metricContainerMemoryPagetables.emit(metrics)
+	mb.metricContainerMemoryPercent.emit(metrics)
+	mb.metricContainerMemoryPercpu.emit(metrics)
+	mb.metricContainerMemoryPgactivate.emit(metrics)
+	mb.metricContainerMemoryPgdeactivate.emit(metrics)
+	mb.metricContainerMemoryPgfault.emit(metrics)
+	mb.metricContainerMemoryPglazyfree.emit(metrics)
+	mb.metricContainerMemoryPglazyfreed.emit(metrics)
+	mb.metricContainerMemoryPgmajfault.emit(metrics)
+	mb.metricContainerMemoryPgpgin.emit(metrics)
+	mb.metricContainerMemoryPgpgout.emit(metrics)
+	mb.metricContainerMemoryPgrefill.emit(metrics)
+	mb.metricContainerMemoryPgscan.emit(metrics)
+	mb.metricContainerMemoryPgsteal.emit(metrics)
+	mb.metricContainerMemoryRss.emit(metrics)
+	mb.metricContainerMemoryRssHuge.emit(metrics)
+	mb.metricContainerMemoryShmem.emit(metrics)
+	mb.metricContainerMemoryShmemThp.emit(metrics)
+	mb.metricContainerMemorySlab.emit(metrics)
+	mb.metricContainerMemorySlabReclaimable.emit(metrics)
+	mb.metricContainerMemorySlabUnreclaimable.emit(metrics)
+	mb.metricContainerMemorySock.emit(metrics)
+	mb.metricContainerMemoryThpCollapseAlloc.emit(metrics)
+	mb.metricContainerMemoryThpFaultAlloc.emit(metrics)
+	mb.metricContainerMemoryTotalActiveAnon.emit(metrics)
+	mb.metricContainerMemoryTotalActiveFile.emit(metrics)
+	mb.metricContainerMemoryTotalCache.emit(metrics)
+	mb.metricContainerMemoryTotalDirty.emit(metrics)
+	mb.metricContainerMemoryTotalInactiveAnon.emit(metrics)
+	mb.metricContainerMemoryTotalInactiveFile.emit(metrics)
+	mb.metricContainerMemoryTotalMappedFile.emit(metrics)
+	mb.metricContainerMemoryTotalPgfault.emit(metrics)
+	mb.metricContainerMemoryTotalPgmajfault.emit(metrics)
+	mb.metricContainerMemoryTotalPgpgin.emit(metrics)
+	mb.metricContainerMemoryTotalPgpgout.emit(metrics)
+	mb.metricContainerMemoryTotalRss.emit(metrics)
+	mb.metricContainerMemoryTotalRssHuge.emit(metrics)
+	mb.metricContainerMemoryTotalUnevictable.emit(metrics)
+	mb.metricContainerMemoryTotalWriteback.emit(metrics)
+	mb.metricContainerMemoryUnevictable.emit(metrics)
+	mb.metricContainerMemoryUsageLimit.emit(metrics)
+	mb.metricContainerMemoryUsageMax.emit(metrics)
+	mb.metricContainerMemoryUsageTotal.emit(metrics)
+	mb.metricContainerMemoryWorkingsetActivateAnon.emit(metrics)
+	mb.metricContainerMemoryWorkingsetActivateFile.emit(metrics)
+	mb.metricContainerMemoryWorkingsetNodereclaim.emit(metrics)
+	mb.metricContainerMemoryWorkingsetRefaultAnon.emit(metrics)
+	mb.metricContainerMemoryWorkingsetRefaultFile.emit(metrics)
+	mb.metricContainerMemoryWorkingsetRestoreAnon.emit(metrics)
+	mb.metricContainerMemoryWorkingsetRestoreFile.emit(metrics)
+	mb.metricContainerMemoryWriteback.emit(metrics)
+	mb.metricContainerNetworkIoUsageRxBytes.emit(metrics)
+	mb.metricContainerNetworkIoUsageRxDropped.emit(metrics)
+	mb.metricContainerNetworkIoUsageRxErrors.emit(metrics)
+	mb.metricContainerNetworkIoUsageRxPackets.emit(metrics)
+	mb.metricContainerNetworkIoUsageTxBytes.emit(metrics)
+	mb.metricContainerNetworkIoUsageTxDropped.emit(metrics)
+	mb.metricContainerNetworkIoUsageTxErrors.emit(metrics)
+	mb.metricContainerNetworkIoUsageTxPackets.emit(metrics)
+	mb.metricContainerPidsCount.emit(metrics)
+	mb.metricContainerPidsLimit.emit(metrics)
+	mb.metricContainerRestarts.emit(metrics)
+	mb.metricContainerUptime.emit(metrics)
+}
+
+// RecordContainerBlockioIoMergedRecursiveDataPoint adds a data point to container.blockio.io_merged_recursive metric.
+func (mb *MetricsBuilder) RecordContainerBlockioIoMergedRecursiveDataPoint(ts pdata.Timestamp, val int64, deviceMajorAttributeValue string, deviceMinorAttributeValue string, operationAttributeValue string) {
+	mb.metricContainerBlockioIoMergedRecursive.recordDataPoint(mb.startTime, ts, val, deviceMajorAttributeValue, deviceMinorAttributeValue, operationAttributeValue)
+}
+
+// RecordContainerBlockioIoQueuedRecursiveDataPoint adds a data point to container.blockio.io_queued_recursive metric.
+func (mb *MetricsBuilder) RecordContainerBlockioIoQueuedRecursiveDataPoint(ts pdata.Timestamp, val int64, deviceMajorAttributeValue string, deviceMinorAttributeValue string, operationAttributeValue string) {
+	mb.metricContainerBlockioIoQueuedRecursive.recordDataPoint(mb.startTime, ts, val, deviceMajorAttributeValue, deviceMinorAttributeValue, operationAttributeValue)
+}
+
+// RecordContainerBlockioIoServiceBytesRecursiveDataPoint adds a data point to container.blockio.io_service_bytes_recursive metric.
+func (mb *MetricsBuilder) RecordContainerBlockioIoServiceBytesRecursiveDataPoint(ts pdata.Timestamp, val int64, deviceMajorAttributeValue string, deviceMinorAttributeValue string, operationAttributeValue string) {
+	mb.metricContainerBlockioIoServiceBytesRecursive.recordDataPoint(mb.startTime, ts, val, deviceMajorAttributeValue, deviceMinorAttributeValue, operationAttributeValue)
+}
+
+// RecordContainerBlockioIoServiceTimeRecursiveDataPoint adds a data point to container.blockio.io_service_time_recursive metric.
+func (mb *MetricsBuilder) RecordContainerBlockioIoServiceTimeRecursiveDataPoint(ts pdata.Timestamp, val int64, deviceMajorAttributeValue string, deviceMinorAttributeValue string, operationAttributeValue string) {
+	mb.metricContainerBlockioIoServiceTimeRecursive.recordDataPoint(mb.startTime, ts, val, deviceMajorAttributeValue, deviceMinorAttributeValue, operationAttributeValue)
+}
+
+// RecordContainerBlockioIoServicedRecursiveDataPoint adds a data point to container.blockio.io_serviced_recursive metric.
+func (mb *MetricsBuilder) RecordContainerBlockioIoServicedRecursiveDataPoint(ts pdata.Timestamp, val int64, deviceMajorAttributeValue string, deviceMinorAttributeValue string, operationAttributeValue string) {
+	mb.metricContainerBlockioIoServicedRecursive.recordDataPoint(mb.startTime, ts, val, deviceMajorAttributeValue, deviceMinorAttributeValue, operationAttributeValue)
+}
+
+// RecordContainerBlockioIoTimeRecursiveDataPoint adds a data point to container.blockio.io_time_recursive metric.
+func (mb *MetricsBuilder) RecordContainerBlockioIoTimeRecursiveDataPoint(ts pdata.Timestamp, val int64, deviceMajorAttributeValue string, deviceMinorAttributeValue string, operationAttributeValue string) {
+	mb.metricContainerBlockioIoTimeRecursive.recordDataPoint(mb.startTime, ts, val, deviceMajorAttributeValue, deviceMinorAttributeValue, operationAttributeValue)
+}
+
+// RecordContainerBlockioIoWaitTimeRecursiveDataPoint adds a data point to container.blockio.io_wait_time_recursive metric.
+func (mb *MetricsBuilder) RecordContainerBlockioIoWaitTimeRecursiveDataPoint(ts pdata.Timestamp, val int64, deviceMajorAttributeValue string, deviceMinorAttributeValue string, operationAttributeValue string) {
+	mb.metricContainerBlockioIoWaitTimeRecursive.recordDataPoint(mb.startTime, ts, val, deviceMajorAttributeValue, deviceMinorAttributeValue, operationAttributeValue)
+}
+
+// RecordContainerBlockioSectorsRecursiveDataPoint adds a data point to container.blockio.sectors_recursive metric.
+func (mb *MetricsBuilder) RecordContainerBlockioSectorsRecursiveDataPoint(ts pdata.Timestamp, val int64, deviceMajorAttributeValue string, deviceMinorAttributeValue string, operationAttributeValue string) {
+	mb.metricContainerBlockioSectorsRecursive.recordDataPoint(mb.startTime, ts, val, deviceMajorAttributeValue, deviceMinorAttributeValue, operationAttributeValue)
+}
+
+// RecordContainerCPUPercentDataPoint adds a data point to container.cpu.percent metric.
+func (mb *MetricsBuilder) RecordContainerCPUPercentDataPoint(ts pdata.Timestamp, val float64) {
+	mb.metricContainerCPUPercent.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerCPUThrottlingDataPeriodsDataPoint adds a data point to container.cpu.throttling_data.periods metric.
+func (mb *MetricsBuilder) RecordContainerCPUThrottlingDataPeriodsDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerCPUThrottlingDataPeriods.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerCPUThrottlingDataThrottledPeriodsDataPoint adds a data point to container.cpu.throttling_data.throttled_periods metric.
+func (mb *MetricsBuilder) RecordContainerCPUThrottlingDataThrottledPeriodsDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerCPUThrottlingDataThrottledPeriods.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerCPUThrottlingDataThrottledTimeDataPoint adds a data point to container.cpu.throttling_data.throttled_time metric.
+func (mb *MetricsBuilder) RecordContainerCPUThrottlingDataThrottledTimeDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerCPUThrottlingDataThrottledTime.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerCPUUsageKernelmodeDataPoint adds a data point to container.cpu.usage.kernelmode metric.
+func (mb *MetricsBuilder) RecordContainerCPUUsageKernelmodeDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerCPUUsageKernelmode.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerCPUUsagePercpuDataPoint adds a data point to container.cpu.usage.percpu metric.
+func (mb *MetricsBuilder) RecordContainerCPUUsagePercpuDataPoint(ts pdata.Timestamp, val int64, coreAttributeValue string) {
+	mb.metricContainerCPUUsagePercpu.recordDataPoint(mb.startTime, ts, val, coreAttributeValue)
+}
+
+// RecordContainerCPUUsageSystemDataPoint adds a data point to container.cpu.usage.system metric.
+func (mb *MetricsBuilder) RecordContainerCPUUsageSystemDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerCPUUsageSystem.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerCPUUsageTotalDataPoint adds a data point to container.cpu.usage.total metric.
+func (mb *MetricsBuilder) RecordContainerCPUUsageTotalDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerCPUUsageTotal.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerCPUUsageUsermodeDataPoint adds a data point to container.cpu.usage.usermode metric.
+func (mb *MetricsBuilder) RecordContainerCPUUsageUsermodeDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerCPUUsageUsermode.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryActiveAnonDataPoint adds a data point to container.memory.active_anon metric.
+func (mb *MetricsBuilder) RecordContainerMemoryActiveAnonDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryActiveAnon.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryActiveFileDataPoint adds a data point to container.memory.active_file metric.
+func (mb *MetricsBuilder) RecordContainerMemoryActiveFileDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryActiveFile.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryAnonDataPoint adds a data point to container.memory.anon metric.
+func (mb *MetricsBuilder) RecordContainerMemoryAnonDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryAnon.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryAnonThpDataPoint adds a data point to container.memory.anon_thp metric.
+func (mb *MetricsBuilder) RecordContainerMemoryAnonThpDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryAnonThp.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryCacheDataPoint adds a data point to container.memory.cache metric.
+func (mb *MetricsBuilder) RecordContainerMemoryCacheDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryCache.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryDirtyDataPoint adds a data point to container.memory.dirty metric.
+func (mb *MetricsBuilder) RecordContainerMemoryDirtyDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryDirty.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryFileDataPoint adds a data point to container.memory.file metric.
+func (mb *MetricsBuilder) RecordContainerMemoryFileDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryFile.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryFileDirtyDataPoint adds a data point to container.memory.file_dirty metric.
+func (mb *MetricsBuilder) RecordContainerMemoryFileDirtyDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryFileDirty.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryFileMappedDataPoint adds a data point to container.memory.file_mapped metric.
+func (mb *MetricsBuilder) RecordContainerMemoryFileMappedDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryFileMapped.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryFileThpDataPoint adds a data point to container.memory.file_thp metric.
+func (mb *MetricsBuilder) RecordContainerMemoryFileThpDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryFileThp.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryFileWritebackDataPoint adds a data point to container.memory.file_writeback metric.
+func (mb *MetricsBuilder) RecordContainerMemoryFileWritebackDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryFileWriteback.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryHierarchicalMemoryLimitDataPoint adds a data point to container.memory.hierarchical_memory_limit metric.
+func (mb *MetricsBuilder) RecordContainerMemoryHierarchicalMemoryLimitDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryHierarchicalMemoryLimit.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryHierarchicalMemswLimitDataPoint adds a data point to container.memory.hierarchical_memsw_limit metric.
+func (mb *MetricsBuilder) RecordContainerMemoryHierarchicalMemswLimitDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryHierarchicalMemswLimit.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryInactiveAnonDataPoint adds a data point to container.memory.inactive_anon metric.
+func (mb *MetricsBuilder) RecordContainerMemoryInactiveAnonDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryInactiveAnon.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryInactiveFileDataPoint adds a data point to container.memory.inactive_file metric.
+func (mb *MetricsBuilder) RecordContainerMemoryInactiveFileDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryInactiveFile.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryKernelStackDataPoint adds a data point to container.memory.kernel_stack metric.
+func (mb *MetricsBuilder) RecordContainerMemoryKernelStackDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryKernelStack.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryMappedFileDataPoint adds a data point to container.memory.mapped_file metric.
+func (mb *MetricsBuilder) RecordContainerMemoryMappedFileDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryMappedFile.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryPagetablesDataPoint adds a data point to container.memory.pagetables metric.
+func (mb *MetricsBuilder) RecordContainerMemoryPagetablesDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryPagetables.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryPercentDataPoint adds a data point to container.memory.percent metric.
+func (mb *MetricsBuilder) RecordContainerMemoryPercentDataPoint(ts pdata.Timestamp, val float64) {
+	mb.metricContainerMemoryPercent.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryPercpuDataPoint adds a data point to container.memory.percpu metric.
+func (mb *MetricsBuilder) RecordContainerMemoryPercpuDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryPercpu.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryPgactivateDataPoint adds a data point to container.memory.pgactivate metric.
+func (mb *MetricsBuilder) RecordContainerMemoryPgactivateDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryPgactivate.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryPgdeactivateDataPoint adds a data point to container.memory.pgdeactivate metric.
+func (mb *MetricsBuilder) RecordContainerMemoryPgdeactivateDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryPgdeactivate.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryPgfaultDataPoint adds a data point to container.memory.pgfault metric.
+func (mb *MetricsBuilder) RecordContainerMemoryPgfaultDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryPgfault.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryPglazyfreeDataPoint adds a data point to container.memory.pglazyfree metric.
+func (mb *MetricsBuilder) RecordContainerMemoryPglazyfreeDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryPglazyfree.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryPglazyfreedDataPoint adds a data point to container.memory.pglazyfreed metric.
+func (mb *MetricsBuilder) RecordContainerMemoryPglazyfreedDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryPglazyfreed.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryPgmajfaultDataPoint adds a data point to container.memory.pgmajfault metric.
+func (mb *MetricsBuilder) RecordContainerMemoryPgmajfaultDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryPgmajfault.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryPgpginDataPoint adds a data point to container.memory.pgpgin metric.
+func (mb *MetricsBuilder) RecordContainerMemoryPgpginDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryPgpgin.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryPgpgoutDataPoint adds a data point to container.memory.pgpgout metric.
+func (mb *MetricsBuilder) RecordContainerMemoryPgpgoutDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryPgpgout.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryPgrefillDataPoint adds a data point to container.memory.pgrefill metric.
+func (mb *MetricsBuilder) RecordContainerMemoryPgrefillDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryPgrefill.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryPgscanDataPoint adds a data point to container.memory.pgscan metric.
+func (mb *MetricsBuilder) RecordContainerMemoryPgscanDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryPgscan.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryPgstealDataPoint adds a data point to container.memory.pgsteal metric.
+func (mb *MetricsBuilder) RecordContainerMemoryPgstealDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryPgsteal.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryRssDataPoint adds a data point to container.memory.rss metric.
+func (mb *MetricsBuilder) RecordContainerMemoryRssDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryRss.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryRssHugeDataPoint adds a data point to container.memory.rss_huge metric.
+func (mb *MetricsBuilder) RecordContainerMemoryRssHugeDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryRssHuge.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryShmemDataPoint adds a data point to container.memory.shmem metric.
+func (mb *MetricsBuilder) RecordContainerMemoryShmemDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryShmem.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryShmemThpDataPoint adds a data point to container.memory.shmem_thp metric.
+func (mb *MetricsBuilder) RecordContainerMemoryShmemThpDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryShmemThp.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemorySlabDataPoint adds a data point to container.memory.slab metric.
+func (mb *MetricsBuilder) RecordContainerMemorySlabDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemorySlab.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemorySlabReclaimableDataPoint adds a data point to container.memory.slab_reclaimable metric.
+func (mb *MetricsBuilder) RecordContainerMemorySlabReclaimableDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemorySlabReclaimable.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemorySlabUnreclaimableDataPoint adds a data point to container.memory.slab_unreclaimable metric.
+func (mb *MetricsBuilder) RecordContainerMemorySlabUnreclaimableDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemorySlabUnreclaimable.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemorySockDataPoint adds a data point to container.memory.sock metric.
+func (mb *MetricsBuilder) RecordContainerMemorySockDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemorySock.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryThpCollapseAllocDataPoint adds a data point to container.memory.thp_collapse_alloc metric.
+func (mb *MetricsBuilder) RecordContainerMemoryThpCollapseAllocDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryThpCollapseAlloc.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryThpFaultAllocDataPoint adds a data point to container.memory.thp_fault_alloc metric.
+func (mb *MetricsBuilder) RecordContainerMemoryThpFaultAllocDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryThpFaultAlloc.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryTotalActiveAnonDataPoint adds a data point to container.memory.total_active_anon metric.
+func (mb *MetricsBuilder) RecordContainerMemoryTotalActiveAnonDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryTotalActiveAnon.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryTotalActiveFileDataPoint adds a data point to container.memory.total_active_file metric.
+func (mb *MetricsBuilder) RecordContainerMemoryTotalActiveFileDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryTotalActiveFile.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryTotalCacheDataPoint adds a data point to container.memory.total_cache metric.
+func (mb *MetricsBuilder) RecordContainerMemoryTotalCacheDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryTotalCache.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryTotalDirtyDataPoint adds a data point to container.memory.total_dirty metric.
+func (mb *MetricsBuilder) RecordContainerMemoryTotalDirtyDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryTotalDirty.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryTotalInactiveAnonDataPoint adds a data point to container.memory.total_inactive_anon metric.
+func (mb *MetricsBuilder) RecordContainerMemoryTotalInactiveAnonDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryTotalInactiveAnon.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryTotalInactiveFileDataPoint adds a data point to container.memory.total_inactive_file metric.
+func (mb *MetricsBuilder) RecordContainerMemoryTotalInactiveFileDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryTotalInactiveFile.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryTotalMappedFileDataPoint adds a data point to container.memory.total_mapped_file metric.
+func (mb *MetricsBuilder) RecordContainerMemoryTotalMappedFileDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryTotalMappedFile.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryTotalPgfaultDataPoint adds a data point to container.memory.total_pgfault metric.
+func (mb *MetricsBuilder) RecordContainerMemoryTotalPgfaultDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryTotalPgfault.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryTotalPgmajfaultDataPoint adds a data point to container.memory.total_pgmajfault metric.
+func (mb *MetricsBuilder) RecordContainerMemoryTotalPgmajfaultDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryTotalPgmajfault.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryTotalPgpginDataPoint adds a data point to container.memory.total_pgpgin metric.
+func (mb *MetricsBuilder) RecordContainerMemoryTotalPgpginDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryTotalPgpgin.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryTotalPgpgoutDataPoint adds a data point to container.memory.total_pgpgout metric.
+func (mb *MetricsBuilder) RecordContainerMemoryTotalPgpgoutDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryTotalPgpgout.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryTotalRssDataPoint adds a data point to container.memory.total_rss metric.
+func (mb *MetricsBuilder) RecordContainerMemoryTotalRssDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryTotalRss.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryTotalRssHugeDataPoint adds a data point to container.memory.total_rss_huge metric.
+func (mb *MetricsBuilder) RecordContainerMemoryTotalRssHugeDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryTotalRssHuge.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryTotalUnevictableDataPoint adds a data point to container.memory.total_unevictable metric.
+func (mb *MetricsBuilder) RecordContainerMemoryTotalUnevictableDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryTotalUnevictable.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryTotalWritebackDataPoint adds a data point to container.memory.total_writeback metric.
+func (mb *MetricsBuilder) RecordContainerMemoryTotalWritebackDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryTotalWriteback.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryUnevictableDataPoint adds a data point to container.memory.unevictable metric.
+func (mb *MetricsBuilder) RecordContainerMemoryUnevictableDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryUnevictable.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryUsageLimitDataPoint adds a data point to container.memory.usage.limit metric.
+func (mb *MetricsBuilder) RecordContainerMemoryUsageLimitDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryUsageLimit.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryUsageMaxDataPoint adds a data point to container.memory.usage.max metric.
+func (mb *MetricsBuilder) RecordContainerMemoryUsageMaxDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryUsageMax.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryUsageTotalDataPoint adds a data point to container.memory.usage.total metric.
+func (mb *MetricsBuilder) RecordContainerMemoryUsageTotalDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryUsageTotal.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryWorkingsetActivateAnonDataPoint adds a data point to container.memory.workingset_activate_anon metric.
+func (mb *MetricsBuilder) RecordContainerMemoryWorkingsetActivateAnonDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryWorkingsetActivateAnon.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryWorkingsetActivateFileDataPoint adds a data point to container.memory.workingset_activate_file metric.
+func (mb *MetricsBuilder) RecordContainerMemoryWorkingsetActivateFileDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryWorkingsetActivateFile.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryWorkingsetNodereclaimDataPoint adds a data point to container.memory.workingset_nodereclaim metric.
+func (mb *MetricsBuilder) RecordContainerMemoryWorkingsetNodereclaimDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryWorkingsetNodereclaim.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryWorkingsetRefaultAnonDataPoint adds a data point to container.memory.workingset_refault_anon metric.
+func (mb *MetricsBuilder) RecordContainerMemoryWorkingsetRefaultAnonDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryWorkingsetRefaultAnon.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryWorkingsetRefaultFileDataPoint adds a data point to container.memory.workingset_refault_file metric.
+func (mb *MetricsBuilder) RecordContainerMemoryWorkingsetRefaultFileDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryWorkingsetRefaultFile.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryWorkingsetRestoreAnonDataPoint adds a data point to container.memory.workingset_restore_anon metric.
+func (mb *MetricsBuilder) RecordContainerMemoryWorkingsetRestoreAnonDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryWorkingsetRestoreAnon.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryWorkingsetRestoreFileDataPoint adds a data point to container.memory.workingset_restore_file metric.
+func (mb *MetricsBuilder) RecordContainerMemoryWorkingsetRestoreFileDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryWorkingsetRestoreFile.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerMemoryWritebackDataPoint adds a data point to container.memory.writeback metric.
+func (mb *MetricsBuilder) RecordContainerMemoryWritebackDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerMemoryWriteback.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerNetworkIoUsageRxBytesDataPoint adds a data point to container.network.io.usage.rx_bytes metric.
+func (mb *MetricsBuilder) RecordContainerNetworkIoUsageRxBytesDataPoint(ts pdata.Timestamp, val int64, interfaceAttributeValue string) {
+	mb.metricContainerNetworkIoUsageRxBytes.recordDataPoint(mb.startTime, ts, val, interfaceAttributeValue)
+}
+
+// RecordContainerNetworkIoUsageRxDroppedDataPoint adds a data point to container.network.io.usage.rx_dropped metric.
+func (mb *MetricsBuilder) RecordContainerNetworkIoUsageRxDroppedDataPoint(ts pdata.Timestamp, val int64, interfaceAttributeValue string) {
+	mb.metricContainerNetworkIoUsageRxDropped.recordDataPoint(mb.startTime, ts, val, interfaceAttributeValue)
+}
+
+// RecordContainerNetworkIoUsageRxErrorsDataPoint adds a data point to container.network.io.usage.rx_errors metric.
+func (mb *MetricsBuilder) RecordContainerNetworkIoUsageRxErrorsDataPoint(ts pdata.Timestamp, val int64, interfaceAttributeValue string) {
+	mb.metricContainerNetworkIoUsageRxErrors.recordDataPoint(mb.startTime, ts, val, interfaceAttributeValue)
+}
+
+// RecordContainerNetworkIoUsageRxPacketsDataPoint adds a data point to container.network.io.usage.rx_packets metric.
+func (mb *MetricsBuilder) RecordContainerNetworkIoUsageRxPacketsDataPoint(ts pdata.Timestamp, val int64, interfaceAttributeValue string) {
+	mb.metricContainerNetworkIoUsageRxPackets.recordDataPoint(mb.startTime, ts, val, interfaceAttributeValue)
+}
+
+// RecordContainerNetworkIoUsageTxBytesDataPoint adds a data point to container.network.io.usage.tx_bytes metric.
+func (mb *MetricsBuilder) RecordContainerNetworkIoUsageTxBytesDataPoint(ts pdata.Timestamp, val int64, interfaceAttributeValue string) {
+	mb.metricContainerNetworkIoUsageTxBytes.recordDataPoint(mb.startTime, ts, val, interfaceAttributeValue)
+}
+
+// RecordContainerNetworkIoUsageTxDroppedDataPoint adds a data point to container.network.io.usage.tx_dropped metric.
+func (mb *MetricsBuilder) RecordContainerNetworkIoUsageTxDroppedDataPoint(ts pdata.Timestamp, val int64, interfaceAttributeValue string) {
+	mb.metricContainerNetworkIoUsageTxDropped.recordDataPoint(mb.startTime, ts, val, interfaceAttributeValue)
+}
+
+// RecordContainerNetworkIoUsageTxErrorsDataPoint adds a data point to container.network.io.usage.tx_errors metric.
+func (mb *MetricsBuilder) RecordContainerNetworkIoUsageTxErrorsDataPoint(ts pdata.Timestamp, val int64, interfaceAttributeValue string) {
+	mb.metricContainerNetworkIoUsageTxErrors.recordDataPoint(mb.startTime, ts, val, interfaceAttributeValue)
+}
+
+// RecordContainerNetworkIoUsageTxPacketsDataPoint adds a data point to container.network.io.usage.tx_packets metric.
+func (mb *MetricsBuilder) RecordContainerNetworkIoUsageTxPacketsDataPoint(ts pdata.Timestamp, val int64, interfaceAttributeValue string) {
+	mb.metricContainerNetworkIoUsageTxPackets.recordDataPoint(mb.startTime, ts, val, interfaceAttributeValue)
+}
+
+// RecordContainerPidsCountDataPoint adds a data point to container.pids.count metric.
+func (mb *MetricsBuilder) RecordContainerPidsCountDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerPidsCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerPidsLimitDataPoint adds a data point to container.pids.limit metric.
+func (mb *MetricsBuilder) RecordContainerPidsLimitDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerPidsLimit.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerRestartsDataPoint adds a data point to container.restarts metric.
+func (mb *MetricsBuilder) RecordContainerRestartsDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricContainerRestarts.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerUptimeDataPoint adds a data point to container.uptime metric.
+func (mb *MetricsBuilder) RecordContainerUptimeDataPoint(ts pdata.Timestamp, val float64) {
+	mb.metricContainerUptime.recordDataPoint(mb.startTime, ts, val)
+}
+
+// Attributes contains the possible metric attributes that can be used.
+var Attributes = struct {
+	// Core (The CPU core number when utilising per-CPU metrics.)
+	Core string
+	// DeviceMajor (Device major number for block IO operations.)
+	DeviceMajor string
+	// DeviceMinor (Device minor number for block IO operations.)
+	DeviceMinor string
+	// Interface (Network interface.)
+	Interface string
+	// Operation (Type of block IO operation, e.g. read, write, sync, async, discard or total.)
+	Operation string
+}{
+	"core",
+	"device_major",
+	"device_minor",
+	"interface",
+	"operation",
+}
+
+// A is an alias for Attributes.
+var A = Attributes
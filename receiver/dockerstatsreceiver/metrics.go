@@ -15,45 +15,41 @@
 package dockerstatsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver"
 
 import (
-	"fmt"
-	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	dtypes "github.com/docker/docker/api/types"
 	"go.opentelemetry.io/collector/model/pdata"
 	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/docker"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver/internal/metadata"
 )
 
-const (
-	metricPrefix = "container."
-)
+// recordContainerStats records the metrics of a container, they are emitted by emitContainerMetrics.
+func (r *receiver) recordContainerStats(now pdata.Timestamp, containerStats *dtypes.StatsJSON, container *docker.Container) {
+	r.recordBlkioMetrics(now, &containerStats.BlkioStats)
+	r.recordCPUMetrics(now, &containerStats.CPUStats, &containerStats.PreCPUStats)
+	r.recordMemoryMetrics(now, &containerStats.MemoryStats)
+	r.recordNetworkMetrics(now, &containerStats.Networks)
+	r.recordPidsMetrics(now, &containerStats.PidsStats)
+	r.recordContainerStateMetrics(now, container)
+}
 
-func ContainerStatsToMetrics(
-	now pdata.Timestamp,
-	containerStats *dtypes.StatsJSON,
-	container docker.Container,
-	config *Config,
-) pdata.Metrics {
-	md := pdata.NewMetrics()
-	rs := md.ResourceMetrics().AppendEmpty()
+// emitContainerMetrics moves the metrics recorded for a container to a new resource.
+func (r *receiver) emitContainerMetrics(dest pdata.ResourceMetricsSlice, container *docker.Container) {
+	rs := dest.AppendEmpty()
 	rs.SetSchemaUrl(conventions.SchemaURL)
 	resourceAttr := rs.Resource().Attributes()
 	resourceAttr.UpsertString(conventions.AttributeContainerID, container.ID)
 	resourceAttr.UpsertString(conventions.AttributeContainerImageName, container.Config.Image)
 	resourceAttr.UpsertString(conventions.AttributeContainerName, strings.TrimPrefix(container.Name, "/"))
 	resourceAttr.UpsertString("container.hostname", container.Config.Hostname)
-	updateConfiguredResourceAttributes(resourceAttr, container, config)
-	ils := rs.InstrumentationLibraryMetrics().AppendEmpty()
+	updateConfiguredResourceAttributes(resourceAttr, *container, r.config)
 
-	appendBlockioMetrics(ils.Metrics(), &containerStats.BlkioStats, now)
-	appendCPUMetrics(ils.Metrics(), &containerStats.CPUStats, &containerStats.PreCPUStats, now, config.ProvidePerCoreCPUMetrics)
-	appendMemoryMetrics(ils.Metrics(), &containerStats.MemoryStats, now)
-	appendNetworkMetrics(ils.Metrics(), &containerStats.Networks, now)
-
-	return md
+	metrics := rs.InstrumentationLibraryMetrics().AppendEmpty().Metrics()
+	r.mb.Emit(metrics)
 }
 
 func updateConfiguredResourceAttributes(resourceAttr pdata.AttributeMap, container docker.Container, config *Config) {
@@ -70,63 +66,48 @@ func updateConfiguredResourceAttributes(resourceAttr pdata.AttributeMap, contain
 	}
 }
 
-type blkioStat struct {
-	name    string
-	unit    string
-	entries []dtypes.BlkioStatEntry
-}
+type blkioRecorder func(mb *metadata.MetricsBuilder, ts pdata.Timestamp, val int64, deviceMajor string, deviceMinor string, operation string)
 
 // metrics for https://www.kernel.org/doc/Documentation/cgroup-v1/blkio-controller.txt
-func appendBlockioMetrics(dest pdata.MetricSlice, blkioStats *dtypes.BlkioStats, ts pdata.Timestamp) {
-	for _, blkiostat := range []blkioStat{
-		{"io_merged_recursive", "1", blkioStats.IoMergedRecursive},
-		{"io_queued_recursive", "1", blkioStats.IoQueuedRecursive},
-		{"io_service_bytes_recursive", "By", blkioStats.IoServiceBytesRecursive},
-		{"io_service_time_recursive", "ns", blkioStats.IoServiceTimeRecursive},
-		{"io_serviced_recursive", "1", blkioStats.IoServicedRecursive},
-		{"io_time_recursive", "ms", blkioStats.IoTimeRecursive},
-		{"io_wait_time_recursive", "1", blkioStats.IoWaitTimeRecursive},
-		{"sectors_recursive", "1", blkioStats.SectorsRecursive},
+func (r *receiver) recordBlkioMetrics(now pdata.Timestamp, blkioStats *dtypes.BlkioStats) {
+	for _, blkiostat := range []struct {
+		record  blkioRecorder
+		entries []dtypes.BlkioStatEntry
+	}{
+		{(*metadata.MetricsBuilder).RecordContainerBlockioIoMergedRecursiveDataPoint, blkioStats.IoMergedRecursive},
+		{(*metadata.MetricsBuilder).RecordContainerBlockioIoQueuedRecursiveDataPoint, blkioStats.IoQueuedRecursive},
+		{(*metadata.MetricsBuilder).RecordContainerBlockioIoServiceBytesRecursiveDataPoint, blkioStats.IoServiceBytesRecursive},
+		{(*metadata.MetricsBuilder).RecordContainerBlockioIoServiceTimeRecursiveDataPoint, blkioStats.IoServiceTimeRecursive},
+		{(*metadata.MetricsBuilder).RecordContainerBlockioIoServicedRecursiveDataPoint, blkioStats.IoServicedRecursive},
+		{(*metadata.MetricsBuilder).RecordContainerBlockioIoTimeRecursiveDataPoint, blkioStats.IoTimeRecursive},
+		{(*metadata.MetricsBuilder).RecordContainerBlockioIoWaitTimeRecursiveDataPoint, blkioStats.IoWaitTimeRecursive},
+		{(*metadata.MetricsBuilder).RecordContainerBlockioSectorsRecursiveDataPoint, blkioStats.SectorsRecursive},
 	} {
-		labelKeys := []string{"device_major", "device_minor"}
 		for _, stat := range blkiostat.entries {
 			if stat.Op == "" {
 				continue
 			}
-
-			statName := fmt.Sprintf("%s.%s", blkiostat.name, strings.ToLower(stat.Op))
-			metricName := fmt.Sprintf("blockio.%s", statName)
-			labelValues := []string{strconv.FormatUint(stat.Major, 10), strconv.FormatUint(stat.Minor, 10)}
-			populateCumulative(dest.AppendEmpty(), metricName, blkiostat.unit, int64(stat.Value), ts, labelKeys, labelValues)
+			blkiostat.record(r.mb, now, int64(stat.Value),
+				strconv.FormatUint(stat.Major, 10), strconv.FormatUint(stat.Minor, 10), strings.ToLower(stat.Op))
 		}
 	}
 }
 
-func appendCPUMetrics(dest pdata.MetricSlice, cpuStats *dtypes.CPUStats, previousCPUStats *dtypes.CPUStats, ts pdata.Timestamp, providePerCoreMetrics bool) {
-	populateCumulative(dest.AppendEmpty(), "cpu.usage.system", "ns", int64(cpuStats.SystemUsage), ts, nil, nil)
-	populateCumulative(dest.AppendEmpty(), "cpu.usage.total", "ns", int64(cpuStats.CPUUsage.TotalUsage), ts, nil, nil)
-
-	populateCumulative(dest.AppendEmpty(), "cpu.usage.kernelmode", "ns", int64(cpuStats.CPUUsage.UsageInKernelmode), ts, nil, nil)
-	populateCumulative(dest.AppendEmpty(), "cpu.usage.usermode", "ns", int64(cpuStats.CPUUsage.UsageInUsermode), ts, nil, nil)
+func (r *receiver) recordCPUMetrics(now pdata.Timestamp, cpuStats *dtypes.CPUStats, previousCPUStats *dtypes.CPUStats) {
+	r.mb.RecordContainerCPUUsageSystemDataPoint(now, int64(cpuStats.SystemUsage))
+	r.mb.RecordContainerCPUUsageTotalDataPoint(now, int64(cpuStats.CPUUsage.TotalUsage))
+	r.mb.RecordContainerCPUUsageKernelmodeDataPoint(now, int64(cpuStats.CPUUsage.UsageInKernelmode))
+	r.mb.RecordContainerCPUUsageUsermodeDataPoint(now, int64(cpuStats.CPUUsage.UsageInUsermode))
 
-	populateCumulative(dest.AppendEmpty(), "cpu.throttling_data.periods", "1", int64(cpuStats.ThrottlingData.Periods), ts, nil, nil)
-	populateCumulative(dest.AppendEmpty(), "cpu.throttling_data.throttled_periods", "1", int64(cpuStats.ThrottlingData.ThrottledPeriods), ts, nil, nil)
-	populateCumulative(dest.AppendEmpty(), "cpu.throttling_data.throttled_time", "ns", int64(cpuStats.ThrottlingData.ThrottledTime), ts, nil, nil)
+	r.mb.RecordContainerCPUThrottlingDataPeriodsDataPoint(now, int64(cpuStats.ThrottlingData.Periods))
+	r.mb.RecordContainerCPUThrottlingDataThrottledPeriodsDataPoint(now, int64(cpuStats.ThrottlingData.ThrottledPeriods))
+	r.mb.RecordContainerCPUThrottlingDataThrottledTimeDataPoint(now, int64(cpuStats.ThrottlingData.ThrottledTime))
 
-	populateGaugeF(dest.AppendEmpty(), "cpu.percent", "1", calculateCPUPercent(previousCPUStats, cpuStats), ts, nil, nil)
+	r.mb.RecordContainerCPUPercentDataPoint(now, calculateCPUPercent(previousCPUStats, cpuStats))
 
-	if !providePerCoreMetrics {
-		return
-	}
-
-	percpuValues := make([]int64, 0, len(cpuStats.CPUUsage.PercpuUsage))
-	percpuLabelKeys := []string{"core"}
-	percpuLabelValues := make([][]string, 0, len(cpuStats.CPUUsage.PercpuUsage))
 	for coreNum, v := range cpuStats.CPUUsage.PercpuUsage {
-		percpuValues = append(percpuValues, int64(v))
-		percpuLabelValues = append(percpuLabelValues, []string{fmt.Sprintf("cpu%s", strconv.Itoa(coreNum))})
+		r.mb.RecordContainerCPUUsagePercpuDataPoint(now, int64(v), "cpu"+strconv.Itoa(coreNum))
 	}
-	populateCumulativeMultiPoints(dest.AppendEmpty(), "cpu.usage.percpu", "ns", percpuValues, ts, percpuLabelKeys, percpuLabelValues)
 }
 
 // From container.calculateCPUPercentUnix()
@@ -159,124 +140,146 @@ func calculateCPUPercent(previous *dtypes.CPUStats, v *dtypes.CPUStats) float64
 	return cpuPercent
 }
 
-var memoryStatsThatAreCumulative = map[string]bool{
-	"pgfault":          true,
-	"pgmajfault":       true,
-	"pgpgin":           true,
-	"pgpgout":          true,
-	"total_pgfault":    true,
-	"total_pgmajfault": true,
-	"total_pgpgin":     true,
-	"total_pgpgout":    true,
+// memoryStatRecorders maps the memory stats reported by the Docker daemon,
+// which are the ones of the memory.stat file of the cgroup of the container,
+// to the metrics they are recorded to.
+var memoryStatRecorders = map[string]func(mb *metadata.MetricsBuilder, ts pdata.Timestamp, val int64){
+	"cache":                     (*metadata.MetricsBuilder).RecordContainerMemoryCacheDataPoint,
+	"rss":                       (*metadata.MetricsBuilder).RecordContainerMemoryRssDataPoint,
+	"rss_huge":                  (*metadata.MetricsBuilder).RecordContainerMemoryRssHugeDataPoint,
+	"dirty":                     (*metadata.MetricsBuilder).RecordContainerMemoryDirtyDataPoint,
+	"writeback":                 (*metadata.MetricsBuilder).RecordContainerMemoryWritebackDataPoint,
+	"mapped_file":               (*metadata.MetricsBuilder).RecordContainerMemoryMappedFileDataPoint,
+	"active_anon":               (*metadata.MetricsBuilder).RecordContainerMemoryActiveAnonDataPoint,
+	"inactive_anon":             (*metadata.MetricsBuilder).RecordContainerMemoryInactiveAnonDataPoint,
+	"active_file":               (*metadata.MetricsBuilder).RecordContainerMemoryActiveFileDataPoint,
+	"inactive_file":             (*metadata.MetricsBuilder).RecordContainerMemoryInactiveFileDataPoint,
+	"unevictable":               (*metadata.MetricsBuilder).RecordContainerMemoryUnevictableDataPoint,
+	"pgpgin":                    (*metadata.MetricsBuilder).RecordContainerMemoryPgpginDataPoint,
+	"pgpgout":                   (*metadata.MetricsBuilder).RecordContainerMemoryPgpgoutDataPoint,
+	"pgfault":                   (*metadata.MetricsBuilder).RecordContainerMemoryPgfaultDataPoint,
+	"pgmajfault":                (*metadata.MetricsBuilder).RecordContainerMemoryPgmajfaultDataPoint,
+	"hierarchical_memory_limit": (*metadata.MetricsBuilder).RecordContainerMemoryHierarchicalMemoryLimitDataPoint,
+	"hierarchical_memsw_limit":  (*metadata.MetricsBuilder).RecordContainerMemoryHierarchicalMemswLimitDataPoint,
+	"total_cache":               (*metadata.MetricsBuilder).RecordContainerMemoryTotalCacheDataPoint,
+	"total_rss":                 (*metadata.MetricsBuilder).RecordContainerMemoryTotalRssDataPoint,
+	"total_rss_huge":            (*metadata.MetricsBuilder).RecordContainerMemoryTotalRssHugeDataPoint,
+	"total_dirty":               (*metadata.MetricsBuilder).RecordContainerMemoryTotalDirtyDataPoint,
+	"total_writeback":           (*metadata.MetricsBuilder).RecordContainerMemoryTotalWritebackDataPoint,
+	"total_mapped_file":         (*metadata.MetricsBuilder).RecordContainerMemoryTotalMappedFileDataPoint,
+	"total_active_anon":         (*metadata.MetricsBuilder).RecordContainerMemoryTotalActiveAnonDataPoint,
+	"total_inactive_anon":       (*metadata.MetricsBuilder).RecordContainerMemoryTotalInactiveAnonDataPoint,
+	"total_active_file":         (*metadata.MetricsBuilder).RecordContainerMemoryTotalActiveFileDataPoint,
+	"total_inactive_file":       (*metadata.MetricsBuilder).RecordContainerMemoryTotalInactiveFileDataPoint,
+	"total_unevictable":         (*metadata.MetricsBuilder).RecordContainerMemoryTotalUnevictableDataPoint,
+	"total_pgpgin":              (*metadata.MetricsBuilder).RecordContainerMemoryTotalPgpginDataPoint,
+	"total_pgpgout":             (*metadata.MetricsBuilder).RecordContainerMemoryTotalPgpgoutDataPoint,
+	"total_pgfault":             (*metadata.MetricsBuilder).RecordContainerMemoryTotalPgfaultDataPoint,
+	"total_pgmajfault":          (*metadata.MetricsBuilder).RecordContainerMemoryTotalPgmajfaultDataPoint,
+	"anon":                      (*metadata.MetricsBuilder).RecordContainerMemoryAnonDataPoint,
+	"file":                      (*metadata.MetricsBuilder).RecordContainerMemoryFileDataPoint,
+	"kernel_stack":              (*metadata.MetricsBuilder).RecordContainerMemoryKernelStackDataPoint,
+	"slab":                      (*metadata.MetricsBuilder).RecordContainerMemorySlabDataPoint,
+	"sock":                      (*metadata.MetricsBuilder).RecordContainerMemorySockDataPoint,
+	"shmem":                     (*metadata.MetricsBuilder).RecordContainerMemoryShmemDataPoint,
+	"file_mapped":               (*metadata.MetricsBuilder).RecordContainerMemoryFileMappedDataPoint,
+	"file_dirty":                (*metadata.MetricsBuilder).RecordContainerMemoryFileDirtyDataPoint,
+	"file_writeback":            (*metadata.MetricsBuilder).RecordContainerMemoryFileWritebackDataPoint,
+	"pagetables":                (*metadata.MetricsBuilder).RecordContainerMemoryPagetablesDataPoint,
+	"percpu":                    (*metadata.MetricsBuilder).RecordContainerMemoryPercpuDataPoint,
+	"slab_reclaimable":          (*metadata.MetricsBuilder).RecordContainerMemorySlabReclaimableDataPoint,
+	"slab_unreclaimable":        (*metadata.MetricsBuilder).RecordContainerMemorySlabUnreclaimableDataPoint,
+	"anon_thp":                  (*metadata.MetricsBuilder).RecordContainerMemoryAnonThpDataPoint,
+	"file_thp":                  (*metadata.MetricsBuilder).RecordContainerMemoryFileThpDataPoint,
+	"shmem_thp":                 (*metadata.MetricsBuilder).RecordContainerMemoryShmemThpDataPoint,
+	"workingset_refault_anon":   (*metadata.MetricsBuilder).RecordContainerMemoryWorkingsetRefaultAnonDataPoint,
+	"workingset_refault_file":   (*metadata.MetricsBuilder).RecordContainerMemoryWorkingsetRefaultFileDataPoint,
+	"workingset_activate_anon":  (*metadata.MetricsBuilder).RecordContainerMemoryWorkingsetActivateAnonDataPoint,
+	"workingset_activate_file":  (*metadata.MetricsBuilder).RecordContainerMemoryWorkingsetActivateFileDataPoint,
+	"workingset_restore_anon":   (*metadata.MetricsBuilder).RecordContainerMemoryWorkingsetRestoreAnonDataPoint,
+	"workingset_restore_file":   (*metadata.MetricsBuilder).RecordContainerMemoryWorkingsetRestoreFileDataPoint,
+	"workingset_nodereclaim":    (*metadata.MetricsBuilder).RecordContainerMemoryWorkingsetNodereclaimDataPoint,
+	"pgrefill":                  (*metadata.MetricsBuilder).RecordContainerMemoryPgrefillDataPoint,
+	"pgscan":                    (*metadata.MetricsBuilder).RecordContainerMemoryPgscanDataPoint,
+	"pgsteal":                   (*metadata.MetricsBuilder).RecordContainerMemoryPgstealDataPoint,
+	"pgactivate":                (*metadata.MetricsBuilder).RecordContainerMemoryPgactivateDataPoint,
+	"pgdeactivate":              (*metadata.MetricsBuilder).RecordContainerMemoryPgdeactivateDataPoint,
+	"pglazyfree":                (*metadata.MetricsBuilder).RecordContainerMemoryPglazyfreeDataPoint,
+	"pglazyfreed":               (*metadata.MetricsBuilder).RecordContainerMemoryPglazyfreedDataPoint,
+	"thp_fault_alloc":           (*metadata.MetricsBuilder).RecordContainerMemoryThpFaultAllocDataPoint,
+	"thp_collapse_alloc":        (*metadata.MetricsBuilder).RecordContainerMemoryThpCollapseAllocDataPoint,
 }
 
-func appendMemoryMetrics(dest pdata.MetricSlice, memoryStats *dtypes.MemoryStats, ts pdata.Timestamp) {
-	totalUsage := int64(memoryStats.Usage - memoryStats.Stats["total_cache"])
-	populateGauge(dest.AppendEmpty(), "memory.usage.limit", int64(memoryStats.Limit), ts)
-	populateGauge(dest.AppendEmpty(), "memory.usage.total", totalUsage, ts)
+func (r *receiver) recordMemoryMetrics(now pdata.Timestamp, memoryStats *dtypes.MemoryStats) {
+	r.mb.RecordContainerMemoryUsageLimitDataPoint(now, int64(memoryStats.Limit))
+	r.mb.RecordContainerMemoryUsageTotalDataPoint(now, int64(memoryUsageNoCache(memoryStats, "total_cache")))
 
 	var pctUsed float64
-	if float64(memoryStats.Limit) == 0 {
-		pctUsed = 0
-	} else {
-		pctUsed = 100.0 * (float64(memoryStats.Usage) - float64(memoryStats.Stats["cache"])) / float64(memoryStats.Limit)
+	if memoryStats.Limit != 0 {
+		pctUsed = 100.0 * float64(memoryUsageNoCache(memoryStats, "cache")) / float64(memoryStats.Limit)
 	}
+	r.mb.RecordContainerMemoryPercentDataPoint(now, pctUsed)
+	r.mb.RecordContainerMemoryUsageMaxDataPoint(now, int64(memoryStats.MaxUsage))
 
-	populateGaugeF(dest.AppendEmpty(), "memory.percent", "1", pctUsed, ts, nil, nil)
-	populateGauge(dest.AppendEmpty(), "memory.usage.max", int64(memoryStats.MaxUsage), ts)
-
-	// Sorted iteration for reproducibility, largely for testing
-	sortedNames := make([]string, 0, len(memoryStats.Stats))
-	for statName := range memoryStats.Stats {
-		sortedNames = append(sortedNames, statName)
+	// Stats without a metric defined in metadata.yaml are not reported.
+	for statName, v := range memoryStats.Stats {
+		if record, ok := memoryStatRecorders[statName]; ok {
+			record(r.mb, now, int64(v))
+		}
 	}
-	sort.Strings(sortedNames)
+}
 
-	for _, statName := range sortedNames {
-		v := memoryStats.Stats[statName]
-		metricName := fmt.Sprintf("memory.%s", statName)
-		if _, exists := memoryStatsThatAreCumulative[statName]; exists {
-			populateCumulative(dest.AppendEmpty(), metricName, "1", int64(v), ts, nil, nil)
-		} else {
-			populateGauge(dest.AppendEmpty(), metricName, int64(v), ts)
+// memoryUsageNoCache returns the memory usage minus the given cache stat with
+// cgroup v1. With cgroup v2, which has no cache stat, the inactive file-backed
+// memory is subtracted instead, as done by `docker stats`.
+func memoryUsageNoCache(memoryStats *dtypes.MemoryStats, cacheStat string) uint64 {
+	if cache, ok := memoryStats.Stats[cacheStat]; ok {
+		if cache > memoryStats.Usage {
+			return 0
 		}
+		return memoryStats.Usage - cache
+	}
+	if inactiveFile, ok := memoryStats.Stats["inactive_file"]; ok && inactiveFile < memoryStats.Usage {
+		return memoryStats.Usage - inactiveFile
 	}
+	return memoryStats.Usage
 }
 
-func appendNetworkMetrics(dest pdata.MetricSlice, networks *map[string]dtypes.NetworkStats, ts pdata.Timestamp) {
+func (r *receiver) recordNetworkMetrics(now pdata.Timestamp, networks *map[string]dtypes.NetworkStats) {
 	if networks == nil || *networks == nil {
 		return
 	}
 
-	labelKeys := []string{"interface"}
 	for nic, stats := range *networks {
-		labelValues := []string{nic}
-
-		populateCumulative(dest.AppendEmpty(), "network.io.usage.rx_bytes", "By", int64(stats.RxBytes), ts, labelKeys, labelValues)
-		populateCumulative(dest.AppendEmpty(), "network.io.usage.tx_bytes", "By", int64(stats.TxBytes), ts, labelKeys, labelValues)
-
-		populateCumulative(dest.AppendEmpty(), "network.io.usage.rx_dropped", "1", int64(stats.RxDropped), ts, labelKeys, labelValues)
-		populateCumulative(dest.AppendEmpty(), "network.io.usage.rx_errors", "1", int64(stats.RxErrors), ts, labelKeys, labelValues)
-		populateCumulative(dest.AppendEmpty(), "network.io.usage.rx_packets", "1", int64(stats.RxPackets), ts, labelKeys, labelValues)
-		populateCumulative(dest.AppendEmpty(), "network.io.usage.tx_dropped", "1", int64(stats.TxDropped), ts, labelKeys, labelValues)
-		populateCumulative(dest.AppendEmpty(), "network.io.usage.tx_errors", "1", int64(stats.TxErrors), ts, labelKeys, labelValues)
-		populateCumulative(dest.AppendEmpty(), "network.io.usage.tx_packets", "1", int64(stats.TxPackets), ts, labelKeys, labelValues)
+		r.mb.RecordContainerNetworkIoUsageRxBytesDataPoint(now, int64(stats.RxBytes), nic)
+		r.mb.RecordContainerNetworkIoUsageTxBytesDataPoint(now, int64(stats.TxBytes), nic)
+
+		r.mb.RecordContainerNetworkIoUsageRxDroppedDataPoint(now, int64(stats.RxDropped), nic)
+		r.mb.RecordContainerNetworkIoUsageRxErrorsDataPoint(now, int64(stats.RxErrors), nic)
+		r.mb.RecordContainerNetworkIoUsageRxPacketsDataPoint(now, int64(stats.RxPackets), nic)
+		r.mb.RecordContainerNetworkIoUsageTxDroppedDataPoint(now, int64(stats.TxDropped), nic)
+		r.mb.RecordContainerNetworkIoUsageTxErrorsDataPoint(now, int64(stats.TxErrors), nic)
+		r.mb.RecordContainerNetworkIoUsageTxPacketsDataPoint(now, int64(stats.TxPackets), nic)
 	}
 }
 
-func populateCumulative(dest pdata.Metric, name string, unit string, val int64, ts pdata.Timestamp, labelKeys []string, labelValues []string) {
-	populateMetricMetadata(dest, name, unit, pdata.MetricDataTypeSum)
-	sum := dest.Sum()
-	sum.SetIsMonotonic(true)
-	sum.SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
-	dp := sum.DataPoints().AppendEmpty()
-	dp.SetIntVal(val)
-	dp.SetTimestamp(ts)
-	populateAttributes(dp.Attributes(), labelKeys, labelValues)
-}
-
-func populateCumulativeMultiPoints(dest pdata.Metric, name string, unit string, vals []int64, ts pdata.Timestamp, labelKeys []string, labelValues [][]string) {
-	populateMetricMetadata(dest, name, unit, pdata.MetricDataTypeSum)
-	sum := dest.Sum()
-	sum.SetIsMonotonic(true)
-	sum.SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
-	dps := sum.DataPoints()
-	dps.EnsureCapacity(len(vals))
-	for i := range vals {
-		dp := dps.AppendEmpty()
-		dp.SetIntVal(vals[i])
-		dp.SetTimestamp(ts)
-		populateAttributes(dp.Attributes(), labelKeys, labelValues[i])
+func (r *receiver) recordPidsMetrics(now pdata.Timestamp, pidsStats *dtypes.PidsStats) {
+	r.mb.RecordContainerPidsCountDataPoint(now, int64(pidsStats.Current))
+	// A zero limit means the number of pids is not limited.
+	if pidsStats.Limit != 0 {
+		r.mb.RecordContainerPidsLimitDataPoint(now, int64(pidsStats.Limit))
 	}
 }
 
-func populateGauge(dest pdata.Metric, name string, val int64, ts pdata.Timestamp) {
-	// Unit, labelKeys, labelValues always constants, when that changes add them as argument to the func.
-	populateMetricMetadata(dest, name, "By", pdata.MetricDataTypeGauge)
-	sum := dest.Gauge()
-	dp := sum.DataPoints().AppendEmpty()
-	dp.SetIntVal(val)
-	dp.SetTimestamp(ts)
-	populateAttributes(dp.Attributes(), nil, nil)
-}
-
-func populateGaugeF(dest pdata.Metric, name string, unit string, val float64, ts pdata.Timestamp, labelKeys []string, labelValues []string) {
-	populateMetricMetadata(dest, name, unit, pdata.MetricDataTypeGauge)
-	sum := dest.Gauge()
-	dp := sum.DataPoints().AppendEmpty()
-	dp.SetDoubleVal(val)
-	dp.SetTimestamp(ts)
-	populateAttributes(dp.Attributes(), labelKeys, labelValues)
-}
-
-func populateMetricMetadata(dest pdata.Metric, name string, unit string, ty pdata.MetricDataType) {
-	dest.SetName(metricPrefix + name)
-	dest.SetUnit(unit)
-	dest.SetDataType(ty)
-}
+func (r *receiver) recordContainerStateMetrics(now pdata.Timestamp, container *docker.Container) {
+	r.mb.RecordContainerRestartsDataPoint(now, int64(container.RestartCount))
 
-func populateAttributes(dest pdata.AttributeMap, labelKeys []string, labelValues []string) {
-	for i := range labelKeys {
-		dest.UpsertString(labelKeys[i], labelValues[i])
+	if container.State == nil {
+		return
+	}
+	if startedAt, err := time.Parse(time.RFC3339Nano, container.State.StartedAt); err == nil {
+		if uptime := now.AsTime().Sub(startedAt); uptime > 0 {
+			r.mb.RecordContainerUptimeDataPoint(now, uptime.Seconds())
+		}
 	}
 }
@@ -18,21 +18,26 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"path"
+	"sort"
 	"testing"
 	"time"
 
 	dtypes "github.com/docker/docker/api/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/model/pdata"
 	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/docker"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver/internal/metadata"
 )
 
 type MetricType int32
 
 const (
 	MetricTypeCumulative MetricType = iota
+	MetricTypeNonMonotonicCumulative
 	MetricTypeGauge
 	MetricTypeDoubleGauge
 )
@@ -51,53 +56,62 @@ type Value struct {
 	doubleValue float64
 }
 
-func metricsData(
-	ts pdata.Timestamp,
-	resourceLabels map[string]string,
-	metrics ...Metric,
-) pdata.Metrics {
-	rLabels := mergeMaps(defaultLabels(), resourceLabels)
-	md := pdata.NewMetrics()
-	rs := md.ResourceMetrics().AppendEmpty()
-	rs.SetSchemaUrl(conventions.SchemaURL)
-	rsAttr := rs.Resource().Attributes()
-	for k, v := range rLabels {
-		rsAttr.UpsertString(k, v)
-	}
-	rsAttr.Sort()
-
-	mdMetrics := rs.InstrumentationLibraryMetrics().AppendEmpty().Metrics()
-	mdMetrics.EnsureCapacity(len(metrics))
-	for _, m := range metrics {
-		mdMetric := mdMetrics.AppendEmpty()
-		mdMetric.SetName(m.name)
-		mdMetric.SetUnit(m.unit)
+// toMetrics converts the metrics of the resource to Metric, sorted by name.
+func toMetrics(t *testing.T, now pdata.Timestamp, ms pdata.MetricSlice) []Metric {
+	metrics := make([]Metric, 0, ms.Len())
+	for i := 0; i < ms.Len(); i++ {
+		m := ms.At(i)
+		metric := Metric{name: m.Name(), unit: m.Unit()}
 
 		var dps pdata.NumberDataPointSlice
-		switch m.mtype {
-		case MetricTypeCumulative:
-			mdMetric.SetDataType(pdata.MetricDataTypeSum)
-			mdMetric.Sum().SetIsMonotonic(true)
-			mdMetric.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
-			dps = mdMetric.Sum().DataPoints()
-		case MetricTypeGauge, MetricTypeDoubleGauge:
-			mdMetric.SetDataType(pdata.MetricDataTypeGauge)
-			dps = mdMetric.Gauge().DataPoints()
+		switch m.DataType() {
+		case pdata.MetricDataTypeSum:
+			require.Equal(t, pdata.MetricAggregationTemporalityCumulative, m.Sum().AggregationTemporality(), m.Name())
+			metric.mtype = MetricTypeCumulative
+			if !m.Sum().IsMonotonic() {
+				metric.mtype = MetricTypeNonMonotonicCumulative
+			}
+			dps = m.Sum().DataPoints()
+		case pdata.MetricDataTypeGauge:
+			metric.mtype = MetricTypeGauge
+			dps = m.Gauge().DataPoints()
+		default:
+			t.Fatalf("unexpected data type %v of metric %s", m.DataType(), m.Name())
 		}
 
-		for _, v := range m.values {
-			dp := dps.AppendEmpty()
-			dp.SetTimestamp(ts)
-			if m.mtype == MetricTypeDoubleGauge {
-				dp.SetDoubleVal(v.doubleValue)
+		for j := 0; j < dps.Len(); j++ {
+			dp := dps.At(j)
+			assert.Equal(t, now, dp.Timestamp(), m.Name())
+
+			var value Value
+			if dp.Type() == pdata.MetricValueTypeDouble {
+				metric.mtype = MetricTypeDoubleGauge
+				value.doubleValue = dp.DoubleVal()
 			} else {
-				dp.SetIntVal(v.value)
+				value.value = dp.IntVal()
 			}
-			populateAttributes(dp.Attributes(), m.labelKeys, v.labelValues)
+
+			if j == 0 {
+				dp.Attributes().Sort().Range(func(k string, _ pdata.AttributeValue) bool {
+					metric.labelKeys = append(metric.labelKeys, k)
+					return true
+				})
+			}
+			for _, k := range metric.labelKeys {
+				v, ok := dp.Attributes().Get(k)
+				require.True(t, ok, "missing attribute %s of metric %s", k, m.Name())
+				value.labelValues = append(value.labelValues, v.StringVal())
+			}
+			metric.values = append(metric.values, value)
 		}
+		metrics = append(metrics, metric)
 	}
+	sortMetrics(metrics)
+	return metrics
+}
 
-	return md
+func sortMetrics(metrics []Metric) {
+	sort.Slice(metrics, func(i, j int) bool { return metrics[i].name < metrics[j].name })
 }
 
 func defaultLabels() map[string]string {
@@ -111,18 +125,34 @@ func defaultLabels() map[string]string {
 
 func defaultMetrics() []Metric {
 	return []Metric{
-		{name: "container.blockio.io_service_bytes_recursive.read", mtype: MetricTypeCumulative, unit: "By", labelKeys: []string{"device_major", "device_minor"}, values: []Value{{labelValues: []string{"202", "0"}, value: 56500224}}},
-		{name: "container.blockio.io_service_bytes_recursive.write", mtype: MetricTypeCumulative, unit: "By", labelKeys: []string{"device_major", "device_minor"}, values: []Value{{labelValues: []string{"202", "0"}, value: 12103680}}},
-		{name: "container.blockio.io_service_bytes_recursive.sync", mtype: MetricTypeCumulative, unit: "By", labelKeys: []string{"device_major", "device_minor"}, values: []Value{{labelValues: []string{"202", "0"}, value: 65314816}}},
-		{name: "container.blockio.io_service_bytes_recursive.async", mtype: MetricTypeCumulative, unit: "By", labelKeys: []string{"device_major", "device_minor"}, values: []Value{{labelValues: []string{"202", "0"}, value: 3289088}}},
-		{name: "container.blockio.io_service_bytes_recursive.discard", mtype: MetricTypeCumulative, unit: "By", labelKeys: []string{"device_major", "device_minor"}, values: []Value{{labelValues: []string{"202", "0"}, value: 0}}},
-		{name: "container.blockio.io_service_bytes_recursive.total", mtype: MetricTypeCumulative, unit: "By", labelKeys: []string{"device_major", "device_minor"}, values: []Value{{labelValues: []string{"202", "0"}, value: 68603904}}},
-		{name: "container.blockio.io_serviced_recursive.read", mtype: MetricTypeCumulative, unit: "1", labelKeys: []string{"device_major", "device_minor"}, values: []Value{{labelValues: []string{"202", "0"}, value: 985}}},
-		{name: "container.blockio.io_serviced_recursive.write", mtype: MetricTypeCumulative, unit: "1", labelKeys: []string{"device_major", "device_minor"}, values: []Value{{labelValues: []string{"202", "0"}, value: 2073}}},
-		{name: "container.blockio.io_serviced_recursive.sync", mtype: MetricTypeCumulative, unit: "1", labelKeys: []string{"device_major", "device_minor"}, values: []Value{{labelValues: []string{"202", "0"}, value: 2902}}},
-		{name: "container.blockio.io_serviced_recursive.async", mtype: MetricTypeCumulative, unit: "1", labelKeys: []string{"device_major", "device_minor"}, values: []Value{{labelValues: []string{"202", "0"}, value: 156}}},
-		{name: "container.blockio.io_serviced_recursive.discard", mtype: MetricTypeCumulative, unit: "1", labelKeys: []string{"device_major", "device_minor"}, values: []Value{{labelValues: []string{"202", "0"}, value: 0}}},
-		{name: "container.blockio.io_serviced_recursive.total", mtype: MetricTypeCumulative, unit: "1", labelKeys: []string{"device_major", "device_minor"}, values: []Value{{labelValues: []string{"202", "0"}, value: 3058}}},
+		{
+			name:      "container.blockio.io_service_bytes_recursive",
+			mtype:     MetricTypeCumulative,
+			unit:      "By",
+			labelKeys: []string{"device_major", "device_minor", "operation"},
+			values: []Value{
+				{labelValues: []string{"202", "0", "read"}, value: 56500224},
+				{labelValues: []string{"202", "0", "write"}, value: 12103680},
+				{labelValues: []string{"202", "0", "sync"}, value: 65314816},
+				{labelValues: []string{"202", "0", "async"}, value: 3289088},
+				{labelValues: []string{"202", "0", "discard"}, value: 0},
+				{labelValues: []string{"202", "0", "total"}, value: 68603904},
+			},
+		},
+		{
+			name:      "container.blockio.io_serviced_recursive",
+			mtype:     MetricTypeCumulative,
+			unit:      "1",
+			labelKeys: []string{"device_major", "device_minor", "operation"},
+			values: []Value{
+				{labelValues: []string{"202", "0", "read"}, value: 985},
+				{labelValues: []string{"202", "0", "write"}, value: 2073},
+				{labelValues: []string{"202", "0", "sync"}, value: 2902},
+				{labelValues: []string{"202", "0", "async"}, value: 156},
+				{labelValues: []string{"202", "0", "discard"}, value: 0},
+				{labelValues: []string{"202", "0", "total"}, value: 3058},
+			},
+		},
 		{name: "container.cpu.usage.system", mtype: MetricTypeCumulative, unit: "ns", labelKeys: nil, values: []Value{{labelValues: nil, value: 4525290000000}}},
 		{name: "container.cpu.usage.total", mtype: MetricTypeCumulative, unit: "ns", labelKeys: nil, values: []Value{{labelValues: nil, value: 8043152341}}},
 		{name: "container.cpu.usage.kernelmode", mtype: MetricTypeCumulative, unit: "ns", labelKeys: nil, values: []Value{{labelValues: nil, value: 970000000}}},
@@ -188,6 +218,21 @@ func mergeMaps(maps ...map[string]string) map[string]string {
 	return merged
 }
 
+// containerMetrics records and emits the metrics of the container stats with a
+// receiver created from the config.
+func containerMetrics(config *Config, now pdata.Timestamp, stats *dtypes.StatsJSON, container docker.Container) pdata.Metrics {
+	recv := &receiver{
+		config:   config,
+		settings: componenttest.NewNopReceiverCreateSettings(),
+		mb:       metadata.NewMetricsBuilder(metricsSettings(config)),
+	}
+
+	md := pdata.NewMetrics()
+	recv.recordContainerStats(now, stats, &container)
+	recv.emitContainerMetrics(md.ResourceMetrics(), &container)
+	return md
+}
+
 func assertMetricsDataEqual(
 	t *testing.T,
 	now pdata.Timestamp,
@@ -195,8 +240,23 @@ func assertMetricsDataEqual(
 	labels map[string]string,
 	actual pdata.Metrics,
 ) {
-	actual.ResourceMetrics().At(0).Resource().Attributes().Sort()
-	assert.Equal(t, metricsData(now, labels, expected...), actual)
+	require.Equal(t, 1, actual.ResourceMetrics().Len())
+	rm := actual.ResourceMetrics().At(0)
+	assert.Equal(t, conventions.SchemaURL, rm.SchemaUrl())
+
+	expectedLabels := map[string]interface{}{}
+	for k, v := range mergeMaps(defaultLabels(), labels) {
+		expectedLabels[k] = v
+	}
+	assert.Equal(t, expectedLabels, rm.Resource().Attributes().AsRaw())
+
+	require.Equal(t, 1, rm.InstrumentationLibraryMetrics().Len())
+	sortMetrics(expected)
+	assert.Equal(t, expected, toMetrics(t, now, rm.InstrumentationLibraryMetrics().At(0).Metrics()))
+}
+
+func defaultConfig() *Config {
+	return &Config{Metrics: metadata.DefaultMetricsSettings()}
 }
 
 func TestZeroValueStats(t *testing.T) {
@@ -209,10 +269,9 @@ func TestZeroValueStats(t *testing.T) {
 		Networks: nil,
 	}
 	containers := containerJSON(t)
-	config := &Config{}
 
 	now := pdata.NewTimestampFromTime(time.Now())
-	md := ContainerStatsToMetrics(now, stats, containers, config)
+	md := containerMetrics(defaultConfig(), now, stats, containers)
 
 	metrics := []Metric{
 		{name: "container.cpu.usage.system", mtype: MetricTypeCumulative, unit: "ns", labelKeys: nil, values: []Value{{labelValues: nil, value: 0}}},
@@ -265,10 +324,9 @@ func containerJSON(t *testing.T) docker.Container {
 func TestStatsToDefaultMetrics(t *testing.T) {
 	stats := statsJSON(t)
 	containers := containerJSON(t)
-	config := &Config{}
 
 	now := pdata.NewTimestampFromTime(time.Now())
-	md := ContainerStatsToMetrics(now, stats, containers, config)
+	md := containerMetrics(defaultConfig(), now, stats, containers)
 
 	assertMetricsDataEqual(t, now, defaultMetrics(), nil, md)
 }
@@ -276,35 +334,18 @@ func TestStatsToDefaultMetrics(t *testing.T) {
 func TestStatsToAllMetrics(t *testing.T) {
 	stats := statsJSON(t)
 	containers := containerJSON(t)
-	config := &Config{
-		ProvidePerCoreCPUMetrics: true,
-	}
-
-	now := pdata.NewTimestampFromTime(time.Now())
-	md := ContainerStatsToMetrics(now, stats, containers, config)
-
-	metrics := []Metric{
-		{name: "container.blockio.io_service_bytes_recursive.read", mtype: MetricTypeCumulative, unit: "By", labelKeys: []string{"device_major", "device_minor"}, values: []Value{{labelValues: []string{"202", "0"}, value: 56500224}}},
-		{name: "container.blockio.io_service_bytes_recursive.write", mtype: MetricTypeCumulative, unit: "By", labelKeys: []string{"device_major", "device_minor"}, values: []Value{{labelValues: []string{"202", "0"}, value: 12103680}}},
-		{name: "container.blockio.io_service_bytes_recursive.sync", mtype: MetricTypeCumulative, unit: "By", labelKeys: []string{"device_major", "device_minor"}, values: []Value{{labelValues: []string{"202", "0"}, value: 65314816}}},
-		{name: "container.blockio.io_service_bytes_recursive.async", mtype: MetricTypeCumulative, unit: "By", labelKeys: []string{"device_major", "device_minor"}, values: []Value{{labelValues: []string{"202", "0"}, value: 3289088}}},
-		{name: "container.blockio.io_service_bytes_recursive.discard", mtype: MetricTypeCumulative, unit: "By", labelKeys: []string{"device_major", "device_minor"}, values: []Value{{labelValues: []string{"202", "0"}, value: 0}}},
-		{name: "container.blockio.io_service_bytes_recursive.total", mtype: MetricTypeCumulative, unit: "By", labelKeys: []string{"device_major", "device_minor"}, values: []Value{{labelValues: []string{"202", "0"}, value: 68603904}}},
-		{name: "container.blockio.io_serviced_recursive.read", mtype: MetricTypeCumulative, unit: "1", labelKeys: []string{"device_major", "device_minor"}, values: []Value{{labelValues: []string{"202", "0"}, value: 985}}},
-		{name: "container.blockio.io_serviced_recursive.write", mtype: MetricTypeCumulative, unit: "1", labelKeys: []string{"device_major", "device_minor"}, values: []Value{{labelValues: []string{"202", "0"}, value: 2073}}},
-		{name: "container.blockio.io_serviced_recursive.sync", mtype: MetricTypeCumulative, unit: "1", labelKeys: []string{"device_major", "device_minor"}, values: []Value{{labelValues: []string{"202", "0"}, value: 2902}}},
-		{name: "container.blockio.io_serviced_recursive.async", mtype: MetricTypeCumulative, unit: "1", labelKeys: []string{"device_major", "device_minor"}, values: []Value{{labelValues: []string{"202", "0"}, value: 156}}},
-		{name: "container.blockio.io_serviced_recursive.discard", mtype: MetricTypeCumulative, unit: "1", labelKeys: []string{"device_major", "device_minor"}, values: []Value{{labelValues: []string{"202", "0"}, value: 0}}},
-		{name: "container.blockio.io_serviced_recursive.total", mtype: MetricTypeCumulative, unit: "1", labelKeys: []string{"device_major", "device_minor"}, values: []Value{{labelValues: []string{"202", "0"}, value: 3058}}},
-		{name: "container.cpu.usage.system", mtype: MetricTypeCumulative, unit: "ns", labelKeys: nil, values: []Value{{labelValues: nil, value: 4525290000000}}},
-		{name: "container.cpu.usage.total", mtype: MetricTypeCumulative, unit: "ns", labelKeys: nil, values: []Value{{labelValues: nil, value: 8043152341}}},
-		{name: "container.cpu.usage.kernelmode", mtype: MetricTypeCumulative, unit: "ns", labelKeys: nil, values: []Value{{labelValues: nil, value: 970000000}}},
-		{name: "container.cpu.usage.usermode", mtype: MetricTypeCumulative, unit: "ns", labelKeys: nil, values: []Value{{labelValues: nil, value: 3510000000}}},
-		{name: "container.cpu.throttling_data.periods", mtype: MetricTypeCumulative, unit: "1", labelKeys: nil, values: []Value{{labelValues: nil, value: 0}}},
-		{name: "container.cpu.throttling_data.throttled_periods", mtype: MetricTypeCumulative, unit: "1", labelKeys: nil, values: []Value{{labelValues: nil, value: 0}}},
-		{name: "container.cpu.throttling_data.throttled_time", mtype: MetricTypeCumulative, unit: "ns", labelKeys: nil, values: []Value{{labelValues: nil, value: 0}}},
-		{name: "container.cpu.percent", mtype: MetricTypeDoubleGauge, unit: "1", labelKeys: nil, values: []Value{{labelValues: nil, doubleValue: 0.19316}}},
-		{
+	config := defaultConfig()
+	config.Metrics.ContainerCPUUsagePercpu.Enabled = true
+	config.Metrics.ContainerPidsCount.Enabled = true
+	config.Metrics.ContainerPidsLimit.Enabled = true
+	config.Metrics.ContainerRestarts.Enabled = true
+	config.Metrics.ContainerUptime.Enabled = true
+
+	now := pdata.NewTimestampFromTime(time.Date(2020, 1, 1, 1, 0, 2, 12345678, time.UTC))
+	md := containerMetrics(config, now, stats, containers)
+
+	metrics := append(defaultMetrics(),
+		Metric{
 			name:      "container.cpu.usage.percpu",
 			mtype:     MetricTypeCumulative,
 			unit:      "ns",
@@ -320,67 +361,131 @@ func TestStatsToAllMetrics(t *testing.T) {
 				{labelValues: []string{"cpu7"}, value: 0},
 			},
 		},
-		{name: "container.memory.usage.limit", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 1026359296}}},
-		{name: "container.memory.usage.total", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 75915264}}},
-		{name: "container.memory.percent", mtype: MetricTypeDoubleGauge, unit: "1", labelKeys: nil, values: []Value{{labelValues: nil, doubleValue: 7.396558329608582}}},
-		{name: "container.memory.usage.max", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 325246976}}},
-		{name: "container.memory.active_anon", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 72585216}}},
-		{name: "container.memory.active_file", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 40316928}}},
-		{name: "container.memory.cache", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 80760832}}},
-		{name: "container.memory.dirty", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 0}}},
-		{name: "container.memory.hierarchical_memory_limit", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 9223372036854771712}}},
-		{name: "container.memory.hierarchical_memsw_limit", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 0}}},
-		{name: "container.memory.inactive_anon", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 0}}},
-		{name: "container.memory.inactive_file", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 40579072}}},
-		{name: "container.memory.mapped_file", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 37711872}}},
-		{name: "container.memory.pgfault", mtype: MetricTypeCumulative, unit: "1", labelKeys: nil, values: []Value{{labelValues: nil, value: 21714}}},
-		{name: "container.memory.pgmajfault", mtype: MetricTypeCumulative, unit: "1", labelKeys: nil, values: []Value{{labelValues: nil, value: 396}}},
-		{name: "container.memory.pgpgin", mtype: MetricTypeCumulative, unit: "1", labelKeys: nil, values: []Value{{labelValues: nil, value: 85140}}},
-		{name: "container.memory.pgpgout", mtype: MetricTypeCumulative, unit: "1", labelKeys: nil, values: []Value{{labelValues: nil, value: 47694}}},
-		{name: "container.memory.rss", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 72568832}}},
-		{name: "container.memory.rss_huge", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 0}}},
-		{name: "container.memory.total_active_anon", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 72585216}}},
-		{name: "container.memory.total_active_file", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 40316928}}},
-		{name: "container.memory.total_cache", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 80760832}}},
-		{name: "container.memory.total_dirty", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 0}}},
-		{name: "container.memory.total_inactive_anon", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 0}}},
-		{name: "container.memory.total_inactive_file", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 40579072}}},
-		{name: "container.memory.total_mapped_file", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 37711872}}},
-		{name: "container.memory.total_pgfault", mtype: MetricTypeCumulative, unit: "1", labelKeys: nil, values: []Value{{labelValues: nil, value: 21714}}},
-		{name: "container.memory.total_pgmajfault", mtype: MetricTypeCumulative, unit: "1", labelKeys: nil, values: []Value{{labelValues: nil, value: 396}}},
-		{name: "container.memory.total_pgpgin", mtype: MetricTypeCumulative, unit: "1", labelKeys: nil, values: []Value{{labelValues: nil, value: 85140}}},
-		{name: "container.memory.total_pgpgout", mtype: MetricTypeCumulative, unit: "1", labelKeys: nil, values: []Value{{labelValues: nil, value: 47694}}},
-		{name: "container.memory.total_rss", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 72568832}}},
-		{name: "container.memory.total_rss_huge", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 0}}},
-		{name: "container.memory.total_unevictable", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 0}}},
-		{name: "container.memory.total_writeback", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 0}}},
-		{name: "container.memory.unevictable", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 0}}},
-		{name: "container.memory.writeback", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 0}}},
-		{name: "container.network.io.usage.rx_bytes", mtype: MetricTypeCumulative, unit: "By", labelKeys: []string{"interface"}, values: []Value{{labelValues: []string{"eth0"}, value: 2787669}}},
-		{name: "container.network.io.usage.tx_bytes", mtype: MetricTypeCumulative, unit: "By", labelKeys: []string{"interface"}, values: []Value{{labelValues: []string{"eth0"}, value: 2275281}}},
-		{name: "container.network.io.usage.rx_dropped", mtype: MetricTypeCumulative, unit: "1", labelKeys: []string{"interface"}, values: []Value{{labelValues: []string{"eth0"}, value: 0}}},
-		{name: "container.network.io.usage.rx_errors", mtype: MetricTypeCumulative, unit: "1", labelKeys: []string{"interface"}, values: []Value{{labelValues: []string{"eth0"}, value: 0}}},
-		{name: "container.network.io.usage.rx_packets", mtype: MetricTypeCumulative, unit: "1", labelKeys: []string{"interface"}, values: []Value{{labelValues: []string{"eth0"}, value: 16598}}},
-		{name: "container.network.io.usage.tx_dropped", mtype: MetricTypeCumulative, unit: "1", labelKeys: []string{"interface"}, values: []Value{{labelValues: []string{"eth0"}, value: 0}}},
-		{name: "container.network.io.usage.tx_errors", mtype: MetricTypeCumulative, unit: "1", labelKeys: []string{"interface"}, values: []Value{{labelValues: []string{"eth0"}, value: 0}}},
-		{name: "container.network.io.usage.tx_packets", mtype: MetricTypeCumulative, unit: "1", labelKeys: []string{"interface"}, values: []Value{{labelValues: []string{"eth0"}, value: 9050}}},
+		Metric{name: "container.pids.count", mtype: MetricTypeNonMonotonicCumulative, unit: "{pids}", labelKeys: nil, values: []Value{{labelValues: nil, value: 34}}},
+		Metric{name: "container.pids.limit", mtype: MetricTypeNonMonotonicCumulative, unit: "{pids}", labelKeys: nil, values: []Value{{labelValues: nil, value: 16384}}},
+		Metric{name: "container.restarts", mtype: MetricTypeCumulative, unit: "{restarts}", labelKeys: nil, values: []Value{{labelValues: nil, value: 3}}},
+		// The container started an hour before now.
+		Metric{name: "container.uptime", mtype: MetricTypeDoubleGauge, unit: "s", labelKeys: nil, values: []Value{{labelValues: nil, doubleValue: 3600}}},
+	)
+
+	assertMetricsDataEqual(t, now, metrics, nil, md)
+}
+
+func TestDeprecatedPerCoreCPUMetrics(t *testing.T) {
+	config := defaultConfig()
+	config.ProvidePerCoreCPUMetrics = true
+
+	now := pdata.NewTimestampFromTime(time.Now())
+	md := containerMetrics(config, now, statsJSON(t), containerJSON(t))
+
+	metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+	var found bool
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() == "container.cpu.usage.percpu" {
+			found = true
+			assert.Equal(t, 8, metrics.At(i).Sum().DataPoints().Len())
+		}
+	}
+	assert.True(t, found, "container.cpu.usage.percpu not found")
+}
+
+func TestDisabledMetrics(t *testing.T) {
+	config := defaultConfig()
+	config.Metrics.ContainerCPUPercent.Enabled = false
+	config.Metrics.ContainerBlockioIoServiceBytesRecursive.Enabled = false
+	config.Metrics.ContainerMemoryCache.Enabled = false
+
+	now := pdata.NewTimestampFromTime(time.Now())
+	md := containerMetrics(config, now, statsJSON(t), containerJSON(t))
+
+	var metrics []Metric
+	for _, m := range defaultMetrics() {
+		switch m.name {
+		case "container.cpu.percent", "container.blockio.io_service_bytes_recursive", "container.memory.cache":
+		default:
+			metrics = append(metrics, m)
+		}
+	}
+	assertMetricsDataEqual(t, now, metrics, nil, md)
+}
+
+func TestMemoryUsageNoCache(t *testing.T) {
+	tests := []struct {
+		name     string
+		stats    map[string]uint64
+		expected uint64
+	}{
+		{
+			name:     "cgroup v1",
+			stats:    map[string]uint64{"total_cache": 300, "inactive_file": 100},
+			expected: 700,
+		},
+		{
+			name:     "cgroup v2",
+			stats:    map[string]uint64{"inactive_file": 100, "file": 300},
+			expected: 900,
+		},
+		{
+			name:     "cache larger than usage",
+			stats:    map[string]uint64{"total_cache": 3000},
+			expected: 0,
+		},
+		{
+			name:     "no stats",
+			expected: 1000,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, memoryUsageNoCache(&dtypes.MemoryStats{Usage: 1000, Stats: test.stats}, "total_cache"))
+		})
 	}
+}
 
+func TestUnknownMemoryStats(t *testing.T) {
+	stats := &dtypes.StatsJSON{
+		Stats: dtypes.Stats{
+			MemoryStats: dtypes.MemoryStats{
+				Stats: map[string]uint64{"anon": 100, "workingset_refault_file": 7, "anon_thp": 200, "unknown": 300},
+			},
+		},
+	}
+
+	now := pdata.NewTimestampFromTime(time.Now())
+	md := containerMetrics(defaultConfig(), now, stats, containerJSON(t))
+
+	metrics := []Metric{
+		{name: "container.cpu.usage.system", mtype: MetricTypeCumulative, unit: "ns", labelKeys: nil, values: []Value{{labelValues: nil, value: 0}}},
+		{name: "container.cpu.usage.total", mtype: MetricTypeCumulative, unit: "ns", labelKeys: nil, values: []Value{{labelValues: nil, value: 0}}},
+		{name: "container.cpu.usage.kernelmode", mtype: MetricTypeCumulative, unit: "ns", labelKeys: nil, values: []Value{{labelValues: nil, value: 0}}},
+		{name: "container.cpu.usage.usermode", mtype: MetricTypeCumulative, unit: "ns", labelKeys: nil, values: []Value{{labelValues: nil, value: 0}}},
+		{name: "container.cpu.throttling_data.periods", mtype: MetricTypeCumulative, unit: "1", labelKeys: nil, values: []Value{{labelValues: nil, value: 0}}},
+		{name: "container.cpu.throttling_data.throttled_periods", mtype: MetricTypeCumulative, unit: "1", labelKeys: nil, values: []Value{{labelValues: nil, value: 0}}},
+		{name: "container.cpu.throttling_data.throttled_time", mtype: MetricTypeCumulative, unit: "ns", labelKeys: nil, values: []Value{{labelValues: nil, value: 0}}},
+		{name: "container.cpu.percent", mtype: MetricTypeDoubleGauge, unit: "1", labelKeys: nil, values: []Value{{labelValues: nil, doubleValue: 0}}},
+		{name: "container.memory.usage.limit", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 0}}},
+		{name: "container.memory.usage.total", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 0}}},
+		{name: "container.memory.percent", mtype: MetricTypeDoubleGauge, unit: "1", labelKeys: nil, values: []Value{{labelValues: nil, doubleValue: 0}}},
+		{name: "container.memory.usage.max", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 0}}},
+		{name: "container.memory.anon", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 100}}},
+		{name: "container.memory.anon_thp", mtype: MetricTypeGauge, unit: "By", labelKeys: nil, values: []Value{{labelValues: nil, value: 200}}},
+		{name: "container.memory.workingset_refault_file", mtype: MetricTypeCumulative, unit: "1", labelKeys: nil, values: []Value{{labelValues: nil, value: 7}}},
+	}
 	assertMetricsDataEqual(t, now, metrics, nil, md)
 }
 
 func TestEnvVarToMetricLabels(t *testing.T) {
 	stats := statsJSON(t)
 	containers := containerJSON(t)
-	config := &Config{
-		EnvVarsToMetricLabels: map[string]string{
-			"MY_ENV_VAR":       "my.env.to.metric.label",
-			"MY_OTHER_ENV_VAR": "my.other.env.to.metric.label",
-		},
+	config := defaultConfig()
+	config.EnvVarsToMetricLabels = map[string]string{
+		"MY_ENV_VAR":       "my.env.to.metric.label",
+		"MY_OTHER_ENV_VAR": "my.other.env.to.metric.label",
 	}
 
 	now := pdata.NewTimestampFromTime(time.Now())
-	md := ContainerStatsToMetrics(now, stats, containers, config)
+	md := containerMetrics(config, now, stats, containers)
 
 	expectedLabels := map[string]string{
 		"my.env.to.metric.label":       "my_env_var_value",
@@ -393,15 +498,14 @@ func TestEnvVarToMetricLabels(t *testing.T) {
 func TestContainerLabelToMetricLabels(t *testing.T) {
 	stats := statsJSON(t)
 	containers := containerJSON(t)
-	config := &Config{
-		ContainerLabelsToMetricLabels: map[string]string{
-			"my.specified.docker.label":    "my.docker.to.metric.label",
-			"other.specified.docker.label": "my.other.docker.to.metric.label",
-		},
+	config := defaultConfig()
+	config.ContainerLabelsToMetricLabels = map[string]string{
+		"my.specified.docker.label":    "my.docker.to.metric.label",
+		"other.specified.docker.label": "my.other.docker.to.metric.label",
 	}
 
 	now := pdata.NewTimestampFromTime(time.Now())
-	md := ContainerStatsToMetrics(now, stats, containers, config)
+	md := containerMetrics(config, now, stats, containers)
 
 	expectedLabels := map[string]string{
 		"my.docker.to.metric.label":       "my_specified_docker_label_value",
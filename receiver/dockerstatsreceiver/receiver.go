@@ -19,6 +19,7 @@ import (
 	"sync"
 	"time"
 
+	dtypes "github.com/docker/docker/api/types"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/model/pdata"
@@ -27,6 +28,7 @@ import (
 	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/docker"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver/internal/metadata"
 )
 
 const (
@@ -38,6 +40,7 @@ type receiver struct {
 	config   *Config
 	settings component.ReceiverCreateSettings
 	client   *docker.Client
+	mb       *metadata.MetricsBuilder
 }
 
 func NewReceiver(
@@ -46,9 +49,14 @@ func NewReceiver(
 	config *Config,
 	nextConsumer consumer.Metrics,
 ) (component.MetricsReceiver, error) {
+	if config.ProvidePerCoreCPUMetrics {
+		set.Logger.Warn("provide_per_core_cpu_metrics is deprecated, enable the container.cpu.usage.percpu metric instead")
+	}
+
 	recv := receiver{
 		config:   config,
 		settings: set,
+		mb:       metadata.NewMetricsBuilder(metricsSettings(config)),
 	}
 
 	scrp, err := scraperhelper.NewScraper(typeStr, recv.scrape, scraperhelper.WithStart(recv.start))
@@ -77,9 +85,20 @@ func (r *receiver) start(ctx context.Context, _ component.Host) error {
 	return nil
 }
 
+// metricsSettings returns the metrics settings of the config, with the
+// container.cpu.usage.percpu metric enabled by the deprecated provide_per_core_cpu_metrics.
+func metricsSettings(config *Config) metadata.MetricsSettings {
+	settings := config.Metrics
+	if config.ProvidePerCoreCPUMetrics {
+		settings.ContainerCPUUsagePercpu.Enabled = true
+	}
+	return settings
+}
+
 type result struct {
-	md  pdata.Metrics
-	err error
+	container docker.Container
+	stats     *dtypes.StatsJSON
+	err       error
 }
 
 func (r *receiver) scrape(ctx context.Context) (pdata.Metrics, error) {
@@ -92,14 +111,7 @@ func (r *receiver) scrape(ctx context.Context) (pdata.Metrics, error) {
 		go func(c docker.Container) {
 			defer wg.Done()
 			statsJSON, err := r.client.FetchContainerStatsAsJSON(ctx, c)
-			if err != nil {
-				results <- result{md: pdata.Metrics{}, err: err}
-				return
-			}
-
-			results <- result{
-				md:  ContainerStatsToMetrics(pdata.NewTimestampFromTime(time.Now()), statsJSON, c, r.config),
-				err: nil}
+			results <- result{container: c, stats: statsJSON, err: err}
 		}(container)
 	}
 
@@ -107,6 +119,7 @@ func (r *receiver) scrape(ctx context.Context) (pdata.Metrics, error) {
 	close(results)
 
 	var errs error
+	now := pdata.NewTimestampFromTime(time.Now())
 	md := pdata.NewMetrics()
 	for res := range results {
 		if res.err != nil {
@@ -114,7 +127,8 @@ func (r *receiver) scrape(ctx context.Context) (pdata.Metrics, error) {
 			errs = multierr.Append(errs, scrapererror.NewPartialScrapeError(res.err, 0))
 			continue
 		}
-		res.md.ResourceMetrics().CopyTo(md.ResourceMetrics())
+		r.recordContainerStats(now, res.stats, &res.container)
+		r.emitContainerMetrics(md.ResourceMetrics(), &res.container)
 	}
 
 	return md, errs
@@ -14,7 +14,12 @@
 
 package fluentforwardreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/fluentforwardreceiver"
 
-import "go.opentelemetry.io/collector/config"
+import (
+	"errors"
+
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configtls"
+)
 
 // Config defines configuration for the SignalFx receiver.
 type Config struct {
@@ -24,4 +29,40 @@ type Config struct {
 	// of the form `<ip addr>:<port>` (TCP) or `unix://<socket_path>` (Unix
 	// domain socket).
 	ListenAddress string `mapstructure:"endpoint"`
+
+	// TLSSetting enables TLS on the connections if set.
+	TLSSetting *configtls.TLSServerSetting `mapstructure:"tls"`
+
+	// Security enables the handshake of the forward protocol if its shared
+	// key is set.
+	Security SecuritySettings `mapstructure:"security"`
+}
+
+// SecuritySettings defines the authentication of the clients during the
+// handshake of the forward protocol.
+type SecuritySettings struct {
+	// SelfHostname is the hostname the receiver identifies with to the clients.
+	SelfHostname string `mapstructure:"self_hostname"`
+
+	// SharedKey is the key shared with the clients to authenticate each other.
+	SharedKey string `mapstructure:"shared_key"`
+
+	// Users requires the clients to authenticate with one of these users if set.
+	Users []UserSettings `mapstructure:"users"`
+}
+
+// UserSettings defines a user the clients can authenticate with.
+type UserSettings struct {
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+}
+
+var errUsersWithoutSharedKey = errors.New(`"security.users" requires a "security.shared_key"`)
+
+// Validate checks the receiver configuration is valid.
+func (c *Config) Validate() error {
+	if c.Security.SharedKey == "" && len(c.Security.Users) > 0 {
+		return errUsersWithoutSharedKey
+	}
+	return nil
 }
@@ -23,6 +23,7 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/configtest"
+	"go.opentelemetry.io/collector/config/configtls"
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -36,9 +37,36 @@ func TestLoadConfig(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, cfg)
 
-	assert.Equal(t, len(cfg.Receivers), 1)
+	assert.Equal(t, len(cfg.Receivers), 2)
 
 	r0 := cfg.Receivers[config.NewComponentID("fluentforward")]
 	assert.Equal(t, r0, factory.CreateDefaultConfig())
 
+	r1 := cfg.Receivers[config.NewComponentIDWithName("fluentforward", "secure")]
+	assert.Equal(t, &Config{
+		ReceiverSettings: config.NewReceiverSettings(config.NewComponentIDWithName("fluentforward", "secure")),
+		ListenAddress:    "0.0.0.0:24224",
+		TLSSetting: &configtls.TLSServerSetting{
+			TLSSetting: configtls.TLSSetting{
+				CertFile: "/test.crt",
+				KeyFile:  "/test.key",
+			},
+		},
+		Security: SecuritySettings{
+			SelfHostname: "collector",
+			SharedKey:    "secret",
+			Users:        []UserSettings{{Username: "fluent", Password: "password"}},
+		},
+	}, r1)
+}
+
+func TestConfigValidate(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Security.Users = []UserSettings{{Username: "fluent", Password: "password"}}
+	assert.Equal(t, errUsersWithoutSharedKey, cfg.Validate())
+
+	cfg.Security.SharedKey = "secret"
+	assert.NoError(t, cfg.Validate())
 }
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentforwardreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/fluentforwardreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/tinylib/msgp/msgp"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/fluentforward"
+)
+
+// handshakeTimeout bounds the time a client has to authenticate.
+const handshakeTimeout = 10 * time.Second
+
+var errAuthenticationFailed = errors.New("authentication failed")
+
+// handshake authenticates the client of the connection as per the handshake
+// phase of the forward protocol: the server sends a HELO message, the client
+// answers with a PING message holding the digest of the shared key and
+// optionally its credentials, and the server replies with a PONG message
+// holding the result and its own digest of the shared key.
+func (s *server) handshake(conn net.Conn, reader *msgp.Reader) error {
+	if err := conn.SetDeadline(time.Now().Add(handshakeTimeout)); err != nil {
+		return err
+	}
+
+	nonce, err := fluentforward.RandomBytes()
+	if err != nil {
+		return err
+	}
+	var authSalt []byte
+	if len(s.security.Users) > 0 {
+		if authSalt, err = fluentforward.RandomBytes(); err != nil {
+			return err
+		}
+	}
+
+	if _, err = conn.Write(fluentforward.AppendHelo(nil, &fluentforward.Helo{Nonce: nonce, AuthSalt: authSalt})); err != nil {
+		return fmt.Errorf("failed to send HELO: %w", err)
+	}
+
+	ping, err := fluentforward.ReadPing(reader)
+	if err != nil {
+		return fmt.Errorf("failed to read PING: %w", err)
+	}
+
+	// As fluentd, the hostname and the digest of the shared key are only
+	// sent to authenticated clients.
+	reason := s.authenticate(ping, nonce, authSalt)
+	pong := &fluentforward.Pong{Authenticated: reason == "", Reason: reason}
+	if pong.Authenticated {
+		pong.Hostname = s.selfHostname
+		pong.SharedKeyDigest = fluentforward.Digest(ping.SharedKeySalt, []byte(s.selfHostname), nonce, []byte(s.security.SharedKey))
+	}
+	if _, err = conn.Write(fluentforward.AppendPong(nil, pong)); err != nil {
+		return fmt.Errorf("failed to send PONG: %w", err)
+	}
+	if !pong.Authenticated {
+		return fmt.Errorf("%w for client %q: %s", errAuthenticationFailed, ping.Hostname, reason)
+	}
+
+	return conn.SetDeadline(time.Time{})
+}
+
+// authenticate returns the reason why the client failed to authenticate, if any.
+func (s *server) authenticate(ping *fluentforward.Ping, nonce []byte, authSalt []byte) string {
+	if !fluentforward.DigestsEqual(ping.SharedKeyDigest, fluentforward.Digest(ping.SharedKeySalt, []byte(ping.Hostname), nonce, []byte(s.security.SharedKey))) {
+		return "shared key mismatch"
+	}
+	if len(s.security.Users) == 0 {
+		return ""
+	}
+	for _, user := range s.security.Users {
+		if user.Username == ping.Username {
+			if fluentforward.DigestsEqual(ping.PasswordDigest, fluentforward.Digest(authSalt, []byte(user.Username), []byte(user.Password))) {
+				return ""
+			}
+			break
+		}
+	}
+	return "username/password mismatch"
+}
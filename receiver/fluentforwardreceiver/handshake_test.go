@@ -0,0 +1,259 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentforwardreceiver
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tinylib/msgp/msgp"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/model/pdata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/fluentforward"
+)
+
+// forwardClient is a minimal client of the forward protocol.
+type forwardClient struct {
+	t      *testing.T
+	conn   net.Conn
+	reader *msgp.Reader
+}
+
+func newForwardClient(t *testing.T, conn net.Conn) *forwardClient {
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+	return &forwardClient{t: t, conn: conn, reader: msgp.NewReader(conn)}
+}
+
+// pongMessage is the result of the authentication.
+type pongMessage struct {
+	authenticated bool
+	reason        string
+}
+
+// handshake authenticates to the server and checks the server knows the shared key.
+func (c *forwardClient) handshake(sharedKey string, username string, password string) pongMessage {
+	helo, err := fluentforward.ReadHelo(c.reader)
+	require.NoError(c.t, err)
+
+	salt := []byte("salt")
+	ping := &fluentforward.Ping{
+		Hostname:        "client",
+		SharedKeySalt:   salt,
+		SharedKeyDigest: fluentforward.Digest(salt, []byte("client"), helo.Nonce, []byte(sharedKey)),
+		Username:        username,
+	}
+	if len(helo.AuthSalt) > 0 {
+		ping.PasswordDigest = fluentforward.Digest(helo.AuthSalt, []byte(username), []byte(password))
+	}
+	_, err = c.conn.Write(fluentforward.AppendPing(nil, ping))
+	require.NoError(c.t, err)
+
+	pong, err := fluentforward.ReadPong(c.reader)
+	require.NoError(c.t, err)
+	if pong.Authenticated {
+		assert.Equal(c.t, "collector", pong.Hostname)
+		assert.Equal(c.t, fluentforward.Digest(salt, []byte(pong.Hostname), helo.Nonce, []byte(sharedKey)), pong.SharedKeyDigest)
+	} else {
+		// The server does not prove it knows the shared key to a client that failed to authenticate.
+		assert.Empty(c.t, pong.Hostname)
+		assert.Empty(c.t, pong.SharedKeyDigest)
+	}
+	return pongMessage{authenticated: pong.Authenticated, reason: pong.Reason}
+}
+
+// sendChunk sends a forward mode event with the chunk option and waits for its ack.
+func (c *forwardClient) sendChunk(chunk string) {
+	var b []byte
+	b = msgp.AppendArrayHeader(b, 3)
+	b = msgp.AppendString(b, "my-tag")
+	b = msgp.AppendArrayHeader(b, 1)
+	b = msgp.AppendArrayHeader(b, 2)
+	b = msgp.AppendInt64(b, 1593031012)
+	b = msgp.AppendMapHeader(b, 1)
+	b = msgp.AppendString(b, "log")
+	b = msgp.AppendString(b, "hello")
+	b = msgp.AppendMapHeader(b, 1)
+	b = msgp.AppendString(b, "chunk")
+	b = msgp.AppendString(b, chunk)
+	_, err := c.conn.Write(b)
+	require.NoError(c.t, err)
+
+	size, err := c.reader.ReadMapHeader()
+	require.NoError(c.t, err)
+	require.Equal(c.t, uint32(1), size)
+	key, err := c.reader.ReadString()
+	require.NoError(c.t, err)
+	require.Equal(c.t, "ack", key)
+	ack, err := c.reader.ReadString()
+	require.NoError(c.t, err)
+	require.Equal(c.t, chunk, ack)
+}
+
+func TestHandshake(t *testing.T) {
+	connect, next, _, cancel := setupServerWithConfig(t, &Config{
+		ListenAddress: "127.0.0.1:0",
+		Security: SecuritySettings{
+			SelfHostname: "collector",
+			SharedKey:    "secret",
+		},
+	})
+	defer cancel()
+
+	conn := connect()
+	defer conn.Close()
+	client := newForwardClient(t, conn)
+	assert.Equal(t, pongMessage{authenticated: true}, client.handshake("secret", "", ""))
+	client.sendChunk("Mzc1NzY0NjQ0MjM2Njg4NDk=")
+
+	require.Eventually(t, func() bool {
+		return next.LogRecordCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestHandshake_SharedKeyMismatch(t *testing.T) {
+	connect, next, _, cancel := setupServerWithConfig(t, &Config{
+		ListenAddress: "127.0.0.1:0",
+		Security: SecuritySettings{
+			SelfHostname: "collector",
+			SharedKey:    "secret",
+		},
+	})
+	defer cancel()
+
+	conn := connect()
+	defer conn.Close()
+	client := newForwardClient(t, conn)
+	assert.Equal(t, pongMessage{reason: "shared key mismatch"}, client.handshake("wrong", "", ""))
+	waitForConnectionClose(t, conn)
+	assert.Equal(t, 0, next.LogRecordCount())
+}
+
+func TestHandshake_Users(t *testing.T) {
+	connect, next, _, cancel := setupServerWithConfig(t, &Config{
+		ListenAddress: "127.0.0.1:0",
+		Security: SecuritySettings{
+			SelfHostname: "collector",
+			SharedKey:    "secret",
+			Users: []UserSettings{
+				{Username: "alice", Password: "alice-password"},
+				{Username: "bob", Password: "bob-password"},
+			},
+		},
+	})
+	defer cancel()
+
+	for _, tt := range []struct {
+		name     string
+		username string
+		password string
+		expected pongMessage
+	}{
+		{name: "valid", username: "bob", password: "bob-password", expected: pongMessage{authenticated: true}},
+		{name: "wrong_password", username: "bob", password: "alice-password", expected: pongMessage{reason: "username/password mismatch"}},
+		{name: "unknown_user", username: "eve", password: "eve-password", expected: pongMessage{reason: "username/password mismatch"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := connect()
+			defer conn.Close()
+			client := newForwardClient(t, conn)
+			assert.Equal(t, tt.expected, client.handshake("secret", tt.username, tt.password))
+			if tt.expected.authenticated {
+				client.sendChunk("chunk")
+			} else {
+				waitForConnectionClose(t, conn)
+			}
+		})
+	}
+
+	assert.Equal(t, 1, next.LogRecordCount())
+}
+
+func TestTLS(t *testing.T) {
+	certFile, keyFile := generateCertificate(t)
+	connect, next, _, cancel := setupServerWithConfig(t, &Config{
+		ListenAddress: "127.0.0.1:0",
+		TLSSetting: &configtls.TLSServerSetting{
+			TLSSetting: configtls.TLSSetting{
+				CertFile: certFile,
+				KeyFile:  keyFile,
+			},
+		},
+		Security: SecuritySettings{
+			SelfHostname: "collector",
+			SharedKey:    "secret",
+		},
+	})
+	defer cancel()
+
+	certPool := x509.NewCertPool()
+	certPEM, err := os.ReadFile(certFile)
+	require.NoError(t, err)
+	require.True(t, certPool.AppendCertsFromPEM(certPEM))
+
+	conn := tls.Client(connect(), &tls.Config{RootCAs: certPool, ServerName: "localhost"})
+	defer conn.Close()
+	client := newForwardClient(t, conn)
+	assert.Equal(t, pongMessage{authenticated: true}, client.handshake("secret", "", ""))
+	client.sendChunk("chunk")
+
+	require.Eventually(t, func() bool {
+		return next.LogRecordCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	logs := next.AllLogs()[0].ResourceLogs().At(0).InstrumentationLibraryLogs().At(0).Logs()
+	assert.Equal(t, pdata.NewAttributeValueString("hello"), logs.At(0).Body())
+}
+
+// generateCertificate writes a self-signed certificate for localhost and its
+// key to a temporary directory.
+func generateCertificate(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}), 0600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}), 0600))
+	return certFile, keyFile
+}
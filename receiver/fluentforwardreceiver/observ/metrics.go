@@ -70,6 +70,18 @@ var (
 		Aggregation: view.Sum(),
 	}
 
+	// FailedToAuthenticate measure for number of clients that failed to authenticate during the handshake.
+	FailedToAuthenticate = stats.Int64(
+		"fluent_authentication_failures",
+		"Number of clients that failed to authenticate during the handshake",
+		stats.UnitDimensionless)
+	failedToAuthenticateView = &view.View{
+		Name:        FailedToAuthenticate.Name(),
+		Measure:     FailedToAuthenticate,
+		Description: FailedToAuthenticate.Description(),
+		Aggregation: view.Sum(),
+	}
+
 	// RecordsGenerated measure for number of log records generated from Fluent forward input.
 	RecordsGenerated = stats.Int64(
 		"fluent_records_generated",
@@ -89,6 +101,7 @@ func MetricViews() []*view.View {
 		connectionsClosedView,
 		eventsParsedView,
 		failedToParseView,
+		failedToAuthenticateView,
 		recordsGeneratedView,
 	}
 }
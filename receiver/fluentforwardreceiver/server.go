@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"os"
 	"time"
 
 	"github.com/tinylib/msgp/msgp"
@@ -35,14 +36,23 @@ import (
 const readBufferSize = 10 * 1024
 
 type server struct {
-	outCh  chan<- Event
-	logger *zap.Logger
+	outCh    chan<- Event
+	logger   *zap.Logger
+	security SecuritySettings
+	// selfHostname is the hostname sent to the clients during the handshake.
+	selfHostname string
 }
 
-func newServer(outCh chan<- Event, logger *zap.Logger) *server {
+func newServer(outCh chan<- Event, logger *zap.Logger, security SecuritySettings) *server {
+	selfHostname := security.SelfHostname
+	if selfHostname == "" {
+		selfHostname, _ = os.Hostname()
+	}
 	return &server{
-		outCh:  outCh,
-		logger: logger,
+		outCh:        outCh,
+		logger:       logger,
+		security:     security,
+		selfHostname: selfHostname,
 	}
 }
 
@@ -91,6 +101,15 @@ func (s *server) handleConnections(ctx context.Context, listener net.Listener) {
 func (s *server) handleConn(ctx context.Context, conn net.Conn) error {
 	reader := msgp.NewReaderSize(conn, readBufferSize)
 
+	if s.security.SharedKey != "" {
+		if err := s.handshake(conn, reader); err != nil {
+			if errors.Is(err, errAuthenticationFailed) {
+				stats.Record(ctx, observ.FailedToAuthenticate.M(1))
+			}
+			return err
+		}
+	}
+
 	for {
 		mode, err := DetermineNextEventMode(reader.R)
 		if err != nil {
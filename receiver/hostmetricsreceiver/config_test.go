@@ -28,13 +28,16 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/diskscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filesystemscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/loadscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/memoryscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/networkscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/nfsscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pagingscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processesscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processscraper"
 )
@@ -72,20 +75,47 @@ func TestLoadConfig(t *testing.T) {
 			loadscraper.TypeStr:       &loadscraper.Config{},
 			filesystemscraper.TypeStr: &filesystemscraper.Config{},
 			memoryscraper.TypeStr:     &memoryscraper.Config{},
-			networkscraper.TypeStr: &networkscraper.Config{
-				Include: networkscraper.MatchConfig{
+			networkscraper.TypeStr: (func() internal.Config {
+				cfg := (&networkscraper.Factory{}).CreateDefaultConfig()
+				cfg.(*networkscraper.Config).Include = networkscraper.MatchConfig{
 					Interfaces: []string{"test1"},
 					Config:     filterset.Config{MatchType: "strict"},
-				},
-			},
+				}
+				return cfg
+			})(),
 			processesscraper.TypeStr: &processesscraper.Config{},
 			pagingscraper.TypeStr:    &pagingscraper.Config{},
-			processscraper.TypeStr: &processscraper.Config{
-				Include: processscraper.MatchConfig{
+			processscraper.TypeStr: (func() internal.Config {
+				cfg := (&processscraper.Factory{}).CreateDefaultConfig()
+				cfg.(*processscraper.Config).Include = processscraper.MatchConfig{
 					Names:  []string{"test2", "test3"},
 					Config: filterset.Config{MatchType: "regexp"},
-				},
-			},
+				}
+				return cfg
+			})(),
+			cgroupscraper.TypeStr: (func() internal.Config {
+				cfg := (&cgroupscraper.Factory{}).CreateDefaultConfig()
+				cfg.(*cgroupscraper.Config).RootPath = "/host/sys/fs/cgroup"
+				cfg.(*cgroupscraper.Config).Include = cgroupscraper.MatchConfig{
+					Paths:  []string{"/system.slice/.*"},
+					Config: filterset.Config{MatchType: "regexp"},
+				}
+				return cfg
+			})(),
+			pressurescraper.TypeStr: (func() internal.Config {
+				cfg := (&pressurescraper.Factory{}).CreateDefaultConfig()
+				cfg.(*pressurescraper.Config).RootPath = "/host/proc"
+				return cfg
+			})(),
+			nfsscraper.TypeStr: (func() internal.Config {
+				cfg := (&nfsscraper.Factory{}).CreateDefaultConfig()
+				cfg.(*nfsscraper.Config).RootPath = "/host/proc"
+				cfg.(*nfsscraper.Config).Exclude = nfsscraper.MatchConfig{
+					MountPoints: []string{"/mnt/scratch"},
+					Config:      filterset.Config{MatchType: "strict"},
+				}
+				return cfg
+			})(),
 		},
 	}
 
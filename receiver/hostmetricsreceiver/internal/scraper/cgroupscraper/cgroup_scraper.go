@@ -0,0 +1,381 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cgroupscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper"
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/host"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper/internal/metadata"
+)
+
+const (
+	cpuMetricsLen    = 4
+	memoryMetricsLen = 3
+	ioMetricsLen     = 2
+	pidsMetricsLen   = 2
+	metricsLen       = cpuMetricsLen + memoryMetricsLen + ioMetricsLen + pidsMetricsLen
+
+	// unlimited is the value of the *.max files when no limit is set.
+	unlimited = "max"
+)
+
+// memoryStatTypes maps the memory.stat entries to the values of the type attribute.
+var memoryStatTypes = []struct {
+	key       string
+	attribute string
+}{
+	{"anon", metadata.AttributeMemoryType.Anon},
+	{"file", metadata.AttributeMemoryType.File},
+	{"kernel_stack", metadata.AttributeMemoryType.KernelStack},
+	{"slab", metadata.AttributeMemoryType.Slab},
+	{"sock", metadata.AttributeMemoryType.Sock},
+	{"shmem", metadata.AttributeMemoryType.Shmem},
+}
+
+// scraper for cgroup Metrics
+type scraper struct {
+	config    *Config
+	mb        *metadata.MetricsBuilder
+	includeFS filterset.FilterSet
+	excludeFS filterset.FilterSet
+
+	// root is the mount point of the unified hierarchy, found at start.
+	root string
+
+	// for mocking
+	bootTime func() (uint64, error)
+}
+
+// cgroup is a directory of the cgroup hierarchy.
+type cgroup struct {
+	// path relative to the root of the hierarchy, starting with a slash.
+	path string
+	dir  string
+}
+
+// newCgroupScraper creates a cgroup Scraper
+func newCgroupScraper(_ context.Context, cfg *Config) (*scraper, error) {
+	scraper := &scraper{config: cfg, bootTime: host.BootTime}
+
+	var err error
+
+	if len(cfg.Include.Paths) > 0 {
+		scraper.includeFS, err = filterset.CreateFilterSet(cfg.Include.Paths, &cfg.Include.Config)
+		if err != nil {
+			return nil, fmt.Errorf("error creating cgroup include filters: %w", err)
+		}
+	}
+
+	if len(cfg.Exclude.Paths) > 0 {
+		scraper.excludeFS, err = filterset.CreateFilterSet(cfg.Exclude.Paths, &cfg.Exclude.Config)
+		if err != nil {
+			return nil, fmt.Errorf("error creating cgroup exclude filters: %w", err)
+		}
+	}
+
+	return scraper, nil
+}
+
+func (s *scraper) start(context.Context, component.Host) error {
+	bootTime, err := s.bootTime()
+	if err != nil {
+		return err
+	}
+
+	s.root, err = unifiedHierarchy(s.config.RootPath)
+	if err != nil {
+		return err
+	}
+
+	s.mb = metadata.NewMetricsBuilder(s.config.Metrics, metadata.WithStartTime(pdata.Timestamp(bootTime*1e9)))
+	return nil
+}
+
+// unifiedHierarchy returns the mount point of the cgroup v2 unified hierarchy: the
+// root path itself, or its "unified" directory on hosts using the hybrid layout.
+func unifiedHierarchy(rootPath string) (string, error) {
+	root := filepath.Clean(rootPath)
+	for _, dir := range []string{root, filepath.Join(root, "unified")} {
+		_, err := os.Stat(filepath.Join(dir, "cgroup.controllers"))
+		if err == nil {
+			return dir, nil
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+	}
+	return "", fmt.Errorf("no cgroup v2 hierarchy found at %s, cgroup v1 is not supported", root)
+}
+
+func (s *scraper) scrape(_ context.Context) (pdata.Metrics, error) {
+	md := pdata.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty().Metrics()
+
+	now := pdata.NewTimestampFromTime(time.Now())
+	cgroups, err := s.listCgroups()
+	if err != nil {
+		return md, scrapererror.NewPartialScrapeError(err, metricsLen)
+	}
+
+	var errs scrapererror.ScrapeErrors
+	for _, cg := range cgroups {
+		if err = s.recordCPUMetrics(now, cg); err != nil {
+			errs.AddPartial(cpuMetricsLen, fmt.Errorf("error reading cpu stats of cgroup %q: %w", cg.path, err))
+		}
+
+		if err = s.recordMemoryMetrics(now, cg); err != nil {
+			errs.AddPartial(memoryMetricsLen, fmt.Errorf("error reading memory stats of cgroup %q: %w", cg.path, err))
+		}
+
+		if err = s.recordIOMetrics(now, cg); err != nil {
+			errs.AddPartial(ioMetricsLen, fmt.Errorf("error reading io stats of cgroup %q: %w", cg.path, err))
+		}
+
+		if err = s.recordPidsMetrics(now, cg); err != nil {
+			errs.AddPartial(pidsMetricsLen, fmt.Errorf("error reading pids of cgroup %q: %w", cg.path, err))
+		}
+	}
+
+	s.mb.Emit(metrics)
+	return md, errs.Combine()
+}
+
+// listCgroups walks the unified hierarchy and returns the cgroups
+// that match the include and exclude filters.
+func (s *scraper) listCgroups() ([]cgroup, error) {
+	root := s.root
+	var cgroups []cgroup
+	err := filepath.WalkDir(root, func(dir string, d os.DirEntry, err error) error {
+		if err != nil {
+			// cgroups may be removed while walking the hierarchy.
+			if dir != root && os.IsNotExist(err) {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, dir)
+		if err != nil {
+			return err
+		}
+		path := "/"
+		if rel != "." {
+			path += filepath.ToSlash(rel)
+		}
+		if s.includeCgroup(path) {
+			cgroups = append(cgroups, cgroup{path: path, dir: dir})
+		}
+		return nil
+	})
+	return cgroups, err
+}
+
+func (s *scraper) includeCgroup(path string) bool {
+	return (s.includeFS == nil || s.includeFS.Matches(path)) &&
+		(s.excludeFS == nil || !s.excludeFS.Matches(path))
+}
+
+func (s *scraper) recordCPUMetrics(now pdata.Timestamp, cg cgroup) error {
+	stat, err := readFlatKeyed(filepath.Join(cg.dir, "cpu.stat"))
+	if err != nil || stat == nil {
+		return err
+	}
+
+	if v, ok := stat["user_usec"]; ok {
+		s.mb.RecordSystemCgroupCPUTimeDataPoint(now, float64(v)/1e6, cg.path, metadata.AttributeState.User)
+	}
+	if v, ok := stat["system_usec"]; ok {
+		s.mb.RecordSystemCgroupCPUTimeDataPoint(now, float64(v)/1e6, cg.path, metadata.AttributeState.System)
+	}
+	// Bandwidth statistics are only available when the cpu controller is enabled.
+	if v, ok := stat["nr_periods"]; ok {
+		s.mb.RecordSystemCgroupCPUPeriodsDataPoint(now, int64(v), cg.path)
+	}
+	if v, ok := stat["nr_throttled"]; ok {
+		s.mb.RecordSystemCgroupCPUThrottledPeriodsDataPoint(now, int64(v), cg.path)
+	}
+	if v, ok := stat["throttled_usec"]; ok {
+		s.mb.RecordSystemCgroupCPUThrottledTimeDataPoint(now, float64(v)/1e6, cg.path)
+	}
+	return nil
+}
+
+func (s *scraper) recordMemoryMetrics(now pdata.Timestamp, cg cgroup) error {
+	current, ok, err := readValue(filepath.Join(cg.dir, "memory.current"))
+	if err != nil {
+		return err
+	}
+	if ok {
+		s.mb.RecordSystemCgroupMemoryUsageDataPoint(now, current, cg.path)
+	}
+
+	limit, ok, err := readValue(filepath.Join(cg.dir, "memory.max"))
+	if err != nil {
+		return err
+	}
+	if ok {
+		s.mb.RecordSystemCgroupMemoryLimitDataPoint(now, limit, cg.path)
+	}
+
+	stat, err := readFlatKeyed(filepath.Join(cg.dir, "memory.stat"))
+	if err != nil {
+		return err
+	}
+	for _, t := range memoryStatTypes {
+		if v, ok := stat[t.key]; ok {
+			s.mb.RecordSystemCgroupMemoryStatDataPoint(now, int64(v), cg.path, t.attribute)
+		}
+	}
+	return nil
+}
+
+func (s *scraper) recordIOMetrics(now pdata.Timestamp, cg cgroup) error {
+	stats, err := readIOStat(filepath.Join(cg.dir, "io.stat"))
+	if err != nil {
+		return err
+	}
+
+	for _, stat := range stats {
+		s.mb.RecordSystemCgroupIoDataPoint(now, int64(stat.values["rbytes"]), cg.path, stat.device, metadata.AttributeDirection.Read)
+		s.mb.RecordSystemCgroupIoDataPoint(now, int64(stat.values["wbytes"]), cg.path, stat.device, metadata.AttributeDirection.Write)
+		s.mb.RecordSystemCgroupIoOperationsDataPoint(now, int64(stat.values["rios"]), cg.path, stat.device, metadata.AttributeDirection.Read)
+		s.mb.RecordSystemCgroupIoOperationsDataPoint(now, int64(stat.values["wios"]), cg.path, stat.device, metadata.AttributeDirection.Write)
+	}
+	return nil
+}
+
+func (s *scraper) recordPidsMetrics(now pdata.Timestamp, cg cgroup) error {
+	current, ok, err := readValue(filepath.Join(cg.dir, "pids.current"))
+	if err != nil {
+		return err
+	}
+	if ok {
+		s.mb.RecordSystemCgroupPidsCountDataPoint(now, current, cg.path)
+	}
+
+	limit, ok, err := readValue(filepath.Join(cg.dir, "pids.max"))
+	if err != nil {
+		return err
+	}
+	if ok {
+		s.mb.RecordSystemCgroupPidsLimitDataPoint(now, limit, cg.path)
+	}
+	return nil
+}
+
+// readFile returns the content of a cgroup interface file, or nil if the file
+// does not exist because the controller is not enabled for the cgroup.
+func readFile(path string) ([]byte, error) {
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return content, err
+}
+
+// readValue reads a file holding a single value. ok is false when the file does
+// not exist or holds "max".
+func readValue(path string) (value int64, ok bool, err error) {
+	content, err := readFile(path)
+	if err != nil || content == nil {
+		return 0, false, err
+	}
+
+	str := strings.TrimSpace(string(content))
+	if str == unlimited {
+		return 0, false, nil
+	}
+	value, err = strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid value in %s: %w", filepath.Base(path), err)
+	}
+	return value, true, nil
+}
+
+// readFlatKeyed reads a file holding "<key> <value>" lines, such as cpu.stat.
+func readFlatKeyed(path string) (map[string]uint64, error) {
+	content, err := readFile(path)
+	if err != nil || content == nil {
+		return nil, err
+	}
+
+	values := map[string]uint64{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		v, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %q in %s: %w", fields[0], filepath.Base(path), err)
+		}
+		values[fields[0]] = v
+	}
+	return values, scanner.Err()
+}
+
+type ioStat struct {
+	device string
+	values map[string]uint64
+}
+
+// readIOStat reads io.stat whose lines are "<major>:<minor> <key>=<value> ...".
+func readIOStat(path string) ([]ioStat, error) {
+	content, err := readFile(path)
+	if err != nil || content == nil {
+		return nil, err
+	}
+
+	var stats []ioStat
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		stat := ioStat{device: fields[0], values: map[string]uint64{}}
+		for _, field := range fields[1:] {
+			kv := strings.SplitN(field, "=", 2)
+			if len(kv) != 2 {
+				continue
+			}
+			v, err := strconv.ParseUint(kv[1], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for %q of device %s in io.stat: %w", kv[0], stat.device, err)
+			}
+			stat.values[kv[0]] = v
+		}
+		stats = append(stats, stat)
+	}
+	return stats, scanner.Err()
+}
@@ -0,0 +1,298 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cgroupscraper
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper/internal/metadata"
+)
+
+var fixtureRoot = filepath.Join("testdata", "cgroup")
+
+func TestScrape(t *testing.T) {
+	config := &Config{
+		Metrics:  metadata.DefaultMetricsSettings(),
+		RootPath: fixtureRoot,
+		Exclude:  MatchConfig{filterset.Config{MatchType: "strict"}, []string{"/user.slice"}},
+	}
+	scraper, err := newCgroupScraper(context.Background(), config)
+	require.NoError(t, err, "Failed to create cgroup scraper: %v", err)
+	scraper.bootTime = func() (uint64, error) { return 100, nil }
+
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize cgroup scraper: %v", err)
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err, "Failed to scrape metrics: %v", err)
+
+	metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+	assert.Equal(t, metricsLen, metrics.Len())
+	for i := 0; i < metrics.Len(); i++ {
+		dps := metrics.At(i).Sum().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			assert.Equal(t, pdata.Timestamp(100*1e9), dps.At(j).StartTimestamp())
+		}
+	}
+
+	assert.Equal(t, map[string]float64{
+		"cgroup=/ state=user":                            6,
+		"cgroup=/ state=system":                          3,
+		"cgroup=/system.slice state=user":                4,
+		"cgroup=/system.slice state=system":              1,
+		"cgroup=/system.slice/sshd.service state=user":   1,
+		"cgroup=/system.slice/sshd.service state=system": 0.5,
+	}, dataPoints(t, metrics, "system.cgroup.cpu.time"))
+	assert.Equal(t, map[string]float64{
+		"cgroup=/system.slice":              0,
+		"cgroup=/system.slice/sshd.service": 10,
+	}, dataPoints(t, metrics, "system.cgroup.cpu.throttled_periods"))
+	assert.Equal(t, map[string]float64{
+		"cgroup=/system.slice":              0,
+		"cgroup=/system.slice/sshd.service": 0.25,
+	}, dataPoints(t, metrics, "system.cgroup.cpu.throttled_time"))
+
+	assert.Equal(t, map[string]float64{
+		"cgroup=/system.slice":              536870912,
+		"cgroup=/system.slice/sshd.service": 10485760,
+	}, dataPoints(t, metrics, "system.cgroup.memory.usage"))
+	assert.Equal(t, map[string]float64{
+		"cgroup=/system.slice/sshd.service": 268435456,
+	}, dataPoints(t, metrics, "system.cgroup.memory.limit"))
+	assert.Equal(t, map[string]float64{
+		"cgroup=/system.slice type=anon":              104857600,
+		"cgroup=/system.slice type=file":              419430400,
+		"cgroup=/system.slice type=kernel_stack":      1048576,
+		"cgroup=/system.slice type=slab":              8388608,
+		"cgroup=/system.slice type=sock":              4096,
+		"cgroup=/system.slice type=shmem":             0,
+		"cgroup=/system.slice/sshd.service type=anon": 4194304,
+		"cgroup=/system.slice/sshd.service type=file": 6291456,
+	}, dataPoints(t, metrics, "system.cgroup.memory.stat"))
+
+	assert.Equal(t, map[string]float64{
+		"cgroup=/ device=8:0 direction=read":                1048576,
+		"cgroup=/ device=8:0 direction=write":               2097152,
+		"cgroup=/system.slice device=8:0 direction=read":    524288,
+		"cgroup=/system.slice device=8:0 direction=write":   1048576,
+		"cgroup=/system.slice device=259:0 direction=read":  4096,
+		"cgroup=/system.slice device=259:0 direction=write": 0,
+	}, dataPoints(t, metrics, "system.cgroup.io"))
+	assert.Equal(t, map[string]float64{
+		"cgroup=/ device=8:0 direction=read":                100,
+		"cgroup=/ device=8:0 direction=write":               200,
+		"cgroup=/system.slice device=8:0 direction=read":    50,
+		"cgroup=/system.slice device=8:0 direction=write":   100,
+		"cgroup=/system.slice device=259:0 direction=read":  1,
+		"cgroup=/system.slice device=259:0 direction=write": 0,
+	}, dataPoints(t, metrics, "system.cgroup.io.operations"))
+
+	assert.Equal(t, map[string]float64{
+		"cgroup=/system.slice":              42,
+		"cgroup=/system.slice/sshd.service": 3,
+	}, dataPoints(t, metrics, "system.cgroup.pids.count"))
+	assert.Equal(t, map[string]float64{
+		"cgroup=/system.slice/sshd.service": 100,
+	}, dataPoints(t, metrics, "system.cgroup.pids.limit"))
+}
+
+func TestScrape_Filters(t *testing.T) {
+	type testCase struct {
+		name            string
+		include         MatchConfig
+		exclude         MatchConfig
+		expectedCgroups []string
+	}
+
+	testCases := []testCase{
+		{
+			name:            "Include regexp",
+			include:         MatchConfig{filterset.Config{MatchType: "regexp"}, []string{`^/system\.slice/.*\.service$`}},
+			expectedCgroups: []string{"/system.slice/sshd.service"},
+		},
+		{
+			name:            "Include and exclude",
+			include:         MatchConfig{filterset.Config{MatchType: "regexp"}, []string{`^/system\.slice`}},
+			exclude:         MatchConfig{filterset.Config{MatchType: "strict"}, []string{"/system.slice/sshd.service"}},
+			expectedCgroups: []string{"/system.slice"},
+		},
+		{
+			name:            "Include root",
+			include:         MatchConfig{filterset.Config{MatchType: "strict"}, []string{"/"}},
+			expectedCgroups: []string{"/"},
+		},
+		{
+			name:    "Include Filter that matches nothing",
+			include: MatchConfig{filterset.Config{MatchType: "strict"}, []string{"@*^#&*$^#)"}},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			config := &Config{
+				Metrics:  metadata.DefaultMetricsSettings(),
+				RootPath: fixtureRoot,
+				Include:  test.include,
+				Exclude:  test.exclude,
+			}
+			scraper, err := newCgroupScraper(context.Background(), config)
+			require.NoError(t, err, "Failed to create cgroup scraper: %v", err)
+			require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+			md, err := scraper.scrape(context.Background())
+			require.NoError(t, err, "Failed to scrape metrics: %v", err)
+
+			cgroups := map[string]bool{}
+			metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+			for i := 0; i < metrics.Len(); i++ {
+				dps := metrics.At(i).Sum().DataPoints()
+				for j := 0; j < dps.Len(); j++ {
+					cgroup, ok := dps.At(j).Attributes().Get(metadata.A.Cgroup)
+					require.True(t, ok)
+					cgroups[cgroup.StringVal()] = true
+				}
+			}
+			var actual []string
+			for cgroup := range cgroups {
+				actual = append(actual, cgroup)
+			}
+			sort.Strings(actual)
+			assert.Equal(t, test.expectedCgroups, actual)
+		})
+	}
+}
+
+func TestScrape_Errors(t *testing.T) {
+	type testCase struct {
+		name              string
+		rootPath          string
+		bootTimeFunc      func() (uint64, error)
+		initializationErr string
+		expectedErr       string
+		expectedFailed    int
+	}
+
+	testCases := []testCase{
+		{
+			name:              "Boot Time Error",
+			rootPath:          fixtureRoot,
+			bootTimeFunc:      func() (uint64, error) { return 0, errors.New("err1") },
+			initializationErr: "err1",
+		},
+		{
+			name:           "Invalid file content",
+			rootPath:       fixtureRoot,
+			expectedErr:    `error reading memory stats of cgroup "/user.slice": invalid value in memory.current`,
+			expectedFailed: memoryMetricsLen,
+		},
+		{
+			name:              "Missing root",
+			rootPath:          filepath.Join("testdata", "missing"),
+			initializationErr: "no cgroup v2 hierarchy found at " + filepath.Join("testdata", "missing") + ", cgroup v1 is not supported",
+		},
+		{
+			name:              "cgroup v1 hierarchy",
+			rootPath:          filepath.Join("testdata", "cgroup", "system.slice"),
+			initializationErr: "no cgroup v2 hierarchy found at " + filepath.Join("testdata", "cgroup", "system.slice") + ", cgroup v1 is not supported",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			config := &Config{Metrics: metadata.DefaultMetricsSettings(), RootPath: test.rootPath}
+			scraper, err := newCgroupScraper(context.Background(), config)
+			require.NoError(t, err, "Failed to create cgroup scraper: %v", err)
+
+			if test.bootTimeFunc != nil {
+				scraper.bootTime = test.bootTimeFunc
+			}
+
+			err = scraper.start(context.Background(), componenttest.NewNopHost())
+			if test.initializationErr != "" {
+				assert.EqualError(t, err, test.initializationErr)
+				return
+			}
+			require.NoError(t, err, "Failed to initialize cgroup scraper: %v", err)
+
+			_, err = scraper.scrape(context.Background())
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+
+			isPartial := scrapererror.IsPartialScrapeError(err)
+			assert.True(t, isPartial)
+			if isPartial {
+				assert.Equal(t, test.expectedFailed, err.(scrapererror.PartialScrapeError).Failed)
+			}
+		})
+	}
+}
+
+// dataPoints returns the values of the data points of the metric keyed by
+// their sorted attributes.
+func dataPoints(t *testing.T, metrics pdata.MetricSlice, name string) map[string]float64 {
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		if metric.Name() != name {
+			continue
+		}
+
+		values := map[string]float64{}
+		dps := metric.Sum().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			dp := dps.At(j)
+			var attrs []string
+			dp.Attributes().Range(func(k string, v pdata.AttributeValue) bool {
+				attrs = append(attrs, k+"="+v.StringVal())
+				return true
+			})
+			sort.Strings(attrs)
+			value := dp.DoubleVal()
+			if dp.Type() == pdata.MetricValueTypeInt {
+				value = float64(dp.IntVal())
+			}
+			values[strings.Join(attrs, " ")] = value
+		}
+		return values
+	}
+	t.Errorf("metric %s not found", name)
+	return nil
+}
+
+func TestUnifiedHierarchy(t *testing.T) {
+	dir, err := unifiedHierarchy(fixtureRoot)
+	require.NoError(t, err)
+	assert.Equal(t, fixtureRoot, dir)
+
+	// The hybrid layout mounts the unified hierarchy under the v1 hierarchies.
+	hybridRoot := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(hybridRoot, "unified"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(hybridRoot, "unified", "cgroup.controllers"), []byte("memory pids\n"), 0600))
+	dir, err = unifiedHierarchy(hybridRoot)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(hybridRoot, "unified"), dir)
+}
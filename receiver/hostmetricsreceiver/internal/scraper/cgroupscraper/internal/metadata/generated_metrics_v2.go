@@ -0,0 +1,834 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// MetricSettings provides common settings for a particular metric.
+type MetricSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// MetricsSettings provides settings for cgroup metrics.
+type MetricsSettings struct {
+	SystemCgroupCPUPeriods          MetricSettings `mapstructure:"system.cgroup.cpu.periods"`
+	SystemCgroupCPUThrottledPeriods MetricSettings `mapstructure:"system.cgroup.cpu.throttled_periods"`
+	SystemCgroupCPUThrottledTime    MetricSettings `mapstructure:"system.cgroup.cpu.throttled_time"`
+	SystemCgroupCPUTime             MetricSettings `mapstructure:"system.cgroup.cpu.time"`
+	SystemCgroupIo                  MetricSettings `mapstructure:"system.cgroup.io"`
+	SystemCgroupIoOperations        MetricSettings `mapstructure:"system.cgroup.io.operations"`
+	SystemCgroupMemoryLimit         MetricSettings `mapstructure:"system.cgroup.memory.limit"`
+	SystemCgroupMemoryStat          MetricSettings `mapstructure:"system.cgroup.memory.stat"`
+	SystemCgroupMemoryUsage         MetricSettings `mapstructure:"system.cgroup.memory.usage"`
+	SystemCgroupPidsCount           MetricSettings `mapstructure:"system.cgroup.pids.count"`
+	SystemCgroupPidsLimit           MetricSettings `mapstructure:"system.cgroup.pids.limit"`
+}
+
+func DefaultMetricsSettings() MetricsSettings {
+	return MetricsSettings{
+		SystemCgroupCPUPeriods: MetricSettings{
+			Enabled: true,
+		},
+		SystemCgroupCPUThrottledPeriods: MetricSettings{
+			Enabled: true,
+		},
+		SystemCgroupCPUThrottledTime: MetricSettings{
+			Enabled: true,
+		},
+		SystemCgroupCPUTime: MetricSettings{
+			Enabled: true,
+		},
+		SystemCgroupIo: MetricSettings{
+			Enabled: true,
+		},
+		SystemCgroupIoOperations: MetricSettings{
+			Enabled: true,
+		},
+		SystemCgroupMemoryLimit: MetricSettings{
+			Enabled: true,
+		},
+		SystemCgroupMemoryStat: MetricSettings{
+			Enabled: true,
+		},
+		SystemCgroupMemoryUsage: MetricSettings{
+			Enabled: true,
+		},
+		SystemCgroupPidsCount: MetricSettings{
+			Enabled: true,
+		},
+		SystemCgroupPidsLimit: MetricSettings{
+			Enabled: true,
+		},
+	}
+}
+
+type metricSystemCgroupCPUPeriods struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cgroup.cpu.periods metric with initial data.
+func (m *metricSystemCgroupCPUPeriods) init() {
+	m.data.SetName("system.cgroup.cpu.periods")
+	m.data.SetDescription("Number of enforcement periods elapsed for the CPU bandwidth limit of the cgroup.")
+	m.data.SetUnit("{periods}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemCgroupCPUPeriods) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, cgroupAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Cgroup, pdata.NewAttributeValueString(cgroupAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCgroupCPUPeriods) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCgroupCPUPeriods) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCgroupCPUPeriods(settings MetricSettings) metricSystemCgroupCPUPeriods {
+	m := metricSystemCgroupCPUPeriods{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemCgroupCPUThrottledPeriods struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cgroup.cpu.throttled_periods metric with initial data.
+func (m *metricSystemCgroupCPUThrottledPeriods) init() {
+	m.data.SetName("system.cgroup.cpu.throttled_periods")
+	m.data.SetDescription("Number of enforcement periods in which the cgroup was throttled.")
+	m.data.SetUnit("{periods}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemCgroupCPUThrottledPeriods) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, cgroupAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Cgroup, pdata.NewAttributeValueString(cgroupAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCgroupCPUThrottledPeriods) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCgroupCPUThrottledPeriods) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCgroupCPUThrottledPeriods(settings MetricSettings) metricSystemCgroupCPUThrottledPeriods {
+	m := metricSystemCgroupCPUThrottledPeriods{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemCgroupCPUThrottledTime struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cgroup.cpu.throttled_time metric with initial data.
+func (m *metricSystemCgroupCPUThrottledTime) init() {
+	m.data.SetName("system.cgroup.cpu.throttled_time")
+	m.data.SetDescription("Total time the tasks of the cgroup were throttled.")
+	m.data.SetUnit("s")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemCgroupCPUThrottledTime) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64, cgroupAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleVal(val)
+	dp.Attributes().Insert(A.Cgroup, pdata.NewAttributeValueString(cgroupAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCgroupCPUThrottledTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCgroupCPUThrottledTime) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCgroupCPUThrottledTime(settings MetricSettings) metricSystemCgroupCPUThrottledTime {
+	m := metricSystemCgroupCPUThrottledTime{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemCgroupCPUTime struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cgroup.cpu.time metric with initial data.
+func (m *metricSystemCgroupCPUTime) init() {
+	m.data.SetName("system.cgroup.cpu.time")
+	m.data.SetDescription("Total CPU seconds consumed by the tasks of the cgroup broken down by state.")
+	m.data.SetUnit("s")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemCgroupCPUTime) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64, cgroupAttributeValue string, stateAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleVal(val)
+	dp.Attributes().Insert(A.Cgroup, pdata.NewAttributeValueString(cgroupAttributeValue))
+	dp.Attributes().Insert(A.State, pdata.NewAttributeValueString(stateAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCgroupCPUTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCgroupCPUTime) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCgroupCPUTime(settings MetricSettings) metricSystemCgroupCPUTime {
+	m := metricSystemCgroupCPUTime{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemCgroupIo struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cgroup.io metric with initial data.
+func (m *metricSystemCgroupIo) init() {
+	m.data.SetName("system.cgroup.io")
+	m.data.SetDescription("Bytes transferred by the cgroup per block device.")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemCgroupIo) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, cgroupAttributeValue string, deviceAttributeValue string, directionAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Cgroup, pdata.NewAttributeValueString(cgroupAttributeValue))
+	dp.Attributes().Insert(A.Device, pdata.NewAttributeValueString(deviceAttributeValue))
+	dp.Attributes().Insert(A.Direction, pdata.NewAttributeValueString(directionAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCgroupIo) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCgroupIo) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCgroupIo(settings MetricSettings) metricSystemCgroupIo {
+	m := metricSystemCgroupIo{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemCgroupIoOperations struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cgroup.io.operations metric with initial data.
+func (m *metricSystemCgroupIoOperations) init() {
+	m.data.SetName("system.cgroup.io.operations")
+	m.data.SetDescription("I/O operations issued by the cgroup per block device.")
+	m.data.SetUnit("{operations}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemCgroupIoOperations) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, cgroupAttributeValue string, deviceAttributeValue string, directionAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Cgroup, pdata.NewAttributeValueString(cgroupAttributeValue))
+	dp.Attributes().Insert(A.Device, pdata.NewAttributeValueString(deviceAttributeValue))
+	dp.Attributes().Insert(A.Direction, pdata.NewAttributeValueString(directionAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCgroupIoOperations) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCgroupIoOperations) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCgroupIoOperations(settings MetricSettings) metricSystemCgroupIoOperations {
+	m := metricSystemCgroupIoOperations{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemCgroupMemoryLimit struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cgroup.memory.limit metric with initial data.
+func (m *metricSystemCgroupMemoryLimit) init() {
+	m.data.SetName("system.cgroup.memory.limit")
+	m.data.SetDescription("Memory usage hard limit of the cgroup. Not reported when the cgroup is unlimited.")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemCgroupMemoryLimit) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, cgroupAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Cgroup, pdata.NewAttributeValueString(cgroupAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCgroupMemoryLimit) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCgroupMemoryLimit) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCgroupMemoryLimit(settings MetricSettings) metricSystemCgroupMemoryLimit {
+	m := metricSystemCgroupMemoryLimit{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemCgroupMemoryStat struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cgroup.memory.stat metric with initial data.
+func (m *metricSystemCgroupMemoryStat) init() {
+	m.data.SetName("system.cgroup.memory.stat")
+	m.data.SetDescription("Memory used by the cgroup broken down by type.")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemCgroupMemoryStat) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, cgroupAttributeValue string, memoryTypeAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Cgroup, pdata.NewAttributeValueString(cgroupAttributeValue))
+	dp.Attributes().Insert(A.MemoryType, pdata.NewAttributeValueString(memoryTypeAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCgroupMemoryStat) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCgroupMemoryStat) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCgroupMemoryStat(settings MetricSettings) metricSystemCgroupMemoryStat {
+	m := metricSystemCgroupMemoryStat{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemCgroupMemoryUsage struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cgroup.memory.usage metric with initial data.
+func (m *metricSystemCgroupMemoryUsage) init() {
+	m.data.SetName("system.cgroup.memory.usage")
+	m.data.SetDescription("Total memory used by the cgroup and its descendants.")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemCgroupMemoryUsage) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, cgroupAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Cgroup, pdata.NewAttributeValueString(cgroupAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCgroupMemoryUsage) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCgroupMemoryUsage) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCgroupMemoryUsage(settings MetricSettings) metricSystemCgroupMemoryUsage {
+	m := metricSystemCgroupMemoryUsage{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemCgroupPidsCount struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cgroup.pids.count metric with initial data.
+func (m *metricSystemCgroupPidsCount) init() {
+	m.data.SetName("system.cgroup.pids.count")
+	m.data.SetDescription("Number of processes in the cgroup and its descendants.")
+	m.data.SetUnit("{processes}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemCgroupPidsCount) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, cgroupAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Cgroup, pdata.NewAttributeValueString(cgroupAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCgroupPidsCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCgroupPidsCount) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCgroupPidsCount(settings MetricSettings) metricSystemCgroupPidsCount {
+	m := metricSystemCgroupPidsCount{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemCgroupPidsLimit struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cgroup.pids.limit metric with initial data.
+func (m *metricSystemCgroupPidsLimit) init() {
+	m.data.SetName("system.cgroup.pids.limit")
+	m.data.SetDescription("Maximum number of processes allowed in the cgroup. Not reported when the cgroup is unlimited.")
+	m.data.SetUnit("{processes}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemCgroupPidsLimit) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, cgroupAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Cgroup, pdata.NewAttributeValueString(cgroupAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCgroupPidsLimit) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCgroupPidsLimit) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCgroupPidsLimit(settings MetricSettings) metricSystemCgroupPidsLimit {
+	m := metricSystemCgroupPidsLimit{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+// MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
+// required to produce metric representation defined in metadata and user settings.
+type MetricsBuilder struct {
+	startTime                             pdata.Timestamp
+	metricSystemCgroupCPUPeriods          metricSystemCgroupCPUPeriods
+	metricSystemCgroupCPUThrottledPeriods metricSystemCgroupCPUThrottledPeriods
+	metricSystemCgroupCPUThrottledTime    metricSystemCgroupCPUThrottledTime
+	metricSystemCgroupCPUTime             metricSystemCgroupCPUTime
+	metricSystemCgroupIo                  metricSystemCgroupIo
+	metricSystemCgroupIoOperations        metricSystemCgroupIoOperations
+	metricSystemCgroupMemoryLimit         metricSystemCgroupMemoryLimit
+	metricSystemCgroupMemoryStat          metricSystemCgroupMemoryStat
+	metricSystemCgroupMemoryUsage         metricSystemCgroupMemoryUsage
+	metricSystemCgroupPidsCount           metricSystemCgroupPidsCount
+	metricSystemCgroupPidsLimit           metricSystemCgroupPidsLimit
+}
+
+// metricBuilderOption applies changes to default metrics builder.
+type metricBuilderOption func(*MetricsBuilder)
+
+// WithStartTime sets startTime on the metrics builder.
+func WithStartTime(startTime pdata.Timestamp) metricBuilderOption {
+	return func(mb *MetricsBuilder) {
+		mb.startTime = startTime
+	}
+}
+
+func NewMetricsBuilder(settings MetricsSettings, options ...metricBuilderOption) *MetricsBuilder {
+	mb := &MetricsBuilder{
+		startTime:                             pdata.NewTimestampFromTime(time.Now()),
+		metricSystemCgroupCPUPeriods:          newMetricSystemCgroupCPUPeriods(settings.SystemCgroupCPUPeriods),
+		metricSystemCgroupCPUThrottledPeriods: newMetricSystemCgroupCPUThrottledPeriods(settings.SystemCgroupCPUThrottledPeriods),
+		metricSystemCgroupCPUThrottledTime:    newMetricSystemCgroupCPUThrottledTime(settings.SystemCgroupCPUThrottledTime),
+		metricSystemCgroupCPUTime:             newMetricSystemCgroupCPUTime(settings.SystemCgroupCPUTime),
+		metricSystemCgroupIo:                  newMetricSystemCgroupIo(settings.SystemCgroupIo),
+		metricSystemCgroupIoOperations:        newMetricSystemCgroupIoOperations(settings.SystemCgroupIoOperations),
+		metricSystemCgroupMemoryLimit:         newMetricSystemCgroupMemoryLimit(settings.SystemCgroupMemoryLimit),
+		metricSystemCgroupMemoryStat:          newMetricSystemCgroupMemoryStat(settings.SystemCgroupMemoryStat),
+		metricSystemCgroupMemoryUsage:         newMetricSystemCgroupMemoryUsage(settings.SystemCgroupMemoryUsage),
+		metricSystemCgroupPidsCount:           newMetricSystemCgroupPidsCount(settings.SystemCgroupPidsCount),
+		metricSystemCgroupPidsLimit:           newMetricSystemCgroupPidsLimit(settings.SystemCgroupPidsLimit),
+	}
+	for _, op := range options {
+		op(mb)
+	}
+	return mb
+}
+
+// Emit appends generated metrics to a pdata.MetricsSlice and updates the internal state to be ready for recording
+// another set of data points. This function will be doing all transformations required to produce metric representation
+// defined in metadata and user settings, e.g. delta/cumulative translation.
+func (mb *MetricsBuilder) Emit(metrics pdata.MetricSlice) {
+	mb.metricSystemCgroupCPUPeriods.emit(metrics)
+	mb.metricSystemCgroupCPUThrottledPeriods.emit(metrics)
+	mb.metricSystemCgroupCPUThrottledTime.emit(metrics)
+	mb.metricSystemCgroupCPUTime.emit(metrics)
+	mb.metricSystemCgroupIo.emit(metrics)
+	mb.metricSystemCgroupIoOperations.emit(metrics)
+	mb.metricSystemCgroupMemoryLimit.emit(metrics)
+	mb.metricSystemCgroupMemoryStat.emit(metrics)
+	mb.metricSystemCgroupMemoryUsage.emit(metrics)
+	mb.metricSystemCgroupPidsCount.emit(metrics)
+	mb.metricSystemCgroupPidsLimit.emit(metrics)
+}
+
+// RecordSystemCgroupCPUPeriodsDataPoint adds a data point to system.cgroup.cpu.periods metric.
+func (mb *MetricsBuilder) RecordSystemCgroupCPUPeriodsDataPoint(ts pdata.Timestamp, val int64, cgroupAttributeValue string) {
+	mb.metricSystemCgroupCPUPeriods.recordDataPoint(mb.startTime, ts, val, cgroupAttributeValue)
+}
+
+// RecordSystemCgroupCPUThrottledPeriodsDataPoint adds a data point to system.cgroup.cpu.throttled_periods metric.
+func (mb *MetricsBuilder) RecordSystemCgroupCPUThrottledPeriodsDataPoint(ts pdata.Timestamp, val int64, cgroupAttributeValue string) {
+	mb.metricSystemCgroupCPUThrottledPeriods.recordDataPoint(mb.startTime, ts, val, cgroupAttributeValue)
+}
+
+// RecordSystemCgroupCPUThrottledTimeDataPoint adds a data point to system.cgroup.cpu.throttled_time metric.
+func (mb *MetricsBuilder) RecordSystemCgroupCPUThrottledTimeDataPoint(ts pdata.Timestamp, val float64, cgroupAttributeValue string) {
+	mb.metricSystemCgroupCPUThrottledTime.recordDataPoint(mb.startTime, ts, val, cgroupAttributeValue)
+}
+
+// RecordSystemCgroupCPUTimeDataPoint adds a data point to system.cgroup.cpu.time metric.
+func (mb *MetricsBuilder) RecordSystemCgroupCPUTimeDataPoint(ts pdata.Timestamp, val float64, cgroupAttributeValue string, stateAttributeValue string) {
+	mb.metricSystemCgroupCPUTime.recordDataPoint(mb.startTime, ts, val, cgroupAttributeValue, stateAttributeValue)
+}
+
+// RecordSystemCgroupIoDataPoint adds a data point to system.cgroup.io metric.
+func (mb *MetricsBuilder) RecordSystemCgroupIoDataPoint(ts pdata.Timestamp, val int64, cgroupAttributeValue string, deviceAttributeValue string, directionAttributeValue string) {
+	mb.metricSystemCgroupIo.recordDataPoint(mb.startTime, ts, val, cgroupAttributeValue, deviceAttributeValue, directionAttributeValue)
+}
+
+// RecordSystemCgroupIoOperationsDataPoint adds a data point to system.cgroup.io.operations metric.
+func (mb *MetricsBuilder) RecordSystemCgroupIoOperationsDataPoint(ts pdata.Timestamp, val int64, cgroupAttributeValue string, deviceAttributeValue string, directionAttributeValue string) {
+	mb.metricSystemCgroupIoOperations.recordDataPoint(mb.startTime, ts, val, cgroupAttributeValue, deviceAttributeValue, directionAttributeValue)
+}
+
+// RecordSystemCgroupMemoryLimitDataPoint adds a data point to system.cgroup.memory.limit metric.
+func (mb *MetricsBuilder) RecordSystemCgroupMemoryLimitDataPoint(ts pdata.Timestamp, val int64, cgroupAttributeValue string) {
+	mb.metricSystemCgroupMemoryLimit.recordDataPoint(mb.startTime, ts, val, cgroupAttributeValue)
+}
+
+// RecordSystemCgroupMemoryStatDataPoint adds a data point to system.cgroup.memory.stat metric.
+func (mb *MetricsBuilder) RecordSystemCgroupMemoryStatDataPoint(ts pdata.Timestamp, val int64, cgroupAttributeValue string, memoryTypeAttributeValue string) {
+	mb.metricSystemCgroupMemoryStat.recordDataPoint(mb.startTime, ts, val, cgroupAttributeValue, memoryTypeAttributeValue)
+}
+
+// RecordSystemCgroupMemoryUsageDataPoint adds a data point to system.cgroup.memory.usage metric.
+func (mb *MetricsBuilder) RecordSystemCgroupMemoryUsageDataPoint(ts pdata.Timestamp, val int64, cgroupAttributeValue string) {
+	mb.metricSystemCgroupMemoryUsage.recordDataPoint(mb.startTime, ts, val, cgroupAttributeValue)
+}
+
+// RecordSystemCgroupPidsCountDataPoint adds a data point to system.cgroup.pids.count metric.
+func (mb *MetricsBuilder) RecordSystemCgroupPidsCountDataPoint(ts pdata.Timestamp, val int64, cgroupAttributeValue string) {
+	mb.metricSystemCgroupPidsCount.recordDataPoint(mb.startTime, ts, val, cgroupAttributeValue)
+}
+
+// RecordSystemCgroupPidsLimitDataPoint adds a data point to system.cgroup.pids.limit metric.
+func (mb *MetricsBuilder) RecordSystemCgroupPidsLimitDataPoint(ts pdata.Timestamp, val int64, cgroupAttributeValue string) {
+	mb.metricSystemCgroupPidsLimit.recordDataPoint(mb.startTime, ts, val, cgroupAttributeValue)
+}
+
+// Attributes contains the possible metric attributes that can be used.
+var Attributes = struct {
+	// Cgroup (Path of the cgroup relative to the root of the cgroup hierarchy.)
+	Cgroup string
+	// Device (Block device as major:minor number.)
+	Device string
+	// Direction (Direction of flow of bytes/operations (read or write).)
+	Direction string
+	// MemoryType (Type of memory as reported in memory.stat.)
+	MemoryType string
+	// State (Breakdown of CPU usage by type.)
+	State string
+}{
+	"cgroup",
+	"device",
+	"direction",
+	"type",
+	"state",
+}
+
+// A is an alias for Attributes.
+var A = Attributes
+
+// AttributeDirection are the possible values that the attribute "direction" can have.
+var AttributeDirection = struct {
+	Read  string
+	Write string
+}{
+	"read",
+	"write",
+}
+
+// AttributeMemoryType are the possible values that the attribute "memory_type" can have.
+var AttributeMemoryType = struct {
+	Anon        string
+	File        string
+	KernelStack string
+	Slab        string
+	Sock        string
+	Shmem       string
+}{
+	"anon",
+	"file",
+	"kernel_stack",
+	"slab",
+	"sock",
+	"shmem",
+}
+
+// AttributeState are the possible values that the attribute "state" can have.
+var AttributeState = struct {
+	System string
+	User   string
+}{
+	"system",
+	"user",
+}
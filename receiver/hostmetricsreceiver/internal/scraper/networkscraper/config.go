@@ -17,12 +17,23 @@ package networkscraper // import "github.com/open-telemetry/opentelemetry-collec
 import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/networkscraper/internal/metadata"
 )
 
 // Config relating to Network Metric Scraper.
 type Config struct {
 	internal.ConfigSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
 
+	// Metrics allows to customize scraped metrics representation.
+	Metrics metadata.MetricsSettings `mapstructure:"metrics"`
+
+	// ProcPath is the mount point of the proc filesystem, used to read the
+	// connection tracking table usage. Defaults to /proc.
+	ProcPath string `mapstructure:"proc_path"`
+	// SysPath is the mount point of the sys filesystem, used to read the
+	// network interface properties. Defaults to /sys.
+	SysPath string `mapstructure:"sys_path"`
+
 	// Include specifies a filter on the network interfaces that should be included from the generated metrics.
 	Include MatchConfig `mapstructure:"include"`
 	// Exclude specifies a filter on the network interfaces that should be excluded from the generated metrics.
@@ -21,6 +21,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/networkscraper/internal/metadata"
 )
 
 // This file implements Factory for Network scraper.
@@ -28,6 +29,9 @@ import (
 const (
 	// TypeStr the value of "type" key in configuration.
 	TypeStr = "network"
+
+	defaultProcPath = "/proc"
+	defaultSysPath  = "/sys"
 )
 
 // Factory is the Factory for scraper.
@@ -36,7 +40,11 @@ type Factory struct {
 
 // CreateDefaultConfig creates the default configuration for the Scraper.
 func (f *Factory) CreateDefaultConfig() internal.Config {
-	return &Config{}
+	return &Config{
+		Metrics:  metadata.DefaultMetricsSettings(),
+		ProcPath: defaultProcPath,
+		SysPath:  defaultSysPath,
+	}
 }
 
 // CreateMetricsScraper creates a scraper based on provided config.
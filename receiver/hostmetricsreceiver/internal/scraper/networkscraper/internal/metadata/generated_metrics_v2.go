@@ -0,0 +1,806 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// MetricSettings provides common settings for a particular metric.
+type MetricSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// MetricsSettings provides settings for network metrics.
+type MetricsSettings struct {
+	SystemNetworkConnections     MetricSettings `mapstructure:"system.network.connections"`
+	SystemNetworkConntrackCount  MetricSettings `mapstructure:"system.network.conntrack.count"`
+	SystemNetworkConntrackMax    MetricSettings `mapstructure:"system.network.conntrack.max"`
+	SystemNetworkDropped         MetricSettings `mapstructure:"system.network.dropped"`
+	SystemNetworkErrors          MetricSettings `mapstructure:"system.network.errors"`
+	SystemNetworkInterfaceMtu    MetricSettings `mapstructure:"system.network.interface.mtu"`
+	SystemNetworkInterfaceSpeed  MetricSettings `mapstructure:"system.network.interface.speed"`
+	SystemNetworkInterfaceUp     MetricSettings `mapstructure:"system.network.interface.up"`
+	SystemNetworkIo              MetricSettings `mapstructure:"system.network.io"`
+	SystemNetworkPackets         MetricSettings `mapstructure:"system.network.packets"`
+	SystemNetworkPortConnections MetricSettings `mapstructure:"system.network.port.connections"`
+}
+
+func DefaultMetricsSettings() MetricsSettings {
+	return MetricsSettings{
+		SystemNetworkConnections: MetricSettings{
+			Enabled: true,
+		},
+		SystemNetworkConntrackCount: MetricSettings{
+			Enabled: false,
+		},
+		SystemNetworkConntrackMax: MetricSettings{
+			Enabled: false,
+		},
+		SystemNetworkDropped: MetricSettings{
+			Enabled: true,
+		},
+		SystemNetworkErrors: MetricSettings{
+			Enabled: true,
+		},
+		SystemNetworkInterfaceMtu: MetricSettings{
+			Enabled: false,
+		},
+		SystemNetworkInterfaceSpeed: MetricSettings{
+			Enabled: false,
+		},
+		SystemNetworkInterfaceUp: MetricSettings{
+			Enabled: false,
+		},
+		SystemNetworkIo: MetricSettings{
+			Enabled: true,
+		},
+		SystemNetworkPackets: MetricSettings{
+			Enabled: true,
+		},
+		SystemNetworkPortConnections: MetricSettings{
+			Enabled: false,
+		},
+	}
+}
+
+type metricSystemNetworkConnections struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.network.connections metric with initial data.
+func (m *metricSystemNetworkConnections) init() {
+	m.data.SetName("system.network.connections")
+	m.data.SetDescription("The number of connections.")
+	m.data.SetUnit("{connections}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemNetworkConnections) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, protocolAttributeValue string, stateAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Protocol, pdata.NewAttributeValueString(protocolAttributeValue))
+	dp.Attributes().Insert(A.State, pdata.NewAttributeValueString(stateAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNetworkConnections) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNetworkConnections) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNetworkConnections(settings MetricSettings) metricSystemNetworkConnections {
+	m := metricSystemNetworkConnections{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNetworkConntrackCount struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.network.conntrack.count metric with initial data.
+func (m *metricSystemNetworkConntrackCount) init() {
+	m.data.SetName("system.network.conntrack.count")
+	m.data.SetDescription("The number of entries in the netfilter connection tracking table.")
+	m.data.SetUnit("{entries}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricSystemNetworkConntrackCount) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNetworkConntrackCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNetworkConntrackCount) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNetworkConntrackCount(settings MetricSettings) metricSystemNetworkConntrackCount {
+	m := metricSystemNetworkConntrackCount{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNetworkConntrackMax struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.network.conntrack.max metric with initial data.
+func (m *metricSystemNetworkConntrackMax) init() {
+	m.data.SetName("system.network.conntrack.max")
+	m.data.SetDescription("The size limit of the netfilter connection tracking table.")
+	m.data.SetUnit("{entries}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricSystemNetworkConntrackMax) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNetworkConntrackMax) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNetworkConntrackMax) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNetworkConntrackMax(settings MetricSettings) metricSystemNetworkConntrackMax {
+	m := metricSystemNetworkConntrackMax{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNetworkDropped struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.network.dropped metric with initial data.
+func (m *metricSystemNetworkDropped) init() {
+	m.data.SetName("system.network.dropped")
+	m.data.SetDescription("The number of packets dropped.")
+	m.data.SetUnit("{packets}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemNetworkDropped) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Device, pdata.NewAttributeValueString(deviceAttributeValue))
+	dp.Attributes().Insert(A.Direction, pdata.NewAttributeValueString(directionAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNetworkDropped) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNetworkDropped) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNetworkDropped(settings MetricSettings) metricSystemNetworkDropped {
+	m := metricSystemNetworkDropped{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNetworkErrors struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.network.errors metric with initial data.
+func (m *metricSystemNetworkErrors) init() {
+	m.data.SetName("system.network.errors")
+	m.data.SetDescription("The number of errors encountered.")
+	m.data.SetUnit("{errors}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemNetworkErrors) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Device, pdata.NewAttributeValueString(deviceAttributeValue))
+	dp.Attributes().Insert(A.Direction, pdata.NewAttributeValueString(directionAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNetworkErrors) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNetworkErrors) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNetworkErrors(settings MetricSettings) metricSystemNetworkErrors {
+	m := metricSystemNetworkErrors{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNetworkInterfaceMtu struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.network.interface.mtu metric with initial data.
+func (m *metricSystemNetworkInterfaceMtu) init() {
+	m.data.SetName("system.network.interface.mtu")
+	m.data.SetDescription("The maximum transmission unit of the network interface.")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemNetworkInterfaceMtu) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, deviceAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Device, pdata.NewAttributeValueString(deviceAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNetworkInterfaceMtu) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNetworkInterfaceMtu) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNetworkInterfaceMtu(settings MetricSettings) metricSystemNetworkInterfaceMtu {
+	m := metricSystemNetworkInterfaceMtu{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNetworkInterfaceSpeed struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.network.interface.speed metric with initial data.
+func (m *metricSystemNetworkInterfaceSpeed) init() {
+	m.data.SetName("system.network.interface.speed")
+	m.data.SetDescription("The link speed of the network interface. Not reported for interfaces without a known speed.")
+	m.data.SetUnit("bit/s")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemNetworkInterfaceSpeed) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, deviceAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Device, pdata.NewAttributeValueString(deviceAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNetworkInterfaceSpeed) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNetworkInterfaceSpeed) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNetworkInterfaceSpeed(settings MetricSettings) metricSystemNetworkInterfaceSpeed {
+	m := metricSystemNetworkInterfaceSpeed{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNetworkInterfaceUp struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.network.interface.up metric with initial data.
+func (m *metricSystemNetworkInterfaceUp) init() {
+	m.data.SetName("system.network.interface.up")
+	m.data.SetDescription("Whether the operational state of the network interface is up (1) or not (0).")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemNetworkInterfaceUp) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, deviceAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Device, pdata.NewAttributeValueString(deviceAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNetworkInterfaceUp) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNetworkInterfaceUp) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNetworkInterfaceUp(settings MetricSettings) metricSystemNetworkInterfaceUp {
+	m := metricSystemNetworkInterfaceUp{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNetworkIo struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.network.io metric with initial data.
+func (m *metricSystemNetworkIo) init() {
+	m.data.SetName("system.network.io")
+	m.data.SetDescription("The number of bytes transmitted and received.")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemNetworkIo) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Device, pdata.NewAttributeValueString(deviceAttributeValue))
+	dp.Attributes().Insert(A.Direction, pdata.NewAttributeValueString(directionAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNetworkIo) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNetworkIo) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNetworkIo(settings MetricSettings) metricSystemNetworkIo {
+	m := metricSystemNetworkIo{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNetworkPackets struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.network.packets metric with initial data.
+func (m *metricSystemNetworkPackets) init() {
+	m.data.SetName("system.network.packets")
+	m.data.SetDescription("The number of packets transferred.")
+	m.data.SetUnit("{packets}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemNetworkPackets) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Device, pdata.NewAttributeValueString(deviceAttributeValue))
+	dp.Attributes().Insert(A.Direction, pdata.NewAttributeValueString(directionAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNetworkPackets) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNetworkPackets) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNetworkPackets(settings MetricSettings) metricSystemNetworkPackets {
+	m := metricSystemNetworkPackets{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNetworkPortConnections struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.network.port.connections metric with initial data.
+func (m *metricSystemNetworkPortConnections) init() {
+	m.data.SetName("system.network.port.connections")
+	m.data.SetDescription("The number of connections whose local port is a listening port, broken down by port.")
+	m.data.SetUnit("{connections}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemNetworkPortConnections) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, protocolAttributeValue string, stateAttributeValue string, portAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Protocol, pdata.NewAttributeValueString(protocolAttributeValue))
+	dp.Attributes().Insert(A.State, pdata.NewAttributeValueString(stateAttributeValue))
+	dp.Attributes().Insert(A.Port, pdata.NewAttributeValueString(portAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNetworkPortConnections) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNetworkPortConnections) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNetworkPortConnections(settings MetricSettings) metricSystemNetworkPortConnections {
+	m := metricSystemNetworkPortConnections{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+// MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
+// required to produce metric representation defined in metadata and user settings.
+type MetricsBuilder struct {
+	startTime                          pdata.Timestamp
+	metricSystemNetworkConnections     metricSystemNetworkConnections
+	metricSystemNetworkConntrackCount  metricSystemNetworkConntrackCount
+	metricSystemNetworkConntrackMax    metricSystemNetworkConntrackMax
+	metricSystemNetworkDropped         metricSystemNetworkDropped
+	metricSystemNetworkErrors          metricSystemNetworkErrors
+	metricSystemNetworkInterfaceMtu    metricSystemNetworkInterfaceMtu
+	metricSystemNetworkInterfaceSpeed  metricSystemNetworkInterfaceSpeed
+	metricSystemNetworkInterfaceUp     metricSystemNetworkInterfaceUp
+	metricSystemNetworkIo              metricSystemNetworkIo
+	metricSystemNetworkPackets         metricSystemNetworkPackets
+	metricSystemNetworkPortConnections metricSystemNetworkPortConnections
+}
+
+// metricBuilderOption applies changes to default metrics builder.
+type metricBuilderOption func(*MetricsBuilder)
+
+// WithStartTime sets startTime on the metrics builder.
+func WithStartTime(startTime pdata.Timestamp) metricBuilderOption {
+	return func(mb *MetricsBuilder) {
+		mb.startTime = startTime
+	}
+}
+
+func NewMetricsBuilder(settings MetricsSettings, options ...metricBuilderOption) *MetricsBuilder {
+	mb := &MetricsBuilder{
+		startTime:                          pdata.NewTimestampFromTime(time.Now()),
+		metricSystemNetworkConnections:     newMetricSystemNetworkConnections(settings.SystemNetworkConnections),
+		metricSystemNetworkConntrackCount:  newMetricSystemNetworkConntrackCount(settings.SystemNetworkConntrackCount),
+		metricSystemNetworkConntrackMax:    newMetricSystemNetworkConntrackMax(settings.SystemNetworkConntrackMax),
+		metricSystemNetworkDropped:         newMetricSystemNetworkDropped(settings.SystemNetworkDropped),
+		metricSystemNetworkErrors:          newMetricSystemNetworkErrors(settings.SystemNetworkErrors),
+		metricSystemNetworkInterfaceMtu:    newMetricSystemNetworkInterfaceMtu(settings.SystemNetworkInterfaceMtu),
+		metricSystemNetworkInterfaceSpeed:  newMetricSystemNetworkInterfaceSpeed(settings.SystemNetworkInterfaceSpeed),
+		metricSystemNetworkInterfaceUp:     newMetricSystemNetworkInterfaceUp(settings.SystemNetworkInterfaceUp),
+		metricSystemNetworkIo:              newMetricSystemNetworkIo(settings.SystemNetworkIo),
+		metricSystemNetworkPackets:         newMetricSystemNetworkPackets(settings.SystemNetworkPackets),
+		metricSystemNetworkPortConnections: newMetricSystemNetworkPortConnections(settings.SystemNetworkPortConnections),
+	}
+	for _, op := range options {
+		op(mb)
+	}
+	return mb
+}
+
+// Emit appends generated metrics to a pdata.MetricsSlice and updates the internal state to be ready for recording
+// another set of data points. This function will be doing all transformations required to produce metric representation
+// defined in metadata and user settings, e.g. delta/cumulative translation.
+func (mb *MetricsBuilder) Emit(metrics pdata.MetricSlice) {
+	mb.metricSystemNetworkConnections.emit(metrics)
+	mb.metricSystemNetworkConntrackCount.emit(metrics)
+	mb.metricSystemNetworkConntrackMax.emit(metrics)
+	mb.metricSystemNetworkDropped.emit(metrics)
+	mb.metricSystemNetworkErrors.emit(metrics)
+	mb.metricSystemNetworkInterfaceMtu.emit(metrics)
+	mb.metricSystemNetworkInterfaceSpeed.emit(metrics)
+	mb.metricSystemNetworkInterfaceUp.emit(metrics)
+	mb.metricSystemNetworkIo.emit(metrics)
+	mb.metricSystemNetworkPackets.emit(metrics)
+	mb.metricSystemNetworkPortConnections.emit(metrics)
+}
+
+// RecordSystemNetworkConnectionsDataPoint adds a data point to system.network.connections metric.
+func (mb *MetricsBuilder) RecordSystemNetworkConnectionsDataPoint(ts pdata.Timestamp, val int64, protocolAttributeValue string, stateAttributeValue string) {
+	mb.metricSystemNetworkConnections.recordDataPoint(mb.startTime, ts, val, protocolAttributeValue, stateAttributeValue)
+}
+
+// RecordSystemNetworkConntrackCountDataPoint adds a data point to system.network.conntrack.count metric.
+func (mb *MetricsBuilder) RecordSystemNetworkConntrackCountDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricSystemNetworkConntrackCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSystemNetworkConntrackMaxDataPoint adds a data point to system.network.conntrack.max metric.
+func (mb *MetricsBuilder) RecordSystemNetworkConntrackMaxDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricSystemNetworkConntrackMax.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSystemNetworkDroppedDataPoint adds a data point to system.network.dropped metric.
+func (mb *MetricsBuilder) RecordSystemNetworkDroppedDataPoint(ts pdata.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue string) {
+	mb.metricSystemNetworkDropped.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue, directionAttributeValue)
+}
+
+// RecordSystemNetworkErrorsDataPoint adds a data point to system.network.errors metric.
+func (mb *MetricsBuilder) RecordSystemNetworkErrorsDataPoint(ts pdata.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue string) {
+	mb.metricSystemNetworkErrors.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue, directionAttributeValue)
+}
+
+// RecordSystemNetworkInterfaceMtuDataPoint adds a data point to system.network.interface.mtu metric.
+func (mb *MetricsBuilder) RecordSystemNetworkInterfaceMtuDataPoint(ts pdata.Timestamp, val int64, deviceAttributeValue string) {
+	mb.metricSystemNetworkInterfaceMtu.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue)
+}
+
+// RecordSystemNetworkInterfaceSpeedDataPoint adds a data point to system.network.interface.speed metric.
+func (mb *MetricsBuilder) RecordSystemNetworkInterfaceSpeedDataPoint(ts pdata.Timestamp, val int64, deviceAttributeValue string) {
+	mb.metricSystemNetworkInterfaceSpeed.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue)
+}
+
+// RecordSystemNetworkInterfaceUpDataPoint adds a data point to system.network.interface.up metric.
+func (mb *MetricsBuilder) RecordSystemNetworkInterfaceUpDataPoint(ts pdata.Timestamp, val int64, deviceAttributeValue string) {
+	mb.metricSystemNetworkInterfaceUp.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue)
+}
+
+// RecordSystemNetworkIoDataPoint adds a data point to system.network.io metric.
+func (mb *MetricsBuilder) RecordSystemNetworkIoDataPoint(ts pdata.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue string) {
+	mb.metricSystemNetworkIo.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue, directionAttributeValue)
+}
+
+// RecordSystemNetworkPacketsDataPoint adds a data point to system.network.packets metric.
+func (mb *MetricsBuilder) RecordSystemNetworkPacketsDataPoint(ts pdata.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue string) {
+	mb.metricSystemNetworkPackets.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue, directionAttributeValue)
+}
+
+// RecordSystemNetworkPortConnectionsDataPoint adds a data point to system.network.port.connections metric.
+func (mb *MetricsBuilder) RecordSystemNetworkPortConnectionsDataPoint(ts pdata.Timestamp, val int64, protocolAttributeValue string, stateAttributeValue string, portAttributeValue string) {
+	mb.metricSystemNetworkPortConnections.recordDataPoint(mb.startTime, ts, val, protocolAttributeValue, stateAttributeValue, portAttributeValue)
+}
+
+// Attributes contains the possible metric attributes that can be used.
+var Attributes = struct {
+	// Device (Name of the network interface.)
+	Device string
+	// Direction (Direction of flow of bytes/opertations (receive or transmit).)
+	Direction string
+	// Port (Local port of the connection on which a socket is listening.)
+	Port string
+	// Protocol (Network protocol, e.g. TCP or UDP.)
+	Protocol string
+	// State (State of the network connection.)
+	State string
+}{
+	"device",
+	"direction",
+	"port",
+	"protocol",
+	"state",
+}
+
+// A is an alias for Attributes.
+var A = Attributes
+
+// AttributeDirection are the possible values that the attribute "direction" can have.
+var AttributeDirection = struct {
+	Receive  string
+	Transmit string
+}{
+	"receive",
+	"transmit",
+}
+
+// AttributeProtocol are the possible values that the attribute "protocol" can have.
+var AttributeProtocol = struct {
+	Tcp string
+}{
+	"tcp",
+}
@@ -17,6 +17,10 @@ package networkscraper // import "github.com/open-telemetry/opentelemetry-collec
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/shirou/gopsutil/v3/host"
@@ -24,20 +28,25 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/model/pdata"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/networkscraper/internal/metadata"
 )
 
 const (
-	networkMetricsLen     = 4
-	connectionsMetricsLen = 1
+	networkMetricsLen   = 4
+	conntrackMetricsLen = 2
+	interfaceMetricsLen = 3
+
+	tcpStateListen = "LISTEN"
 )
 
 // scraper for Network Metrics
 type scraper struct {
 	config    *Config
 	startTime pdata.Timestamp
+	mb        *metadata.MetricsBuilder
 	includeFS filterset.FilterSet
 	excludeFS filterset.FilterSet
 
@@ -77,6 +86,7 @@ func (s *scraper) start(context.Context, component.Host) error {
 	}
 
 	s.startTime = pdata.Timestamp(bootTime * 1e9)
+	s.mb = metadata.NewMetricsBuilder(s.config.Metrics, metadata.WithStartTime(s.startTime))
 	return nil
 }
 
@@ -85,21 +95,37 @@ func (s *scraper) scrape(_ context.Context) (pdata.Metrics, error) {
 	metrics := md.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty().Metrics()
 	var errors scrapererror.ScrapeErrors
 
-	err := s.scrapeAndAppendNetworkCounterMetrics(metrics, s.startTime)
+	now := pdata.NewTimestampFromTime(time.Now())
+	err := s.recordNetworkCounterMetrics(now)
 	if err != nil {
 		errors.AddPartial(networkMetricsLen, err)
 	}
 
-	err = s.scrapeAndAppendNetworkConnectionsMetric(metrics)
+	err = s.recordNetworkConnectionsMetrics(now)
 	if err != nil {
-		errors.AddPartial(connectionsMetricsLen, err)
+		errors.AddPartial(s.connectionsMetricsLen(), err)
+	}
+
+	if s.config.Metrics.SystemNetworkConntrackCount.Enabled || s.config.Metrics.SystemNetworkConntrackMax.Enabled {
+		err = s.recordNetworkConntrackMetrics(now)
+		if err != nil {
+			errors.AddPartial(conntrackMetricsLen, err)
+		}
 	}
 
+	if s.config.Metrics.SystemNetworkInterfaceMtu.Enabled || s.config.Metrics.SystemNetworkInterfaceSpeed.Enabled ||
+		s.config.Metrics.SystemNetworkInterfaceUp.Enabled {
+		err = s.recordNetworkInterfaceMetrics(now)
+		if err != nil {
+			errors.AddPartial(interfaceMetricsLen, err)
+		}
+	}
+
+	s.mb.Emit(metrics)
 	return md, errors.Combine()
 }
 
-func (s *scraper) scrapeAndAppendNetworkCounterMetrics(metrics pdata.MetricSlice, startTime pdata.Timestamp) error {
-	now := pdata.NewTimestampFromTime(time.Now())
+func (s *scraper) recordNetworkCounterMetrics(now pdata.Timestamp) error {
 
 	// get total stats only
 	ioCounters, err := s.ioCounters( /*perNetworkInterfaceController=*/ true)
@@ -110,116 +136,154 @@ func (s *scraper) scrapeAndAppendNetworkCounterMetrics(metrics pdata.MetricSlice
 	// filter network interfaces by name
 	ioCounters = s.filterByInterface(ioCounters)
 
-	if len(ioCounters) > 0 {
-		startIdx := metrics.Len()
-		metrics.EnsureCapacity(startIdx + networkMetricsLen)
-		initializeNetworkPacketsMetric(metrics.AppendEmpty(), metadata.Metrics.SystemNetworkPackets, startTime, now, ioCounters)
-		initializeNetworkDroppedPacketsMetric(metrics.AppendEmpty(), metadata.Metrics.SystemNetworkDropped, startTime, now, ioCounters)
-		initializeNetworkErrorsMetric(metrics.AppendEmpty(), metadata.Metrics.SystemNetworkErrors, startTime, now, ioCounters)
-		initializeNetworkIOMetric(metrics.AppendEmpty(), metadata.Metrics.SystemNetworkIo, startTime, now, ioCounters)
+	for _, ioCounter := range ioCounters {
+		s.mb.RecordSystemNetworkPacketsDataPoint(now, int64(ioCounter.PacketsSent), ioCounter.Name, metadata.AttributeDirection.Transmit)
+		s.mb.RecordSystemNetworkPacketsDataPoint(now, int64(ioCounter.PacketsRecv), ioCounter.Name, metadata.AttributeDirection.Receive)
+		s.mb.RecordSystemNetworkDroppedDataPoint(now, int64(ioCounter.Dropout), ioCounter.Name, metadata.AttributeDirection.Transmit)
+		s.mb.RecordSystemNetworkDroppedDataPoint(now, int64(ioCounter.Dropin), ioCounter.Name, metadata.AttributeDirection.Receive)
+		s.mb.RecordSystemNetworkErrorsDataPoint(now, int64(ioCounter.Errout), ioCounter.Name, metadata.AttributeDirection.Transmit)
+		s.mb.RecordSystemNetworkErrorsDataPoint(now, int64(ioCounter.Errin), ioCounter.Name, metadata.AttributeDirection.Receive)
+		s.mb.RecordSystemNetworkIoDataPoint(now, int64(ioCounter.BytesSent), ioCounter.Name, metadata.AttributeDirection.Transmit)
+		s.mb.RecordSystemNetworkIoDataPoint(now, int64(ioCounter.BytesRecv), ioCounter.Name, metadata.AttributeDirection.Receive)
 	}
 
 	return nil
 }
 
-func initializeNetworkPacketsMetric(metric pdata.Metric, metricIntf metadata.MetricIntf, startTime, now pdata.Timestamp, ioCountersSlice []net.IOCountersStat) {
-	metricIntf.Init(metric)
+func (s *scraper) recordNetworkConnectionsMetrics(now pdata.Timestamp) error {
 
-	idps := metric.Sum().DataPoints()
-	idps.EnsureCapacity(2 * len(ioCountersSlice))
-	for _, ioCounters := range ioCountersSlice {
-		initializeNetworkDataPoint(idps.AppendEmpty(), startTime, now, ioCounters.Name, metadata.AttributeDirection.Transmit, int64(ioCounters.PacketsSent))
-		initializeNetworkDataPoint(idps.AppendEmpty(), startTime, now, ioCounters.Name, metadata.AttributeDirection.Receive, int64(ioCounters.PacketsRecv))
+	connections, err := s.connections("tcp")
+	if err != nil {
+		return err
 	}
-}
 
-func initializeNetworkDroppedPacketsMetric(metric pdata.Metric, metricIntf metadata.MetricIntf, startTime, now pdata.Timestamp, ioCountersSlice []net.IOCountersStat) {
-	metricIntf.Init(metric)
+	for state, count := range getTCPConnectionStatusCounts(connections) {
+		s.mb.RecordSystemNetworkConnectionsDataPoint(now, count, metadata.AttributeProtocol.Tcp, state)
+	}
 
-	idps := metric.Sum().DataPoints()
-	idps.EnsureCapacity(2 * len(ioCountersSlice))
-	for _, ioCounters := range ioCountersSlice {
-		initializeNetworkDataPoint(idps.AppendEmpty(), startTime, now, ioCounters.Name, metadata.AttributeDirection.Transmit, int64(ioCounters.Dropout))
-		initializeNetworkDataPoint(idps.AppendEmpty(), startTime, now, ioCounters.Name, metadata.AttributeDirection.Receive, int64(ioCounters.Dropin))
+	if s.config.Metrics.SystemNetworkPortConnections.Enabled {
+		for port, stateCounts := range getTCPConnectionPortCounts(connections) {
+			for state, count := range stateCounts {
+				s.mb.RecordSystemNetworkPortConnectionsDataPoint(now, count, metadata.AttributeProtocol.Tcp, state, strconv.FormatUint(uint64(port), 10))
+			}
+		}
 	}
+	return nil
 }
 
-func initializeNetworkErrorsMetric(metric pdata.Metric, metricIntf metadata.MetricIntf, startTime, now pdata.Timestamp, ioCountersSlice []net.IOCountersStat) {
-	metricIntf.Init(metric)
-
-	idps := metric.Sum().DataPoints()
-	idps.EnsureCapacity(2 * len(ioCountersSlice))
-	for _, ioCounters := range ioCountersSlice {
-		initializeNetworkDataPoint(idps.AppendEmpty(), startTime, now, ioCounters.Name, metadata.AttributeDirection.Transmit, int64(ioCounters.Errout))
-		initializeNetworkDataPoint(idps.AppendEmpty(), startTime, now, ioCounters.Name, metadata.AttributeDirection.Receive, int64(ioCounters.Errin))
+// connectionsMetricsLen returns the number of enabled metrics recorded from the TCP connections.
+func (s *scraper) connectionsMetricsLen() int {
+	n := 0
+	if s.config.Metrics.SystemNetworkConnections.Enabled {
+		n++
 	}
+	if s.config.Metrics.SystemNetworkPortConnections.Enabled {
+		n++
+	}
+	return n
 }
 
-func initializeNetworkIOMetric(metric pdata.Metric, metricIntf metadata.MetricIntf, startTime, now pdata.Timestamp, ioCountersSlice []net.IOCountersStat) {
-	metricIntf.Init(metric)
+func getTCPConnectionStatusCounts(connections []net.ConnectionStat) map[string]int64 {
+	tcpStatuses := make(map[string]int64, len(allTCPStates))
+	for _, state := range allTCPStates {
+		tcpStatuses[state] = 0
+	}
 
-	idps := metric.Sum().DataPoints()
-	idps.EnsureCapacity(2 * len(ioCountersSlice))
-	for _, ioCounters := range ioCountersSlice {
-		initializeNetworkDataPoint(idps.AppendEmpty(), startTime, now, ioCounters.Name, metadata.AttributeDirection.Transmit, int64(ioCounters.BytesSent))
-		initializeNetworkDataPoint(idps.AppendEmpty(), startTime, now, ioCounters.Name, metadata.AttributeDirection.Receive, int64(ioCounters.BytesRecv))
+	for _, connection := range connections {
+		tcpStatuses[connection.Status]++
 	}
+	return tcpStatuses
 }
 
-func initializeNetworkDataPoint(dataPoint pdata.NumberDataPoint, startTime, now pdata.Timestamp, deviceLabel, directionLabel string, value int64) {
-	attributes := dataPoint.Attributes()
-	attributes.InsertString(metadata.Attributes.Device, deviceLabel)
-	attributes.InsertString(metadata.Attributes.Direction, directionLabel)
-	dataPoint.SetStartTimestamp(startTime)
-	dataPoint.SetTimestamp(now)
-	dataPoint.SetIntVal(value)
+// getTCPConnectionPortCounts counts the connections by state for each local port
+// on which a socket is listening. Other local ports are ephemeral and ignored to
+// keep the cardinality bounded.
+func getTCPConnectionPortCounts(connections []net.ConnectionStat) map[uint32]map[string]int64 {
+	portCounts := map[uint32]map[string]int64{}
+	for _, connection := range connections {
+		if connection.Status == tcpStateListen {
+			portCounts[connection.Laddr.Port] = map[string]int64{}
+		}
+	}
+
+	for _, connection := range connections {
+		if stateCounts, ok := portCounts[connection.Laddr.Port]; ok {
+			stateCounts[connection.Status]++
+		}
+	}
+	return portCounts
 }
 
-func (s *scraper) scrapeAndAppendNetworkConnectionsMetric(metrics pdata.MetricSlice) error {
-	now := pdata.NewTimestampFromTime(time.Now())
+func (s *scraper) recordNetworkConntrackMetrics(now pdata.Timestamp) error {
+	dir := filepath.Join(s.config.ProcPath, "sys", "net", "netfilter")
 
-	connections, err := s.connections("tcp")
+	count, err := readInt(filepath.Join(dir, "nf_conntrack_count"))
 	if err != nil {
-		return err
+		return fmt.Errorf("error reading conntrack count: %w", err)
+	}
+	maxEntries, err := readInt(filepath.Join(dir, "nf_conntrack_max"))
+	if err != nil {
+		return fmt.Errorf("error reading conntrack max: %w", err)
 	}
 
-	tcpConnectionStatusCounts := getTCPConnectionStatusCounts(connections)
-
-	startIdx := metrics.Len()
-	metrics.EnsureCapacity(startIdx + connectionsMetricsLen)
-	initializeNetworkConnectionsMetric(metrics.AppendEmpty(), now, tcpConnectionStatusCounts)
+	s.mb.RecordSystemNetworkConntrackCountDataPoint(now, count)
+	s.mb.RecordSystemNetworkConntrackMaxDataPoint(now, maxEntries)
 	return nil
 }
 
-func getTCPConnectionStatusCounts(connections []net.ConnectionStat) map[string]int64 {
-	tcpStatuses := make(map[string]int64, len(allTCPStates))
-	for _, state := range allTCPStates {
-		tcpStatuses[state] = 0
-	}
+// recordNetworkInterfaceMetrics records the properties of the network interfaces
+// found in /sys/class/net.
+func (s *scraper) recordNetworkInterfaceMetrics(now pdata.Timestamp) error {
+	dir := filepath.Join(s.config.SysPath, "class", "net")
 
-	for _, connection := range connections {
-		tcpStatuses[connection.Status]++
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
 	}
-	return tcpStatuses
-}
 
-func initializeNetworkConnectionsMetric(metric pdata.Metric, now pdata.Timestamp, connectionStateCounts map[string]int64) {
-	metadata.Metrics.SystemNetworkConnections.Init(metric)
+	var errs error
+	for _, entry := range entries {
+		name := entry.Name()
+		if !s.includeInterface(name) {
+			continue
+		}
 
-	idps := metric.Sum().DataPoints()
-	idps.EnsureCapacity(len(connectionStateCounts))
+		mtu, err := readInt(filepath.Join(dir, name, "mtu"))
+		switch {
+		case err == nil:
+			s.mb.RecordSystemNetworkInterfaceMtuDataPoint(now, mtu, name)
+		case !os.IsNotExist(err):
+			errs = multierr.Append(errs, fmt.Errorf("error reading mtu of %s: %w", name, err))
+		}
+
+		// The speed is unknown, -1 or not readable at all, for virtual interfaces
+		// and interfaces without a link.
+		if speed, err := readInt(filepath.Join(dir, name, "speed")); err == nil && speed > 0 {
+			s.mb.RecordSystemNetworkInterfaceSpeedDataPoint(now, speed*1e6, name)
+		}
 
-	for connectionState, count := range connectionStateCounts {
-		initializeNetworkConnectionsDataPoint(idps.AppendEmpty(), now, metadata.AttributeProtocol.Tcp, connectionState, count)
+		operState, err := os.ReadFile(filepath.Join(dir, name, "operstate"))
+		switch {
+		case err == nil:
+			var up int64
+			if strings.TrimSpace(string(operState)) == "up" {
+				up = 1
+			}
+			s.mb.RecordSystemNetworkInterfaceUpDataPoint(now, up, name)
+		case !os.IsNotExist(err):
+			errs = multierr.Append(errs, fmt.Errorf("error reading operational state of %s: %w", name, err))
+		}
 	}
+
+	return errs
 }
 
-func initializeNetworkConnectionsDataPoint(dataPoint pdata.NumberDataPoint, now pdata.Timestamp, protocolLabel, stateLabel string, value int64) {
-	attributes := dataPoint.Attributes()
-	attributes.InsertString(metadata.Attributes.Protocol, protocolLabel)
-	attributes.InsertString(metadata.Attributes.State, stateLabel)
-	dataPoint.SetTimestamp(now)
-	dataPoint.SetIntVal(value)
+func readInt(path string) (int64, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(content)), 10, 64)
 }
 
 func (s *scraper) filterByInterface(ioCounters []net.IOCountersStat) []net.IOCountersStat {
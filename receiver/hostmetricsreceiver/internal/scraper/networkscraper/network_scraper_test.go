@@ -17,6 +17,9 @@ package networkscraper
 import (
 	"context"
 	"errors"
+	"path/filepath"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/shirou/gopsutil/v3/net"
@@ -49,17 +52,22 @@ func TestScrape(t *testing.T) {
 	testCases := []testCase{
 		{
 			name:                 "Standard",
+			config:               Config{Metrics: metadata.DefaultMetricsSettings()},
 			expectNetworkMetrics: true,
 		},
 		{
 			name:                 "Validate Start Time",
+			config:               Config{Metrics: metadata.DefaultMetricsSettings()},
 			bootTimeFunc:         func() (uint64, error) { return 100, nil },
 			expectNetworkMetrics: true,
 			expectedStartTime:    100 * 1e9,
 		},
 		{
-			name:                 "Include Filter that matches nothing",
-			config:               Config{Include: MatchConfig{filterset.Config{MatchType: "strict"}, []string{"@*^#&*$^#)"}}},
+			name: "Include Filter that matches nothing",
+			config: Config{
+				Metrics: metadata.DefaultMetricsSettings(),
+				Include: MatchConfig{filterset.Config{MatchType: "strict"}, []string{"@*^#&*$^#)"}},
+			},
 			expectNetworkMetrics: false,
 		},
 		{
@@ -79,15 +87,28 @@ func TestScrape(t *testing.T) {
 		},
 		{
 			name:             "IOCounters Error",
+			config:           Config{Metrics: metadata.DefaultMetricsSettings()},
 			ioCountersFunc:   func(bool) ([]net.IOCountersStat, error) { return nil, errors.New("err2") },
 			expectedErr:      "err2",
 			expectedErrCount: networkMetricsLen,
 		},
 		{
 			name:             "Connections Error",
+			config:           Config{Metrics: metadata.DefaultMetricsSettings()},
 			connectionsFunc:  func(string) ([]net.ConnectionStat, error) { return nil, errors.New("err3") },
 			expectedErr:      "err3",
-			expectedErrCount: connectionsMetricsLen,
+			expectedErrCount: 1,
+		},
+		{
+			name: "Connections Error with port connections",
+			config: Config{Metrics: func() metadata.MetricsSettings {
+				ms := metadata.DefaultMetricsSettings()
+				ms.SystemNetworkPortConnections.Enabled = true
+				return ms
+			}()},
+			connectionsFunc:  func(string) ([]net.ConnectionStat, error) { return nil, errors.New("err3") },
+			expectedErr:      "err3",
+			expectedErrCount: 2,
 		},
 	}
 
@@ -139,24 +160,25 @@ func TestScrape(t *testing.T) {
 			assert.Equal(t, expectedMetricCount, md.MetricCount())
 
 			metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
-			idx := 0
+			reportedMetrics := map[string]pdata.Metric{}
+			for i := 0; i < metrics.Len(); i++ {
+				reportedMetrics[metrics.At(i).Name()] = metrics.At(i)
+			}
 			if test.expectNetworkMetrics {
-				assertNetworkIOMetricValid(t, metrics.At(idx+0), metadata.Metrics.SystemNetworkPackets.New(), test.expectedStartTime)
-				assertNetworkIOMetricValid(t, metrics.At(idx+1), metadata.Metrics.SystemNetworkDropped.New(), test.expectedStartTime)
-				assertNetworkIOMetricValid(t, metrics.At(idx+2), metadata.Metrics.SystemNetworkErrors.New(), test.expectedStartTime)
-				assertNetworkIOMetricValid(t, metrics.At(idx+3), metadata.Metrics.SystemNetworkIo.New(), test.expectedStartTime)
-				internal.AssertSameTimeStampForMetrics(t, metrics, 0, 4)
-				idx += 4
+				assertNetworkIOMetricValid(t, reportedMetrics["system.network.packets"], test.expectedStartTime)
+				assertNetworkIOMetricValid(t, reportedMetrics["system.network.dropped"], test.expectedStartTime)
+				assertNetworkIOMetricValid(t, reportedMetrics["system.network.errors"], test.expectedStartTime)
+				assertNetworkIOMetricValid(t, reportedMetrics["system.network.io"], test.expectedStartTime)
+				internal.AssertSameTimeStampForAllMetrics(t, metrics)
 			}
 
-			assertNetworkConnectionsMetricValid(t, metrics.At(idx+0))
-			internal.AssertSameTimeStampForMetrics(t, metrics, idx, idx+1)
+			assertNetworkConnectionsMetricValid(t, reportedMetrics["system.network.connections"])
 		})
 	}
 }
 
-func assertNetworkIOMetricValid(t *testing.T, metric pdata.Metric, descriptor pdata.Metric, startTime pdata.Timestamp) {
-	internal.AssertDescriptorEqual(t, descriptor, metric)
+func assertNetworkIOMetricValid(t *testing.T, metric pdata.Metric, startTime pdata.Timestamp) {
+	require.Equal(t, pdata.MetricDataTypeSum, metric.DataType())
 	if startTime != 0 {
 		internal.AssertSumMetricStartTimeEquals(t, metric, startTime)
 	}
@@ -167,8 +189,120 @@ func assertNetworkIOMetricValid(t *testing.T, metric pdata.Metric, descriptor pd
 }
 
 func assertNetworkConnectionsMetricValid(t *testing.T, metric pdata.Metric) {
-	internal.AssertDescriptorEqual(t, metadata.Metrics.SystemNetworkConnections.New(), metric)
+	require.Equal(t, pdata.MetricDataTypeSum, metric.DataType())
 	internal.AssertSumMetricHasAttributeValue(t, metric, 0, "protocol", pdata.NewAttributeValueString(metadata.AttributeProtocol.Tcp))
 	internal.AssertSumMetricHasAttribute(t, metric, 0, "state")
 	assert.Equal(t, 12, metric.Sum().DataPoints().Len())
 }
+
+func TestScrape_OptionalMetrics(t *testing.T) {
+	config := &Config{
+		Metrics:  metadata.DefaultMetricsSettings(),
+		ProcPath: filepath.Join("testdata", "proc"),
+		SysPath:  filepath.Join("testdata", "sys"),
+		Exclude:  MatchConfig{filterset.Config{MatchType: "strict"}, []string{"lo"}},
+	}
+	config.Metrics.SystemNetworkPortConnections.Enabled = true
+	config.Metrics.SystemNetworkConntrackCount.Enabled = true
+	config.Metrics.SystemNetworkConntrackMax.Enabled = true
+	config.Metrics.SystemNetworkInterfaceMtu.Enabled = true
+	config.Metrics.SystemNetworkInterfaceSpeed.Enabled = true
+	config.Metrics.SystemNetworkInterfaceUp.Enabled = true
+
+	scraper, err := newNetworkScraper(context.Background(), config)
+	require.NoError(t, err, "Failed to create network scraper: %v", err)
+	scraper.ioCounters = func(bool) ([]net.IOCountersStat, error) { return nil, nil }
+	scraper.connections = func(string) ([]net.ConnectionStat, error) {
+		return []net.ConnectionStat{
+			{Status: "LISTEN", Laddr: net.Addr{IP: "0.0.0.0", Port: 80}},
+			{Status: "LISTEN", Laddr: net.Addr{IP: "::", Port: 80}},
+			{Status: "ESTABLISHED", Laddr: net.Addr{IP: "10.0.0.1", Port: 80}, Raddr: net.Addr{IP: "10.0.0.2", Port: 50000}},
+			{Status: "TIME_WAIT", Laddr: net.Addr{IP: "10.0.0.1", Port: 80}, Raddr: net.Addr{IP: "10.0.0.3", Port: 50001}},
+			{Status: "TIME_WAIT", Laddr: net.Addr{IP: "10.0.0.1", Port: 80}, Raddr: net.Addr{IP: "10.0.0.4", Port: 50002}},
+			{Status: "LISTEN", Laddr: net.Addr{IP: "127.0.0.1", Port: 5432}},
+			{Status: "ESTABLISHED", Laddr: net.Addr{IP: "10.0.0.1", Port: 40000}, Raddr: net.Addr{IP: "10.0.0.5", Port: 443}},
+		}, nil
+	}
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err, "Failed to scrape metrics: %v", err)
+
+	metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+	assert.Equal(t, map[string]int64{
+		"port=80 protocol=tcp state=LISTEN":      2,
+		"port=80 protocol=tcp state=ESTABLISHED": 1,
+		"port=80 protocol=tcp state=TIME_WAIT":   2,
+		"port=5432 protocol=tcp state=LISTEN":    1,
+	}, dataPoints(t, metrics, "system.network.port.connections"))
+	assert.Equal(t, map[string]int64{"": 1234}, dataPoints(t, metrics, "system.network.conntrack.count"))
+	assert.Equal(t, map[string]int64{"": 262144}, dataPoints(t, metrics, "system.network.conntrack.max"))
+	assert.Equal(t, map[string]int64{
+		"device=eth0":    9000,
+		"device=docker0": 1500,
+	}, dataPoints(t, metrics, "system.network.interface.mtu"))
+	assert.Equal(t, map[string]int64{
+		"device=eth0": 10000000000,
+	}, dataPoints(t, metrics, "system.network.interface.speed"))
+	assert.Equal(t, map[string]int64{
+		"device=eth0":    1,
+		"device=docker0": 0,
+	}, dataPoints(t, metrics, "system.network.interface.up"))
+}
+
+func TestScrape_OptionalMetricsErrors(t *testing.T) {
+	config := &Config{
+		Metrics:  metadata.DefaultMetricsSettings(),
+		ProcPath: filepath.Join("testdata", "missing"),
+		SysPath:  filepath.Join("testdata", "missing"),
+	}
+	config.Metrics.SystemNetworkConntrackCount.Enabled = true
+	config.Metrics.SystemNetworkInterfaceUp.Enabled = true
+
+	scraper, err := newNetworkScraper(context.Background(), config)
+	require.NoError(t, err, "Failed to create network scraper: %v", err)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	_, err = scraper.scrape(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error reading conntrack count")
+
+	isPartial := scrapererror.IsPartialScrapeError(err)
+	assert.True(t, isPartial)
+	if isPartial {
+		assert.Equal(t, conntrackMetricsLen+interfaceMetricsLen, err.(scrapererror.PartialScrapeError).Failed)
+	}
+}
+
+// dataPoints returns the values of the data points of the metric keyed by
+// their sorted attributes.
+func dataPoints(t *testing.T, metrics pdata.MetricSlice, name string) map[string]int64 {
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		if metric.Name() != name {
+			continue
+		}
+
+		dps := pdata.NewNumberDataPointSlice()
+		switch metric.DataType() {
+		case pdata.MetricDataTypeGauge:
+			dps = metric.Gauge().DataPoints()
+		case pdata.MetricDataTypeSum:
+			dps = metric.Sum().DataPoints()
+		}
+
+		values := map[string]int64{}
+		for j := 0; j < dps.Len(); j++ {
+			var attrs []string
+			dps.At(j).Attributes().Range(func(k string, v pdata.AttributeValue) bool {
+				attrs = append(attrs, k+"="+v.StringVal())
+				return true
+			})
+			sort.Strings(attrs)
+			values[strings.Join(attrs, " ")] = dps.At(j).IntVal()
+		}
+		return values
+	}
+	t.Errorf("metric %s not found", name)
+	return nil
+}
@@ -0,0 +1,608 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// MetricSettings provides common settings for a particular metric.
+type MetricSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// MetricsSettings provides settings for nfs metrics.
+type MetricsSettings struct {
+	SystemNfsClientRPCAuthRefreshes   MetricSettings `mapstructure:"system.nfs.client.rpc.auth_refreshes"`
+	SystemNfsClientRPCCalls           MetricSettings `mapstructure:"system.nfs.client.rpc.calls"`
+	SystemNfsClientRPCRetransmissions MetricSettings `mapstructure:"system.nfs.client.rpc.retransmissions"`
+	SystemNfsExecuteTime              MetricSettings `mapstructure:"system.nfs.execute_time"`
+	SystemNfsIo                       MetricSettings `mapstructure:"system.nfs.io"`
+	SystemNfsOperations               MetricSettings `mapstructure:"system.nfs.operations"`
+	SystemNfsRetransmissions          MetricSettings `mapstructure:"system.nfs.retransmissions"`
+	SystemNfsRtt                      MetricSettings `mapstructure:"system.nfs.rtt"`
+}
+
+func DefaultMetricsSettings() MetricsSettings {
+	return MetricsSettings{
+		SystemNfsClientRPCAuthRefreshes: MetricSettings{
+			Enabled: true,
+		},
+		SystemNfsClientRPCCalls: MetricSettings{
+			Enabled: true,
+		},
+		SystemNfsClientRPCRetransmissions: MetricSettings{
+			Enabled: true,
+		},
+		SystemNfsExecuteTime: MetricSettings{
+			Enabled: true,
+		},
+		SystemNfsIo: MetricSettings{
+			Enabled: true,
+		},
+		SystemNfsOperations: MetricSettings{
+			Enabled: true,
+		},
+		SystemNfsRetransmissions: MetricSettings{
+			Enabled: true,
+		},
+		SystemNfsRtt: MetricSettings{
+			Enabled: true,
+		},
+	}
+}
+
+type metricSystemNfsClientRPCAuthRefreshes struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.nfs.client.rpc.auth_refreshes metric with initial data.
+func (m *metricSystemNfsClientRPCAuthRefreshes) init() {
+	m.data.SetName("system.nfs.client.rpc.auth_refreshes")
+	m.data.SetDescription("Number of times the NFS client refreshed its RPC credentials.")
+	m.data.SetUnit("{refreshes}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricSystemNfsClientRPCAuthRefreshes) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNfsClientRPCAuthRefreshes) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNfsClientRPCAuthRefreshes) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNfsClientRPCAuthRefreshes(settings MetricSettings) metricSystemNfsClientRPCAuthRefreshes {
+	m := metricSystemNfsClientRPCAuthRefreshes{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNfsClientRPCCalls struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.nfs.client.rpc.calls metric with initial data.
+func (m *metricSystemNfsClientRPCCalls) init() {
+	m.data.SetName("system.nfs.client.rpc.calls")
+	m.data.SetDescription("Number of RPC calls issued by the NFS client.")
+	m.data.SetUnit("{calls}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricSystemNfsClientRPCCalls) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNfsClientRPCCalls) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNfsClientRPCCalls) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNfsClientRPCCalls(settings MetricSettings) metricSystemNfsClientRPCCalls {
+	m := metricSystemNfsClientRPCCalls{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNfsClientRPCRetransmissions struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.nfs.client.rpc.retransmissions metric with initial data.
+func (m *metricSystemNfsClientRPCRetransmissions) init() {
+	m.data.SetName("system.nfs.client.rpc.retransmissions")
+	m.data.SetDescription("Number of RPC calls retransmitted by the NFS client.")
+	m.data.SetUnit("{calls}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricSystemNfsClientRPCRetransmissions) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNfsClientRPCRetransmissions) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNfsClientRPCRetransmissions) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNfsClientRPCRetransmissions(settings MetricSettings) metricSystemNfsClientRPCRetransmissions {
+	m := metricSystemNfsClientRPCRetransmissions{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNfsExecuteTime struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.nfs.execute_time metric with initial data.
+func (m *metricSystemNfsExecuteTime) init() {
+	m.data.SetName("system.nfs.execute_time")
+	m.data.SetDescription("Total execution time of the NFS operations on the mount, including the time spent queued in the client.")
+	m.data.SetUnit("s")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemNfsExecuteTime) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64, mountpointAttributeValue string, serverAttributeValue string, operationAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleVal(val)
+	dp.Attributes().Insert(A.Mountpoint, pdata.NewAttributeValueString(mountpointAttributeValue))
+	dp.Attributes().Insert(A.Server, pdata.NewAttributeValueString(serverAttributeValue))
+	dp.Attributes().Insert(A.Operation, pdata.NewAttributeValueString(operationAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNfsExecuteTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNfsExecuteTime) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNfsExecuteTime(settings MetricSettings) metricSystemNfsExecuteTime {
+	m := metricSystemNfsExecuteTime{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNfsIo struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.nfs.io metric with initial data.
+func (m *metricSystemNfsIo) init() {
+	m.data.SetName("system.nfs.io")
+	m.data.SetDescription("Number of bytes read from or written to files on the mount by applications, including direct I/O.")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemNfsIo) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, mountpointAttributeValue string, serverAttributeValue string, directionAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Mountpoint, pdata.NewAttributeValueString(mountpointAttributeValue))
+	dp.Attributes().Insert(A.Server, pdata.NewAttributeValueString(serverAttributeValue))
+	dp.Attributes().Insert(A.Direction, pdata.NewAttributeValueString(directionAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNfsIo) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNfsIo) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNfsIo(settings MetricSettings) metricSystemNfsIo {
+	m := metricSystemNfsIo{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNfsOperations struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.nfs.operations metric with initial data.
+func (m *metricSystemNfsOperations) init() {
+	m.data.SetName("system.nfs.operations")
+	m.data.SetDescription("Number of NFS operations completed on the mount.")
+	m.data.SetUnit("{operations}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemNfsOperations) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, mountpointAttributeValue string, serverAttributeValue string, operationAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Mountpoint, pdata.NewAttributeValueString(mountpointAttributeValue))
+	dp.Attributes().Insert(A.Server, pdata.NewAttributeValueString(serverAttributeValue))
+	dp.Attributes().Insert(A.Operation, pdata.NewAttributeValueString(operationAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNfsOperations) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNfsOperations) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNfsOperations(settings MetricSettings) metricSystemNfsOperations {
+	m := metricSystemNfsOperations{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNfsRetransmissions struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.nfs.retransmissions metric with initial data.
+func (m *metricSystemNfsRetransmissions) init() {
+	m.data.SetName("system.nfs.retransmissions")
+	m.data.SetDescription("Number of times NFS operations on the mount were retransmitted.")
+	m.data.SetUnit("{transmissions}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemNfsRetransmissions) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, mountpointAttributeValue string, serverAttributeValue string, operationAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Mountpoint, pdata.NewAttributeValueString(mountpointAttributeValue))
+	dp.Attributes().Insert(A.Server, pdata.NewAttributeValueString(serverAttributeValue))
+	dp.Attributes().Insert(A.Operation, pdata.NewAttributeValueString(operationAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNfsRetransmissions) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNfsRetransmissions) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNfsRetransmissions(settings MetricSettings) metricSystemNfsRetransmissions {
+	m := metricSystemNfsRetransmissions{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNfsRtt struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.nfs.rtt metric with initial data.
+func (m *metricSystemNfsRtt) init() {
+	m.data.SetName("system.nfs.rtt")
+	m.data.SetDescription("Total round trip time of the NFS operations on the mount, from sending the request to receiving the reply.")
+	m.data.SetUnit("s")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemNfsRtt) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64, mountpointAttributeValue string, serverAttributeValue string, operationAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleVal(val)
+	dp.Attributes().Insert(A.Mountpoint, pdata.NewAttributeValueString(mountpointAttributeValue))
+	dp.Attributes().Insert(A.Server, pdata.NewAttributeValueString(serverAttributeValue))
+	dp.Attributes().Insert(A.Operation, pdata.NewAttributeValueString(operationAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNfsRtt) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNfsRtt) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNfsRtt(settings MetricSettings) metricSystemNfsRtt {
+	m := metricSystemNfsRtt{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+// MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
+// required to produce metric representation defined in metadata and user settings.
+type MetricsBuilder struct {
+	startTime                               pdata.Timestamp
+	metricSystemNfsClientRPCAuthRefreshes   metricSystemNfsClientRPCAuthRefreshes
+	metricSystemNfsClientRPCCalls           metricSystemNfsClientRPCCalls
+	metricSystemNfsClientRPCRetransmissions metricSystemNfsClientRPCRetransmissions
+	metricSystemNfsExecuteTime              metricSystemNfsExecuteTime
+	metricSystemNfsIo                       metricSystemNfsIo
+	metricSystemNfsOperations               metricSystemNfsOperations
+	metricSystemNfsRetransmissions          metricSystemNfsRetransmissions
+	metricSystemNfsRtt                      metricSystemNfsRtt
+}
+
+// metricBuilderOption applies changes to default metrics builder.
+type metricBuilderOption func(*MetricsBuilder)
+
+// WithStartTime sets startTime on the metrics builder.
+func WithStartTime(startTime pdata.Timestamp) metricBuilderOption {
+	return func(mb *MetricsBuilder) {
+		mb.startTime = startTime
+	}
+}
+
+func NewMetricsBuilder(settings MetricsSettings, options ...metricBuilderOption) *MetricsBuilder {
+	mb := &MetricsBuilder{
+		startTime:                               pdata.NewTimestampFromTime(time.Now()),
+		metricSystemNfsClientRPCAuthRefreshes:   newMetricSystemNfsClientRPCAuthRefreshes(settings.SystemNfsClientRPCAuthRefreshes),
+		metricSystemNfsClientRPCCalls:           newMetricSystemNfsClientRPCCalls(settings.SystemNfsClientRPCCalls),
+		metricSystemNfsClientRPCRetransmissions: newMetricSystemNfsClientRPCRetransmissions(settings.SystemNfsClientRPCRetransmissions),
+		metricSystemNfsExecuteTime:              newMetricSystemNfsExecuteTime(settings.SystemNfsExecuteTime),
+		metricSystemNfsIo:                       newMetricSystemNfsIo(settings.SystemNfsIo),
+		metricSystemNfsOperations:               newMetricSystemNfsOperations(settings.SystemNfsOperations),
+		metricSystemNfsRetransmissions:          newMetricSystemNfsRetransmissions(settings.SystemNfsRetransmissions),
+		metricSystemNfsRtt:                      newMetricSystemNfsRtt(settings.SystemNfsRtt),
+	}
+	for _, op := range options {
+		op(mb)
+	}
+	return mb
+}
+
+// Emit appends generated metrics to a pdata.MetricsSlice and updates the internal state to be ready for recording
+// another set of data points. This function will be doing all transformations required to produce metric representation
+// defined in metadata and user settings, e.g. delta/cumulative translation.
+func (mb *MetricsBuilder) Emit(metrics pdata.MetricSlice) {
+	mb.metricSystemNfsClientRPCAuthRefreshes.emit(metrics)
+	mb.metricSystemNfsClientRPCCalls.emit(metrics)
+	mb.metricSystemNfsClientRPCRetransmissions.emit(metrics)
+	mb.metricSystemNfsExecuteTime.emit(metrics)
+	mb.metricSystemNfsIo.emit(metrics)
+	mb.metricSystemNfsOperations.emit(metrics)
+	mb.metricSystemNfsRetransmissions.emit(metrics)
+	mb.metricSystemNfsRtt.emit(metrics)
+}
+
+// RecordSystemNfsClientRPCAuthRefreshesDataPoint adds a data point to system.nfs.client.rpc.auth_refreshes metric.
+func (mb *MetricsBuilder) RecordSystemNfsClientRPCAuthRefreshesDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricSystemNfsClientRPCAuthRefreshes.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSystemNfsClientRPCCallsDataPoint adds a data point to system.nfs.client.rpc.calls metric.
+func (mb *MetricsBuilder) RecordSystemNfsClientRPCCallsDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricSystemNfsClientRPCCalls.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSystemNfsClientRPCRetransmissionsDataPoint adds a data point to system.nfs.client.rpc.retransmissions metric.
+func (mb *MetricsBuilder) RecordSystemNfsClientRPCRetransmissionsDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricSystemNfsClientRPCRetransmissions.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSystemNfsExecuteTimeDataPoint adds a data point to system.nfs.execute_time metric.
+func (mb *MetricsBuilder) RecordSystemNfsExecuteTimeDataPoint(ts pdata.Timestamp, val float64, mountpointAttributeValue string, serverAttributeValue string, operationAttributeValue string) {
+	mb.metricSystemNfsExecuteTime.recordDataPoint(mb.startTime, ts, val, mountpointAttributeValue, serverAttributeValue, operationAttributeValue)
+}
+
+// RecordSystemNfsIoDataPoint adds a data point to system.nfs.io metric.
+func (mb *MetricsBuilder) RecordSystemNfsIoDataPoint(ts pdata.Timestamp, val int64, mountpointAttributeValue string, serverAttributeValue string, directionAttributeValue string) {
+	mb.metricSystemNfsIo.recordDataPoint(mb.startTime, ts, val, mountpointAttributeValue, serverAttributeValue, directionAttributeValue)
+}
+
+// RecordSystemNfsOperationsDataPoint adds a data point to system.nfs.operations metric.
+func (mb *MetricsBuilder) RecordSystemNfsOperationsDataPoint(ts pdata.Timestamp, val int64, mountpointAttributeValue string, serverAttributeValue string, operationAttributeValue string) {
+	mb.metricSystemNfsOperations.recordDataPoint(mb.startTime, ts, val, mountpointAttributeValue, serverAttributeValue, operationAttributeValue)
+}
+
+// RecordSystemNfsRetransmissionsDataPoint adds a data point to system.nfs.retransmissions metric.
+func (mb *MetricsBuilder) RecordSystemNfsRetransmissionsDataPoint(ts pdata.Timestamp, val int64, mountpointAttributeValue string, serverAttributeValue string, operationAttributeValue string) {
+	mb.metricSystemNfsRetransmissions.recordDataPoint(mb.startTime, ts, val, mountpointAttributeValue, serverAttributeValue, operationAttributeValue)
+}
+
+// RecordSystemNfsRttDataPoint adds a data point to system.nfs.rtt metric.
+func (mb *MetricsBuilder) RecordSystemNfsRttDataPoint(ts pdata.Timestamp, val float64, mountpointAttributeValue string, serverAttributeValue string, operationAttributeValue string) {
+	mb.metricSystemNfsRtt.recordDataPoint(mb.startTime, ts, val, mountpointAttributeValue, serverAttributeValue, operationAttributeValue)
+}
+
+// Attributes contains the possible metric attributes that can be used.
+var Attributes = struct {
+	// Direction (Direction of the transfer (read or write).)
+	Direction string
+	// Mountpoint (Mountpoint path.)
+	Mountpoint string
+	// Operation (NFS operation, e.g. READ, WRITE or GETATTR.)
+	Operation string
+	// Server (Hostname or address of the NFS server.)
+	Server string
+}{
+	"direction",
+	"mountpoint",
+	"operation",
+	"server",
+}
+
+// A is an alias for Attributes.
+var A = Attributes
+
+// AttributeDirection are the possible values that the attribute "direction" can have.
+var AttributeDirection = struct {
+	Read  string
+	Write string
+}{
+	"read",
+	"write",
+}
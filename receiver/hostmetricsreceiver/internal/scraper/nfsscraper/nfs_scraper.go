@@ -0,0 +1,305 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nfsscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/nfsscraper"
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/host"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/nfsscraper/internal/metadata"
+)
+
+const (
+	mountMetricsLen = 5
+	rpcMetricsLen   = 3
+
+	// perOpFieldsLen is the number of per-op counters read from the
+	// mountstats; kernels since 5.x append an errors counter.
+	perOpFieldsLen = 8
+)
+
+// mountPointUnescaper reverts the octal escaping the kernel applies to mount points.
+var mountPointUnescaper = strings.NewReplacer(`\040`, " ", `\011`, "\t", `\012`, "\n", `\134`, `\`)
+
+// scraper for NFS client Metrics
+type scraper struct {
+	config    *Config
+	mb        *metadata.MetricsBuilder
+	includeFS filterset.FilterSet
+	excludeFS filterset.FilterSet
+
+	// for mocking
+	bootTime func() (uint64, error)
+}
+
+// nfsMount holds the statistics of a NFS mount read from the mountstats.
+type nfsMount struct {
+	mountPoint   string
+	server       string
+	readBytes    int64
+	writtenBytes int64
+	operations   []nfsOperation
+}
+
+// nfsOperation holds the per-op statistics of a NFS mount.
+type nfsOperation struct {
+	name            string
+	ops             int64
+	transmissions   int64
+	rttMillis       int64
+	executionMillis int64
+}
+
+// newNFSScraper creates a NFS Scraper
+func newNFSScraper(_ context.Context, cfg *Config) (*scraper, error) {
+	scraper := &scraper{config: cfg, bootTime: host.BootTime}
+
+	var err error
+
+	if len(cfg.Include.MountPoints) > 0 {
+		scraper.includeFS, err = filterset.CreateFilterSet(cfg.Include.MountPoints, &cfg.Include.Config)
+		if err != nil {
+			return nil, fmt.Errorf("error creating mount point include filters: %w", err)
+		}
+	}
+
+	if len(cfg.Exclude.MountPoints) > 0 {
+		scraper.excludeFS, err = filterset.CreateFilterSet(cfg.Exclude.MountPoints, &cfg.Exclude.Config)
+		if err != nil {
+			return nil, fmt.Errorf("error creating mount point exclude filters: %w", err)
+		}
+	}
+
+	return scraper, nil
+}
+
+func (s *scraper) start(context.Context, component.Host) error {
+	bootTime, err := s.bootTime()
+	if err != nil {
+		return err
+	}
+
+	s.mb = metadata.NewMetricsBuilder(s.config.Metrics, metadata.WithStartTime(pdata.Timestamp(bootTime*1e9)))
+	return nil
+}
+
+func (s *scraper) scrape(_ context.Context) (pdata.Metrics, error) {
+	md := pdata.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty().Metrics()
+
+	now := pdata.NewTimestampFromTime(time.Now())
+
+	var errs scrapererror.ScrapeErrors
+	if err := s.recordMountMetrics(now); err != nil {
+		errs.AddPartial(mountMetricsLen, fmt.Errorf("error reading mountstats: %w", err))
+	}
+
+	if err := s.recordRPCMetrics(now); err != nil {
+		errs.AddPartial(rpcMetricsLen, fmt.Errorf("error reading NFS client RPC stats: %w", err))
+	}
+
+	s.mb.Emit(metrics)
+	return md, errs.Combine()
+}
+
+func (s *scraper) recordMountMetrics(now pdata.Timestamp) error {
+	content, err := os.ReadFile(filepath.Join(s.config.RootPath, "self", "mountstats"))
+	if err != nil {
+		return err
+	}
+
+	mounts, err := parseMountStats(content)
+	if err != nil {
+		return err
+	}
+
+	for _, mount := range mounts {
+		if !s.includeMountPoint(mount.mountPoint) {
+			continue
+		}
+
+		s.mb.RecordSystemNfsIoDataPoint(now, mount.readBytes, mount.mountPoint, mount.server, metadata.AttributeDirection.Read)
+		s.mb.RecordSystemNfsIoDataPoint(now, mount.writtenBytes, mount.mountPoint, mount.server, metadata.AttributeDirection.Write)
+
+		for _, op := range mount.operations {
+			// Most of the operations are never issued by a client, skip them
+			// to keep the number of time series down.
+			if op.ops == 0 && op.transmissions == 0 {
+				continue
+			}
+
+			retransmissions := op.transmissions - op.ops
+			if retransmissions < 0 {
+				retransmissions = 0
+			}
+
+			s.mb.RecordSystemNfsOperationsDataPoint(now, op.ops, mount.mountPoint, mount.server, op.name)
+			s.mb.RecordSystemNfsRetransmissionsDataPoint(now, retransmissions, mount.mountPoint, mount.server, op.name)
+			s.mb.RecordSystemNfsRttDataPoint(now, float64(op.rttMillis)/1e3, mount.mountPoint, mount.server, op.name)
+			s.mb.RecordSystemNfsExecuteTimeDataPoint(now, float64(op.executionMillis)/1e3, mount.mountPoint, mount.server, op.name)
+		}
+	}
+	return nil
+}
+
+func (s *scraper) includeMountPoint(mountPoint string) bool {
+	return (s.includeFS == nil || s.includeFS.Matches(mountPoint)) &&
+		(s.excludeFS == nil || !s.excludeFS.Matches(mountPoint))
+}
+
+// parseMountStats returns the NFS mounts of the content of /proc/self/mountstats, e.g.
+//
+//	device nfs.example.com:/export mounted on /mnt/data with fstype nfs4 statvers=1.1
+//		bytes:	1048576 2097152 0 0 1048576 2097152 256 512
+//		per-op statistics
+//		        READ: 256 258 0 34816 1083392 12 1540 1620 0
+func parseMountStats(content []byte) ([]*nfsMount, error) {
+	var mounts []*nfsMount
+	var mount *nfsMount
+	var perOp bool
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		if fields[0] == "device" {
+			mount, perOp = nil, false
+			// device <device> mounted on <mount point> with fstype <type> [statvers=<version>]
+			if len(fields) < 8 || fields[2] != "mounted" || fields[3] != "on" || fields[5] != "with" || fields[6] != "fstype" {
+				return nil, fmt.Errorf("invalid device line: %q", scanner.Text())
+			}
+			if fstype := fields[7]; fstype != "nfs" && fstype != "nfs4" {
+				continue
+			}
+			mount = &nfsMount{
+				mountPoint: mountPointUnescaper.Replace(fields[4]),
+				server:     nfsServer(fields[1]),
+			}
+			mounts = append(mounts, mount)
+			continue
+		}
+
+		if mount == nil {
+			continue
+		}
+
+		switch {
+		case fields[0] == "bytes:":
+			// normalreadbytes normalwritebytes directreadbytes directwritebytes serverreadbytes serverwritebytes readpages writepages
+			values, err := parseCounters(fields[1:], 4)
+			if err != nil {
+				return nil, fmt.Errorf("invalid bytes of mount %q: %w", mount.mountPoint, err)
+			}
+			mount.readBytes = values[0] + values[2]
+			mount.writtenBytes = values[1] + values[3]
+		case fields[0] == "per-op":
+			perOp = true
+		case perOp && strings.HasSuffix(fields[0], ":"):
+			// ops transmissions timeouts bytes_sent bytes_received queue_ms rtt_ms execute_ms [errors]
+			values, err := parseCounters(fields[1:], perOpFieldsLen)
+			if err != nil {
+				return nil, fmt.Errorf("invalid per-op statistics of mount %q: %w", mount.mountPoint, err)
+			}
+			mount.operations = append(mount.operations, nfsOperation{
+				name:            strings.TrimSuffix(fields[0], ":"),
+				ops:             values[0],
+				transmissions:   values[1],
+				rttMillis:       values[6],
+				executionMillis: values[7],
+			})
+		}
+	}
+	return mounts, scanner.Err()
+}
+
+// nfsServer returns the server part of a NFS device, e.g. "nfs.example.com" for
+// "nfs.example.com:/export" or "fd00::1" for "[fd00::1]:/export".
+func nfsServer(device string) string {
+	server := device
+	if i := strings.LastIndex(device, ":/"); i >= 0 {
+		server = device[:i]
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(server, "["), "]")
+}
+
+// parseCounters parses at least minLen counters.
+func parseCounters(fields []string, minLen int) ([]int64, error) {
+	if len(fields) < minLen {
+		return nil, fmt.Errorf("expected at least %d values, got %d", minLen, len(fields))
+	}
+
+	values := make([]int64, len(fields))
+	for i, field := range fields {
+		v, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+// recordRPCMetrics records the rpc line of /proc/net/rpc/nfs, e.g.
+//
+//	rpc 1218785755 374636 1218815394
+//
+// The file only exists when the NFS client module is loaded.
+func (s *scraper) recordRPCMetrics(now pdata.Timestamp) error {
+	content, err := os.ReadFile(filepath.Join(s.config.RootPath, "net", "rpc", "nfs"))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || fields[0] != "rpc" {
+			continue
+		}
+
+		// calls retransmissions auth_refreshes
+		values, err := parseCounters(fields[1:], 3)
+		if err != nil {
+			return fmt.Errorf("invalid rpc statistics: %w", err)
+		}
+		s.mb.RecordSystemNfsClientRPCCallsDataPoint(now, values[0])
+		s.mb.RecordSystemNfsClientRPCRetransmissionsDataPoint(now, values[1])
+		s.mb.RecordSystemNfsClientRPCAuthRefreshesDataPoint(now, values[2])
+		return nil
+	}
+	if err = scanner.Err(); err != nil {
+		return err
+	}
+	return fmt.Errorf("rpc statistics not found")
+}
@@ -0,0 +1,280 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nfsscraper
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/nfsscraper/internal/metadata"
+)
+
+var fixtureRoot = filepath.Join("testdata", "proc")
+
+func TestScrape(t *testing.T) {
+	config := &Config{Metrics: metadata.DefaultMetricsSettings(), RootPath: fixtureRoot}
+	scraper, err := newNFSScraper(context.Background(), config)
+	require.NoError(t, err, "Failed to create NFS scraper: %v", err)
+	scraper.bootTime = func() (uint64, error) { return 100, nil }
+
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize NFS scraper: %v", err)
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err, "Failed to scrape metrics: %v", err)
+
+	metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+	assert.Equal(t, mountMetricsLen+rpcMetricsLen, metrics.Len())
+	for i := 0; i < metrics.Len(); i++ {
+		dps := metrics.At(i).Sum().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			assert.Equal(t, pdata.Timestamp(100*1e9), dps.At(j).StartTimestamp())
+		}
+	}
+
+	assert.Equal(t, map[string]float64{
+		"direction=read mountpoint=/mnt/data server=nfs.example.com":  1052672,
+		"direction=write mountpoint=/mnt/data server=nfs.example.com": 2105344,
+		"direction=read mountpoint=/mnt/back ups server=10.0.0.5":     65536,
+		"direction=write mountpoint=/mnt/back ups server=10.0.0.5":    0,
+	}, dataPoints(t, metrics, "system.nfs.io"))
+	assert.Equal(t, map[string]float64{
+		"mountpoint=/mnt/data operation=READ server=nfs.example.com":    256,
+		"mountpoint=/mnt/data operation=WRITE server=nfs.example.com":   512,
+		"mountpoint=/mnt/data operation=COMMIT server=nfs.example.com":  4,
+		"mountpoint=/mnt/data operation=GETATTR server=nfs.example.com": 1000,
+		"mountpoint=/mnt/back ups operation=NULL server=10.0.0.5":       1,
+		"mountpoint=/mnt/back ups operation=GETATTR server=10.0.0.5":    20,
+		"mountpoint=/mnt/back ups operation=READ server=10.0.0.5":       16,
+	}, dataPoints(t, metrics, "system.nfs.operations"))
+	assert.Equal(t, map[string]float64{
+		"mountpoint=/mnt/data operation=READ server=nfs.example.com":    2,
+		"mountpoint=/mnt/data operation=WRITE server=nfs.example.com":   0,
+		"mountpoint=/mnt/data operation=COMMIT server=nfs.example.com":  0,
+		"mountpoint=/mnt/data operation=GETATTR server=nfs.example.com": 5,
+		"mountpoint=/mnt/back ups operation=NULL server=10.0.0.5":       0,
+		"mountpoint=/mnt/back ups operation=GETATTR server=10.0.0.5":    0,
+		"mountpoint=/mnt/back ups operation=READ server=10.0.0.5":       0,
+	}, dataPoints(t, metrics, "system.nfs.retransmissions"))
+	assert.Equal(t, map[string]float64{
+		"mountpoint=/mnt/data operation=READ server=nfs.example.com":    1.54,
+		"mountpoint=/mnt/data operation=WRITE server=nfs.example.com":   4.096,
+		"mountpoint=/mnt/data operation=COMMIT server=nfs.example.com":  0.008,
+		"mountpoint=/mnt/data operation=GETATTR server=nfs.example.com": 0.75,
+		"mountpoint=/mnt/back ups operation=NULL server=10.0.0.5":       0.001,
+		"mountpoint=/mnt/back ups operation=GETATTR server=10.0.0.5":    0.01,
+		"mountpoint=/mnt/back ups operation=READ server=10.0.0.5":       0.04,
+	}, dataPoints(t, metrics, "system.nfs.rtt"))
+	assert.Equal(t, map[string]float64{
+		"mountpoint=/mnt/data operation=READ server=nfs.example.com":    1.62,
+		"mountpoint=/mnt/data operation=WRITE server=nfs.example.com":   4.25,
+		"mountpoint=/mnt/data operation=COMMIT server=nfs.example.com":  0.009,
+		"mountpoint=/mnt/data operation=GETATTR server=nfs.example.com": 0.9,
+		"mountpoint=/mnt/back ups operation=NULL server=10.0.0.5":       0.001,
+		"mountpoint=/mnt/back ups operation=GETATTR server=10.0.0.5":    0.012,
+		"mountpoint=/mnt/back ups operation=READ server=10.0.0.5":       0.045,
+	}, dataPoints(t, metrics, "system.nfs.execute_time"))
+
+	assert.Equal(t, map[string]float64{"": 1797}, dataPoints(t, metrics, "system.nfs.client.rpc.calls"))
+	assert.Equal(t, map[string]float64{"": 5}, dataPoints(t, metrics, "system.nfs.client.rpc.retransmissions"))
+	assert.Equal(t, map[string]float64{"": 2}, dataPoints(t, metrics, "system.nfs.client.rpc.auth_refreshes"))
+}
+
+func TestScrape_Filters(t *testing.T) {
+	type testCase struct {
+		name                string
+		include             MatchConfig
+		exclude             MatchConfig
+		expectedMountPoints []string
+	}
+
+	testCases := []testCase{
+		{
+			name:                "Include strict",
+			include:             MatchConfig{filterset.Config{MatchType: "strict"}, []string{"/mnt/data"}},
+			expectedMountPoints: []string{"/mnt/data"},
+		},
+		{
+			name:                "Exclude regexp",
+			exclude:             MatchConfig{filterset.Config{MatchType: "regexp"}, []string{`^/mnt/d`}},
+			expectedMountPoints: []string{"/mnt/back ups"},
+		},
+		{
+			name:                "Include and exclude",
+			include:             MatchConfig{filterset.Config{MatchType: "regexp"}, []string{`^/mnt/`}},
+			exclude:             MatchConfig{filterset.Config{MatchType: "strict"}, []string{"/mnt/back ups"}},
+			expectedMountPoints: []string{"/mnt/data"},
+		},
+		{
+			name:    "Include Filter that matches nothing",
+			include: MatchConfig{filterset.Config{MatchType: "strict"}, []string{"@*^#&*$^#)"}},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			config := &Config{
+				Metrics:  metadata.DefaultMetricsSettings(),
+				RootPath: fixtureRoot,
+				Include:  test.include,
+				Exclude:  test.exclude,
+			}
+			scraper, err := newNFSScraper(context.Background(), config)
+			require.NoError(t, err, "Failed to create NFS scraper: %v", err)
+			require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+			md, err := scraper.scrape(context.Background())
+			require.NoError(t, err, "Failed to scrape metrics: %v", err)
+
+			mountPoints := map[string]bool{}
+			metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+			for i := 0; i < metrics.Len(); i++ {
+				dps := metrics.At(i).Sum().DataPoints()
+				for j := 0; j < dps.Len(); j++ {
+					// The client RPC metrics are not per mount.
+					if mountPoint, ok := dps.At(j).Attributes().Get(metadata.A.Mountpoint); ok {
+						mountPoints[mountPoint.StringVal()] = true
+					}
+				}
+			}
+			var actual []string
+			for mountPoint := range mountPoints {
+				actual = append(actual, mountPoint)
+			}
+			sort.Strings(actual)
+			assert.Equal(t, test.expectedMountPoints, actual)
+		})
+	}
+}
+
+func TestScrape_NoNFS(t *testing.T) {
+	config := &Config{Metrics: metadata.DefaultMetricsSettings(), RootPath: filepath.Join("testdata", "nonfs")}
+	scraper, err := newNFSScraper(context.Background(), config)
+	require.NoError(t, err, "Failed to create NFS scraper: %v", err)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err, "Failed to scrape metrics: %v", err)
+	assert.Equal(t, 0, md.MetricCount())
+}
+
+func TestScrape_Errors(t *testing.T) {
+	type testCase struct {
+		name              string
+		rootPath          string
+		bootTimeFunc      func() (uint64, error)
+		initializationErr string
+		expectedErr       string
+		expectedFailed    int
+	}
+
+	testCases := []testCase{
+		{
+			name:              "Boot Time Error",
+			rootPath:          fixtureRoot,
+			bootTimeFunc:      func() (uint64, error) { return 0, errors.New("err1") },
+			initializationErr: "err1",
+		},
+		{
+			name:           "Invalid file content",
+			rootPath:       filepath.Join("testdata", "invalid"),
+			expectedErr:    `error reading mountstats: invalid per-op statistics of mount "/mnt/data"`,
+			expectedFailed: mountMetricsLen + rpcMetricsLen,
+		},
+		{
+			name:           "Missing mountstats",
+			rootPath:       filepath.Join("testdata", "missing"),
+			expectedErr:    "error reading mountstats",
+			expectedFailed: mountMetricsLen,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			config := &Config{Metrics: metadata.DefaultMetricsSettings(), RootPath: test.rootPath}
+			scraper, err := newNFSScraper(context.Background(), config)
+			require.NoError(t, err, "Failed to create NFS scraper: %v", err)
+
+			if test.bootTimeFunc != nil {
+				scraper.bootTime = test.bootTimeFunc
+			}
+
+			err = scraper.start(context.Background(), componenttest.NewNopHost())
+			if test.initializationErr != "" {
+				assert.EqualError(t, err, test.initializationErr)
+				return
+			}
+			require.NoError(t, err, "Failed to initialize NFS scraper: %v", err)
+
+			_, err = scraper.scrape(context.Background())
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+
+			isPartial := scrapererror.IsPartialScrapeError(err)
+			assert.True(t, isPartial)
+			if isPartial {
+				assert.Equal(t, test.expectedFailed, err.(scrapererror.PartialScrapeError).Failed)
+			}
+		})
+	}
+}
+
+func TestNFSServer(t *testing.T) {
+	assert.Equal(t, "nfs.example.com", nfsServer("nfs.example.com:/export"))
+	assert.Equal(t, "fd00::1", nfsServer("[fd00::1]:/export"))
+	assert.Equal(t, "10.0.0.5", nfsServer("10.0.0.5:/"))
+}
+
+// dataPoints returns the values of the data points of the metric keyed by
+// their sorted attributes.
+func dataPoints(t *testing.T, metrics pdata.MetricSlice, name string) map[string]float64 {
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		if metric.Name() != name {
+			continue
+		}
+
+		values := map[string]float64{}
+		dps := metric.Sum().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			dp := dps.At(j)
+			var attrs []string
+			dp.Attributes().Range(func(k string, v pdata.AttributeValue) bool {
+				attrs = append(attrs, k+"="+v.StringVal())
+				return true
+			})
+			sort.Strings(attrs)
+			value := dp.DoubleVal()
+			if dp.Type() == pdata.MetricValueTypeInt {
+				value = float64(dp.IntVal())
+			}
+			values[strings.Join(attrs, " ")] = value
+		}
+		return values
+	}
+	t.Errorf("metric %s not found", name)
+	return nil
+}
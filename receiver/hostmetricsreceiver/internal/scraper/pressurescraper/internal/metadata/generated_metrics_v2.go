@@ -0,0 +1,516 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// MetricSettings provides common settings for a particular metric.
+type MetricSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// MetricsSettings provides settings for pressure metrics.
+type MetricsSettings struct {
+	SystemPressureStallRatio   MetricSettings `mapstructure:"system.pressure.stall_ratio"`
+	SystemPressureStallTime    MetricSettings `mapstructure:"system.pressure.stall_time"`
+	SystemVmstatOomKills       MetricSettings `mapstructure:"system.vmstat.oom_kills"`
+	SystemVmstatPagesReclaimed MetricSettings `mapstructure:"system.vmstat.pages.reclaimed"`
+	SystemVmstatPagesScanned   MetricSettings `mapstructure:"system.vmstat.pages.scanned"`
+	SystemVmstatSwap           MetricSettings `mapstructure:"system.vmstat.swap"`
+}
+
+func DefaultMetricsSettings() MetricsSettings {
+	return MetricsSettings{
+		SystemPressureStallRatio: MetricSettings{
+			Enabled: true,
+		},
+		SystemPressureStallTime: MetricSettings{
+			Enabled: true,
+		},
+		SystemVmstatOomKills: MetricSettings{
+			Enabled: true,
+		},
+		SystemVmstatPagesReclaimed: MetricSettings{
+			Enabled: true,
+		},
+		SystemVmstatPagesScanned: MetricSettings{
+			Enabled: true,
+		},
+		SystemVmstatSwap: MetricSettings{
+			Enabled: true,
+		},
+	}
+}
+
+type metricSystemPressureStallRatio struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.pressure.stall_ratio metric with initial data.
+func (m *metricSystemPressureStallRatio) init() {
+	m.data.SetName("system.pressure.stall_ratio")
+	m.data.SetDescription("Fraction of time in which tasks were stalled on the resource, as a moving average over the window.")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemPressureStallRatio) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64, pressureResourceAttributeValue string, stallTypeAttributeValue string, windowAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleVal(val)
+	dp.Attributes().Insert(A.PressureResource, pdata.NewAttributeValueString(pressureResourceAttributeValue))
+	dp.Attributes().Insert(A.StallType, pdata.NewAttributeValueString(stallTypeAttributeValue))
+	dp.Attributes().Insert(A.Window, pdata.NewAttributeValueString(windowAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemPressureStallRatio) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemPressureStallRatio) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemPressureStallRatio(settings MetricSettings) metricSystemPressureStallRatio {
+	m := metricSystemPressureStallRatio{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemPressureStallTime struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.pressure.stall_time metric with initial data.
+func (m *metricSystemPressureStallTime) init() {
+	m.data.SetName("system.pressure.stall_time")
+	m.data.SetDescription("Total time in which tasks were stalled on the resource.")
+	m.data.SetUnit("s")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemPressureStallTime) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64, pressureResourceAttributeValue string, stallTypeAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleVal(val)
+	dp.Attributes().Insert(A.PressureResource, pdata.NewAttributeValueString(pressureResourceAttributeValue))
+	dp.Attributes().Insert(A.StallType, pdata.NewAttributeValueString(stallTypeAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemPressureStallTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemPressureStallTime) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemPressureStallTime(settings MetricSettings) metricSystemPressureStallTime {
+	m := metricSystemPressureStallTime{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemVmstatOomKills struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.vmstat.oom_kills metric with initial data.
+func (m *metricSystemVmstatOomKills) init() {
+	m.data.SetName("system.vmstat.oom_kills")
+	m.data.SetDescription("Number of processes killed by the out of memory killer.")
+	m.data.SetUnit("{processes}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricSystemVmstatOomKills) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemVmstatOomKills) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemVmstatOomKills) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemVmstatOomKills(settings MetricSettings) metricSystemVmstatOomKills {
+	m := metricSystemVmstatOomKills{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemVmstatPagesReclaimed struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.vmstat.pages.reclaimed metric with initial data.
+func (m *metricSystemVmstatPagesReclaimed) init() {
+	m.data.SetName("system.vmstat.pages.reclaimed")
+	m.data.SetDescription("Number of pages reclaimed.")
+	m.data.SetUnit("{pages}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemVmstatPagesReclaimed) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, reclaimerAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Reclaimer, pdata.NewAttributeValueString(reclaimerAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemVmstatPagesReclaimed) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemVmstatPagesReclaimed) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemVmstatPagesReclaimed(settings MetricSettings) metricSystemVmstatPagesReclaimed {
+	m := metricSystemVmstatPagesReclaimed{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemVmstatPagesScanned struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.vmstat.pages.scanned metric with initial data.
+func (m *metricSystemVmstatPagesScanned) init() {
+	m.data.SetName("system.vmstat.pages.scanned")
+	m.data.SetDescription("Number of pages scanned for reclaim.")
+	m.data.SetUnit("{pages}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemVmstatPagesScanned) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, reclaimerAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Reclaimer, pdata.NewAttributeValueString(reclaimerAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemVmstatPagesScanned) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemVmstatPagesScanned) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemVmstatPagesScanned(settings MetricSettings) metricSystemVmstatPagesScanned {
+	m := metricSystemVmstatPagesScanned{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemVmstatSwap struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.vmstat.swap metric with initial data.
+func (m *metricSystemVmstatSwap) init() {
+	m.data.SetName("system.vmstat.swap")
+	m.data.SetDescription("Number of pages swapped in or out.")
+	m.data.SetUnit("{pages}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemVmstatSwap) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, directionAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Direction, pdata.NewAttributeValueString(directionAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemVmstatSwap) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemVmstatSwap) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemVmstatSwap(settings MetricSettings) metricSystemVmstatSwap {
+	m := metricSystemVmstatSwap{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+// MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
+// required to produce metric representation defined in metadata and user settings.
+type MetricsBuilder struct {
+	startTime                        pdata.Timestamp
+	metricSystemPressureStallRatio   metricSystemPressureStallRatio
+	metricSystemPressureStallTime    metricSystemPressureStallTime
+	metricSystemVmstatOomKills       metricSystemVmstatOomKills
+	metricSystemVmstatPagesReclaimed metricSystemVmstatPagesReclaimed
+	metricSystemVmstatPagesScanned   metricSystemVmstatPagesScanned
+	metricSystemVmstatSwap           metricSystemVmstatSwap
+}
+
+// metricBuilderOption applies changes to default metrics builder.
+type metricBuilderOption func(*MetricsBuilder)
+
+// WithStartTime sets startTime on the metrics builder.
+func WithStartTime(startTime pdata.Timestamp) metricBuilderOption {
+	return func(mb *MetricsBuilder) {
+		mb.startTime = startTime
+	}
+}
+
+func NewMetricsBuilder(settings MetricsSettings, options ...metricBuilderOption) *MetricsBuilder {
+	mb := &MetricsBuilder{
+		startTime:                        pdata.NewTimestampFromTime(time.Now()),
+		metricSystemPressureStallRatio:   newMetricSystemPressureStallRatio(settings.SystemPressureStallRatio),
+		metricSystemPressureStallTime:    newMetricSystemPressureStallTime(settings.SystemPressureStallTime),
+		metricSystemVmstatOomKills:       newMetricSystemVmstatOomKills(settings.SystemVmstatOomKills),
+		metricSystemVmstatPagesReclaimed: newMetricSystemVmstatPagesReclaimed(settings.SystemVmstatPagesReclaimed),
+		metricSystemVmstatPagesScanned:   newMetricSystemVmstatPagesScanned(settings.SystemVmstatPagesScanned),
+		metricSystemVmstatSwap:           newMetricSystemVmstatSwap(settings.SystemVmstatSwap),
+	}
+	for _, op := range options {
+		op(mb)
+	}
+	return mb
+}
+
+// Emit appends generated metrics to a pdata.MetricsSlice and updates the internal state to be ready for recording
+// another set of data points. This function will be doing all transformations required to produce metric representation
+// defined in metadata and user settings, e.g. delta/cumulative translation.
+func (mb *MetricsBuilder) Emit(metrics pdata.MetricSlice) {
+	mb.metricSystemPressureStallRatio.emit(metrics)
+	mb.metricSystemPressureStallTime.emit(metrics)
+	mb.metricSystemVmstatOomKills.emit(metrics)
+	mb.metricSystemVmstatPagesReclaimed.emit(metrics)
+	mb.metricSystemVmstatPagesScanned.emit(metrics)
+	mb.metricSystemVmstatSwap.emit(metrics)
+}
+
+// RecordSystemPressureStallRatioDataPoint adds a data point to system.pressure.stall_ratio metric.
+func (mb *MetricsBuilder) RecordSystemPressureStallRatioDataPoint(ts pdata.Timestamp, val float64, pressureResourceAttributeValue string, stallTypeAttributeValue string, windowAttributeValue string) {
+	mb.metricSystemPressureStallRatio.recordDataPoint(mb.startTime, ts, val, pressureResourceAttributeValue, stallTypeAttributeValue, windowAttributeValue)
+}
+
+// RecordSystemPressureStallTimeDataPoint adds a data point to system.pressure.stall_time metric.
+func (mb *MetricsBuilder) RecordSystemPressureStallTimeDataPoint(ts pdata.Timestamp, val float64, pressureResourceAttributeValue string, stallTypeAttributeValue string) {
+	mb.metricSystemPressureStallTime.recordDataPoint(mb.startTime, ts, val, pressureResourceAttributeValue, stallTypeAttributeValue)
+}
+
+// RecordSystemVmstatOomKillsDataPoint adds a data point to system.vmstat.oom_kills metric.
+func (mb *MetricsBuilder) RecordSystemVmstatOomKillsDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricSystemVmstatOomKills.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSystemVmstatPagesReclaimedDataPoint adds a data point to system.vmstat.pages.reclaimed metric.
+func (mb *MetricsBuilder) RecordSystemVmstatPagesReclaimedDataPoint(ts pdata.Timestamp, val int64, reclaimerAttributeValue string) {
+	mb.metricSystemVmstatPagesReclaimed.recordDataPoint(mb.startTime, ts, val, reclaimerAttributeValue)
+}
+
+// RecordSystemVmstatPagesScannedDataPoint adds a data point to system.vmstat.pages.scanned metric.
+func (mb *MetricsBuilder) RecordSystemVmstatPagesScannedDataPoint(ts pdata.Timestamp, val int64, reclaimerAttributeValue string) {
+	mb.metricSystemVmstatPagesScanned.recordDataPoint(mb.startTime, ts, val, reclaimerAttributeValue)
+}
+
+// RecordSystemVmstatSwapDataPoint adds a data point to system.vmstat.swap metric.
+func (mb *MetricsBuilder) RecordSystemVmstatSwapDataPoint(ts pdata.Timestamp, val int64, directionAttributeValue string) {
+	mb.metricSystemVmstatSwap.recordDataPoint(mb.startTime, ts, val, directionAttributeValue)
+}
+
+// Attributes contains the possible metric attributes that can be used.
+var Attributes = struct {
+	// Direction (Direction of the swap operation (in or out).)
+	Direction string
+	// PressureResource (Resource under pressure.)
+	PressureResource string
+	// Reclaimer (Whether pages were reclaimed by the background kswapd daemon or directly by the allocating task.)
+	Reclaimer string
+	// StallType (Whether some or all non-idle tasks were stalled.)
+	StallType string
+	// Window (Moving average over the last 10, 60 or 300 seconds.)
+	Window string
+}{
+	"direction",
+	"resource",
+	"reclaimer",
+	"type",
+	"window",
+}
+
+// A is an alias for Attributes.
+var A = Attributes
+
+// AttributeDirection are the possible values that the attribute "direction" can have.
+var AttributeDirection = struct {
+	In  string
+	Out string
+}{
+	"in",
+	"out",
+}
+
+// AttributePressureResource are the possible values that the attribute "pressure_resource" can have.
+var AttributePressureResource = struct {
+	Cpu    string
+	Memory string
+	Io     string
+}{
+	"cpu",
+	"memory",
+	"io",
+}
+
+// AttributeReclaimer are the possible values that the attribute "reclaimer" can have.
+var AttributeReclaimer = struct {
+	Kswapd string
+	Direct string
+}{
+	"kswapd",
+	"direct",
+}
+
+// AttributeStallType are the possible values that the attribute "stall_type" can have.
+var AttributeStallType = struct {
+	Some string
+	Full string
+}{
+	"some",
+	"full",
+}
+
+// AttributeWindow are the possible values that the attribute "window" can have.
+var AttributeWindow = struct {
+	Avg10  string
+	Avg60  string
+	Avg300 string
+}{
+	"avg10",
+	"avg60",
+	"avg300",
+}
@@ -0,0 +1,235 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pressurescraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper"
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/host"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper/internal/metadata"
+)
+
+const (
+	pressureMetricsLen = 2
+	vmstatMetricsLen   = 4
+)
+
+var (
+	pressureResources = []string{
+		metadata.AttributePressureResource.Cpu,
+		metadata.AttributePressureResource.Memory,
+		metadata.AttributePressureResource.Io,
+	}
+
+	pressureWindows = []string{
+		metadata.AttributeWindow.Avg10,
+		metadata.AttributeWindow.Avg60,
+		metadata.AttributeWindow.Avg300,
+	}
+)
+
+// scraper for pressure stall information and vmstat Metrics
+type scraper struct {
+	logger   *zap.Logger
+	config   *Config
+	rootPath string
+	mb       *metadata.MetricsBuilder
+
+	// pressureSupported tells whether the kernel exposes pressure stall information.
+	pressureSupported bool
+
+	// for mocking
+	bootTime func() (uint64, error)
+}
+
+// newPressureScraper creates a pressure Scraper
+func newPressureScraper(_ context.Context, logger *zap.Logger, cfg *Config) *scraper {
+	rootPath := cfg.RootPath
+	if rootPath == "" {
+		rootPath = hostProc()
+	}
+	return &scraper{logger: logger, config: cfg, rootPath: rootPath, bootTime: host.BootTime}
+}
+
+// hostProc returns the mount point of the proc filesystem, honoring HOST_PROC like gopsutil.
+func hostProc() string {
+	if p := os.Getenv("HOST_PROC"); p != "" {
+		return p
+	}
+	return "/proc"
+}
+
+func (s *scraper) start(context.Context, component.Host) error {
+	bootTime, err := s.bootTime()
+	if err != nil {
+		return err
+	}
+
+	// Kernels older than 4.20 or built without CONFIG_PSI have no /proc/pressure: rather
+	// than failing every scrape, the pressure metrics are reported as unsupported once.
+	s.pressureSupported = true
+	if s.config.Metrics.SystemPressureStallRatio.Enabled || s.config.Metrics.SystemPressureStallTime.Enabled {
+		if _, err = os.Stat(filepath.Join(s.rootPath, "pressure")); os.IsNotExist(err) {
+			s.logger.Warn("Pressure stall information is not supported by the kernel, the system.pressure metrics will not be reported",
+				zap.String("path", filepath.Join(s.rootPath, "pressure")))
+			s.pressureSupported = false
+		}
+	}
+
+	s.mb = metadata.NewMetricsBuilder(s.config.Metrics, metadata.WithStartTime(pdata.Timestamp(bootTime*1e9)))
+	return nil
+}
+
+func (s *scraper) scrape(_ context.Context) (pdata.Metrics, error) {
+	md := pdata.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty().Metrics()
+
+	now := pdata.NewTimestampFromTime(time.Now())
+
+	var errs scrapererror.ScrapeErrors
+	if s.pressureSupported && (s.config.Metrics.SystemPressureStallRatio.Enabled || s.config.Metrics.SystemPressureStallTime.Enabled) {
+		for _, resource := range pressureResources {
+			if err := s.recordPressureMetrics(now, resource); err != nil {
+				errs.AddPartial(pressureMetricsLen, fmt.Errorf("error reading %s pressure: %w", resource, err))
+			}
+		}
+	}
+
+	if err := s.recordVMStatMetrics(now); err != nil {
+		errs.AddPartial(vmstatMetricsLen, fmt.Errorf("error reading vmstat: %w", err))
+	}
+
+	s.mb.Emit(metrics)
+	return md, errs.Combine()
+}
+
+// recordPressureMetrics records the content of /proc/pressure/<resource>, e.g.
+//
+//	some avg10=0.00 avg60=0.12 avg300=0.05 total=1234567
+//	full avg10=0.00 avg60=0.00 avg300=0.00 total=123456
+func (s *scraper) recordPressureMetrics(now pdata.Timestamp, resource string) error {
+	content, err := os.ReadFile(filepath.Join(s.rootPath, "pressure", resource))
+	if err != nil {
+		return err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		stallType := fields[0]
+		if stallType != metadata.AttributeStallType.Some && stallType != metadata.AttributeStallType.Full {
+			continue
+		}
+
+		values := map[string]string{}
+		for _, field := range fields[1:] {
+			if kv := strings.SplitN(field, "=", 2); len(kv) == 2 {
+				values[kv[0]] = kv[1]
+			}
+		}
+
+		for _, window := range pressureWindows {
+			v, ok := values[window]
+			if !ok {
+				continue
+			}
+			percent, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return fmt.Errorf("invalid %s value: %w", window, err)
+			}
+			// The averages are percentages of the window.
+			s.mb.RecordSystemPressureStallRatioDataPoint(now, percent/100, resource, stallType, window)
+		}
+
+		if v, ok := values["total"]; ok {
+			total, err := strconv.ParseUint(v, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid total value: %w", err)
+			}
+			s.mb.RecordSystemPressureStallTimeDataPoint(now, float64(total)/1e6, resource, stallType)
+		}
+	}
+	return scanner.Err()
+}
+
+// recordVMStatMetrics records counters of /proc/vmstat. Counters missing from
+// the file, e.g. on older kernels, are not reported.
+func (s *scraper) recordVMStatMetrics(now pdata.Timestamp) error {
+	vmstat, err := readVMStat(filepath.Join(s.rootPath, "vmstat"))
+	if err != nil {
+		return err
+	}
+
+	if v, ok := vmstat["oom_kill"]; ok {
+		s.mb.RecordSystemVmstatOomKillsDataPoint(now, v)
+	}
+	if v, ok := vmstat["pswpin"]; ok {
+		s.mb.RecordSystemVmstatSwapDataPoint(now, v, metadata.AttributeDirection.In)
+	}
+	if v, ok := vmstat["pswpout"]; ok {
+		s.mb.RecordSystemVmstatSwapDataPoint(now, v, metadata.AttributeDirection.Out)
+	}
+	if v, ok := vmstat["pgscan_kswapd"]; ok {
+		s.mb.RecordSystemVmstatPagesScannedDataPoint(now, v, metadata.AttributeReclaimer.Kswapd)
+	}
+	if v, ok := vmstat["pgscan_direct"]; ok {
+		s.mb.RecordSystemVmstatPagesScannedDataPoint(now, v, metadata.AttributeReclaimer.Direct)
+	}
+	if v, ok := vmstat["pgsteal_kswapd"]; ok {
+		s.mb.RecordSystemVmstatPagesReclaimedDataPoint(now, v, metadata.AttributeReclaimer.Kswapd)
+	}
+	if v, ok := vmstat["pgsteal_direct"]; ok {
+		s.mb.RecordSystemVmstatPagesReclaimedDataPoint(now, v, metadata.AttributeReclaimer.Direct)
+	}
+	return nil
+}
+
+func readVMStat(path string) (map[string]int64, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	vmstat := map[string]int64{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		v, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %q: %w", fields[0], err)
+		}
+		vmstat[fields[0]] = v
+	}
+	return vmstat, scanner.Err()
+}
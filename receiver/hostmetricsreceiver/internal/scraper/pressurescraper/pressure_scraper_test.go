@@ -0,0 +1,222 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pressurescraper
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper/internal/metadata"
+)
+
+func TestScrape(t *testing.T) {
+	config := &Config{Metrics: metadata.DefaultMetricsSettings(), RootPath: filepath.Join("testdata", "proc")}
+	scraper := newPressureScraper(context.Background(), zap.NewNop(), config)
+	scraper.bootTime = func() (uint64, error) { return 100, nil }
+
+	err := scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize pressure scraper: %v", err)
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err, "Failed to scrape metrics: %v", err)
+
+	metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+	assert.Equal(t, pressureMetricsLen+vmstatMetricsLen, metrics.Len())
+
+	ratios := dataPoints(t, metrics, "system.pressure.stall_ratio")
+	assert.Len(t, ratios, 18)
+	assert.InDelta(t, 0.015, ratios["resource=cpu type=some window=avg10"], 1e-9)
+	assert.InDelta(t, 0.0425, ratios["resource=memory type=full window=avg60"], 1e-9)
+	assert.InDelta(t, 0.003, ratios["resource=io type=some window=avg300"], 1e-9)
+
+	assert.Equal(t, map[string]float64{
+		"resource=cpu type=some":    2.5,
+		"resource=cpu type=full":    0,
+		"resource=memory type=some": 45,
+		"resource=memory type=full": 20,
+		"resource=io type=some":     1,
+		"resource=io type=full":     0.5,
+	}, dataPoints(t, metrics, "system.pressure.stall_time"))
+
+	assert.Equal(t, map[string]float64{"": 3}, dataPoints(t, metrics, "system.vmstat.oom_kills"))
+	assert.Equal(t, map[string]float64{
+		"direction=in":  100,
+		"direction=out": 250,
+	}, dataPoints(t, metrics, "system.vmstat.swap"))
+	assert.Equal(t, map[string]float64{
+		"reclaimer=kswapd": 8000,
+		"reclaimer=direct": 600,
+	}, dataPoints(t, metrics, "system.vmstat.pages.scanned"))
+	assert.Equal(t, map[string]float64{
+		"reclaimer=kswapd": 5000,
+		"reclaimer=direct": 300,
+	}, dataPoints(t, metrics, "system.vmstat.pages.reclaimed"))
+
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		if metric.DataType() != pdata.MetricDataTypeSum {
+			continue
+		}
+		dps := metric.Sum().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			assert.Equal(t, pdata.Timestamp(100*1e9), dps.At(j).StartTimestamp())
+		}
+	}
+}
+
+func TestScrape_PressureDisabled(t *testing.T) {
+	config := &Config{Metrics: metadata.DefaultMetricsSettings(), RootPath: filepath.Join("testdata", "nopsi")}
+	config.Metrics.SystemPressureStallRatio.Enabled = false
+	config.Metrics.SystemPressureStallTime.Enabled = false
+	scraper := newPressureScraper(context.Background(), zap.NewNop(), config)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+	require.Equal(t, 1, metrics.Len())
+	assert.Equal(t, "system.vmstat.swap", metrics.At(0).Name())
+}
+
+func TestScrape_PressureUnsupported(t *testing.T) {
+	config := &Config{Metrics: metadata.DefaultMetricsSettings(), RootPath: filepath.Join("testdata", "nopsi")}
+	scraper := newPressureScraper(context.Background(), zap.NewNop(), config)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	// The missing pressure stall information is not reported as a scrape error.
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+	require.Equal(t, 1, metrics.Len())
+	assert.Equal(t, "system.vmstat.swap", metrics.At(0).Name())
+}
+
+func TestScrape_HostProc(t *testing.T) {
+	t.Setenv("HOST_PROC", filepath.Join("testdata", "proc"))
+	config := &Config{Metrics: metadata.DefaultMetricsSettings()}
+	scraper := newPressureScraper(context.Background(), zap.NewNop(), config)
+	scraper.bootTime = func() (uint64, error) { return 100, nil }
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, pressureMetricsLen+vmstatMetricsLen, md.MetricCount())
+}
+
+func TestScrape_Errors(t *testing.T) {
+	type testCase struct {
+		name              string
+		rootPath          string
+		bootTimeFunc      func() (uint64, error)
+		initializationErr string
+		expectedErr       []string
+		expectedFailed    int
+	}
+
+	testCases := []testCase{
+		{
+			name:              "Boot Time Error",
+			rootPath:          filepath.Join("testdata", "proc"),
+			bootTimeFunc:      func() (uint64, error) { return 0, errors.New("err1") },
+			initializationErr: "err1",
+		},
+		{
+			name:           "Invalid values",
+			rootPath:       filepath.Join("testdata", "invalid"),
+			expectedErr:    []string{"error reading memory pressure: invalid avg10 value", `error reading vmstat: invalid value for "pswpin"`},
+			expectedFailed: pressureMetricsLen + vmstatMetricsLen,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			config := &Config{Metrics: metadata.DefaultMetricsSettings(), RootPath: test.rootPath}
+			scraper := newPressureScraper(context.Background(), zap.NewNop(), config)
+			if test.bootTimeFunc != nil {
+				scraper.bootTime = test.bootTimeFunc
+			}
+
+			err := scraper.start(context.Background(), componenttest.NewNopHost())
+			if test.initializationErr != "" {
+				assert.EqualError(t, err, test.initializationErr)
+				return
+			}
+			require.NoError(t, err, "Failed to initialize pressure scraper: %v", err)
+
+			_, err = scraper.scrape(context.Background())
+			require.Error(t, err)
+			for _, expected := range test.expectedErr {
+				assert.Contains(t, err.Error(), expected)
+			}
+
+			isPartial := scrapererror.IsPartialScrapeError(err)
+			assert.True(t, isPartial)
+			if isPartial {
+				assert.Equal(t, test.expectedFailed, err.(scrapererror.PartialScrapeError).Failed)
+			}
+		})
+	}
+}
+
+// dataPoints returns the values of the data points of the metric keyed by
+// their sorted attributes.
+func dataPoints(t *testing.T, metrics pdata.MetricSlice, name string) map[string]float64 {
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		if metric.Name() != name {
+			continue
+		}
+
+		dps := pdata.NewNumberDataPointSlice()
+		switch metric.DataType() {
+		case pdata.MetricDataTypeGauge:
+			dps = metric.Gauge().DataPoints()
+		case pdata.MetricDataTypeSum:
+			dps = metric.Sum().DataPoints()
+		}
+
+		values := map[string]float64{}
+		for j := 0; j < dps.Len(); j++ {
+			dp := dps.At(j)
+			var attrs []string
+			dp.Attributes().Range(func(k string, v pdata.AttributeValue) bool {
+				attrs = append(attrs, k+"="+v.StringVal())
+				return true
+			})
+			sort.Strings(attrs)
+			value := dp.DoubleVal()
+			if dp.Type() == pdata.MetricValueTypeInt {
+				value = float64(dp.IntVal())
+			}
+			values[strings.Join(attrs, " ")] = value
+		}
+		return values
+	}
+	t.Errorf("metric %s not found", name)
+	return nil
+}
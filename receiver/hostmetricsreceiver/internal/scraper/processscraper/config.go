@@ -17,21 +17,44 @@ package processscraper // import "github.com/open-telemetry/opentelemetry-collec
 import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processscraper/internal/metadata"
 )
 
 // Config relating to Process Metric Scraper.
 type Config struct {
 	internal.ConfigSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
 
-	// Include specifies a filter on the process names that should be included from the generated metrics.
-	// Exclude specifies a filter on the process names that should be excluded from the generated metrics.
+	// Metrics allows to customize scraped metrics representation.
+	Metrics metadata.MetricsSettings `mapstructure:"metrics"`
+
+	// ResourceAttributes allows to enable optional resource attributes.
+	ResourceAttributes ResourceAttributesSettings `mapstructure:"resource_attributes"`
+
+	// Include specifies a filter on the processes that should be included from the generated metrics.
+	// Exclude specifies a filter on the processes that should be excluded from the generated metrics.
 	// If neither `include` or `exclude` are set, process metrics will be generated for all processes.
 	Include MatchConfig `mapstructure:"include"`
 	Exclude MatchConfig `mapstructure:"exclude"`
 }
 
+// MatchConfig matches processes by name, executable path, command line and owner.
+// A process matches when it matches all the properties that are set.
 type MatchConfig struct {
 	filterset.Config `mapstructure:",squash"`
 
-	Names []string `mapstructure:"names"`
+	Names        []string `mapstructure:"names"`
+	Executables  []string `mapstructure:"executables"`
+	CommandLines []string `mapstructure:"command_lines"`
+	Owners       []string `mapstructure:"owners"`
+}
+
+// ResourceAttributesSettings provides settings for the optional resource attributes.
+type ResourceAttributesSettings struct {
+	// ProcessCgroup is the path of the process in the cgroup hierarchy. Only available on Linux.
+	ProcessCgroup ResourceAttributeSettings `mapstructure:"process.cgroup"`
+}
+
+// ResourceAttributeSettings provides common settings for a particular resource attribute.
+type ResourceAttributeSettings struct {
+	Enabled bool `mapstructure:"enabled"`
 }
@@ -27,11 +27,16 @@ import (
 // with the process handle, and provides a function to
 // initialize a pdata.Resource with the metadata
 
+// attributeProcessCgroup is the optional resource attribute holding the
+// path of the process in the cgroup hierarchy.
+const attributeProcessCgroup = "process.cgroup"
+
 type processMetadata struct {
 	pid        int32
 	executable *executableMetadata
 	command    *commandMetadata
 	username   string
+	cgroup     string
 	handle     processHandle
 }
 
@@ -46,9 +51,21 @@ type commandMetadata struct {
 	commandLineSlice []string
 }
 
+// commandLineString returns the full command line of the process, or an
+// empty string if it is not known.
+func (c *commandMetadata) commandLineString() string {
+	if c == nil {
+		return ""
+	}
+	if c.commandLineSlice != nil {
+		return strings.Join(c.commandLineSlice, " ")
+	}
+	return c.commandLine
+}
+
 func (m *processMetadata) initializeResource(resource pdata.Resource) {
 	attr := resource.Attributes()
-	attr.EnsureCapacity(6)
+	attr.EnsureCapacity(7)
 	attr.InsertInt(conventions.AttributeProcessPID, int64(m.pid))
 	attr.InsertString(conventions.AttributeProcessExecutableName, m.executable.name)
 	attr.InsertString(conventions.AttributeProcessExecutablePath, m.executable.path)
@@ -65,6 +82,9 @@ func (m *processMetadata) initializeResource(resource pdata.Resource) {
 	if m.username != "" {
 		attr.InsertString(conventions.AttributeProcessOwner, m.username)
 	}
+	if m.cgroup != "" {
+		attr.InsertString(attributeProcessCgroup, m.cgroup)
+	}
 }
 
 // processHandles provides a wrapper around []*process.Process
@@ -85,6 +105,11 @@ type processHandle interface {
 	Times() (*cpu.TimesStat, error)
 	MemoryInfo() (*process.MemoryInfoStat, error)
 	IOCounters() (*process.IOCountersStat, error)
+	NumFDs() (int32, error)
+	NumThreads() (int32, error)
+	NumCtxSwitches() (*process.NumCtxSwitchesStat, error)
+	PageFaults() (*process.PageFaultsStat, error)
+	CreateTime() (int64, error)
 }
 
 type gopsProcessHandles struct {
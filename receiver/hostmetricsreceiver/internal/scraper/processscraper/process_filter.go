@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processscraper"
+
+import (
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+)
+
+// processFilter matches processes against a MatchConfig. A process matches
+// when it matches all the filters that are configured.
+type processFilter struct {
+	names        filterset.FilterSet
+	executables  filterset.FilterSet
+	commandLines filterset.FilterSet
+	owners       filterset.FilterSet
+}
+
+// newProcessFilter creates a processFilter from cfg. It returns nil if no
+// filter is configured.
+func newProcessFilter(cfg *MatchConfig) (*processFilter, error) {
+	if len(cfg.Names) == 0 && len(cfg.Executables) == 0 && len(cfg.CommandLines) == 0 && len(cfg.Owners) == 0 {
+		return nil, nil
+	}
+
+	var (
+		f   processFilter
+		err error
+	)
+	if f.names, err = createFilterSet(cfg.Names, &cfg.Config); err != nil {
+		return nil, err
+	}
+	if f.executables, err = createFilterSet(cfg.Executables, &cfg.Config); err != nil {
+		return nil, err
+	}
+	if f.commandLines, err = createFilterSet(cfg.CommandLines, &cfg.Config); err != nil {
+		return nil, err
+	}
+	if f.owners, err = createFilterSet(cfg.Owners, &cfg.Config); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+func createFilterSet(items []string, cfg *filterset.Config) (filterset.FilterSet, error) {
+	if len(items) == 0 {
+		return nil, nil
+	}
+	return filterset.CreateFilterSet(items, cfg)
+}
+
+// matchesExecutable returns whether the executable matches the name and
+// executable filters.
+func (f *processFilter) matchesExecutable(executable *executableMetadata) bool {
+	return matches(f.names, executable.name) && matches(f.executables, executable.path)
+}
+
+// matchesCommandAndOwner returns whether the command line and owner of the
+// process match the command line and owner filters.
+func (f *processFilter) matchesCommandAndOwner(command *commandMetadata, owner string) bool {
+	return matches(f.commandLines, command.commandLineString()) && matches(f.owners, owner)
+}
+
+// needsCommandOrOwner returns whether the filter can only be evaluated once
+// the command line or owner of the process are known.
+func (f *processFilter) needsCommandOrOwner() bool {
+	return f.commandLines != nil || f.owners != nil
+}
+
+func matches(fs filterset.FilterSet, value string) bool {
+	return fs == nil || fs.Matches(value)
+}
@@ -19,56 +19,62 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/shirou/gopsutil/v3/cpu"
 	"github.com/shirou/gopsutil/v3/host"
-	"github.com/shirou/gopsutil/v3/process"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/model/pdata"
 	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processscraper/internal/metadata"
 )
 
 const (
-	cpuMetricsLen    = 1
-	memoryMetricsLen = 2
-	diskMetricsLen   = 1
-
-	metricsLen = cpuMetricsLen + memoryMetricsLen + diskMetricsLen
+	cpuMetricsLen            = 1
+	memoryMetricsLen         = 2
+	diskMetricsLen           = 1
+	fileDescriptorMetricsLen = 1
+	threadMetricsLen         = 1
+	contextSwitchMetricsLen  = 1
+	pagingMetricsLen         = 1
+	startTimeMetricsLen      = 1
+
+	metricsLen = cpuMetricsLen + memoryMetricsLen + diskMetricsLen + fileDescriptorMetricsLen + threadMetricsLen +
+		contextSwitchMetricsLen + pagingMetricsLen + startTimeMetricsLen
 )
 
 // scraper for Process Metrics
 type scraper struct {
 	config    *Config
 	startTime pdata.Timestamp
-	includeFS filterset.FilterSet
-	excludeFS filterset.FilterSet
+	include   *processFilter
+	exclude   *processFilter
+	mb        *metadata.MetricsBuilder
 
 	// for mocking
 	bootTime          func() (uint64, error)
 	getProcessHandles func() (processHandles, error)
+	getProcessCgroup  func(pid int32) (string, error)
 }
 
 // newProcessScraper creates a Process Scraper
 func newProcessScraper(cfg *Config) (*scraper, error) {
-	scraper := &scraper{config: cfg, bootTime: host.BootTime, getProcessHandles: getProcessHandlesInternal}
+	scraper := &scraper{
+		config:            cfg,
+		bootTime:          host.BootTime,
+		getProcessHandles: getProcessHandlesInternal,
+		getProcessCgroup:  getProcessCgroup,
+	}
 
 	var err error
 
-	if len(cfg.Include.Names) > 0 {
-		scraper.includeFS, err = filterset.CreateFilterSet(cfg.Include.Names, &cfg.Include.Config)
-		if err != nil {
-			return nil, fmt.Errorf("error creating process include filters: %w", err)
-		}
+	scraper.include, err = newProcessFilter(&cfg.Include)
+	if err != nil {
+		return nil, fmt.Errorf("error creating process include filters: %w", err)
 	}
 
-	if len(cfg.Exclude.Names) > 0 {
-		scraper.excludeFS, err = filterset.CreateFilterSet(cfg.Exclude.Names, &cfg.Exclude.Config)
-		if err != nil {
-			return nil, fmt.Errorf("error creating process exclude filters: %w", err)
-		}
+	scraper.exclude, err = newProcessFilter(&cfg.Exclude)
+	if err != nil {
+		return nil, fmt.Errorf("error creating process exclude filters: %w", err)
 	}
 
 	return scraper, nil
@@ -81,6 +87,7 @@ func (s *scraper) start(context.Context, component.Host) error {
 	}
 
 	s.startTime = pdata.Timestamp(bootTime * 1e9)
+	s.mb = metadata.NewMetricsBuilder(s.config.Metrics, metadata.WithStartTime(s.startTime))
 	return nil
 }
 
@@ -109,17 +116,39 @@ func (s *scraper) scrape(_ context.Context) (pdata.Metrics, error) {
 
 		now := pdata.NewTimestampFromTime(time.Now())
 
-		if err = scrapeAndAppendCPUTimeMetric(metrics, s.startTime, now, md.handle); err != nil {
+		if err = s.scrapeAndAppendCPUTimeMetric(now, md.handle); err != nil {
 			errs.AddPartial(cpuMetricsLen, fmt.Errorf("error reading cpu times for process %q (pid %v): %w", md.executable.name, md.pid, err))
 		}
 
-		if err = scrapeAndAppendMemoryUsageMetrics(metrics, now, md.handle); err != nil {
+		if err = s.scrapeAndAppendMemoryUsageMetrics(now, md.handle); err != nil {
 			errs.AddPartial(memoryMetricsLen, fmt.Errorf("error reading memory info for process %q (pid %v): %w", md.executable.name, md.pid, err))
 		}
 
-		if err = scrapeAndAppendDiskIOMetric(metrics, s.startTime, now, md.handle); err != nil {
+		if err = s.scrapeAndAppendDiskIOMetric(now, md.handle); err != nil {
 			errs.AddPartial(diskMetricsLen, fmt.Errorf("error reading disk usage for process %q (pid %v): %w", md.executable.name, md.pid, err))
 		}
+
+		if err = s.scrapeAndAppendOpenFileDescriptorsMetric(now, md.handle); err != nil {
+			errs.AddPartial(fileDescriptorMetricsLen, fmt.Errorf("error reading open file descriptor count for process %q (pid %v): %w", md.executable.name, md.pid, err))
+		}
+
+		if err = s.scrapeAndAppendThreadsMetric(now, md.handle); err != nil {
+			errs.AddPartial(threadMetricsLen, fmt.Errorf("error reading thread info for process %q (pid %v): %w", md.executable.name, md.pid, err))
+		}
+
+		if err = s.scrapeAndAppendContextSwitchMetrics(now, md.handle); err != nil {
+			errs.AddPartial(contextSwitchMetricsLen, fmt.Errorf("error reading context switch counts for process %q (pid %v): %w", md.executable.name, md.pid, err))
+		}
+
+		if err = s.scrapeAndAppendPagingFaultsMetric(now, md.handle); err != nil {
+			errs.AddPartial(pagingMetricsLen, fmt.Errorf("error reading memory paging info for process %q (pid %v): %w", md.executable.name, md.pid, err))
+		}
+
+		if err = s.scrapeAndAppendStartTimeMetric(now, md.handle); err != nil {
+			errs.AddPartial(startTimeMetricsLen, fmt.Errorf("error reading create time for process %q (pid %v): %w", md.executable.name, md.pid, err))
+		}
+
+		s.mb.Emit(metrics)
 	}
 
 	return md, errs.Combine()
@@ -148,20 +177,33 @@ func (s *scraper) getProcessMetadata() ([]*processMetadata, error) {
 			continue
 		}
 
-		// filter processes by name
-		if (s.includeFS != nil && !s.includeFS.Matches(executable.name)) ||
-			(s.excludeFS != nil && s.excludeFS.Matches(executable.name)) {
+		// filter processes by name and executable path first to avoid
+		// collecting details of processes that are filtered out
+		if s.include != nil && !s.include.matchesExecutable(executable) {
+			continue
+		}
+		excluded := s.exclude != nil && s.exclude.matchesExecutable(executable)
+		if excluded && !s.exclude.needsCommandOrOwner() {
 			continue
 		}
 
-		command, err := getProcessCommand(handle)
-		if err != nil {
-			errs.AddPartial(0, fmt.Errorf("error reading command for process %q (pid %v): %w", executable.name, pid, err))
+		command, cmdErr := getProcessCommand(handle)
+		username, usernameErr := handle.Username()
+
+		// filter processes by command line and owner
+		if s.include != nil && !s.include.matchesCommandAndOwner(command, username) {
+			continue
+		}
+		if excluded && s.exclude.matchesCommandAndOwner(command, username) {
+			continue
 		}
 
-		username, err := handle.Username()
-		if err != nil {
-			errs.AddPartial(0, fmt.Errorf("error reading username for process %q (pid %v): %w", executable.name, pid, err))
+		if cmdErr != nil {
+			errs.AddPartial(0, fmt.Errorf("error reading command for process %q (pid %v): %w", executable.name, pid, cmdErr))
+		}
+
+		if usernameErr != nil {
+			errs.AddPartial(0, fmt.Errorf("error reading username for process %q (pid %v): %w", executable.name, pid, usernameErr))
 		}
 
 		md := &processMetadata{
@@ -172,72 +214,132 @@ func (s *scraper) getProcessMetadata() ([]*processMetadata, error) {
 			handle:     handle,
 		}
 
+		if s.config.ResourceAttributes.ProcessCgroup.Enabled {
+			md.cgroup, err = s.getProcessCgroup(pid)
+			if err != nil {
+				errs.AddPartial(0, fmt.Errorf("error reading cgroup for process %q (pid %v): %w", executable.name, pid, err))
+			}
+		}
+
 		metadata = append(metadata, md)
 	}
 
 	return metadata, errs.Combine()
 }
 
-func scrapeAndAppendCPUTimeMetric(metrics pdata.MetricSlice, startTime, now pdata.Timestamp, handle processHandle) error {
+func (s *scraper) scrapeAndAppendCPUTimeMetric(now pdata.Timestamp, handle processHandle) error {
+	if !s.config.Metrics.ProcessCPUTime.Enabled {
+		return nil
+	}
+
 	times, err := handle.Times()
 	if err != nil {
 		return err
 	}
 
-	initializeCPUTimeMetric(metrics.AppendEmpty(), startTime, now, times)
+	s.recordCPUTimeMetric(now, times)
 	return nil
 }
 
-func initializeCPUTimeMetric(metric pdata.Metric, startTime, now pdata.Timestamp, times *cpu.TimesStat) {
-	metadata.Metrics.ProcessCPUTime.Init(metric)
+func (s *scraper) scrapeAndAppendMemoryUsageMetrics(now pdata.Timestamp, handle processHandle) error {
+	if !s.config.Metrics.ProcessMemoryPhysicalUsage.Enabled && !s.config.Metrics.ProcessMemoryVirtualUsage.Enabled {
+		return nil
+	}
+
+	mem, err := handle.MemoryInfo()
+	if err != nil {
+		return err
+	}
 
-	ddps := metric.Sum().DataPoints()
-	ddps.EnsureCapacity(cpuStatesLen)
-	appendCPUTimeStateDataPoints(ddps, startTime, now, times)
+	s.mb.RecordProcessMemoryPhysicalUsageDataPoint(now, int64(mem.RSS))
+	s.mb.RecordProcessMemoryVirtualUsageDataPoint(now, int64(mem.VMS))
+	return nil
 }
 
-func scrapeAndAppendMemoryUsageMetrics(metrics pdata.MetricSlice, now pdata.Timestamp, handle processHandle) error {
-	mem, err := handle.MemoryInfo()
+func (s *scraper) scrapeAndAppendDiskIOMetric(now pdata.Timestamp, handle processHandle) error {
+	if !s.config.Metrics.ProcessDiskIo.Enabled {
+		return nil
+	}
+
+	io, err := handle.IOCounters()
 	if err != nil {
 		return err
 	}
 
-	initializeMemoryUsageMetric(metrics.AppendEmpty(), metadata.Metrics.ProcessMemoryPhysicalUsage, now, int64(mem.RSS))
-	initializeMemoryUsageMetric(metrics.AppendEmpty(), metadata.Metrics.ProcessMemoryVirtualUsage, now, int64(mem.VMS))
+	s.mb.RecordProcessDiskIoDataPoint(now, int64(io.ReadBytes), metadata.AttributeDirection.Read)
+	s.mb.RecordProcessDiskIoDataPoint(now, int64(io.WriteBytes), metadata.AttributeDirection.Write)
 	return nil
 }
 
-func initializeMemoryUsageMetric(metric pdata.Metric, metricIntf metadata.MetricIntf, now pdata.Timestamp, usage int64) {
-	metricIntf.Init(metric)
-	initializeMemoryUsageDataPoint(metric.Sum().DataPoints().AppendEmpty(), now, usage)
+func (s *scraper) scrapeAndAppendOpenFileDescriptorsMetric(now pdata.Timestamp, handle processHandle) error {
+	if !s.config.Metrics.ProcessOpenFileDescriptors.Enabled {
+		return nil
+	}
+
+	fds, err := handle.NumFDs()
+	if err != nil {
+		return err
+	}
+
+	s.mb.RecordProcessOpenFileDescriptorsDataPoint(now, int64(fds))
+	return nil
 }
 
-func initializeMemoryUsageDataPoint(dataPoint pdata.NumberDataPoint, now pdata.Timestamp, usage int64) {
-	dataPoint.SetTimestamp(now)
-	dataPoint.SetIntVal(usage)
+func (s *scraper) scrapeAndAppendThreadsMetric(now pdata.Timestamp, handle processHandle) error {
+	if !s.config.Metrics.ProcessThreads.Enabled {
+		return nil
+	}
+
+	threads, err := handle.NumThreads()
+	if err != nil {
+		return err
+	}
+
+	s.mb.RecordProcessThreadsDataPoint(now, int64(threads))
+	return nil
 }
 
-func scrapeAndAppendDiskIOMetric(metrics pdata.MetricSlice, startTime, now pdata.Timestamp, handle processHandle) error {
-	io, err := handle.IOCounters()
+func (s *scraper) scrapeAndAppendContextSwitchMetrics(now pdata.Timestamp, handle processHandle) error {
+	if !s.config.Metrics.ProcessContextSwitches.Enabled {
+		return nil
+	}
+
+	ctxSwitches, err := handle.NumCtxSwitches()
 	if err != nil {
 		return err
 	}
 
-	initializeDiskIOMetric(metrics.AppendEmpty(), startTime, now, io)
+	s.mb.RecordProcessContextSwitchesDataPoint(now, ctxSwitches.Involuntary, metadata.AttributeContextSwitchType.Involuntary)
+	s.mb.RecordProcessContextSwitchesDataPoint(now, ctxSwitches.Voluntary, metadata.AttributeContextSwitchType.Voluntary)
 	return nil
 }
 
-func initializeDiskIOMetric(metric pdata.Metric, startTime, now pdata.Timestamp, io *process.IOCountersStat) {
-	metadata.Metrics.ProcessDiskIo.Init(metric)
+func (s *scraper) scrapeAndAppendPagingFaultsMetric(now pdata.Timestamp, handle processHandle) error {
+	if !s.config.Metrics.ProcessPagingFaults.Enabled {
+		return nil
+	}
+
+	faults, err := handle.PageFaults()
+	if err != nil {
+		return err
+	}
 
-	idps := metric.Sum().DataPoints()
-	initializeDiskIODataPoint(idps.AppendEmpty(), startTime, now, int64(io.ReadBytes), metadata.AttributeDirection.Read)
-	initializeDiskIODataPoint(idps.AppendEmpty(), startTime, now, int64(io.WriteBytes), metadata.AttributeDirection.Write)
+	s.mb.RecordProcessPagingFaultsDataPoint(now, int64(faults.MajorFaults), metadata.AttributePagingFaultType.Major)
+	s.mb.RecordProcessPagingFaultsDataPoint(now, int64(faults.MinorFaults), metadata.AttributePagingFaultType.Minor)
+	return nil
 }
 
-func initializeDiskIODataPoint(dataPoint pdata.NumberDataPoint, startTime, now pdata.Timestamp, value int64, directionLabel string) {
-	dataPoint.Attributes().InsertString(metadata.Attributes.Direction, directionLabel)
-	dataPoint.SetStartTimestamp(startTime)
-	dataPoint.SetTimestamp(now)
-	dataPoint.SetIntVal(value)
+func (s *scraper) scrapeAndAppendStartTimeMetric(now pdata.Timestamp, handle processHandle) error {
+	if !s.config.Metrics.ProcessStartTime.Enabled {
+		return nil
+	}
+
+	// create time is reported in milliseconds since the epoch
+	createTime, err := handle.CreateTime()
+	if err != nil {
+		return err
+	}
+
+	s.mb.RecordProcessStartTimeDataPoint(now, createTime/1e3)
+	return nil
 }
@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+// +build linux
+
+package processscraper
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetProcessCgroup(t *testing.T) {
+	testCases := []struct {
+		name     string
+		contents string
+		expected string
+	}{
+		{
+			name:     "Unified Hierarchy",
+			contents: "0::/system.slice/docker.service\n",
+			expected: "/system.slice/docker.service",
+		},
+		{
+			name:     "Hybrid Hierarchy",
+			contents: "12:pids:/user.slice\n1:name=systemd:/user.slice/session-1.scope\n0::/user.slice/session-1.scope\n",
+			expected: "/user.slice/session-1.scope",
+		},
+		{
+			name:     "Legacy Hierarchy With Systemd",
+			contents: "11:cpu,cpuacct:/docker/abc\n1:name=systemd:/docker/def\n",
+			expected: "/docker/def",
+		},
+		{
+			name:     "Legacy Hierarchy",
+			contents: "11:cpu,cpuacct:/docker/abc\n10:memory:/docker/def\n",
+			expected: "/docker/abc",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			procRoot := t.TempDir()
+			require.NoError(t, os.MkdirAll(filepath.Join(procRoot, "42"), 0700))
+			require.NoError(t, ioutil.WriteFile(filepath.Join(procRoot, "42", "cgroup"), []byte(test.contents), 0600))
+			t.Setenv("HOST_PROC", procRoot)
+
+			cgroup, err := getProcessCgroup(42)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, cgroup)
+		})
+	}
+}
+
+func TestGetProcessCgroup_Error(t *testing.T) {
+	t.Setenv("HOST_PROC", t.TempDir())
+
+	_, err := getProcessCgroup(42)
+	assert.Error(t, err)
+}
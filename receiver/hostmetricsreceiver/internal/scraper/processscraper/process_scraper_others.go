@@ -22,9 +22,7 @@ import (
 	"go.opentelemetry.io/collector/model/pdata"
 )
 
-const cpuStatesLen = 0
-
-func appendCPUTimeStateDataPoints(ddps pdata.NumberDataPointSlice, startTime, now pdata.Timestamp, cpuTime *cpu.TimesStat) {
+func (s *scraper) recordCPUTimeMetric(now pdata.Timestamp, cpuTime *cpu.TimesStat) {
 }
 
 func getProcessExecutable(processHandle) (*executableMetadata, error) {
@@ -34,3 +32,7 @@ func getProcessExecutable(processHandle) (*executableMetadata, error) {
 func getProcessCommand(processHandle) (*commandMetadata, error) {
 	return nil, nil
 }
+
+func getProcessCgroup(int32) (string, error) {
+	return "", nil
+}
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/shirou/gopsutil/v3/cpu"
@@ -48,7 +49,7 @@ func TestScrape(t *testing.T) {
 	const bootTime = 100
 	const expectedStartTime = 100 * 1e9
 
-	scraper, err := newProcessScraper(&Config{})
+	scraper, err := newProcessScraper(&Config{Metrics: metadata.DefaultMetricsSettings()})
 	scraper.bootTime = func() (uint64, error) { return bootTime, nil }
 	require.NoError(t, err, "Failed to create process scraper: %v", err)
 	err = scraper.start(context.Background(), componenttest.NewNopHost())
@@ -73,8 +74,8 @@ func TestScrape(t *testing.T) {
 	assertSchemaIsSet(t, md.ResourceMetrics())
 	assertProcessResourceAttributesExist(t, md.ResourceMetrics())
 	assertCPUTimeMetricValid(t, md.ResourceMetrics(), expectedStartTime)
-	assertMemoryUsageMetricValid(t, metadata.Metrics.ProcessMemoryPhysicalUsage.New(), md.ResourceMetrics())
-	assertMemoryUsageMetricValid(t, metadata.Metrics.ProcessMemoryVirtualUsage.New(), md.ResourceMetrics())
+	assertMemoryUsageMetricValid(t, newSumDescriptor("process.memory.physical_usage", "The amount of physical memory in use.", "By"), md.ResourceMetrics())
+	assertMemoryUsageMetricValid(t, newSumDescriptor("process.memory.virtual_usage", "Virtual memory size.", "By"), md.ResourceMetrics())
 	assertDiskIOMetricValid(t, md.ResourceMetrics(), expectedStartTime)
 	assertSameTimeStampForAllMetricsWithinResource(t, md.ResourceMetrics())
 }
@@ -98,9 +99,19 @@ func assertProcessResourceAttributesExist(t *testing.T, resourceMetrics pdata.Re
 	}
 }
 
+func newSumDescriptor(name, description, unit string) pdata.Metric {
+	descriptor := pdata.NewMetric()
+	descriptor.SetName(name)
+	descriptor.SetDescription(description)
+	descriptor.SetUnit(unit)
+	descriptor.SetDataType(pdata.MetricDataTypeSum)
+	return descriptor
+}
+
 func assertCPUTimeMetricValid(t *testing.T, resourceMetrics pdata.ResourceMetricsSlice, startTime pdata.Timestamp) {
-	cpuTimeMetric := getMetric(t, metadata.Metrics.ProcessCPUTime.New(), resourceMetrics)
-	internal.AssertDescriptorEqual(t, metadata.Metrics.ProcessCPUTime.New(), cpuTimeMetric)
+	descriptor := newSumDescriptor("process.cpu.time", "Total CPU seconds broken down by different states.", "s")
+	cpuTimeMetric := getMetric(t, descriptor, resourceMetrics)
+	internal.AssertDescriptorEqual(t, descriptor, cpuTimeMetric)
 	if startTime != 0 {
 		internal.AssertSumMetricStartTimeEquals(t, cpuTimeMetric, startTime)
 	}
@@ -117,8 +128,9 @@ func assertMemoryUsageMetricValid(t *testing.T, descriptor pdata.Metric, resourc
 }
 
 func assertDiskIOMetricValid(t *testing.T, resourceMetrics pdata.ResourceMetricsSlice, startTime pdata.Timestamp) {
-	diskIOMetric := getMetric(t, metadata.Metrics.ProcessDiskIo.New(), resourceMetrics)
-	internal.AssertDescriptorEqual(t, metadata.Metrics.ProcessDiskIo.New(), diskIOMetric)
+	descriptor := newSumDescriptor("process.disk.io", "Disk bytes transferred.", "By")
+	diskIOMetric := getMetric(t, descriptor, resourceMetrics)
+	internal.AssertDescriptorEqual(t, descriptor, diskIOMetric)
 	if startTime != 0 {
 		internal.AssertSumMetricStartTimeEquals(t, diskIOMetric, startTime)
 	}
@@ -171,7 +183,7 @@ func TestScrapeMetrics_NewError(t *testing.T) {
 func TestScrapeMetrics_GetProcessesError(t *testing.T) {
 	skipTestOnUnsupportedOS(t)
 
-	scraper, err := newProcessScraper(&Config{})
+	scraper, err := newProcessScraper(&Config{Metrics: metadata.DefaultMetricsSettings()})
 	require.NoError(t, err, "Failed to create process scraper: %v", err)
 
 	scraper.getProcessHandles = func() (processHandles, error) { return nil, errors.New("err1") }
@@ -245,6 +257,31 @@ func (p *processHandleMock) IOCounters() (*process.IOCountersStat, error) {
 	return args.Get(0).(*process.IOCountersStat), args.Error(1)
 }
 
+func (p *processHandleMock) NumFDs() (int32, error) {
+	args := p.MethodCalled("NumFDs")
+	return args.Get(0).(int32), args.Error(1)
+}
+
+func (p *processHandleMock) NumThreads() (int32, error) {
+	args := p.MethodCalled("NumThreads")
+	return args.Get(0).(int32), args.Error(1)
+}
+
+func (p *processHandleMock) NumCtxSwitches() (*process.NumCtxSwitchesStat, error) {
+	args := p.MethodCalled("NumCtxSwitches")
+	return args.Get(0).(*process.NumCtxSwitchesStat), args.Error(1)
+}
+
+func (p *processHandleMock) PageFaults() (*process.PageFaultsStat, error) {
+	args := p.MethodCalled("PageFaults")
+	return args.Get(0).(*process.PageFaultsStat), args.Error(1)
+}
+
+func (p *processHandleMock) CreateTime() (int64, error) {
+	args := p.MethodCalled("CreateTime")
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func newDefaultHandleMock() *processHandleMock {
 	handleMock := &processHandleMock{}
 	handleMock.On("Username").Return("username", nil)
@@ -303,7 +340,7 @@ func TestScrapeMetrics_Filtered(t *testing.T) {
 
 	for _, test := range testCases {
 		t.Run(test.name, func(t *testing.T) {
-			config := &Config{}
+			config := &Config{Metrics: metadata.DefaultMetricsSettings()}
 
 			if len(test.include) > 0 {
 				config.Include = MatchConfig{
@@ -422,7 +459,7 @@ func TestScrapeMetrics_ProcessErrors(t *testing.T) {
 				t.Skipf("skipping test %v on %v", test.name, runtime.GOOS)
 			}
 
-			scraper, err := newProcessScraper(&Config{})
+			scraper, err := newProcessScraper(&Config{Metrics: metadata.DefaultMetricsSettings()})
 			require.NoError(t, err, "Failed to create process scraper: %v", err)
 			err = scraper.start(context.Background(), componenttest.NewNopHost())
 			require.NoError(t, err, "Failed to initialize process scraper: %v", err)
@@ -482,10 +519,271 @@ func getExpectedLengthOfReturnedMetrics(nameError, exeError, timeError, memError
 }
 
 func getExpectedScrapeFailures(nameError, exeError, timeError, memError, diskError error) int {
-	expectedResourceMetricsLen, expectedMetricsLen := getExpectedLengthOfReturnedMetrics(nameError, exeError, timeError, memError, diskError)
+	expectedResourceMetricsLen, _ := getExpectedLengthOfReturnedMetrics(nameError, exeError, timeError, memError, diskError)
 	if expectedResourceMetricsLen == 0 {
 		return 1
 	}
 
-	return metricsLen - expectedMetricsLen
+	expectedFailures := 0
+	if timeError != nil {
+		expectedFailures += cpuMetricsLen
+	}
+	if memError != nil {
+		expectedFailures += memoryMetricsLen
+	}
+	if diskError != nil {
+		expectedFailures += diskMetricsLen
+	}
+	return expectedFailures
+}
+
+func TestScrapeMetrics_FilteredByProperties(t *testing.T) {
+	skipTestOnUnsupportedOS(t)
+
+	type processInfo struct {
+		name    string
+		exe     string
+		cmdline []string
+		owner   string
+	}
+
+	processes := []processInfo{
+		{name: "java", exe: "/usr/bin/java", cmdline: []string{"/usr/bin/java", "-jar", "app.jar"}, owner: "app"},
+		{name: "java", exe: "/opt/jdk/bin/java", cmdline: []string{"/opt/jdk/bin/java", "-jar", "other.jar"}, owner: "root"},
+		{name: "nginx", exe: "/usr/sbin/nginx", cmdline: []string{"/usr/sbin/nginx", "-g", "daemon off;"}, owner: "root"},
+	}
+
+	testCases := []struct {
+		name         string
+		include      MatchConfig
+		exclude      MatchConfig
+		expectedExes []string
+	}{
+		{
+			name:         "Include Executable",
+			include:      MatchConfig{Executables: []string{"/usr/.*"}},
+			expectedExes: []string{"/usr/bin/java", "/usr/sbin/nginx"},
+		},
+		{
+			name:         "Include Command Line",
+			include:      MatchConfig{CommandLines: []string{".*app\\.jar.*"}},
+			expectedExes: []string{"/usr/bin/java"},
+		},
+		{
+			name:         "Include Name And Owner",
+			include:      MatchConfig{Names: []string{"java"}, Owners: []string{"root"}},
+			expectedExes: []string{"/opt/jdk/bin/java"},
+		},
+		{
+			name:         "Exclude Owner",
+			exclude:      MatchConfig{Owners: []string{"root"}},
+			expectedExes: []string{"/usr/bin/java"},
+		},
+		{
+			name:         "Exclude Name And Command Line",
+			exclude:      MatchConfig{Names: []string{"java"}, CommandLines: []string{".*other\\.jar.*"}},
+			expectedExes: []string{"/usr/bin/java", "/usr/sbin/nginx"},
+		},
+		{
+			name:         "Include & Exclude",
+			include:      MatchConfig{Owners: []string{"root"}},
+			exclude:      MatchConfig{Names: []string{"nginx"}},
+			expectedExes: []string{"/opt/jdk/bin/java"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			config := &Config{Metrics: metadata.DefaultMetricsSettings(), Include: test.include, Exclude: test.exclude}
+			config.Include.Config = filterset.Config{MatchType: filterset.Regexp}
+			config.Exclude.Config = filterset.Config{MatchType: filterset.Regexp}
+
+			scraper, err := newProcessScraper(config)
+			require.NoError(t, err, "Failed to create process scraper: %v", err)
+			err = scraper.start(context.Background(), componenttest.NewNopHost())
+			require.NoError(t, err, "Failed to initialize process scraper: %v", err)
+
+			handles := make([]*processHandleMock, 0, len(processes))
+			for _, p := range processes {
+				handleMock := &processHandleMock{}
+				handleMock.On("Name").Return(p.name, nil)
+				handleMock.On("Exe").Return(p.exe, nil)
+				handleMock.On("Username").Return(p.owner, nil)
+				handleMock.On("Cmdline").Return(strings.Join(p.cmdline, " "), nil)
+				handleMock.On("CmdlineSlice").Return(p.cmdline, nil)
+				handleMock.On("Times").Return(&cpu.TimesStat{}, nil)
+				handleMock.On("MemoryInfo").Return(&process.MemoryInfoStat{}, nil)
+				handleMock.On("IOCounters").Return(&process.IOCountersStat{}, nil)
+				handles = append(handles, handleMock)
+			}
+
+			scraper.getProcessHandles = func() (processHandles, error) {
+				return &processHandlesMock{handles: handles}, nil
+			}
+
+			md, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+
+			exes := make([]string, 0, md.ResourceMetrics().Len())
+			for i := 0; i < md.ResourceMetrics().Len(); i++ {
+				exe, _ := md.ResourceMetrics().At(i).Resource().Attributes().Get(conventions.AttributeProcessExecutablePath)
+				exes = append(exes, exe.StringVal())
+			}
+			assert.Equal(t, test.expectedExes, exes)
+		})
+	}
+}
+
+func TestScrapeMetrics_OptionalMetrics(t *testing.T) {
+	skipTestOnUnsupportedOS(t)
+
+	config := &Config{Metrics: metadata.MetricsSettings{
+		ProcessOpenFileDescriptors: metadata.MetricSettings{Enabled: true},
+		ProcessThreads:             metadata.MetricSettings{Enabled: true},
+		ProcessContextSwitches:     metadata.MetricSettings{Enabled: true},
+		ProcessPagingFaults:        metadata.MetricSettings{Enabled: true},
+		ProcessStartTime:           metadata.MetricSettings{Enabled: true},
+	}}
+	config.ResourceAttributes.ProcessCgroup.Enabled = true
+
+	scraper, err := newProcessScraper(config)
+	require.NoError(t, err, "Failed to create process scraper: %v", err)
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize process scraper: %v", err)
+
+	handleMock := &processHandleMock{}
+	handleMock.On("Name").Return("test", nil)
+	handleMock.On("Exe").Return("test", nil)
+	handleMock.On("Username").Return("username", nil)
+	handleMock.On("Cmdline").Return("cmdline", nil)
+	handleMock.On("CmdlineSlice").Return([]string{"cmdline"}, nil)
+	handleMock.On("NumFDs").Return(int32(12), nil)
+	handleMock.On("NumThreads").Return(int32(4), nil)
+	handleMock.On("NumCtxSwitches").Return(&process.NumCtxSwitchesStat{Voluntary: 10, Involuntary: 2}, nil)
+	handleMock.On("PageFaults").Return(&process.PageFaultsStat{MajorFaults: 3, MinorFaults: 30}, nil)
+	handleMock.On("CreateTime").Return(int64(1600000000123), nil)
+
+	scraper.getProcessHandles = func() (processHandles, error) {
+		return &processHandlesMock{handles: []*processHandleMock{handleMock}}, nil
+	}
+	scraper.getProcessCgroup = func(int32) (string, error) {
+		return "/system.slice/test.service", nil
+	}
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+	// the disabled default metrics are not scraped
+	handleMock.AssertNotCalled(t, "Times")
+	handleMock.AssertNotCalled(t, "MemoryInfo")
+	handleMock.AssertNotCalled(t, "IOCounters")
+
+	rm := md.ResourceMetrics().At(0)
+	cgroup, ok := rm.Resource().Attributes().Get(attributeProcessCgroup)
+	require.True(t, ok)
+	assert.Equal(t, "/system.slice/test.service", cgroup.StringVal())
+
+	metrics := getMetricSlice(t, rm)
+	require.Equal(t, 5, metrics.Len())
+	values := map[string][]int64{}
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		var dps pdata.NumberDataPointSlice
+		if metric.DataType() == pdata.MetricDataTypeGauge {
+			dps = metric.Gauge().DataPoints()
+		} else {
+			dps = metric.Sum().DataPoints()
+		}
+		for j := 0; j < dps.Len(); j++ {
+			values[metric.Name()] = append(values[metric.Name()], dps.At(j).IntVal())
+		}
+	}
+	assert.Equal(t, map[string][]int64{
+		"process.open_file_descriptors": {12},
+		"process.threads":               {4},
+		"process.context_switches":      {2, 10},
+		"process.paging.faults":         {3, 30},
+		"process.start_time":            {1600000000},
+	}, values)
+
+	contextSwitches := getMetric(t, newSumDescriptor("process.context_switches", "", ""), md.ResourceMetrics())
+	internal.AssertSumMetricHasAttributeValue(t, contextSwitches, 0, metadata.Attributes.ContextSwitchType, pdata.NewAttributeValueString(metadata.AttributeContextSwitchType.Involuntary))
+	internal.AssertSumMetricHasAttributeValue(t, contextSwitches, 1, metadata.Attributes.ContextSwitchType, pdata.NewAttributeValueString(metadata.AttributeContextSwitchType.Voluntary))
+}
+
+func TestScrapeMetrics_OptionalMetricsErrors(t *testing.T) {
+	skipTestOnUnsupportedOS(t)
+
+	config := &Config{Metrics: metadata.MetricsSettings{
+		ProcessOpenFileDescriptors: metadata.MetricSettings{Enabled: true},
+		ProcessPagingFaults:        metadata.MetricSettings{Enabled: true},
+	}}
+	config.ResourceAttributes.ProcessCgroup.Enabled = true
+
+	scraper, err := newProcessScraper(config)
+	require.NoError(t, err, "Failed to create process scraper: %v", err)
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize process scraper: %v", err)
+
+	handleMock := newDefaultHandleMock()
+	handleMock.On("Name").Return("test", nil)
+	handleMock.On("Exe").Return("test", nil)
+	handleMock.On("NumFDs").Return(int32(0), errors.New("err1"))
+	handleMock.On("PageFaults").Return(&process.PageFaultsStat{}, errors.New("err2"))
+
+	scraper.getProcessHandles = func() (processHandles, error) {
+		return &processHandlesMock{handles: []*processHandleMock{handleMock}}, nil
+	}
+	scraper.getProcessCgroup = func(int32) (string, error) {
+		return "", errors.New("err3")
+	}
+
+	md, err := scraper.scrape(context.Background())
+	assert.EqualError(t, err, `error reading cgroup for process "test" (pid 1): err3; `+
+		`error reading open file descriptor count for process "test" (pid 1): err1; `+
+		`error reading memory paging info for process "test" (pid 1): err2`)
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.Equal(t, fileDescriptorMetricsLen+pagingMetricsLen, err.(scrapererror.PartialScrapeError).Failed)
+	assert.Equal(t, 1, md.ResourceMetrics().Len())
+	assert.Equal(t, 0, md.MetricCount())
+}
+
+func TestScrapeMetrics_AllMetricsErrors(t *testing.T) {
+	skipTestOnUnsupportedOS(t)
+
+	config := &Config{Metrics: metadata.DefaultMetricsSettings()}
+	config.Metrics.ProcessOpenFileDescriptors.Enabled = true
+	config.Metrics.ProcessThreads.Enabled = true
+	config.Metrics.ProcessContextSwitches.Enabled = true
+	config.Metrics.ProcessPagingFaults.Enabled = true
+	config.Metrics.ProcessStartTime.Enabled = true
+
+	scraper, err := newProcessScraper(config)
+	require.NoError(t, err, "Failed to create process scraper: %v", err)
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize process scraper: %v", err)
+
+	handleMock := &processHandleMock{}
+	handleMock.On("Name").Return("test", nil)
+	handleMock.On("Exe").Return("test", nil)
+	handleMock.On("Username").Return("username", nil)
+	handleMock.On("Cmdline").Return("cmdline", nil)
+	handleMock.On("CmdlineSlice").Return([]string{"cmdline"}, nil)
+	handleMock.On("Times").Return(&cpu.TimesStat{}, errors.New("err"))
+	handleMock.On("MemoryInfo").Return(&process.MemoryInfoStat{}, errors.New("err"))
+	handleMock.On("IOCounters").Return(&process.IOCountersStat{}, errors.New("err"))
+	handleMock.On("NumFDs").Return(int32(0), errors.New("err"))
+	handleMock.On("NumThreads").Return(int32(0), errors.New("err"))
+	handleMock.On("NumCtxSwitches").Return(&process.NumCtxSwitchesStat{}, errors.New("err"))
+	handleMock.On("PageFaults").Return(&process.PageFaultsStat{}, errors.New("err"))
+	handleMock.On("CreateTime").Return(int64(0), errors.New("err"))
+
+	scraper.getProcessHandles = func() (processHandles, error) {
+		return &processHandlesMock{handles: []*processHandleMock{handleMock}}, nil
+	}
+
+	md, err := scraper.scrape(context.Background())
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.Equal(t, metricsLen, err.(scrapererror.PartialScrapeError).Failed)
+	assert.Equal(t, 0, md.MetricCount())
 }
@@ -15,13 +15,17 @@
 package k8sclusterreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver"
 
 import (
+	"fmt"
 	"time"
 
 	quotaclientset "github.com/openshift/client-go/quota/clientset/versioned"
 	"go.opentelemetry.io/collector/config"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
 	k8s "k8s.io/client-go/kubernetes"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/collection"
 )
 
 // Config defines configuration for kubernetes cluster receiver.
@@ -44,13 +48,31 @@ type Config struct {
 	// Whether OpenShift supprot should be enabled or not.
 	Distribution string `mapstructure:"distribution"`
 
+	// Custom resources to watch, with the fields of each object to report as metrics.
+	CustomResources []collection.CustomResourceConfig `mapstructure:"custom_resources"`
+
 	// For mocking.
 	makeClient               func(apiConf k8sconfig.APIConfig) (k8s.Interface, error)
 	makeOpenShiftQuotaClient func(apiConf k8sconfig.APIConfig) (quotaclientset.Interface, error)
+	makeDynamicClient        func(apiConf k8sconfig.APIConfig) (dynamic.Interface, error)
 }
 
 func (cfg *Config) Validate() error {
-	return cfg.APIConfig.Validate()
+	if err := cfg.APIConfig.Validate(); err != nil {
+		return err
+	}
+	gvks := make(map[schema.GroupVersionKind]struct{}, len(cfg.CustomResources))
+	for i, cr := range cfg.CustomResources {
+		if err := cr.Validate(); err != nil {
+			return fmt.Errorf("invalid custom_resources[%d]: %w", i, err)
+		}
+		gvk := cr.GroupVersionKind()
+		if _, ok := gvks[gvk]; ok {
+			return fmt.Errorf("invalid custom_resources[%d]: duplicate %s", i, gvk)
+		}
+		gvks[gvk] = struct{}{}
+	}
+	return nil
 }
 
 func (cfg *Config) getK8sClient() (k8s.Interface, error) {
@@ -66,3 +88,10 @@ func (cfg *Config) getOpenShiftQuotaClient() (quotaclientset.Interface, error) {
 	}
 	return cfg.makeOpenShiftQuotaClient(cfg.APIConfig)
 }
+
+func (cfg *Config) getDynamicClient() (dynamic.Interface, error) {
+	if cfg.makeDynamicClient == nil {
+		cfg.makeDynamicClient = k8sconfig.MakeDynamicClient
+	}
+	return cfg.makeDynamicClient(cfg.APIConfig)
+}
@@ -26,6 +26,7 @@ import (
 	"go.opentelemetry.io/collector/config/configtest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/collection"
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -40,7 +41,7 @@ func TestLoadConfig(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, cfg)
 
-	assert.Equal(t, len(cfg.Receivers), 3)
+	assert.Equal(t, len(cfg.Receivers), 4)
 
 	r1 := cfg.Receivers[config.NewComponentID(typeStr)]
 	assert.Equal(t, r1, factory.CreateDefaultConfig())
@@ -70,4 +71,58 @@ func TestLoadConfig(t *testing.T) {
 				AuthType: k8sconfig.AuthTypeServiceAccount,
 			},
 		})
+
+	r4 := cfg.Receivers[config.NewComponentIDWithName(typeStr, "custom_resources")].(*Config)
+	assert.Equal(t, r4,
+		&Config{
+			ReceiverSettings:           config.NewReceiverSettings(config.NewComponentIDWithName(typeStr, "custom_resources")),
+			Distribution:               distributionKubernetes,
+			CollectionInterval:         10 * time.Second,
+			NodeConditionTypesToReport: []string{"Ready"},
+			APIConfig: k8sconfig.APIConfig{
+				AuthType: k8sconfig.AuthTypeServiceAccount,
+			},
+			CustomResources: []collection.CustomResourceConfig{
+				{
+					Group:    "argoproj.io",
+					Version:  "v1alpha1",
+					Kind:     "Rollout",
+					Resource: "rollouts",
+					LabelsFromPath: map[string]string{
+						"app": "{.metadata.labels.app}",
+					},
+					Metrics: []collection.CustomResourceMetricConfig{
+						{
+							Name:        "argo.rollout.available_replicas",
+							Description: "Number of available replicas of the rollout",
+							Path:        "{.status.availableReplicas}",
+						},
+						{
+							Name: "argo.rollout.healthy",
+							Path: "{.status.phase}",
+							ValueMapping: map[string]float64{
+								"Healthy":  1,
+								"Degraded": 0,
+							},
+						},
+					},
+				},
+			},
+		})
+}
+
+func TestInvalidCustomResourceConfig(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.CustomResources = []collection.CustomResourceConfig{{Version: "v1"}}
+	assert.EqualError(t, cfg.Validate(), "invalid custom_resources[0]: version, kind and resource must be specified")
+
+	certificate := collection.CustomResourceConfig{
+		Group:    "cert-manager.io",
+		Version:  "v1",
+		Kind:     "Certificate",
+		Resource: "certificates",
+		Metrics:  []collection.CustomResourceMetricConfig{{Name: "certmanager.certificate.ready", Path: "{.status.conditions[0].status}"}},
+	}
+	cfg.CustomResources = []collection.CustomResourceConfig{certificate, certificate}
+	assert.EqualError(t, cfg.Validate(), "invalid custom_resources[1]: duplicate cert-manager.io/v1, Kind=Certificate")
 }
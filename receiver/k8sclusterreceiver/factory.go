@@ -24,8 +24,10 @@ import (
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"k8s.io/client-go/dynamic"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 )
 
 const (
@@ -58,8 +60,37 @@ func createDefaultConfig() config.Receiver {
 func createMetricsReceiver(
 	_ context.Context, params component.ReceiverCreateSettings, cfg config.Receiver,
 	consumer consumer.Metrics) (component.MetricsReceiver, error) {
-	rCfg := cfg.(*Config)
+	r, err := getOrCreateReceiver(params, cfg.(*Config))
+	if err != nil {
+		return nil, err
+	}
+	r.Unwrap().(*kubernetesReceiver).metricsConsumer = consumer
+
+	return r, nil
+}
+
+func createLogsReceiver(
+	_ context.Context, params component.ReceiverCreateSettings, cfg config.Receiver,
+	consumer consumer.Logs) (component.LogsReceiver, error) {
+	r, err := getOrCreateReceiver(params, cfg.(*Config))
+	if err != nil {
+		return nil, err
+	}
+	r.Unwrap().(*kubernetesReceiver).logsConsumer = consumer
 
+	return r, nil
+}
+
+// getOrCreateReceiver returns the receiver shared by the metrics and logs
+// pipelines for the given configuration, so that resources are watched once.
+func getOrCreateReceiver(params component.ReceiverCreateSettings, rCfg *Config) (*sharedcomponent.SharedComponent, error) {
+	return receivers.GetOrTryAdd(rCfg, func() (component.Component, error) {
+		return createReceiver(params, rCfg)
+	})
+}
+
+// createReceiver creates the k8s clients for the given configuration and a receiver using them.
+func createReceiver(params component.ReceiverCreateSettings, rCfg *Config) (*kubernetesReceiver, error) {
 	k8sClient, err := rCfg.getK8sClient()
 	if err != nil {
 		return nil, err
@@ -78,7 +109,15 @@ func createMetricsReceiver(
 		return nil, fmt.Errorf("\"%s\" is not a supported distribution. Must be one of: \"openshift\", \"kubernetes\"", rCfg.Distribution)
 	}
 
-	return newReceiver(params, rCfg, consumer, k8sClient, osQuotaClient)
+	var dynamicClient dynamic.Interface
+	if len(rCfg.CustomResources) > 0 {
+		dynamicClient, err = rCfg.getDynamicClient()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return newReceiver(params, rCfg, k8sClient, osQuotaClient, dynamicClient)
 }
 
 // NewFactory creates a factory for k8s_cluster receiver.
@@ -86,5 +125,10 @@ func NewFactory() component.ReceiverFactory {
 	return receiverhelper.NewFactory(
 		typeStr,
 		createDefaultConfig,
-		receiverhelper.WithMetrics(createMetricsReceiver))
+		receiverhelper.WithMetrics(createMetricsReceiver),
+		receiverhelper.WithLogs(createLogsReceiver))
 }
+
+// This is the map of already created k8s_cluster receivers for particular configurations.
+// The same receiver is used when it is part of both metrics and logs pipelines.
+var receivers = sharedcomponent.NewSharedComponents()
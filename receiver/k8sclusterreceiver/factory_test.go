@@ -26,9 +26,14 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	fakeDynamic "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/collection"
 )
 
 func TestFactory(t *testing.T) {
@@ -80,7 +85,39 @@ func TestFactory(t *testing.T) {
 	require.NoError(t, r.Start(ctx, nopHostWithExporters{}))
 	require.NoError(t, r.Shutdown(ctx))
 	rCfg.MetadataExporters = []string{"nop/withoutmetadata"}
+	r, err = f.CreateMetricsReceiver(
+		context.Background(), componenttest.NewNopReceiverCreateSettings(),
+		rCfg, consumertest.NewNop(),
+	)
+	require.NoError(t, err)
 	require.Error(t, r.Start(context.Background(), nopHostWithExporters{}))
+	require.NoError(t, r.Shutdown(ctx))
+}
+
+func TestFactorySharedReceiver(t *testing.T) {
+	f := NewFactory()
+	rCfg := f.CreateDefaultConfig().(*Config)
+	rCfg.makeClient = func(apiConf k8sconfig.APIConfig) (kubernetes.Interface, error) {
+		return nil, nil
+	}
+
+	mr, err := f.CreateMetricsReceiver(
+		context.Background(), componenttest.NewNopReceiverCreateSettings(),
+		rCfg, consumertest.NewNop(),
+	)
+	require.NoError(t, err)
+	lr, err := f.CreateLogsReceiver(
+		context.Background(), componenttest.NewNopReceiverCreateSettings(),
+		rCfg, consumertest.NewNop(),
+	)
+	require.NoError(t, err)
+
+	// The same receiver is used by both pipelines.
+	require.Same(t, mr, lr)
+	kr := unwrapReceiver(mr)
+	require.NotNil(t, kr.metricsConsumer)
+	require.NotNil(t, kr.logsConsumer)
+	require.NoError(t, mr.Shutdown(context.Background()))
 }
 
 func TestFactoryDistributions(t *testing.T) {
@@ -105,8 +142,9 @@ func TestFactoryDistributions(t *testing.T) {
 	)
 	require.NoError(t, err)
 	require.NotNil(t, r)
-	rr := r.(*kubernetesReceiver)
+	rr := unwrapReceiver(r)
 	require.Nil(t, rr.resourceWatcher.osQuotaClient)
+	require.NoError(t, r.Shutdown(context.Background()))
 
 	// openshift
 	rCfg.Distribution = "openshift"
@@ -116,8 +154,9 @@ func TestFactoryDistributions(t *testing.T) {
 	)
 	require.NoError(t, err)
 	require.NotNil(t, r)
-	rr = r.(*kubernetesReceiver)
+	rr = unwrapReceiver(r)
 	require.NotNil(t, rr.resourceWatcher.osQuotaClient)
+	require.NoError(t, r.Shutdown(context.Background()))
 
 	// bad distribution
 	rCfg.Distribution = "unknown-distro"
@@ -130,6 +169,53 @@ func TestFactoryDistributions(t *testing.T) {
 	require.EqualError(t, err, "\"unknown-distro\" is not a supported distribution. Must be one of: \"openshift\", \"kubernetes\"")
 }
 
+func TestFactoryCustomResources(t *testing.T) {
+	f := NewFactory()
+	rCfg := f.CreateDefaultConfig().(*Config)
+	rCfg.makeClient = func(apiConf k8sconfig.APIConfig) (kubernetes.Interface, error) {
+		return nil, nil
+	}
+	rCfg.makeDynamicClient = func(apiConf k8sconfig.APIConfig) (dynamic.Interface, error) {
+		return fakeDynamic.NewSimpleDynamicClient(runtime.NewScheme()), nil
+	}
+
+	// Without custom resources no dynamic informer is set up.
+	r, err := f.CreateMetricsReceiver(
+		context.Background(), componenttest.NewNopReceiverCreateSettings(),
+		rCfg, consumertest.NewNop(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(unwrapReceiver(r).resourceWatcher.informerFactories))
+	require.NoError(t, r.Shutdown(context.Background()))
+
+	rCfg.CustomResources = []collection.CustomResourceConfig{
+		{
+			Group:    "cert-manager.io",
+			Version:  "v1",
+			Kind:     "Certificate",
+			Resource: "certificates",
+			Metrics: []collection.CustomResourceMetricConfig{
+				{
+					Name:         "certmanager.certificate.ready",
+					Path:         `{.status.conditions[?(@.type=="Ready")].status}`,
+					ValueMapping: map[string]float64{"True": 1, "False": 0},
+				},
+			},
+		},
+	}
+	r, err = f.CreateMetricsReceiver(
+		context.Background(), componenttest.NewNopReceiverCreateSettings(),
+		rCfg, consumertest.NewNop(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(unwrapReceiver(r).resourceWatcher.informerFactories))
+	require.NoError(t, r.Shutdown(context.Background()))
+}
+
+func unwrapReceiver(r component.Receiver) *kubernetesReceiver {
+	return r.(*sharedcomponent.SharedComponent).Unwrap().(*kubernetesReceiver)
+}
+
 // nopHostWithExporters mocks a receiver.ReceiverHost for test purposes.
 type nopHostWithExporters struct {
 }
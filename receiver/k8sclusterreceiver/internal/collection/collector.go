@@ -27,7 +27,9 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
 
@@ -77,6 +79,7 @@ type DataCollector struct {
 	metadataStore            *metadataStore
 	nodeConditionsToReport   []string
 	allocatableTypesToReport []string
+	customResources          map[schema.GroupVersionKind]*customResourceCollector
 }
 
 // NewDataCollector returns a DataCollector.
@@ -89,9 +92,22 @@ func NewDataCollector(logger *zap.Logger, nodeConditionsToReport, allocatableTyp
 		metadataStore:            &metadataStore{},
 		nodeConditionsToReport:   nodeConditionsToReport,
 		allocatableTypesToReport: allocatableTypesToReport,
+		customResources:          map[schema.GroupVersionKind]*customResourceCollector{},
 	}
 }
 
+// SetupCustomResources configures the collection of metrics from custom resources.
+func (dc *DataCollector) SetupCustomResources(cfgs []CustomResourceConfig) error {
+	for _, cfg := range cfgs {
+		crc, err := newCustomResourceCollector(cfg)
+		if err != nil {
+			return err
+		}
+		dc.customResources[crc.gvk] = crc
+	}
+	return nil
+}
+
 // SetupMetadataStore initializes a metadata store for the kubernetes object.
 func (dc *DataCollector) SetupMetadataStore(o runtime.Object, store cache.Store) {
 	dc.metadataStore.setupStore(o, store)
@@ -152,6 +168,12 @@ func (dc *DataCollector) SyncMetrics(obj interface{}) {
 		rm = getMetricsForHPA(o)
 	case *quotav1.ClusterResourceQuota:
 		rm = getMetricsForClusterResourceQuota(o)
+	case *unstructured.Unstructured:
+		crc, ok := dc.customResources[o.GroupVersionKind()]
+		if !ok {
+			return
+		}
+		rm = crc.getMetrics(o, dc.logger)
 	default:
 		return
 	}
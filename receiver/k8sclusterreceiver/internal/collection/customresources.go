@@ -0,0 +1,308 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/collection"
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	metricspb "github.com/census-instrumentation/opencensus-proto/gen-go/metrics/v1"
+	resourcepb "github.com/census-instrumentation/opencensus-proto/gen-go/resource/v1"
+	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/utils"
+)
+
+// CustomResourceConfig defines how to turn fields of an arbitrary Kubernetes
+// resource, typically defined by a CRD, into gauges.
+type CustomResourceConfig struct {
+	// Group of the resource, e.g. "argoproj.io". Empty for the core group.
+	Group string `mapstructure:"group"`
+	// Version of the resource, e.g. "v1alpha1".
+	Version string `mapstructure:"version"`
+	// Kind of the resource, e.g. "Rollout".
+	Kind string `mapstructure:"kind"`
+	// Resource is the plural name used to list and watch the resource, e.g. "rollouts",
+	// as listed by `kubectl api-resources`.
+	Resource string `mapstructure:"resource"`
+	// LabelsFromPath maps label names to JSONPath expressions evaluated against
+	// each object, e.g. `{.metadata.labels.app}`. These labels are added to all
+	// the metrics of the resource.
+	LabelsFromPath map[string]string `mapstructure:"labels_from_path"`
+	// Metrics to extract from each object of the resource.
+	Metrics []CustomResourceMetricConfig `mapstructure:"metrics"`
+}
+
+// CustomResourceMetricConfig defines a gauge whose value is taken from a field of the object.
+type CustomResourceMetricConfig struct {
+	// Name of the metric.
+	Name string `mapstructure:"name"`
+	// Description of the metric.
+	Description string `mapstructure:"description"`
+	// Unit of the metric. Defaults to "1".
+	Unit string `mapstructure:"unit"`
+	// Path is the JSONPath expression selecting the value, e.g. `{.status.availableReplicas}`.
+	// Numbers are reported as is, booleans as 0 or 1 and strings are parsed as
+	// numbers unless they are found in ValueMapping.
+	Path string `mapstructure:"path"`
+	// LabelsFromPath maps label names to JSONPath expressions, in addition to
+	// the labels defined for the whole resource.
+	LabelsFromPath map[string]string `mapstructure:"labels_from_path"`
+	// ValueMapping maps string values found at Path to numbers, e.g. "True": 1.
+	ValueMapping map[string]float64 `mapstructure:"value_mapping"`
+}
+
+// GroupVersionKind returns the kind of the objects of the resource.
+func (c CustomResourceConfig) GroupVersionKind() schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: c.Group, Version: c.Version, Kind: c.Kind}
+}
+
+// GroupVersionResource returns the resource to list and watch.
+func (c CustomResourceConfig) GroupVersionResource() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: c.Group, Version: c.Version, Resource: c.Resource}
+}
+
+// Validate checks that the custom resource configuration is complete and that
+// all JSONPath expressions can be parsed.
+func (c CustomResourceConfig) Validate() error {
+	// The resource isn't derived from the kind as plurals may be irregular, e.g. "policies".
+	if c.Version == "" || c.Kind == "" || c.Resource == "" {
+		return errors.New("version, kind and resource must be specified")
+	}
+	if len(c.Metrics) == 0 {
+		return fmt.Errorf("no metrics defined for %s", c.Kind)
+	}
+	if _, err := parseLabelPaths(c.LabelsFromPath); err != nil {
+		return err
+	}
+	for _, m := range c.Metrics {
+		if m.Name == "" {
+			return fmt.Errorf("metric name must be specified for %s", c.Kind)
+		}
+		if _, err := parseJSONPath(m.Name, m.Path); err != nil {
+			return err
+		}
+		if _, err := parseLabelPaths(m.LabelsFromPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type labelPath struct {
+	key  string
+	path *jsonpath.JSONPath
+}
+
+type customResourceMetric struct {
+	descriptor   *metricspb.MetricDescriptor
+	path         *jsonpath.JSONPath
+	labels       []labelPath
+	valueMapping map[string]float64
+}
+
+// customResourceCollector extracts metrics from objects of a single custom resource kind.
+type customResourceCollector struct {
+	gvk     schema.GroupVersionKind
+	metrics []customResourceMetric
+}
+
+func newCustomResourceCollector(c CustomResourceConfig) (*customResourceCollector, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Validate already ensures that the paths parse.
+	resourceLabels, _ := parseLabelPaths(c.LabelsFromPath)
+	crc := &customResourceCollector{
+		gvk: c.GroupVersionKind(),
+	}
+	for _, m := range c.Metrics {
+		path, _ := parseJSONPath(m.Name, m.Path)
+		metricLabels, _ := parseLabelPaths(m.LabelsFromPath)
+		labels := mergeLabelPaths(resourceLabels, metricLabels)
+
+		unit := m.Unit
+		if unit == "" {
+			unit = "1"
+		}
+		labelKeys := make([]*metricspb.LabelKey, len(labels))
+		for i, l := range labels {
+			labelKeys[i] = &metricspb.LabelKey{Key: l.key}
+		}
+
+		crc.metrics = append(crc.metrics, customResourceMetric{
+			descriptor: &metricspb.MetricDescriptor{
+				Name:        m.Name,
+				Description: m.Description,
+				Unit:        unit,
+				Type:        metricspb.MetricDescriptor_GAUGE_DOUBLE,
+				LabelKeys:   labelKeys,
+			},
+			path:         path,
+			labels:       labels,
+			valueMapping: m.ValueMapping,
+		})
+	}
+	return crc, nil
+}
+
+func (crc *customResourceCollector) getMetrics(obj *unstructured.Unstructured, logger *zap.Logger) []*resourceMetrics {
+	var metrics []*metricspb.Metric
+	for _, m := range crc.metrics {
+		value, err := m.getValue(obj.Object)
+		if err != nil {
+			logger.Debug(
+				"failed to get custom resource metric value",
+				zap.String("metric", m.descriptor.Name),
+				zap.String("object", obj.GetName()),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		labelValues := make([]*metricspb.LabelValue, len(m.labels))
+		for i, l := range m.labels {
+			v, err := findString(l.path, obj.Object)
+			labelValues[i] = &metricspb.LabelValue{Value: v, HasValue: err == nil}
+		}
+
+		metrics = append(metrics, &metricspb.Metric{
+			MetricDescriptor: m.descriptor,
+			Timeseries: []*metricspb.TimeSeries{
+				utils.GetDoubleTimeSeriesWithLabels(value, labelValues),
+			},
+		})
+	}
+
+	if len(metrics) == 0 {
+		return nil
+	}
+
+	return []*resourceMetrics{
+		{
+			resource: getResourceForCustomResource(obj),
+			metrics:  metrics,
+		},
+	}
+}
+
+func getResourceForCustomResource(obj *unstructured.Unstructured) *resourcepb.Resource {
+	kind := strings.ToLower(obj.GetKind())
+	labels := map[string]string{
+		getOTelUIDFromKind(kind):            string(obj.GetUID()),
+		getOTelNameFromKind(kind):           obj.GetName(),
+		conventions.AttributeK8SClusterName: obj.GetClusterName(),
+	}
+	if obj.GetNamespace() != "" {
+		labels[conventions.AttributeK8SNamespaceName] = obj.GetNamespace()
+	}
+	return &resourcepb.Resource{
+		Type:   k8sType,
+		Labels: labels,
+	}
+}
+
+func (m customResourceMetric) getValue(obj map[string]interface{}) (float64, error) {
+	results, err := m.path.FindResults(obj)
+	if err != nil {
+		return 0, err
+	}
+	if len(results) == 0 || len(results[0]) == 0 {
+		return 0, errors.New("no value found")
+	}
+
+	v := reflect.Indirect(results[0][0])
+	if v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	case reflect.Bool:
+		if v.Bool() {
+			return 1, nil
+		}
+		return 0, nil
+	case reflect.String:
+		if mapped, ok := m.valueMapping[v.String()]; ok {
+			return mapped, nil
+		}
+		return strconv.ParseFloat(v.String(), 64)
+	}
+	return 0, fmt.Errorf("unsupported value of kind %s", v.Kind())
+}
+
+func findString(path *jsonpath.JSONPath, obj map[string]interface{}) (string, error) {
+	results, err := path.FindResults(obj)
+	if err != nil {
+		return "", err
+	}
+	if len(results) == 0 || len(results[0]) == 0 {
+		return "", errors.New("no value found")
+	}
+	return fmt.Sprint(results[0][0].Interface()), nil
+}
+
+func parseJSONPath(name, path string) (*jsonpath.JSONPath, error) {
+	jp := jsonpath.New(name)
+	if err := jp.Parse(path); err != nil {
+		return nil, fmt.Errorf("invalid JSONPath %q for %s: %w", path, name, err)
+	}
+	return jp, nil
+}
+
+func parseLabelPaths(labels map[string]string) ([]labelPath, error) {
+	out := make([]labelPath, 0, len(labels))
+	for k, p := range labels {
+		jp, err := parseJSONPath(k, p)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, labelPath{key: k, path: jp})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].key < out[j].key })
+	return out, nil
+}
+
+// mergeLabelPaths combines the labels of a resource with the labels of one of
+// its metrics, the latter taking precedence.
+func mergeLabelPaths(resourceLabels, metricLabels []labelPath) []labelPath {
+	byKey := map[string]labelPath{}
+	for _, l := range resourceLabels {
+		byKey[l.key] = l
+	}
+	for _, l := range metricLabels {
+		byKey[l.key] = l
+	}
+	out := make([]labelPath, 0, len(byKey))
+	for _, l := range byKey {
+		out = append(out, l)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].key < out[j].key })
+	return out
+}
@@ -0,0 +1,217 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+import (
+	"testing"
+
+	metricspb "github.com/census-instrumentation/opencensus-proto/gen-go/metrics/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+var rolloutConfig = CustomResourceConfig{
+	Group:    "argoproj.io",
+	Version:  "v1alpha1",
+	Kind:     "Rollout",
+	Resource: "rollouts",
+	LabelsFromPath: map[string]string{
+		"app": "{.metadata.labels.app}",
+	},
+	Metrics: []CustomResourceMetricConfig{
+		{
+			Name: "argo.rollout.available_replicas",
+			Path: "{.status.availableReplicas}",
+		},
+		{
+			Name: "argo.rollout.healthy",
+			Path: "{.status.phase}",
+			LabelsFromPath: map[string]string{
+				"phase": "{.status.phase}",
+			},
+			ValueMapping: map[string]float64{
+				"Healthy":     1,
+				"Progressing": 0,
+			},
+		},
+		{
+			Name: "argo.rollout.paused",
+			Path: "{.spec.paused}",
+		},
+		{
+			Name: "argo.rollout.missing",
+			Path: "{.status.doesNotExist}",
+		},
+	},
+}
+
+func newRollout() *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "argoproj.io/v1alpha1",
+			"kind":       "Rollout",
+			"metadata": map[string]interface{}{
+				"name":        "test-rollout-1",
+				"namespace":   "test-namespace",
+				"uid":         "test-rollout-1-uid",
+				"clusterName": "test-cluster",
+				"labels": map[string]interface{}{
+					"app": "web",
+				},
+			},
+			"spec": map[string]interface{}{
+				"paused": true,
+			},
+			"status": map[string]interface{}{
+				"availableReplicas": int64(3),
+				"phase":             "Healthy",
+			},
+		},
+	}
+}
+
+func TestCustomResourceMetrics(t *testing.T) {
+	dc := NewDataCollector(zap.NewNop(), []string{}, []string{})
+	require.NoError(t, dc.SetupCustomResources([]CustomResourceConfig{rolloutConfig}))
+
+	crc := dc.customResources[schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout"}]
+	require.NotNil(t, crc)
+
+	actualResourceMetrics := crc.getMetrics(newRollout(), zap.NewNop())
+	require.Equal(t, 1, len(actualResourceMetrics))
+	// The metric with a missing field is skipped.
+	require.Equal(t, 3, len(actualResourceMetrics[0].metrics))
+
+	rm := actualResourceMetrics[0]
+	testutils.AssertResource(t, rm.resource, k8sType,
+		map[string]string{
+			"k8s.rollout.uid":    "test-rollout-1-uid",
+			"k8s.rollout.name":   "test-rollout-1",
+			"k8s.namespace.name": "test-namespace",
+			"k8s.cluster.name":   "test-cluster",
+		},
+	)
+
+	assertCustomResourceMetric(t, rm.metrics[0], "argo.rollout.available_replicas",
+		map[string]string{"app": "web"}, 3)
+	assertCustomResourceMetric(t, rm.metrics[1], "argo.rollout.healthy",
+		map[string]string{"app": "web", "phase": "Healthy"}, 1)
+	assertCustomResourceMetric(t, rm.metrics[2], "argo.rollout.paused",
+		map[string]string{"app": "web"}, 1)
+}
+
+func TestCustomResourceSyncMetrics(t *testing.T) {
+	dc := NewDataCollector(zap.NewNop(), []string{}, []string{})
+	require.NoError(t, dc.SetupCustomResources([]CustomResourceConfig{rolloutConfig}))
+
+	dc.SyncMetrics(newRollout())
+	require.Equal(t, 1, len(dc.metricsStore.metricsCache))
+
+	// Objects of kinds which are not configured are ignored.
+	other := newRollout()
+	other.SetKind("Experiment")
+	other.SetUID("test-experiment-1-uid")
+	dc.SyncMetrics(other)
+	require.Equal(t, 1, len(dc.metricsStore.metricsCache))
+
+	dc.RemoveFromMetricsStore(newRollout())
+	require.Equal(t, 0, len(dc.metricsStore.metricsCache))
+}
+
+func TestCustomResourceConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  CustomResourceConfig
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			config: rolloutConfig,
+		},
+		{
+			name:    "missing kind",
+			config:  CustomResourceConfig{Version: "v1"},
+			wantErr: "version, kind and resource must be specified",
+		},
+		{
+			name:    "missing resource",
+			config:  CustomResourceConfig{Version: "v1", Kind: "Policy"},
+			wantErr: "version, kind and resource must be specified",
+		},
+		{
+			name:    "no metrics",
+			config:  CustomResourceConfig{Version: "v1", Kind: "Certificate", Resource: "certificates"},
+			wantErr: "no metrics defined for Certificate",
+		},
+		{
+			name: "missing metric name",
+			config: CustomResourceConfig{
+				Version:  "v1",
+				Kind:     "Certificate",
+				Resource: "certificates",
+				Metrics:  []CustomResourceMetricConfig{{Path: "{.status.notAfter}"}},
+			},
+			wantErr: "metric name must be specified for Certificate",
+		},
+		{
+			name: "invalid path",
+			config: CustomResourceConfig{
+				Version:  "v1",
+				Kind:     "Certificate",
+				Resource: "certificates",
+				Metrics:  []CustomResourceMetricConfig{{Name: "cert.ready", Path: "{.status"}},
+			},
+			wantErr: `invalid JSONPath "{.status" for cert.ready: unclosed action`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCustomResourceGroupVersionResource(t *testing.T) {
+	assert.Equal(t,
+		schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "rollouts"},
+		rolloutConfig.GroupVersionResource(),
+	)
+
+	cfg := CustomResourceConfig{Group: "policy.networking.k8s.io", Version: "v1alpha1", Kind: "AdminNetworkPolicy", Resource: "adminnetworkpolicies"}
+	assert.Equal(t, "adminnetworkpolicies", cfg.GroupVersionResource().Resource)
+}
+
+func assertCustomResourceMetric(t *testing.T, actualMetric *metricspb.Metric,
+	expectedMetric string, expectedLabels map[string]string, expectedValue float64) {
+	require.Equal(t, expectedMetric, actualMetric.MetricDescriptor.Name)
+	require.Equal(t, metricspb.MetricDescriptor_GAUGE_DOUBLE, actualMetric.MetricDescriptor.Type)
+
+	labels := map[string]string{}
+	for i, k := range actualMetric.MetricDescriptor.LabelKeys {
+		labels[k.Key] = actualMetric.Timeseries[0].LabelValues[i].Value
+	}
+	require.Equal(t, expectedLabels, labels)
+	require.Equal(t, expectedValue, actualMetric.Timeseries[0].Points[0].GetDoubleValue())
+}
@@ -0,0 +1,271 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/collection"
+
+import (
+	"fmt"
+	"time"
+
+	resourcepb "github.com/census-instrumentation/opencensus-proto/gen-go/resource/v1"
+	quotav1 "github.com/openshift/api/quota/v1"
+	"go.opentelemetry.io/collector/model/pdata"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/api/autoscaling/v2beta1"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Attribute keys of entity event log records.
+const (
+	entityEventKey            = "k8s.entity.event"
+	entityKindKey             = "k8s.entity.kind"
+	podPhaseKey               = "k8s.pod.phase"
+	podPreviousPhaseKey       = "k8s.pod.phase.previous"
+	namespacePhaseKey         = "k8s.namespace.phase"
+	namespacePreviousPhaseKey = "k8s.namespace.phase.previous"
+	containerRestartCountKey  = "k8s.container.restart_count"
+	containerRestartReasonKey = "k8s.container.restart_reason"
+	containerExitCodeKey      = "k8s.container.exit_code"
+)
+
+const (
+	containerReasonOOMKilled   = "OOMKilled"
+	entityEventsInstrumentName = "otelcol/k8sclusterreceiver"
+)
+
+// Values of the k8s.entity.event attribute.
+const (
+	EntityEventCreated            = "created"
+	EntityEventDeleted            = "deleted"
+	EntityEventPhaseChanged       = "phase_changed"
+	EntityEventContainerRestarted = "container_restarted"
+	EntityEventOOMKilled          = "oom_killed"
+)
+
+type entityEvent struct {
+	resource   *resourcepb.Resource
+	event      string
+	severity   pdata.SeverityNumber
+	message    string
+	attributes map[string]pdata.AttributeValue
+}
+
+// GetEntityEvents returns log records describing lifecycle changes of a Kubernetes object
+// between its old and new state. oldObj is nil when the object was created and newObj is
+// nil when it was deleted. Log records carry the same resource as the metrics of the object.
+func (dc *DataCollector) GetEntityEvents(oldObj, newObj interface{}, ts time.Time) pdata.Logs {
+	if d, ok := oldObj.(cache.DeletedFinalStateUnknown); ok {
+		oldObj = d.Obj
+	}
+
+	var events []entityEvent
+	switch {
+	case oldObj == nil && newObj != nil:
+		if e, ok := getLifecycleEvent(newObj, EntityEventCreated); ok {
+			events = append(events, e)
+		}
+	case oldObj != nil && newObj == nil:
+		if e, ok := getLifecycleEvent(oldObj, EntityEventDeleted); ok {
+			events = append(events, e)
+		}
+	case oldObj != nil && newObj != nil:
+		events = getUpdateEvents(oldObj, newObj)
+	}
+
+	ld := pdata.NewLogs()
+	for _, e := range events {
+		rl := ld.ResourceLogs().AppendEmpty()
+		for k, v := range e.resource.Labels {
+			rl.Resource().Attributes().UpsertString(k, v)
+		}
+		ill := rl.InstrumentationLibraryLogs().AppendEmpty()
+		ill.InstrumentationLibrary().SetName(entityEventsInstrumentName)
+		lr := ill.Logs().AppendEmpty()
+		lr.SetTimestamp(pdata.NewTimestampFromTime(ts))
+		lr.SetSeverityNumber(e.severity)
+		lr.SetSeverityText(severityText(e.severity))
+		lr.Body().SetStringVal(e.message)
+		lr.Attributes().UpsertString(entityEventKey, e.event)
+		for k, v := range e.attributes {
+			lr.Attributes().Upsert(k, v)
+		}
+	}
+	return ld
+}
+
+func getLifecycleEvent(obj interface{}, event string) (entityEvent, bool) {
+	resource, kind, name := getResourceForObject(obj)
+	if resource == nil {
+		return entityEvent{}, false
+	}
+	return entityEvent{
+		resource: resource,
+		event:    event,
+		severity: pdata.SeverityNumberINFO,
+		message:  fmt.Sprintf("%s %s %s", kind, name, event),
+		attributes: map[string]pdata.AttributeValue{
+			entityKindKey: pdata.NewAttributeValueString(kind),
+		},
+	}, true
+}
+
+func getUpdateEvents(oldObj, newObj interface{}) []entityEvent {
+	switch o := newObj.(type) {
+	case *corev1.Pod:
+		if old, ok := oldObj.(*corev1.Pod); ok {
+			return getPodUpdateEvents(old, o)
+		}
+	case *corev1.Namespace:
+		old, ok := oldObj.(*corev1.Namespace)
+		if !ok || old.Status.Phase == o.Status.Phase {
+			return nil
+		}
+		return []entityEvent{{
+			resource: getResourceForNamespace(o),
+			event:    EntityEventPhaseChanged,
+			severity: pdata.SeverityNumberINFO,
+			message:  fmt.Sprintf("Namespace %s phase changed from %s to %s", o.Name, old.Status.Phase, o.Status.Phase),
+			attributes: map[string]pdata.AttributeValue{
+				entityKindKey:             pdata.NewAttributeValueString("Namespace"),
+				namespacePhaseKey:         pdata.NewAttributeValueString(string(o.Status.Phase)),
+				namespacePreviousPhaseKey: pdata.NewAttributeValueString(string(old.Status.Phase)),
+			},
+		}}
+	}
+	return nil
+}
+
+func getPodUpdateEvents(old, pod *corev1.Pod) []entityEvent {
+	var events []entityEvent
+	if old.Status.Phase != pod.Status.Phase {
+		events = append(events, entityEvent{
+			resource: getResourceForPod(pod),
+			event:    EntityEventPhaseChanged,
+			severity: pdata.SeverityNumberINFO,
+			message:  fmt.Sprintf("Pod %s phase changed from %s to %s", pod.Name, old.Status.Phase, pod.Status.Phase),
+			attributes: map[string]pdata.AttributeValue{
+				entityKindKey:       pdata.NewAttributeValueString("Pod"),
+				podPhaseKey:         pdata.NewAttributeValueString(string(pod.Status.Phase)),
+				podPreviousPhaseKey: pdata.NewAttributeValueString(string(old.Status.Phase)),
+			},
+		})
+	}
+
+	oldStatuses := map[string]corev1.ContainerStatus{}
+	for _, cs := range old.Status.ContainerStatuses {
+		oldStatuses[cs.Name] = cs
+	}
+	podLabels := getResourceForPod(pod).Labels
+	for _, cs := range pod.Status.ContainerStatuses {
+		oldCs, ok := oldStatuses[cs.Name]
+		if !ok {
+			continue
+		}
+
+		var terminated *corev1.ContainerStateTerminated
+		switch {
+		case cs.RestartCount > oldCs.RestartCount:
+			terminated = cs.LastTerminationState.Terminated
+		case cs.State.Terminated != nil && oldCs.State.Terminated == nil:
+			// Containers which are not restarted, e.g. with a Never restart
+			// policy, are only reported when they got OOM killed.
+			if cs.State.Terminated.Reason != containerReasonOOMKilled {
+				continue
+			}
+			terminated = cs.State.Terminated
+		default:
+			continue
+		}
+
+		events = append(events, getContainerTerminationEvent(pod, cs, terminated, podLabels))
+	}
+	return events
+}
+
+func getContainerTerminationEvent(pod *corev1.Pod, cs corev1.ContainerStatus,
+	terminated *corev1.ContainerStateTerminated, podLabels map[string]string) entityEvent {
+	e := entityEvent{
+		resource: getResourceForContainer(getAllContainerLabels(cs, podLabels)),
+		event:    EntityEventContainerRestarted,
+		severity: pdata.SeverityNumberWARN,
+		message:  fmt.Sprintf("Container %s of pod %s restarted", cs.Name, pod.Name),
+		attributes: map[string]pdata.AttributeValue{
+			entityKindKey:            pdata.NewAttributeValueString("Container"),
+			containerRestartCountKey: pdata.NewAttributeValueInt(int64(cs.RestartCount)),
+		},
+	}
+	if terminated == nil {
+		return e
+	}
+
+	e.attributes[containerRestartReasonKey] = pdata.NewAttributeValueString(terminated.Reason)
+	e.attributes[containerExitCodeKey] = pdata.NewAttributeValueInt(int64(terminated.ExitCode))
+	e.message = fmt.Sprintf("%s: %s (exit code %d)", e.message, terminated.Reason, terminated.ExitCode)
+	if terminated.Reason == containerReasonOOMKilled {
+		e.event = EntityEventOOMKilled
+		e.severity = pdata.SeverityNumberERROR
+		e.message = fmt.Sprintf("Container %s of pod %s was OOM killed", cs.Name, pod.Name)
+	}
+	return e
+}
+
+// getResourceForObject returns the resource used for the metrics of the object
+// along with its kind and name, or a nil resource if the object is not collected.
+func getResourceForObject(obj interface{}) (*resourcepb.Resource, string, string) {
+	switch o := obj.(type) {
+	case *corev1.Pod:
+		return getResourceForPod(o), "Pod", o.Name
+	case *corev1.Node:
+		return getResourceForNode(o), "Node", o.Name
+	case *corev1.Namespace:
+		return getResourceForNamespace(o), "Namespace", o.Name
+	case *corev1.ReplicationController:
+		return getResourceForReplicationController(o), k8sKindReplicationController, o.Name
+	case *corev1.ResourceQuota:
+		return getResourceForResourceQuota(o), "ResourceQuota", o.Name
+	case *appsv1.Deployment:
+		return getResourceForDeployment(o), k8sKindDeployment, o.Name
+	case *appsv1.ReplicaSet:
+		return getResourceForReplicaSet(o), k8sKindReplicaSet, o.Name
+	case *appsv1.DaemonSet:
+		return getResourceForDaemonSet(o), k8sKindDaemonSet, o.Name
+	case *appsv1.StatefulSet:
+		return getResourceForStatefulSet(o), k8sStatefulSet, o.Name
+	case *batchv1.Job:
+		return getResourceForJob(o), k8sKindJob, o.Name
+	case *batchv1beta1.CronJob:
+		return getResourceForCronJob(o), k8sKindCronJob, o.Name
+	case *v2beta1.HorizontalPodAutoscaler:
+		return getResourceForHPA(o), "HPA", o.Name
+	case *quotav1.ClusterResourceQuota:
+		return getResourceForClusterResourceQuota(o), "ClusterResourceQuota", o.Name
+	case *unstructured.Unstructured:
+		return getResourceForCustomResource(o), o.GetKind(), o.GetName()
+	}
+	return nil, "", ""
+}
+
+func severityText(s pdata.SeverityNumber) string {
+	switch s {
+	case pdata.SeverityNumberWARN:
+		return "WARN"
+	case pdata.SeverityNumberERROR:
+		return "ERROR"
+	}
+	return "INFO"
+}
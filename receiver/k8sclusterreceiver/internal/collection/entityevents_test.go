@@ -0,0 +1,122 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestEntityEventsLifecycle(t *testing.T) {
+	dc := NewDataCollector(zap.NewNop(), []string{}, []string{})
+	pod := newPodWithContainer("1", podSpecWithContainer("container-name"), podStatusWithContainer("container-name", "container-id"))
+
+	created := dc.GetEntityEvents(nil, pod, time.Now())
+	require.Equal(t, 1, created.LogRecordCount())
+	rl := created.ResourceLogs().At(0)
+	assertResourceAttribute(t, rl.Resource(), "k8s.pod.uid", "test-pod-1-uid")
+	lr := rl.InstrumentationLibraryLogs().At(0).Logs().At(0)
+	assertLogAttribute(t, lr, "k8s.entity.event", EntityEventCreated)
+	assertLogAttribute(t, lr, "k8s.entity.kind", "Pod")
+	assert.Equal(t, "Pod test-pod-1 created", lr.Body().StringVal())
+
+	deleted := dc.GetEntityEvents(cache.DeletedFinalStateUnknown{Obj: pod}, nil, time.Now())
+	require.Equal(t, 1, deleted.LogRecordCount())
+	lr = deleted.ResourceLogs().At(0).InstrumentationLibraryLogs().At(0).Logs().At(0)
+	assertLogAttribute(t, lr, "k8s.entity.event", EntityEventDeleted)
+
+	// Objects which are not collected are ignored.
+	assert.Equal(t, 0, dc.GetEntityEvents(nil, &corev1.Service{}, time.Now()).LogRecordCount())
+}
+
+func TestEntityEventsPodUpdates(t *testing.T) {
+	dc := NewDataCollector(zap.NewNop(), []string{}, []string{})
+	old := newPodWithContainer("1", podSpecWithContainer("container-name"), podStatusWithContainer("container-name", "container-id"))
+	old.Status.Phase = corev1.PodPending
+
+	// No changes.
+	assert.Equal(t, 0, dc.GetEntityEvents(old, old.DeepCopy(), time.Now()).LogRecordCount())
+
+	pod := old.DeepCopy()
+	pod.Status.Phase = corev1.PodRunning
+	pod.Status.ContainerStatuses[0].RestartCount = 4
+	pod.Status.ContainerStatuses[0].LastTerminationState = corev1.ContainerState{
+		Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137},
+	}
+
+	logs := dc.GetEntityEvents(old, pod, time.Now())
+	require.Equal(t, 2, logs.LogRecordCount())
+
+	phase := logs.ResourceLogs().At(0)
+	lr := phase.InstrumentationLibraryLogs().At(0).Logs().At(0)
+	assertLogAttribute(t, lr, "k8s.entity.event", EntityEventPhaseChanged)
+	assertLogAttribute(t, lr, "k8s.pod.phase", "Running")
+	assertLogAttribute(t, lr, "k8s.pod.phase.previous", "Pending")
+
+	oom := logs.ResourceLogs().At(1)
+	assertResourceAttribute(t, oom.Resource(), "container.id", "container-id")
+	assertResourceAttribute(t, oom.Resource(), "k8s.pod.uid", "test-pod-1-uid")
+	lr = oom.InstrumentationLibraryLogs().At(0).Logs().At(0)
+	assertLogAttribute(t, lr, "k8s.entity.event", EntityEventOOMKilled)
+	assertLogAttribute(t, lr, "k8s.container.restart_reason", "OOMKilled")
+	assert.Equal(t, pdata.SeverityNumberERROR, lr.SeverityNumber())
+	exitCode, _ := lr.Attributes().Get("k8s.container.exit_code")
+	assert.Equal(t, int64(137), exitCode.IntVal())
+
+	restarted := pod.DeepCopy()
+	restarted.Status.ContainerStatuses[0].RestartCount = 5
+	restarted.Status.ContainerStatuses[0].LastTerminationState = corev1.ContainerState{
+		Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1},
+	}
+	logs = dc.GetEntityEvents(pod, restarted, time.Now())
+	require.Equal(t, 1, logs.LogRecordCount())
+	lr = logs.ResourceLogs().At(0).InstrumentationLibraryLogs().At(0).Logs().At(0)
+	assertLogAttribute(t, lr, "k8s.entity.event", EntityEventContainerRestarted)
+	assertLogAttribute(t, lr, "k8s.container.restart_reason", "Error")
+	assert.Equal(t, "Container container-name of pod test-pod-1 restarted: Error (exit code 1)", lr.Body().StringVal())
+}
+
+func TestEntityEventsNamespacePhase(t *testing.T) {
+	dc := NewDataCollector(zap.NewNop(), []string{}, []string{})
+	old := newNamespace("1")
+	old.Status.Phase = corev1.NamespaceActive
+	ns := old.DeepCopy()
+	ns.Status.Phase = corev1.NamespaceTerminating
+
+	logs := dc.GetEntityEvents(old, ns, time.Now())
+	require.Equal(t, 1, logs.LogRecordCount())
+	lr := logs.ResourceLogs().At(0).InstrumentationLibraryLogs().At(0).Logs().At(0)
+	assertLogAttribute(t, lr, "k8s.namespace.phase", "Terminating")
+	assertLogAttribute(t, lr, "k8s.namespace.phase.previous", "Active")
+}
+
+func assertResourceAttribute(t *testing.T, r pdata.Resource, key, expected string) {
+	v, ok := r.Attributes().Get(key)
+	require.True(t, ok, "missing resource attribute %s", key)
+	assert.Equal(t, expected, v.StringVal())
+}
+
+func assertLogAttribute(t *testing.T, lr pdata.LogRecord, key, expected string) {
+	v, ok := lr.Attributes().Get(key)
+	require.True(t, ok, "missing attribute %s", key)
+	assert.Equal(t, expected, v.StringVal())
+}
@@ -20,6 +20,7 @@ import (
 	"regexp"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -27,6 +28,10 @@ import (
 // GetUIDForObject returns the UID for a Kubernetes object.
 func GetUIDForObject(obj runtime.Object) (types.UID, error) {
 	var key types.UID
+	// Unstructured objects, such as custom resources, do not expose ObjectMeta.
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		return u.GetUID(), nil
+	}
 	oma, ok := obj.(metav1.ObjectMetaAccessor)
 	if !ok || oma.GetObjectMeta() == nil {
 		return key, errors.New("kubernetes object is not of the expected form")
@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -39,6 +40,11 @@ func TestGetUIDForObject(t *testing.T) {
 	}
 	actual, _ = GetUIDForObject(node)
 	require.Equal(t, types.UID("test-node-uid"), actual)
+
+	cr := &unstructured.Unstructured{}
+	cr.SetUID("test-cr-uid")
+	actual, _ = GetUIDForObject(cr)
+	require.Equal(t, types.UID("test-cr-uid"), actual)
 }
 
 func TestStripContainerID(t *testing.T) {
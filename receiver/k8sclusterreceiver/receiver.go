@@ -23,7 +23,9 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/model/pdata"
 	"go.opentelemetry.io/collector/obsreport"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -34,20 +36,28 @@ const (
 )
 
 var _ component.MetricsReceiver = (*kubernetesReceiver)(nil)
+var _ component.LogsReceiver = (*kubernetesReceiver)(nil)
 
 type kubernetesReceiver struct {
 	resourceWatcher *resourceWatcher
 
-	config   *Config
-	settings component.ReceiverCreateSettings
-	consumer consumer.Metrics
-	cancel   context.CancelFunc
-	obsrecv  *obsreport.Receiver
+	config          *Config
+	settings        component.ReceiverCreateSettings
+	metricsConsumer consumer.Metrics
+	logsConsumer    consumer.Logs
+	cancel          context.CancelFunc
+	obsrecv         *obsreport.Receiver
 }
 
 func (kr *kubernetesReceiver) Start(ctx context.Context, host component.Host) error {
 	ctx, kr.cancel = context.WithCancel(ctx)
 
+	if kr.logsConsumer != nil {
+		kr.resourceWatcher.entityEventConsumer = func(logs pdata.Logs) {
+			kr.consumeEntityEvents(ctx, logs)
+		}
+	}
+
 	exporters := host.GetExporters()
 	if err := kr.resourceWatcher.setupMetadataExporters(
 		exporters[config.MetricsDataType], kr.config.MetadataExporters); err != nil {
@@ -79,6 +89,11 @@ func (kr *kubernetesReceiver) Start(ctx context.Context, host component.Host) er
 		kr.settings.Logger.Info("Completed syncing shared informer caches.")
 		kr.resourceWatcher.initialSyncDone.Store(true)
 
+		// Only entity events are reported when the receiver is used in logs pipelines only.
+		if kr.metricsConsumer == nil {
+			return
+		}
+
 		ticker := time.NewTicker(kr.config.CollectionInterval)
 		defer ticker.Stop()
 
@@ -96,7 +111,9 @@ func (kr *kubernetesReceiver) Start(ctx context.Context, host component.Host) er
 }
 
 func (kr *kubernetesReceiver) Shutdown(context.Context) error {
-	kr.cancel()
+	if kr.cancel != nil {
+		kr.cancel()
+	}
 	return nil
 }
 
@@ -107,21 +124,33 @@ func (kr *kubernetesReceiver) dispatchMetrics(ctx context.Context) {
 	c := kr.obsrecv.StartMetricsOp(ctx)
 
 	numPoints := mds.DataPointCount()
-	err := kr.consumer.ConsumeMetrics(c, mds)
+	err := kr.metricsConsumer.ConsumeMetrics(c, mds)
 	kr.obsrecv.EndMetricsOp(c, typeStr, numPoints, err)
 }
 
+func (kr *kubernetesReceiver) consumeEntityEvents(ctx context.Context, logs pdata.Logs) {
+	c := kr.obsrecv.StartLogsOp(ctx)
+
+	numRecords := logs.LogRecordCount()
+	err := kr.logsConsumer.ConsumeLogs(c, logs)
+	kr.obsrecv.EndLogsOp(c, typeStr, numRecords, err)
+}
+
 // newReceiver creates the Kubernetes cluster receiver with the given configuration.
 func newReceiver(
-	set component.ReceiverCreateSettings, config *Config, consumer consumer.Metrics,
-	client kubernetes.Interface, osQuotaClient quotaclientset.Interface) (component.MetricsReceiver, error) {
+	set component.ReceiverCreateSettings, config *Config,
+	client kubernetes.Interface, osQuotaClient quotaclientset.Interface, dynamicClient dynamic.Interface) (*kubernetesReceiver, error) {
 	resourceWatcher := newResourceWatcher(set.Logger, client, osQuotaClient, config.NodeConditionTypesToReport, config.AllocatableTypesToReport, defaultInitialSyncTimeout)
+	if dynamicClient != nil {
+		if err := resourceWatcher.setupCustomResources(dynamicClient, config.CustomResources); err != nil {
+			return nil, err
+		}
+	}
 
 	return &kubernetesReceiver{
 		resourceWatcher: resourceWatcher,
 		settings:        set,
 		config:          config,
-		consumer:        consumer,
 		obsrecv: obsreport.NewReceiver(obsreport.ReceiverSettings{
 			ReceiverID:             config.ID(),
 			Transport:              transport,
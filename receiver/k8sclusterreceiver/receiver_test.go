@@ -84,6 +84,41 @@ func TestReceiver(t *testing.T) {
 	r.Shutdown(ctx)
 }
 
+func TestReceiverEntityEvents(t *testing.T) {
+	tt, err := obsreporttest.SetupTelemetry()
+	require.NoError(t, err)
+	defer tt.Shutdown(context.Background())
+
+	client := fake.NewSimpleClientset()
+	sink := new(consumertest.LogsSink)
+
+	r := setupReceiver(client, nil, consumertest.NewNop(), 10*time.Second, tt)
+	r.logsConsumer = sink
+
+	// Objects which exist before the receiver starts are not reported as created.
+	createPods(t, client, 2)
+
+	ctx := context.Background()
+	require.NoError(t, r.Start(ctx, componenttest.NewNopHost()))
+	require.Eventually(t, func() bool {
+		return r.resourceWatcher.initialSyncDone.Load()
+	}, 10*time.Second, 100*time.Millisecond)
+
+	deletePods(t, client, 1)
+
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 1
+	}, 10*time.Second, 100*time.Millisecond,
+		"entity events not collected")
+
+	lr := sink.AllLogs()[0].ResourceLogs().At(0).InstrumentationLibraryLogs().At(0).Logs().At(0)
+	event, ok := lr.Attributes().Get("k8s.entity.event")
+	require.True(t, ok)
+	require.Equal(t, "deleted", event.StringVal())
+
+	require.NoError(t, r.Shutdown(ctx))
+}
+
 func TestReceiverTimesOutAfterStartup(t *testing.T) {
 	tt, err := obsreporttest.SetupTelemetry()
 	require.NoError(t, err)
@@ -218,7 +253,7 @@ func setupReceiver(
 		resourceWatcher: rw,
 		settings:        tt.ToReceiverCreateSettings(),
 		config:          config,
-		consumer:        consumer,
+		metricsConsumer: consumer,
 		obsrecv: obsreport.NewReceiver(obsreport.ReceiverSettings{
 			ReceiverID:             config.ID(),
 			Transport:              "http",
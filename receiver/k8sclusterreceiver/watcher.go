@@ -25,6 +25,7 @@ import (
 	quotainformersv1 "github.com/openshift/client-go/quota/informers/externalversions"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/model/pdata"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 	appsv1 "k8s.io/api/apps/v1"
@@ -32,7 +33,11 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
@@ -57,10 +62,14 @@ type resourceWatcher struct {
 	initialTimeout      time.Duration
 	initialSyncDone     *atomic.Bool
 	initialSyncTimedOut *atomic.Bool
+	startTime           time.Time
+	entityEventConsumer entityEventConsumer
 }
 
 type metadataConsumer func(metadata []*metadata.MetadataUpdate) error
 
+type entityEventConsumer func(logs pdata.Logs)
+
 // newResourceWatcher creates a Kubernetes resource watcher.
 func newResourceWatcher(
 	logger *zap.Logger, client kubernetes.Interface, osQuotaClient quotaclientset.Interface,
@@ -74,6 +83,7 @@ func newResourceWatcher(
 		initialSyncDone:     atomic.NewBool(false),
 		initialSyncTimedOut: atomic.NewBool(false),
 		initialTimeout:      initialSyncTimeout,
+		startTime:           time.Now(),
 	}
 
 	rw.prepareSharedInformerFactory()
@@ -111,6 +121,33 @@ func (rw *resourceWatcher) prepareSharedInformerFactory() {
 	rw.informerFactories = append(rw.informerFactories, factory)
 }
 
+// dynamicSharedInformer adapts a dynamic informer factory to the sharedInformer interface.
+type dynamicSharedInformer struct {
+	dynamicinformer.DynamicSharedInformerFactory
+}
+
+func (d dynamicSharedInformer) WaitForCacheSync(stopCh <-chan struct{}) map[reflect.Type]bool {
+	d.DynamicSharedInformerFactory.WaitForCacheSync(stopCh)
+	return nil
+}
+
+// setupCustomResources adds informers for the configured custom resources.
+func (rw *resourceWatcher) setupCustomResources(client dynamic.Interface, cfgs []collection.CustomResourceConfig) error {
+	if len(cfgs) == 0 {
+		return nil
+	}
+	if err := rw.dataCollector.SetupCustomResources(cfgs); err != nil {
+		return err
+	}
+
+	factory := dynamicinformer.NewDynamicSharedInformerFactory(client, 0)
+	for _, cfg := range cfgs {
+		rw.setupInformers(&unstructured.Unstructured{}, factory.ForResource(cfg.GroupVersionResource()).Informer())
+	}
+	rw.informerFactories = append(rw.informerFactories, dynamicSharedInformer{factory})
+	return nil
+}
+
 // startWatchingResources starts up all informers.
 func (rw *resourceWatcher) startWatchingResources(ctx context.Context, inf sharedInformer) context.Context {
 	var cancel context.CancelFunc
@@ -144,6 +181,12 @@ func (rw *resourceWatcher) onAdd(obj interface{}) {
 	rw.waitForInitialInformerSync()
 	rw.dataCollector.SyncMetrics(obj)
 
+	// Objects listed on startup are added as well, only report the ones
+	// created since the watcher started.
+	if o, ok := obj.(metav1.Object); ok && !o.GetCreationTimestamp().Time.Before(rw.startTime.Truncate(time.Second)) {
+		rw.syncEntityEvents(nil, obj)
+	}
+
 	// Sync metadata only if there's at least one destination for it to sent.
 	if len(rw.metadataConsumers) == 0 {
 		return
@@ -156,12 +199,14 @@ func (rw *resourceWatcher) onAdd(obj interface{}) {
 func (rw *resourceWatcher) onDelete(obj interface{}) {
 	rw.waitForInitialInformerSync()
 	rw.dataCollector.RemoveFromMetricsStore(obj)
+	rw.syncEntityEvents(obj, nil)
 }
 
 func (rw *resourceWatcher) onUpdate(oldObj, newObj interface{}) {
 	rw.waitForInitialInformerSync()
 	// Sync metrics from the new object
 	rw.dataCollector.SyncMetrics(newObj)
+	rw.syncEntityEvents(oldObj, newObj)
 
 	// Sync metadata only if there's at least one destination for it to sent.
 	if len(rw.metadataConsumers) == 0 {
@@ -247,3 +292,16 @@ func (rw *resourceWatcher) syncMetadataUpdate(oldMetadata,
 		consume(metadataUpdate)
 	}
 }
+
+// syncEntityEvents sends lifecycle events of the object, if any, to the logs pipeline.
+func (rw *resourceWatcher) syncEntityEvents(oldObj, newObj interface{}) {
+	if rw.entityEventConsumer == nil {
+		return
+	}
+
+	logs := rw.dataCollector.GetEntityEvents(oldObj, newObj, time.Now())
+	if logs.LogRecordCount() == 0 {
+		return
+	}
+	rw.entityEventConsumer(logs)
+}
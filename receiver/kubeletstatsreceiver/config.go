@@ -46,6 +46,12 @@ type Config struct {
 	// "container", "pod", "node" and "volume" are the only valid groups.
 	MetricGroupsToCollect []kubelet.MetricGroup `mapstructure:"metric_groups"`
 
+	// ResourceMetricGroups provides a list of metric groups for which CPU and memory
+	// requests, limits and utilization relative to them should be collected.
+	// "container" and "pod" are the only valid groups. Setting this enables
+	// calls to the /pods endpoint to fetch the pod specs.
+	ResourceMetricGroups []kubelet.MetricGroup `mapstructure:"resource_metric_groups"`
+
 	// Configuration of the Kubernetes API client.
 	K8sAPIConfig *k8sconfig.APIConfig `mapstructure:"k8s_api_config"`
 }
@@ -59,9 +65,25 @@ func (cfg *Config) Validate() error {
 			return err
 		}
 	}
+	// The resource metrics are recorded along with the other metrics of their group,
+	// so a group which isn't collected would silently produce nothing.
+	for _, g := range cfg.ResourceMetricGroups {
+		if !containsMetricGroup(cfg.MetricGroupsToCollect, g) {
+			return fmt.Errorf("resource_metric_groups entry %q must also be listed in metric_groups", g)
+		}
+	}
 	return nil
 }
 
+func containsMetricGroup(groups []kubelet.MetricGroup, group kubelet.MetricGroup) bool {
+	for _, g := range groups {
+		if g == group {
+			return true
+		}
+	}
+	return false
+}
+
 // getReceiverOptions returns scraperOptions is the config is valid,
 // otherwise it will return an error.
 func (cfg *Config) getReceiverOptions() (*scraperOptions, error) {
@@ -75,6 +97,11 @@ func (cfg *Config) getReceiverOptions() (*scraperOptions, error) {
 		return nil, err
 	}
 
+	rmgs, err := getResourceMetricGroups(cfg.ResourceMetricGroups)
+	if err != nil {
+		return nil, err
+	}
+
 	var k8sAPIClient kubernetes.Interface
 	if cfg.K8sAPIConfig != nil {
 		k8sAPIClient, err = k8sconfig.MakeClient(*cfg.K8sAPIConfig)
@@ -88,6 +115,7 @@ func (cfg *Config) getReceiverOptions() (*scraperOptions, error) {
 		collectionInterval:    cfg.CollectionInterval,
 		extraMetadataLabels:   cfg.ExtraMetadataLabels,
 		metricGroupsToCollect: mgs,
+		resourceMetricGroups:  rmgs,
 		k8sAPIClient:          k8sAPIClient,
 	}, nil
 }
@@ -106,6 +134,20 @@ func getMapFromSlice(collect []kubelet.MetricGroup) (map[kubelet.MetricGroup]boo
 	return out, nil
 }
 
+// getResourceMetricGroups returns a set of kubelet.MetricGroup values for which
+// resource metrics are collected. Returns an err if invalid entries are encountered.
+func getResourceMetricGroups(groups []kubelet.MetricGroup) (map[kubelet.MetricGroup]bool, error) {
+	out := make(map[kubelet.MetricGroup]bool, len(groups))
+	for _, g := range groups {
+		if !kubelet.ValidResourceMetricGroups[g] {
+			return nil, fmt.Errorf("invalid entry in resource_metric_groups: %q", g)
+		}
+		out[g] = true
+	}
+
+	return out, nil
+}
+
 func (cfg *Config) Unmarshal(componentParser *config.Map) error {
 	if componentParser == nil {
 		// Nothing to do if there is no config given.
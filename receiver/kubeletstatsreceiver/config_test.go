@@ -168,12 +168,69 @@ func TestLoadConfig(t *testing.T) {
 		},
 		K8sAPIConfig: &k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeKubeConfig},
 	}, metadataWithK8sAPICfg)
+
+	resourceMetricGroupsCfg := cfg.Receivers[config.NewComponentIDWithName(typeStr, "resource_metric_groups")].(*Config)
+	require.Equal(t, &Config{
+		ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+			ReceiverSettings:   config.NewReceiverSettings(config.NewComponentIDWithName(typeStr, "resource_metric_groups")),
+			CollectionInterval: duration,
+		},
+		ClientConfig: kube.ClientConfig{
+			APIConfig: k8sconfig.APIConfig{
+				AuthType: "serviceAccount",
+			},
+		},
+		MetricGroupsToCollect: []kubelet.MetricGroup{
+			kubelet.ContainerMetricGroup,
+			kubelet.PodMetricGroup,
+			kubelet.NodeMetricGroup,
+		},
+		ResourceMetricGroups: []kubelet.MetricGroup{
+			kubelet.ContainerMetricGroup,
+			kubelet.PodMetricGroup,
+		},
+	}, resourceMetricGroupsCfg)
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name                  string
+		metricGroupsToCollect []kubelet.MetricGroup
+		resourceMetricGroups  []kubelet.MetricGroup
+		wantErr               string
+	}{
+		{
+			name:                  "resource metric groups collected",
+			metricGroupsToCollect: []kubelet.MetricGroup{kubelet.ContainerMetricGroup, kubelet.PodMetricGroup},
+			resourceMetricGroups:  []kubelet.MetricGroup{kubelet.ContainerMetricGroup, kubelet.PodMetricGroup},
+		},
+		{
+			name:                  "resource metric group not collected",
+			metricGroupsToCollect: []kubelet.MetricGroup{kubelet.PodMetricGroup, kubelet.NodeMetricGroup},
+			resourceMetricGroups:  []kubelet.MetricGroup{kubelet.ContainerMetricGroup},
+			wantErr:               `resource_metric_groups entry "container" must also be listed in metric_groups`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.MetricGroupsToCollect = tt.metricGroupsToCollect
+			cfg.ResourceMetricGroups = tt.resourceMetricGroups
+			err := cfg.Validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
 }
 
 func TestGetReceiverOptions(t *testing.T) {
 	type fields struct {
 		extraMetadataLabels   []kubelet.MetadataLabel
 		metricGroupsToCollect []kubelet.MetricGroup
+		resourceMetricGroups  []kubelet.MetricGroup
 		k8sAPIConfig          *k8sconfig.APIConfig
 	}
 	tests := []struct {
@@ -192,6 +249,9 @@ func TestGetReceiverOptions(t *testing.T) {
 					kubelet.NodeMetricGroup,
 					kubelet.PodMetricGroup,
 				},
+				resourceMetricGroups: []kubelet.MetricGroup{
+					kubelet.PodMetricGroup,
+				},
 			},
 			want: &scraperOptions{
 				id: config.NewComponentID(typeStr),
@@ -202,6 +262,9 @@ func TestGetReceiverOptions(t *testing.T) {
 					kubelet.NodeMetricGroup: true,
 					kubelet.PodMetricGroup:  true,
 				},
+				resourceMetricGroups: map[kubelet.MetricGroup]bool{
+					kubelet.PodMetricGroup: true,
+				},
 				collectionInterval: 10 * time.Second,
 			},
 		},
@@ -225,6 +288,16 @@ func TestGetReceiverOptions(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name: "Invalid resource metric group",
+			fields: fields{
+				resourceMetricGroups: []kubelet.MetricGroup{
+					kubelet.NodeMetricGroup,
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name: "Fails to create k8s API client",
 			fields: fields{
@@ -243,6 +316,7 @@ func TestGetReceiverOptions(t *testing.T) {
 				},
 				ExtraMetadataLabels:   tt.fields.extraMetadataLabels,
 				MetricGroupsToCollect: tt.fields.metricGroupsToCollect,
+				ResourceMetricGroups:  tt.fields.resourceMetricGroups,
 				K8sAPIConfig:          tt.fields.k8sAPIConfig,
 			}
 			got, err := cfg.getReceiverOptions()
@@ -40,11 +40,19 @@ var ValidMetricGroups = map[MetricGroup]bool{
 	VolumeMetricGroup:    true,
 }
 
+// ValidResourceMetricGroups map of metric groups that support
+// resource requests and limits metrics.
+var ValidResourceMetricGroups = map[MetricGroup]bool{
+	ContainerMetricGroup: true,
+	PodMetricGroup:       true,
+}
+
 type metricDataAccumulator struct {
 	m                     []pdata.Metrics
 	metadata              Metadata
 	logger                *zap.Logger
 	metricGroupsToCollect map[MetricGroup]bool
+	resourceMetricGroups  map[MetricGroup]bool
 	time                  time.Time
 	typeStr               string
 }
@@ -98,6 +106,18 @@ func (a *metricDataAccumulator) podStats(s stats.PodStats) {
 	addFilesystemMetrics(ilm.Metrics(), podPrefix, s.EphemeralStorage, currentTime)
 	addNetworkMetrics(ilm.Metrics(), podPrefix, s.Network, startTime, currentTime)
 
+	if a.resourceMetricGroups[PodMetricGroup] {
+		r, err := a.metadata.getPodResources(s.PodRef.UID)
+		if err != nil {
+			a.logger.Warn(
+				"failed to fetch pod resources",
+				zap.String("pod", s.PodRef.Name),
+				zap.Error(err))
+		} else {
+			addResourceMetrics(ilm.Metrics(), podPrefix, r, s.CPU, s.Memory, currentTime)
+		}
+	}
+
 	a.m = append(a.m, md)
 }
 
@@ -126,6 +146,19 @@ func (a *metricDataAccumulator) containerStats(sPod stats.PodStats, s stats.Cont
 	addCPUMetrics(ilm.Metrics(), containerPrefix, s.CPU, startTime, currentTime)
 	addMemoryMetrics(ilm.Metrics(), containerPrefix, s.Memory, currentTime)
 	addFilesystemMetrics(ilm.Metrics(), containerPrefix, s.Rootfs, currentTime)
+
+	if a.resourceMetricGroups[ContainerMetricGroup] {
+		r, err := a.metadata.getContainerResources(sPod.PodRef.UID, s.Name)
+		if err != nil {
+			a.logger.Warn(
+				"failed to fetch container resources",
+				zap.String("pod", sPod.PodRef.Name),
+				zap.String("container", s.Name),
+				zap.Error(err))
+		} else {
+			addResourceMetrics(ilm.Metrics(), containerPrefix, r, s.CPU, s.Memory, currentTime)
+		}
+	}
 	a.m = append(a.m, md)
 }
 
@@ -21,6 +21,7 @@ import (
 
 	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -146,3 +147,72 @@ func (m *Metadata) setExtraVolumeMetadata(podUID string, volumeName string, labe
 
 	return fmt.Errorf("pod %q with volume %q not found in the fetched metadata", podUID, volumeName)
 }
+
+// getContainerResources retrieves the resource requests and limits from the pod spec for given pod UID
+// and container name, returns an error if no container found in the metadata that matches the requirements.
+func (m *Metadata) getContainerResources(podUID string, containerName string) (v1.ResourceRequirements, error) {
+	if m.PodsMetadata == nil {
+		return v1.ResourceRequirements{}, errors.New("pods metadata were not fetched")
+	}
+
+	uid := types.UID(podUID)
+	for _, pod := range m.PodsMetadata.Items {
+		if pod.UID == uid {
+			for _, container := range pod.Spec.Containers {
+				if containerName == container.Name {
+					return container.Resources, nil
+				}
+			}
+		}
+	}
+
+	return v1.ResourceRequirements{}, fmt.Errorf("pod %q with container %q not found in the fetched metadata", podUID, containerName)
+}
+
+// getPodResources retrieves the resource requests and limits of a pod with given UID
+// by summing the values declared by each of its containers. A pod limit is only
+// reported for a resource when every container of the pod sets a limit for it.
+func (m *Metadata) getPodResources(podUID string) (v1.ResourceRequirements, error) {
+	if m.PodsMetadata == nil {
+		return v1.ResourceRequirements{}, errors.New("pods metadata were not fetched")
+	}
+
+	uid := types.UID(podUID)
+	for _, pod := range m.PodsMetadata.Items {
+		if pod.UID == uid {
+			return sumContainerResources(pod.Spec.Containers), nil
+		}
+	}
+
+	return v1.ResourceRequirements{}, fmt.Errorf("pod %q not found in the fetched metadata", podUID)
+}
+
+func sumContainerResources(containers []v1.Container) v1.ResourceRequirements {
+	out := v1.ResourceRequirements{
+		Requests: v1.ResourceList{},
+		Limits:   v1.ResourceList{},
+	}
+	for _, name := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+		var requested bool
+		var request, limit resource.Quantity
+		limited := len(containers) > 0
+		for _, container := range containers {
+			if q, ok := container.Resources.Requests[name]; ok {
+				request.Add(q)
+				requested = true
+			}
+			if q, ok := container.Resources.Limits[name]; ok {
+				limit.Add(q)
+			} else {
+				limited = false
+			}
+		}
+		if requested {
+			out.Requests[name] = request
+		}
+		if limited {
+			out.Limits[name] = limit
+		}
+	}
+	return out
+}
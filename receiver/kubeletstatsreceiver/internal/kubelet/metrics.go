@@ -25,11 +25,13 @@ import (
 func MetricsData(
 	logger *zap.Logger, summary *stats.Summary,
 	metadata Metadata, typeStr string,
-	metricGroupsToCollect map[MetricGroup]bool) []pdata.Metrics {
+	metricGroupsToCollect map[MetricGroup]bool,
+	resourceMetricGroups map[MetricGroup]bool) []pdata.Metrics {
 	acc := &metricDataAccumulator{
 		metadata:              metadata,
 		logger:                logger,
 		metricGroupsToCollect: metricGroupsToCollect,
+		resourceMetricGroups:  resourceMetricGroups,
 		time:                  time.Now(),
 		typeStr:               typeStr,
 	}
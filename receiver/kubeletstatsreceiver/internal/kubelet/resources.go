@@ -0,0 +1,65 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubelet // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/kubelet"
+
+import (
+	"go.opentelemetry.io/collector/model/pdata"
+	v1 "k8s.io/api/core/v1"
+	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/metadata"
+)
+
+// addResourceMetrics emits the CPU and memory requests and limits taken from the pod spec
+// along with the current usage expressed as a ratio of each of them.
+func addResourceMetrics(
+	dest pdata.MetricSlice, prefix string, r v1.ResourceRequirements,
+	cpu *stats.CPUStats, mem *stats.MemoryStats, currentTime pdata.Timestamp) {
+	var cpuUsage, memUsage *float64
+	if cpu != nil && cpu.UsageNanoCores != nil {
+		v := float64(*cpu.UsageNanoCores) / 1_000_000_000
+		cpuUsage = &v
+	}
+	if mem != nil && mem.WorkingSetBytes != nil {
+		v := float64(*mem.WorkingSetBytes)
+		memUsage = &v
+	}
+
+	if q, ok := r.Requests[v1.ResourceCPU]; ok {
+		cores := float64(q.MilliValue()) / 1_000
+		fillDoubleGauge(dest.AppendEmpty(), prefix, metadata.M.CPURequest, cores, currentTime)
+		addUtilization(dest, prefix, metadata.M.CPURequestUtilization, cpuUsage, cores, currentTime)
+	}
+	if q, ok := r.Limits[v1.ResourceCPU]; ok {
+		cores := float64(q.MilliValue()) / 1_000
+		fillDoubleGauge(dest.AppendEmpty(), prefix, metadata.M.CPULimit, cores, currentTime)
+		addUtilization(dest, prefix, metadata.M.CPULimitUtilization, cpuUsage, cores, currentTime)
+	}
+	if q, ok := r.Requests[v1.ResourceMemory]; ok {
+		fillIntGauge(dest.AppendEmpty(), prefix, metadata.M.MemoryRequest, q.Value(), currentTime)
+		addUtilization(dest, prefix, metadata.M.MemoryRequestUtilization, memUsage, float64(q.Value()), currentTime)
+	}
+	if q, ok := r.Limits[v1.ResourceMemory]; ok {
+		fillIntGauge(dest.AppendEmpty(), prefix, metadata.M.MemoryLimit, q.Value(), currentTime)
+		addUtilization(dest, prefix, metadata.M.MemoryLimitUtilization, memUsage, float64(q.Value()), currentTime)
+	}
+}
+
+func addUtilization(dest pdata.MetricSlice, prefix string, metricInt metadata.MetricIntf, usage *float64, total float64, currentTime pdata.Timestamp) {
+	if usage == nil || total == 0 {
+		return
+	}
+	fillDoubleGauge(dest.AppendEmpty(), prefix, metricInt, *usage/total, currentTime)
+}
@@ -0,0 +1,163 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubelet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
+)
+
+func resourcesPodList() *v1.PodList {
+	return &v1.PodList{
+		Items: []v1.Pod{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: "pod-uid-123",
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name: "container1",
+							Resources: v1.ResourceRequirements{
+								Requests: v1.ResourceList{
+									v1.ResourceCPU:    resource.MustParse("500m"),
+									v1.ResourceMemory: resource.MustParse("100Mi"),
+								},
+								Limits: v1.ResourceList{
+									v1.ResourceCPU:    resource.MustParse("1"),
+									v1.ResourceMemory: resource.MustParse("200Mi"),
+								},
+							},
+						},
+						{
+							Name: "container2",
+							Resources: v1.ResourceRequirements{
+								Requests: v1.ResourceList{
+									v1.ResourceCPU: resource.MustParse("250m"),
+								},
+								Limits: v1.ResourceList{
+									v1.ResourceCPU: resource.MustParse("500m"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestGetContainerResources(t *testing.T) {
+	metadata := NewMetadata(nil, resourcesPodList(), nil)
+
+	r, err := metadata.getContainerResources("pod-uid-123", "container1")
+	require.NoError(t, err)
+	assert.Equal(t, "500m", r.Requests.Cpu().String())
+	assert.Equal(t, "200Mi", r.Limits.Memory().String())
+
+	_, err = metadata.getContainerResources("pod-uid-123", "container3")
+	assert.EqualError(t, err, `pod "pod-uid-123" with container "container3" not found in the fetched metadata`)
+
+	empty := NewMetadata(nil, nil, nil)
+	_, err = empty.getContainerResources("pod-uid-123", "container1")
+	assert.EqualError(t, err, "pods metadata were not fetched")
+}
+
+func TestGetPodResources(t *testing.T) {
+	metadata := NewMetadata(nil, resourcesPodList(), nil)
+
+	r, err := metadata.getPodResources("pod-uid-123")
+	require.NoError(t, err)
+	assert.Equal(t, int64(750), r.Requests.Cpu().MilliValue())
+	assert.Equal(t, int64(1500), r.Limits.Cpu().MilliValue())
+	assert.Equal(t, int64(100*1024*1024), r.Requests.Memory().Value())
+	// container2 has no memory limit, so the pod is not limited.
+	_, ok := r.Limits[v1.ResourceMemory]
+	assert.False(t, ok)
+
+	_, err = metadata.getPodResources("pod-uid-456")
+	assert.EqualError(t, err, `pod "pod-uid-456" not found in the fetched metadata`)
+}
+
+func TestResourceMetrics(t *testing.T) {
+	nanoCores := uint64(250_000_000)
+	workingSet := uint64(50 * 1024 * 1024)
+	acc := metricDataAccumulator{
+		metadata: NewMetadata(nil, resourcesPodList(), nil),
+		logger:   zap.NewNop(),
+		metricGroupsToCollect: map[MetricGroup]bool{
+			ContainerMetricGroup: true,
+		},
+		resourceMetricGroups: map[MetricGroup]bool{
+			ContainerMetricGroup: true,
+		},
+		time: time.Now(),
+	}
+	acc.containerStats(
+		stats.PodStats{PodRef: stats.PodReference{UID: "pod-uid-123"}},
+		stats.ContainerStats{
+			Name:   "container1",
+			CPU:    &stats.CPUStats{UsageNanoCores: &nanoCores},
+			Memory: &stats.MemoryStats{WorkingSetBytes: &workingSet},
+		},
+	)
+	require.Equal(t, 1, len(acc.m))
+
+	values := map[string]pdata.NumberDataPoint{}
+	ms := acc.m[0].ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		values[ms.At(i).Name()] = ms.At(i).Gauge().DataPoints().At(0)
+	}
+
+	assert.Equal(t, 0.5, values["container.cpu.request"].DoubleVal())
+	assert.Equal(t, 1.0, values["container.cpu.limit"].DoubleVal())
+	assert.Equal(t, 0.5, values["container.cpu.request_utilization"].DoubleVal())
+	assert.Equal(t, 0.25, values["container.cpu.limit_utilization"].DoubleVal())
+	assert.Equal(t, int64(100*1024*1024), values["container.memory.request"].IntVal())
+	assert.Equal(t, int64(200*1024*1024), values["container.memory.limit"].IntVal())
+	assert.Equal(t, 0.5, values["container.memory.request_utilization"].DoubleVal())
+	assert.Equal(t, 0.25, values["container.memory.limit_utilization"].DoubleVal())
+}
+
+func TestResourceMetricsMissingMetadata(t *testing.T) {
+	observedLogger, logs := observer.New(zapcore.WarnLevel)
+	acc := metricDataAccumulator{
+		metadata: NewMetadata(nil, nil, nil),
+		logger:   zap.New(observedLogger),
+		metricGroupsToCollect: map[MetricGroup]bool{
+			PodMetricGroup: true,
+		},
+		resourceMetricGroups: map[MetricGroup]bool{
+			PodMetricGroup: true,
+		},
+	}
+	acc.podStats(stats.PodStats{PodRef: stats.PodReference{UID: "pod-uid-123"}})
+
+	// Usage metrics are still reported when the pod spec cannot be found.
+	assert.Equal(t, 1, len(acc.m))
+	require.Equal(t, 1, logs.Len())
+	assert.Equal(t, "failed to fetch pod resources", logs.All()[0].Message)
+}
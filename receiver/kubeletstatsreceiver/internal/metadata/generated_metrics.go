@@ -41,37 +41,53 @@ func (m *metricImpl) Init(metric pdata.Metric) {
 }
 
 type metricStruct struct {
-	CPUTime               MetricIntf
-	CPUUtilization        MetricIntf
-	FilesystemAvailable   MetricIntf
-	FilesystemCapacity    MetricIntf
-	FilesystemUsage       MetricIntf
-	MemoryAvailable       MetricIntf
-	MemoryMajorPageFaults MetricIntf
-	MemoryPageFaults      MetricIntf
-	MemoryRss             MetricIntf
-	MemoryUsage           MetricIntf
-	MemoryWorkingSet      MetricIntf
-	NetworkErrors         MetricIntf
-	NetworkIo             MetricIntf
-	VolumeAvailable       MetricIntf
-	VolumeCapacity        MetricIntf
-	VolumeInodes          MetricIntf
-	VolumeInodesFree      MetricIntf
-	VolumeInodesUsed      MetricIntf
+	CPULimit                 MetricIntf
+	CPULimitUtilization      MetricIntf
+	CPURequest               MetricIntf
+	CPURequestUtilization    MetricIntf
+	CPUTime                  MetricIntf
+	CPUUtilization           MetricIntf
+	FilesystemAvailable      MetricIntf
+	FilesystemCapacity       MetricIntf
+	FilesystemUsage          MetricIntf
+	MemoryAvailable          MetricIntf
+	MemoryLimit              MetricIntf
+	MemoryLimitUtilization   MetricIntf
+	MemoryMajorPageFaults    MetricIntf
+	MemoryPageFaults         MetricIntf
+	MemoryRequest            MetricIntf
+	MemoryRequestUtilization MetricIntf
+	MemoryRss                MetricIntf
+	MemoryUsage              MetricIntf
+	MemoryWorkingSet         MetricIntf
+	NetworkErrors            MetricIntf
+	NetworkIo                MetricIntf
+	VolumeAvailable          MetricIntf
+	VolumeCapacity           MetricIntf
+	VolumeInodes             MetricIntf
+	VolumeInodesFree         MetricIntf
+	VolumeInodesUsed         MetricIntf
 }
 
 // Names returns a list of all the metric name strings.
 func (m *metricStruct) Names() []string {
 	return []string{
+		"cpu.limit",
+		"cpu.limit_utilization",
+		"cpu.request",
+		"cpu.request_utilization",
 		"cpu.time",
 		"cpu.utilization",
 		"filesystem.available",
 		"filesystem.capacity",
 		"filesystem.usage",
 		"memory.available",
+		"memory.limit",
+		"memory.limit_utilization",
 		"memory.major_page_faults",
 		"memory.page_faults",
+		"memory.request",
+		"memory.request_utilization",
 		"memory.rss",
 		"memory.usage",
 		"memory.working_set",
@@ -86,24 +102,32 @@ func (m *metricStruct) Names() []string {
 }
 
 var metricsByName = map[string]MetricIntf{
-	"cpu.time":                 Metrics.CPUTime,
-	"cpu.utilization":          Metrics.CPUUtilization,
-	"filesystem.available":     Metrics.FilesystemAvailable,
-	"filesystem.capacity":      Metrics.FilesystemCapacity,
-	"filesystem.usage":         Metrics.FilesystemUsage,
-	"memory.available":         Metrics.MemoryAvailable,
-	"memory.major_page_faults": Metrics.MemoryMajorPageFaults,
-	"memory.page_faults":       Metrics.MemoryPageFaults,
-	"memory.rss":               Metrics.MemoryRss,
-	"memory.usage":             Metrics.MemoryUsage,
-	"memory.working_set":       Metrics.MemoryWorkingSet,
-	"network.errors":           Metrics.NetworkErrors,
-	"network.io":               Metrics.NetworkIo,
-	"volume.available":         Metrics.VolumeAvailable,
-	"volume.capacity":          Metrics.VolumeCapacity,
-	"volume.inodes":            Metrics.VolumeInodes,
-	"volume.inodes.free":       Metrics.VolumeInodesFree,
-	"volume.inodes.used":       Metrics.VolumeInodesUsed,
+	"cpu.limit":                  Metrics.CPULimit,
+	"cpu.limit_utilization":      Metrics.CPULimitUtilization,
+	"cpu.request":                Metrics.CPURequest,
+	"cpu.request_utilization":    Metrics.CPURequestUtilization,
+	"cpu.time":                   Metrics.CPUTime,
+	"cpu.utilization":            Metrics.CPUUtilization,
+	"filesystem.available":       Metrics.FilesystemAvailable,
+	"filesystem.capacity":        Metrics.FilesystemCapacity,
+	"filesystem.usage":           Metrics.FilesystemUsage,
+	"memory.available":           Metrics.MemoryAvailable,
+	"memory.limit":               Metrics.MemoryLimit,
+	"memory.limit_utilization":   Metrics.MemoryLimitUtilization,
+	"memory.major_page_faults":   Metrics.MemoryMajorPageFaults,
+	"memory.page_faults":         Metrics.MemoryPageFaults,
+	"memory.request":             Metrics.MemoryRequest,
+	"memory.request_utilization": Metrics.MemoryRequestUtilization,
+	"memory.rss":                 Metrics.MemoryRss,
+	"memory.usage":               Metrics.MemoryUsage,
+	"memory.working_set":         Metrics.MemoryWorkingSet,
+	"network.errors":             Metrics.NetworkErrors,
+	"network.io":                 Metrics.NetworkIo,
+	"volume.available":           Metrics.VolumeAvailable,
+	"volume.capacity":            Metrics.VolumeCapacity,
+	"volume.inodes":              Metrics.VolumeInodes,
+	"volume.inodes.free":         Metrics.VolumeInodesFree,
+	"volume.inodes.used":         Metrics.VolumeInodesUsed,
 }
 
 func (m *metricStruct) ByName(n string) MetricIntf {
@@ -113,6 +137,42 @@ func (m *metricStruct) ByName(n string) MetricIntf {
 // Metrics contains a set of methods for each metric that help with
 // manipulating those metrics.
 var Metrics = &metricStruct{
+	&metricImpl{
+		"cpu.limit",
+		func(metric pdata.Metric) {
+			metric.SetName("cpu.limit")
+			metric.SetDescription("CPU limit set in the pod spec, in cores")
+			metric.SetUnit("1")
+			metric.SetDataType(pdata.MetricDataTypeGauge)
+		},
+	},
+	&metricImpl{
+		"cpu.limit_utilization",
+		func(metric pdata.Metric) {
+			metric.SetName("cpu.limit_utilization")
+			metric.SetDescription("CPU usage as a ratio of the CPU limit")
+			metric.SetUnit("1")
+			metric.SetDataType(pdata.MetricDataTypeGauge)
+		},
+	},
+	&metricImpl{
+		"cpu.request",
+		func(metric pdata.Metric) {
+			metric.SetName("cpu.request")
+			metric.SetDescription("CPU requested in the pod spec, in cores")
+			metric.SetUnit("1")
+			metric.SetDataType(pdata.MetricDataTypeGauge)
+		},
+	},
+	&metricImpl{
+		"cpu.request_utilization",
+		func(metric pdata.Metric) {
+			metric.SetName("cpu.request_utilization")
+			metric.SetDescription("CPU usage as a ratio of the CPU request")
+			metric.SetUnit("1")
+			metric.SetDataType(pdata.MetricDataTypeGauge)
+		},
+	},
 	&metricImpl{
 		"cpu.time",
 		func(metric pdata.Metric) {
@@ -169,6 +229,24 @@ var Metrics = &metricStruct{
 			metric.SetDataType(pdata.MetricDataTypeGauge)
 		},
 	},
+	&metricImpl{
+		"memory.limit",
+		func(metric pdata.Metric) {
+			metric.SetName("memory.limit")
+			metric.SetDescription("Memory limit set in the pod spec")
+			metric.SetUnit("By")
+			metric.SetDataType(pdata.MetricDataTypeGauge)
+		},
+	},
+	&metricImpl{
+		"memory.limit_utilization",
+		func(metric pdata.Metric) {
+			metric.SetName("memory.limit_utilization")
+			metric.SetDescription("Memory working set as a ratio of the memory limit")
+			metric.SetUnit("1")
+			metric.SetDataType(pdata.MetricDataTypeGauge)
+		},
+	},
 	&metricImpl{
 		"memory.major_page_faults",
 		func(metric pdata.Metric) {
@@ -187,6 +265,24 @@ var Metrics = &metricStruct{
 			metric.SetDataType(pdata.MetricDataTypeGauge)
 		},
 	},
+	&metricImpl{
+		"memory.request",
+		func(metric pdata.Metric) {
+			metric.SetName("memory.request")
+			metric.SetDescription("Memory requested in the pod spec")
+			metric.SetUnit("By")
+			metric.SetDataType(pdata.MetricDataTypeGauge)
+		},
+	},
+	&metricImpl{
+		"memory.request_utilization",
+		func(metric pdata.Metric) {
+			metric.SetName("memory.request_utilization")
+			metric.SetDescription("Memory working set as a ratio of the memory request")
+			metric.SetUnit("1")
+			metric.SetDataType(pdata.MetricDataTypeGauge)
+		},
+	},
 	&metricImpl{
 		"memory.rss",
 		func(metric pdata.Metric) {
@@ -36,6 +36,7 @@ type scraperOptions struct {
 	collectionInterval    time.Duration
 	extraMetadataLabels   []kubelet.MetadataLabel
 	metricGroupsToCollect map[kubelet.MetricGroup]bool
+	resourceMetricGroups  map[kubelet.MetricGroup]bool
 	k8sAPIClient          kubernetes.Interface
 }
 
@@ -45,6 +46,7 @@ type kubletScraper struct {
 	logger                *zap.Logger
 	extraMetadataLabels   []kubelet.MetadataLabel
 	metricGroupsToCollect map[kubelet.MetricGroup]bool
+	resourceMetricGroups  map[kubelet.MetricGroup]bool
 	k8sAPIClient          kubernetes.Interface
 	cachedVolumeLabels    map[string]map[string]string
 }
@@ -60,6 +62,7 @@ func newKubletScraper(
 		logger:                set.Logger,
 		extraMetadataLabels:   rOptions.extraMetadataLabels,
 		metricGroupsToCollect: rOptions.metricGroupsToCollect,
+		resourceMetricGroups:  rOptions.resourceMetricGroups,
 		k8sAPIClient:          rOptions.k8sAPIClient,
 		cachedVolumeLabels:    make(map[string]map[string]string),
 	}
@@ -74,8 +77,8 @@ func (r *kubletScraper) scrape(context.Context) (pdata.Metrics, error) {
 	}
 
 	var podsMetadata *v1.PodList
-	// fetch metadata only when extra metadata labels are needed
-	if len(r.extraMetadataLabels) > 0 {
+	// fetch metadata only when extra metadata labels or resource metrics are needed
+	if len(r.extraMetadataLabels) > 0 || len(r.resourceMetricGroups) > 0 {
 		podsMetadata, err = r.metadataProvider.Pods()
 		if err != nil {
 			r.logger.Error("call to /pods endpoint failed", zap.Error(err))
@@ -84,7 +87,7 @@ func (r *kubletScraper) scrape(context.Context) (pdata.Metrics, error) {
 	}
 
 	metadata := kubelet.NewMetadata(r.extraMetadataLabels, podsMetadata, r.detailedPVCLabelsSetter())
-	mds := kubelet.MetricsData(r.logger, summary, metadata, typeStr, r.metricGroupsToCollect)
+	mds := kubelet.MetricsData(r.logger, summary, metadata, typeStr, r.metricGroupsToCollect, r.resourceMetricGroups)
 	md := pdata.NewMetrics()
 	for i := range mds {
 		mds[i].ResourceMetrics().MoveAndAppendTo(md.ResourceMetrics())
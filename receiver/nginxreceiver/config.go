@@ -15,11 +15,37 @@
 package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver"
 
 import (
+	"fmt"
+
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 )
 
+const (
+	// apiStubStatus is the page of the ngx_http_stub_status_module.
+	apiStubStatus = "stub_status"
+	// apiPlus is the NGINX Plus REST API.
+	apiPlus = "plus"
+	// apiVTS is the JSON page of the nginx-module-vts virtual host traffic status module.
+	apiVTS = "vts"
+)
+
 type Config struct {
 	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
 	confighttp.HTTPClientSettings           `mapstructure:",squash"`
+
+	// API is the kind of status served at the endpoint: "stub_status", "plus"
+	// for the NGINX Plus API, whose endpoint includes the API version, e.g.
+	// http://localhost:8080/api/7, or "vts" for the JSON page of the VTS module,
+	// e.g. http://localhost/status/format/json. Default is "stub_status".
+	API string `mapstructure:"api"`
+}
+
+func (cfg *Config) Validate() error {
+	switch cfg.API {
+	case "", apiStubStatus, apiPlus, apiVTS:
+		return nil
+	default:
+		return fmt.Errorf("api must be one of %q, %q or %q, got %q", apiStubStatus, apiPlus, apiVTS, cfg.API)
+	}
 }
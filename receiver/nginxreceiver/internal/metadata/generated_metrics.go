@@ -41,10 +41,19 @@ func (m *metricImpl) Init(metric pdata.Metric) {
 }
 
 type metricStruct struct {
-	NginxConnectionsAccepted MetricIntf
-	NginxConnectionsCurrent  MetricIntf
-	NginxConnectionsHandled  MetricIntf
-	NginxRequests            MetricIntf
+	NginxConnectionsAccepted      MetricIntf
+	NginxConnectionsCurrent       MetricIntf
+	NginxConnectionsHandled       MetricIntf
+	NginxRequests                 MetricIntf
+	NginxServerZoneIo             MetricIntf
+	NginxServerZoneRequests       MetricIntf
+	NginxServerZoneResponses      MetricIntf
+	NginxUpstreamPeerFails        MetricIntf
+	NginxUpstreamPeerHealth       MetricIntf
+	NginxUpstreamPeerIo           MetricIntf
+	NginxUpstreamPeerRequests     MetricIntf
+	NginxUpstreamPeerResponseTime MetricIntf
+	NginxUpstreamPeerResponses    MetricIntf
 }
 
 // Names returns a list of all the metric name strings.
@@ -54,14 +63,32 @@ func (m *metricStruct) Names() []string {
 		"nginx.connections_current",
 		"nginx.connections_handled",
 		"nginx.requests",
+		"nginx.server_zone.io",
+		"nginx.server_zone.requests",
+		"nginx.server_zone.responses",
+		"nginx.upstream.peer.fails",
+		"nginx.upstream.peer.health",
+		"nginx.upstream.peer.io",
+		"nginx.upstream.peer.requests",
+		"nginx.upstream.peer.response_time",
+		"nginx.upstream.peer.responses",
 	}
 }
 
 var metricsByName = map[string]MetricIntf{
-	"nginx.connections_accepted": Metrics.NginxConnectionsAccepted,
-	"nginx.connections_current":  Metrics.NginxConnectionsCurrent,
-	"nginx.connections_handled":  Metrics.NginxConnectionsHandled,
-	"nginx.requests":             Metrics.NginxRequests,
+	"nginx.connections_accepted":        Metrics.NginxConnectionsAccepted,
+	"nginx.connections_current":         Metrics.NginxConnectionsCurrent,
+	"nginx.connections_handled":         Metrics.NginxConnectionsHandled,
+	"nginx.requests":                    Metrics.NginxRequests,
+	"nginx.server_zone.io":              Metrics.NginxServerZoneIo,
+	"nginx.server_zone.requests":        Metrics.NginxServerZoneRequests,
+	"nginx.server_zone.responses":       Metrics.NginxServerZoneResponses,
+	"nginx.upstream.peer.fails":         Metrics.NginxUpstreamPeerFails,
+	"nginx.upstream.peer.health":        Metrics.NginxUpstreamPeerHealth,
+	"nginx.upstream.peer.io":            Metrics.NginxUpstreamPeerIo,
+	"nginx.upstream.peer.requests":      Metrics.NginxUpstreamPeerRequests,
+	"nginx.upstream.peer.response_time": Metrics.NginxUpstreamPeerResponseTime,
+	"nginx.upstream.peer.responses":     Metrics.NginxUpstreamPeerResponses,
 }
 
 func (m *metricStruct) ByName(n string) MetricIntf {
@@ -113,6 +140,101 @@ var Metrics = &metricStruct{
 			metric.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
 		},
 	},
+	&metricImpl{
+		"nginx.server_zone.io",
+		func(metric pdata.Metric) {
+			metric.SetName("nginx.server_zone.io")
+			metric.SetDescription("The total number of bytes received from and sent to clients by the server zone. Only reported by the NGINX Plus API and the VTS module.")
+			metric.SetUnit("By")
+			metric.SetDataType(pdata.MetricDataTypeSum)
+			metric.Sum().SetIsMonotonic(true)
+			metric.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+		},
+	},
+	&metricImpl{
+		"nginx.server_zone.requests",
+		func(metric pdata.Metric) {
+			metric.SetName("nginx.server_zone.requests")
+			metric.SetDescription("The total number of client requests received by the server zone. Only reported by the NGINX Plus API and the VTS module.")
+			metric.SetUnit("requests")
+			metric.SetDataType(pdata.MetricDataTypeSum)
+			metric.Sum().SetIsMonotonic(true)
+			metric.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+		},
+	},
+	&metricImpl{
+		"nginx.server_zone.responses",
+		func(metric pdata.Metric) {
+			metric.SetName("nginx.server_zone.responses")
+			metric.SetDescription("The total number of responses sent to clients by the server zone, by response code class. Only reported by the NGINX Plus API and the VTS module.")
+			metric.SetUnit("responses")
+			metric.SetDataType(pdata.MetricDataTypeSum)
+			metric.Sum().SetIsMonotonic(true)
+			metric.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+		},
+	},
+	&metricImpl{
+		"nginx.upstream.peer.fails",
+		func(metric pdata.Metric) {
+			metric.SetName("nginx.upstream.peer.fails")
+			metric.SetDescription("The total number of unsuccessful attempts to communicate with the upstream peer. Only reported by the NGINX Plus API.")
+			metric.SetUnit("fails")
+			metric.SetDataType(pdata.MetricDataTypeSum)
+			metric.Sum().SetIsMonotonic(true)
+			metric.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+		},
+	},
+	&metricImpl{
+		"nginx.upstream.peer.health",
+		func(metric pdata.Metric) {
+			metric.SetName("nginx.upstream.peer.health")
+			metric.SetDescription("Whether the upstream peer is up (1) or not (0). Only reported by the NGINX Plus API and the VTS module.")
+			metric.SetUnit("1")
+			metric.SetDataType(pdata.MetricDataTypeGauge)
+		},
+	},
+	&metricImpl{
+		"nginx.upstream.peer.io",
+		func(metric pdata.Metric) {
+			metric.SetName("nginx.upstream.peer.io")
+			metric.SetDescription("The total number of bytes sent to and received from the upstream peer. Only reported by the NGINX Plus API and the VTS module.")
+			metric.SetUnit("By")
+			metric.SetDataType(pdata.MetricDataTypeSum)
+			metric.Sum().SetIsMonotonic(true)
+			metric.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+		},
+	},
+	&metricImpl{
+		"nginx.upstream.peer.requests",
+		func(metric pdata.Metric) {
+			metric.SetName("nginx.upstream.peer.requests")
+			metric.SetDescription("The total number of client requests forwarded to the upstream peer. Only reported by the NGINX Plus API and the VTS module.")
+			metric.SetUnit("requests")
+			metric.SetDataType(pdata.MetricDataTypeSum)
+			metric.Sum().SetIsMonotonic(true)
+			metric.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+		},
+	},
+	&metricImpl{
+		"nginx.upstream.peer.response_time",
+		func(metric pdata.Metric) {
+			metric.SetName("nginx.upstream.peer.response_time")
+			metric.SetDescription("The average time to get the full response from the upstream peer. Only reported by the NGINX Plus API and the VTS module.")
+			metric.SetUnit("ms")
+			metric.SetDataType(pdata.MetricDataTypeGauge)
+		},
+	},
+	&metricImpl{
+		"nginx.upstream.peer.responses",
+		func(metric pdata.Metric) {
+			metric.SetName("nginx.upstream.peer.responses")
+			metric.SetDescription("The total number of responses obtained from the upstream peer, by response code class. Only reported by the NGINX Plus API and the VTS module.")
+			metric.SetUnit("responses")
+			metric.SetDataType(pdata.MetricDataTypeSum)
+			metric.Sum().SetIsMonotonic(true)
+			metric.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+		},
+	},
 }
 
 // M contains a set of methods for each metric that help with
@@ -121,24 +243,50 @@ var M = Metrics
 
 // Attributes contains the possible metric attributes that can be used.
 var Attributes = struct {
+	// Direction (The direction of the transferred bytes)
+	Direction string
+	// Peer (The address of the upstream peer)
+	Peer string
 	// State (The state of a connection)
 	State string
+	// StatusClass (The class of the response status code, e.g. 2xx)
+	StatusClass string
+	// Upstream (The name of the upstream group)
+	Upstream string
+	// Zone (The name of the server zone)
+	Zone string
 }{
+	"direction",
+	"peer",
 	"state",
+	"status_class",
+	"upstream",
+	"zone",
 }
 
 // A is an alias for Attributes.
 var A = Attributes
 
+// AttributeDirection are the possible values that the attribute "direction" can have.
+var AttributeDirection = struct {
+	Received string
+	Sent     string
+}{
+	"received",
+	"sent",
+}
+
 // AttributeState are the possible values that the attribute "state" can have.
 var AttributeState = struct {
 	Active  string
 	Reading string
 	Writing string
 	Waiting string
+	Idle    string
 }{
 	"active",
 	"reading",
 	"writing",
 	"waiting",
+	"idle",
 }
@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver"
+
+import (
+	"context"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver/internal/metadata"
+)
+
+// The following types hold the fields used by the receiver of the documents
+// returned by the NGINX Plus API, see https://nginx.org/en/docs/http/ngx_http_api_module.html.
+
+type plusConnections struct {
+	Accepted int64 `json:"accepted"`
+	Dropped  int64 `json:"dropped"`
+	Active   int64 `json:"active"`
+	Idle     int64 `json:"idle"`
+}
+
+type plusRequests struct {
+	Total int64 `json:"total"`
+}
+
+type plusServerZone struct {
+	Requests  int64           `json:"requests"`
+	Responses responseClasses `json:"responses"`
+	Received  int64           `json:"received"`
+	Sent      int64           `json:"sent"`
+}
+
+type plusUpstream struct {
+	Peers []plusPeer `json:"peers"`
+}
+
+type plusPeer struct {
+	Server       string          `json:"server"`
+	State        string          `json:"state"`
+	Requests     int64           `json:"requests"`
+	Responses    responseClasses `json:"responses"`
+	Received     int64           `json:"received"`
+	Sent         int64           `json:"sent"`
+	Fails        int64           `json:"fails"`
+	ResponseTime float64         `json:"response_time"`
+}
+
+// plusStatus fetches the stats from the NGINX Plus API, whose versioned base
+// URL is the configured endpoint.
+func (r *nginxScraper) plusStatus(ctx context.Context) (*nginxStatus, error) {
+	var connections plusConnections
+	if err := r.getJSON(ctx, "/connections", &connections); err != nil {
+		return nil, err
+	}
+	var requests plusRequests
+	if err := r.getJSON(ctx, "/http/requests", &requests); err != nil {
+		return nil, err
+	}
+	var zones map[string]plusServerZone
+	if err := r.getJSON(ctx, "/http/server_zones", &zones); err != nil {
+		return nil, err
+	}
+	var upstreams map[string]plusUpstream
+	if err := r.getJSON(ctx, "/http/upstreams", &upstreams); err != nil {
+		return nil, err
+	}
+
+	status := &nginxStatus{
+		requests: requests.Total,
+		accepted: connections.Accepted,
+		handled:  connections.Accepted - connections.Dropped,
+		connections: []connectionState{
+			{metadata.AttributeState.Active, connections.Active},
+			{metadata.AttributeState.Idle, connections.Idle},
+		},
+		peerFails: true,
+	}
+
+	for _, name := range sortedKeys(zones) {
+		zone := zones[name]
+		status.serverZones = append(status.serverZones, serverZone{
+			name:      name,
+			requests:  zone.Requests,
+			responses: zone.Responses,
+			received:  zone.Received,
+			sent:      zone.Sent,
+		})
+	}
+
+	for _, name := range sortedKeys(upstreams) {
+		for _, peer := range upstreams[name].Peers {
+			status.upstreamPeers = append(status.upstreamPeers, upstreamPeer{
+				upstream:     name,
+				peer:         peer.Server,
+				requests:     peer.Requests,
+				responses:    peer.Responses,
+				received:     peer.Received,
+				sent:         peer.Sent,
+				responseTime: peer.ResponseTime,
+				up:           peer.State == "up",
+				fails:        peer.Fails,
+			})
+		}
+	}
+	return status, nil
+}
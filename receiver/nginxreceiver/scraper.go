@@ -55,7 +55,31 @@ func (r *nginxScraper) start(_ context.Context, host component.Host) error {
 	return nil
 }
 
-func (r *nginxScraper) scrape(context.Context) (pdata.Metrics, error) {
+func (r *nginxScraper) scrape(ctx context.Context) (pdata.Metrics, error) {
+	var status *nginxStatus
+	var err error
+	switch r.cfg.API {
+	case apiPlus:
+		status, err = r.plusStatus(ctx)
+	case apiVTS:
+		status, err = r.vtsStatus(ctx)
+	default:
+		status, err = r.stubStatus()
+	}
+	if err != nil {
+		r.logger.Error("Failed to fetch nginx stats", zap.Error(err))
+		return pdata.Metrics{}, err
+	}
+
+	now := pdata.NewTimestampFromTime(time.Now())
+	md := pdata.NewMetrics()
+	ilm := md.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty()
+	ilm.InstrumentationLibrary().SetName("otelcol/nginx")
+	recordStatus(ilm.Metrics(), now, status)
+	return md, nil
+}
+
+func (r *nginxScraper) stubStatus() (*nginxStatus, error) {
 	// Init client in scrape method in case there are transient errors in the
 	// constructor.
 	if r.client == nil {
@@ -63,34 +87,26 @@ func (r *nginxScraper) scrape(context.Context) (pdata.Metrics, error) {
 		r.client, err = client.NewNginxClient(r.httpClient, r.cfg.HTTPClientSettings.Endpoint)
 		if err != nil {
 			r.client = nil
-			return pdata.Metrics{}, err
+			return nil, err
 		}
 	}
 
 	stats, err := r.client.GetStubStats()
 	if err != nil {
-		r.logger.Error("Failed to fetch nginx stats", zap.Error(err))
-		return pdata.Metrics{}, err
+		return nil, err
 	}
 
-	now := pdata.NewTimestampFromTime(time.Now())
-	md := pdata.NewMetrics()
-	ilm := md.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty()
-	ilm.InstrumentationLibrary().SetName("otelcol/nginx")
-
-	addIntSum(ilm.Metrics(), metadata.M.NginxRequests.Init, now, stats.Requests)
-	addIntSum(ilm.Metrics(), metadata.M.NginxConnectionsAccepted.Init, now, stats.Connections.Accepted)
-	addIntSum(ilm.Metrics(), metadata.M.NginxConnectionsHandled.Init, now, stats.Connections.Handled)
-
-	currConnMetric := ilm.Metrics().AppendEmpty()
-	metadata.M.NginxConnectionsCurrent.Init(currConnMetric)
-	dps := currConnMetric.Gauge().DataPoints()
-	addCurrentConnectionDataPoint(dps, metadata.AttributeState.Active, now, stats.Connections.Active)
-	addCurrentConnectionDataPoint(dps, metadata.AttributeState.Reading, now, stats.Connections.Reading)
-	addCurrentConnectionDataPoint(dps, metadata.AttributeState.Writing, now, stats.Connections.Writing)
-	addCurrentConnectionDataPoint(dps, metadata.AttributeState.Waiting, now, stats.Connections.Waiting)
-
-	return md, nil
+	return &nginxStatus{
+		requests: stats.Requests,
+		accepted: stats.Connections.Accepted,
+		handled:  stats.Connections.Handled,
+		connections: []connectionState{
+			{metadata.AttributeState.Active, stats.Connections.Active},
+			{metadata.AttributeState.Reading, stats.Connections.Reading},
+			{metadata.AttributeState.Writing, stats.Connections.Writing},
+			{metadata.AttributeState.Waiting, stats.Connections.Waiting},
+		},
+	}, nil
 }
 
 func addIntSum(metrics pdata.MetricSlice, initFunc func(pdata.Metric), now pdata.Timestamp, value int64) {
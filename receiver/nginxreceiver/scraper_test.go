@@ -17,6 +17,7 @@ package nginxreceiver
 import (
 	"context"
 	"errors"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"path/filepath"
@@ -58,6 +59,83 @@ func TestScraper(t *testing.T) {
 	require.NoError(t, scrapertest.CompareMetricSlices(eMetricSlice, aMetricSlice))
 }
 
+func TestScraperPlus(t *testing.T) {
+	fixtures := map[string]string{
+		"/api/7/connections":       "connections.json",
+		"/api/7/http/requests":     "http_requests.json",
+		"/api/7/http/server_zones": "http_server_zones.json",
+		"/api/7/http/upstreams":    "http_upstreams.json",
+	}
+	nginxMock := newJSONMockServer(t, func(path string) string {
+		if f, ok := fixtures[path]; ok {
+			return filepath.Join("testdata", "plus", f)
+		}
+		return ""
+	})
+
+	testScraperWithAPI(t, apiPlus, nginxMock.URL+"/api/7", filepath.Join("testdata", "scraper", "expected_plus.json"))
+}
+
+func TestScraperVTS(t *testing.T) {
+	nginxMock := newJSONMockServer(t, func(path string) string {
+		if path == "/status/format/json" {
+			return filepath.Join("testdata", "vts", "status.json")
+		}
+		return ""
+	})
+
+	testScraperWithAPI(t, apiVTS, nginxMock.URL+"/status/format/json", filepath.Join("testdata", "scraper", "expected_vts.json"))
+}
+
+func testScraperWithAPI(t *testing.T, api string, endpoint string, expectedFile string) {
+	cfg := &Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: endpoint,
+		},
+		API: api,
+	}
+	require.NoError(t, cfg.Validate())
+
+	scraper := newNginxScraper(zap.NewNop(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	aMetricSlice := actualMetrics.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+
+	expectedMetrics, err := golden.ReadMetrics(expectedFile)
+	require.NoError(t, err)
+	eMetricSlice := expectedMetrics.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+
+	require.NoError(t, scrapertest.CompareMetricSlices(eMetricSlice, aMetricSlice))
+}
+
+func TestScraperPlusError(t *testing.T) {
+	nginxMock := newJSONMockServer(t, func(path string) string {
+		if path == "/api/7/connections" {
+			return filepath.Join("testdata", "plus", "connections.json")
+		}
+		return ""
+	})
+
+	sc := newNginxScraper(zap.NewNop(), &Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: nginxMock.URL + "/api/7",
+		},
+		API: apiPlus,
+	})
+	require.NoError(t, sc.start(context.Background(), componenttest.NewNopHost()))
+	_, err := sc.scrape(context.Background())
+	require.EqualError(t, err, "expected 200 response, got 404")
+}
+
+func TestConfigValidate(t *testing.T) {
+	for _, api := range []string{"", apiStubStatus, apiPlus, apiVTS} {
+		require.NoError(t, (&Config{API: api}).Validate())
+	}
+	require.EqualError(t, (&Config{API: "status"}).Validate(), `api must be one of "stub_status", "plus" or "vts", got "status"`)
+}
+
 func TestScraperError(t *testing.T) {
 	nginxMock := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		if req.URL.Path == "/status" {
@@ -122,3 +200,23 @@ Reading: 6 Writing: 179 Waiting: 106
 		rw.WriteHeader(404)
 	}))
 }
+
+// newJSONMockServer serves the JSON fixture returned by fixture for a request
+// path, or a 404 when it returns an empty path.
+func newJSONMockServer(t *testing.T, fixture func(path string) string) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		file := fixture(req.URL.Path)
+		if file == "" {
+			rw.WriteHeader(404)
+			return
+		}
+		body, err := ioutil.ReadFile(file)
+		require.NoError(t, err)
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(200)
+		_, err = rw.Write(body)
+		require.NoError(t, err)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
@@ -0,0 +1,235 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+
+	"go.opentelemetry.io/collector/model/pdata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver/internal/metadata"
+)
+
+// nginxStatus holds the stats reported by any of the supported APIs. The
+// server zones and upstream peers are only reported by NGINX Plus and VTS.
+type nginxStatus struct {
+	requests    int64
+	accepted    int64
+	handled     int64
+	connections []connectionState
+
+	serverZones   []serverZone
+	upstreamPeers []upstreamPeer
+	// peerFails is set when the API reports the failures of the upstream peers.
+	peerFails bool
+}
+
+type connectionState struct {
+	state string
+	count int64
+}
+
+type serverZone struct {
+	name      string
+	requests  int64
+	responses responseClasses
+	received  int64
+	sent      int64
+}
+
+type upstreamPeer struct {
+	upstream     string
+	peer         string
+	requests     int64
+	responses    responseClasses
+	received     int64
+	sent         int64
+	responseTime float64
+	up           bool
+	fails        int64
+}
+
+// responseClasses holds the number of responses by status code class, in the
+// format used by both the NGINX Plus API and the VTS module.
+type responseClasses struct {
+	Class1xx int64 `json:"1xx"`
+	Class2xx int64 `json:"2xx"`
+	Class3xx int64 `json:"3xx"`
+	Class4xx int64 `json:"4xx"`
+	Class5xx int64 `json:"5xx"`
+}
+
+type classCount struct {
+	class string
+	count int64
+}
+
+func (r responseClasses) byClass() []classCount {
+	return []classCount{
+		{"1xx", r.Class1xx},
+		{"2xx", r.Class2xx},
+		{"3xx", r.Class3xx},
+		{"4xx", r.Class4xx},
+		{"5xx", r.Class5xx},
+	}
+}
+
+// getJSON decodes the JSON document served at the given path of the endpoint.
+func (r *nginxScraper) getJSON(ctx context.Context, path string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.cfg.HTTPClientSettings.Endpoint+path, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("expected 200 response, got %d", resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode response body of %s: %w", req.URL, err)
+	}
+	return nil
+}
+
+func recordStatus(ms pdata.MetricSlice, now pdata.Timestamp, status *nginxStatus) {
+	addIntSum(ms, metadata.M.NginxRequests.Init, now, status.requests)
+	addIntSum(ms, metadata.M.NginxConnectionsAccepted.Init, now, status.accepted)
+	addIntSum(ms, metadata.M.NginxConnectionsHandled.Init, now, status.handled)
+
+	currConnMetric := ms.AppendEmpty()
+	metadata.M.NginxConnectionsCurrent.Init(currConnMetric)
+	dps := currConnMetric.Gauge().DataPoints()
+	for _, c := range status.connections {
+		addCurrentConnectionDataPoint(dps, c.state, now, c.count)
+	}
+
+	if len(status.serverZones) > 0 {
+		recordServerZones(ms, now, status.serverZones)
+	}
+	if len(status.upstreamPeers) > 0 {
+		recordUpstreamPeers(ms, now, status.upstreamPeers, status.peerFails)
+	}
+}
+
+func recordServerZones(ms pdata.MetricSlice, now pdata.Timestamp, zones []serverZone) {
+	requests := newDataPoints(ms, metadata.M.NginxServerZoneRequests)
+	responses := newDataPoints(ms, metadata.M.NginxServerZoneResponses)
+	io := newDataPoints(ms, metadata.M.NginxServerZoneIo)
+
+	for _, zone := range zones {
+		attrs := map[string]string{metadata.A.Zone: zone.name}
+		addIntDataPoint(requests, now, zone.requests, attrs)
+		addResponsesDataPoints(responses, now, zone.responses, attrs)
+		addIntDataPoint(io, now, zone.received, withAttribute(attrs, metadata.A.Direction, metadata.AttributeDirection.Received))
+		addIntDataPoint(io, now, zone.sent, withAttribute(attrs, metadata.A.Direction, metadata.AttributeDirection.Sent))
+	}
+}
+
+func recordUpstreamPeers(ms pdata.MetricSlice, now pdata.Timestamp, peers []upstreamPeer, withFails bool) {
+	requests := newDataPoints(ms, metadata.M.NginxUpstreamPeerRequests)
+	responses := newDataPoints(ms, metadata.M.NginxUpstreamPeerResponses)
+	io := newDataPoints(ms, metadata.M.NginxUpstreamPeerIo)
+	responseTime := newDataPoints(ms, metadata.M.NginxUpstreamPeerResponseTime)
+	health := newDataPoints(ms, metadata.M.NginxUpstreamPeerHealth)
+	var fails pdata.NumberDataPointSlice
+	if withFails {
+		fails = newDataPoints(ms, metadata.M.NginxUpstreamPeerFails)
+	}
+
+	for _, peer := range peers {
+		attrs := map[string]string{
+			metadata.A.Upstream: peer.upstream,
+			metadata.A.Peer:     peer.peer,
+		}
+		addIntDataPoint(requests, now, peer.requests, attrs)
+		addResponsesDataPoints(responses, now, peer.responses, attrs)
+		addIntDataPoint(io, now, peer.received, withAttribute(attrs, metadata.A.Direction, metadata.AttributeDirection.Received))
+		addIntDataPoint(io, now, peer.sent, withAttribute(attrs, metadata.A.Direction, metadata.AttributeDirection.Sent))
+
+		dp := responseTime.AppendEmpty()
+		dp.SetTimestamp(now)
+		dp.SetDoubleVal(peer.responseTime)
+		setAttributes(dp, attrs)
+
+		var up int64
+		if peer.up {
+			up = 1
+		}
+		addIntDataPoint(health, now, up, attrs)
+
+		if withFails {
+			addIntDataPoint(fails, now, peer.fails, attrs)
+		}
+	}
+}
+
+// newDataPoints appends the given metric and returns its data points.
+func newDataPoints(ms pdata.MetricSlice, metric metadata.MetricIntf) pdata.NumberDataPointSlice {
+	m := ms.AppendEmpty()
+	metric.Init(m)
+	if m.DataType() == pdata.MetricDataTypeGauge {
+		return m.Gauge().DataPoints()
+	}
+	return m.Sum().DataPoints()
+}
+
+func addResponsesDataPoints(dps pdata.NumberDataPointSlice, now pdata.Timestamp, responses responseClasses, attrs map[string]string) {
+	for _, c := range responses.byClass() {
+		addIntDataPoint(dps, now, c.count, withAttribute(attrs, metadata.A.StatusClass, c.class))
+	}
+}
+
+func addIntDataPoint(dps pdata.NumberDataPointSlice, now pdata.Timestamp, value int64, attrs map[string]string) {
+	dp := dps.AppendEmpty()
+	dp.SetTimestamp(now)
+	dp.SetIntVal(value)
+	setAttributes(dp, attrs)
+}
+
+func setAttributes(dp pdata.NumberDataPoint, attrs map[string]string) {
+	for k, v := range attrs {
+		dp.Attributes().UpsertString(k, v)
+	}
+	dp.Attributes().Sort()
+}
+
+// withAttribute returns a copy of the attributes with an additional one.
+func withAttribute(attrs map[string]string, key, value string) map[string]string {
+	res := make(map[string]string, len(attrs)+1)
+	for k, v := range attrs {
+		res[k] = v
+	}
+	res[key] = value
+	return res
+}
+
+// sortedKeys returns the keys of a map decoded from a JSON object in order.
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	for _, k := range reflect.ValueOf(m).MapKeys() {
+		keys = append(keys, k.String())
+	}
+	sort.Strings(keys)
+	return keys
+}
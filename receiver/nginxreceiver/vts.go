@@ -0,0 +1,110 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver"
+
+import (
+	"context"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver/internal/metadata"
+)
+
+// vtsAllZones is the server zone of the VTS module totaling all the others.
+const vtsAllZones = "*"
+
+// The following types hold the fields used by the receiver of the JSON page of
+// the VTS module, see https://github.com/vozlt/nginx-module-vts#json.
+
+type vtsStatus struct {
+	Connections   vtsConnections               `json:"connections"`
+	ServerZones   map[string]vtsZone           `json:"serverZones"`
+	UpstreamZones map[string][]vtsUpstreamPeer `json:"upstreamZones"`
+}
+
+type vtsConnections struct {
+	Active   int64 `json:"active"`
+	Reading  int64 `json:"reading"`
+	Writing  int64 `json:"writing"`
+	Waiting  int64 `json:"waiting"`
+	Accepted int64 `json:"accepted"`
+	Handled  int64 `json:"handled"`
+	Requests int64 `json:"requests"`
+}
+
+type vtsZone struct {
+	RequestCounter int64           `json:"requestCounter"`
+	InBytes        int64           `json:"inBytes"`
+	OutBytes       int64           `json:"outBytes"`
+	Responses      responseClasses `json:"responses"`
+}
+
+type vtsUpstreamPeer struct {
+	Server         string          `json:"server"`
+	RequestCounter int64           `json:"requestCounter"`
+	InBytes        int64           `json:"inBytes"`
+	OutBytes       int64           `json:"outBytes"`
+	Responses      responseClasses `json:"responses"`
+	ResponseMsec   float64         `json:"responseMsec"`
+	Down           bool            `json:"down"`
+}
+
+// vtsStatus fetches the stats from the JSON page of the VTS module served at the configured endpoint.
+func (r *nginxScraper) vtsStatus(ctx context.Context) (*nginxStatus, error) {
+	var vts vtsStatus
+	if err := r.getJSON(ctx, "", &vts); err != nil {
+		return nil, err
+	}
+
+	status := &nginxStatus{
+		requests: vts.Connections.Requests,
+		accepted: vts.Connections.Accepted,
+		handled:  vts.Connections.Handled,
+		connections: []connectionState{
+			{metadata.AttributeState.Active, vts.Connections.Active},
+			{metadata.AttributeState.Reading, vts.Connections.Reading},
+			{metadata.AttributeState.Writing, vts.Connections.Writing},
+			{metadata.AttributeState.Waiting, vts.Connections.Waiting},
+		},
+	}
+
+	for _, name := range sortedKeys(vts.ServerZones) {
+		if name == vtsAllZones {
+			continue
+		}
+		zone := vts.ServerZones[name]
+		status.serverZones = append(status.serverZones, serverZone{
+			name:      name,
+			requests:  zone.RequestCounter,
+			responses: zone.Responses,
+			received:  zone.InBytes,
+			sent:      zone.OutBytes,
+		})
+	}
+
+	for _, name := range sortedKeys(vts.UpstreamZones) {
+		for _, peer := range vts.UpstreamZones[name] {
+			status.upstreamPeers = append(status.upstreamPeers, upstreamPeer{
+				upstream:     name,
+				peer:         peer.Server,
+				requests:     peer.RequestCounter,
+				responses:    peer.Responses,
+				received:     peer.InBytes,
+				sent:         peer.OutBytes,
+				responseTime: peer.ResponseMsec,
+				up:           !peer.Down,
+			})
+		}
+	}
+	return status, nil
+}
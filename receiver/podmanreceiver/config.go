@@ -31,6 +31,10 @@ type Config struct {
 	APIVersion    string `mapstructure:"api_version"`
 	SSHKey        string `mapstructure:"ssh_key"`
 	SSHPassphrase string `mapstructure:"ssh_passphrase"`
+
+	// DiscoverRootlessSockets makes the receiver also collect from the rootless
+	// Podman services of all users, whose sockets are found under /run/user/*/podman.
+	DiscoverRootlessSockets bool `mapstructure:"discover_rootless_sockets"`
 }
 
 func (config Config) Validate() error {
@@ -50,4 +50,5 @@ func TestLoadConfig(t *testing.T) {
 	assert.Equal(t, "podman_stats/all", ascfg.ID().String())
 	assert.Equal(t, "http://example.com/", ascfg.Endpoint)
 	assert.Equal(t, 2*time.Second, ascfg.CollectionInterval)
+	assert.True(t, ascfg.DiscoverRootlessSockets)
 }
@@ -0,0 +1,246 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+// +build !windows
+
+package podmanreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/podmanreceiver"
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/model/pdata"
+	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.uber.org/zap"
+)
+
+const transport = "http"
+
+// eventsRetryInterval is the time waited before watching the events of a
+// Podman service again after the stream ended with an error.
+var eventsRetryInterval = 3 * time.Second
+
+var _ component.LogsReceiver = (*eventsReceiver)(nil)
+
+// eventsReceiver reports the events of the Podman containers and pods as logs.
+type eventsReceiver struct {
+	config        *Config
+	set           component.ReceiverCreateSettings
+	clientFactory clientFactory
+	nextConsumer  consumer.Logs
+	obsrecv       *obsreport.Receiver
+
+	clients *podmanClients
+	// loops holds the cancel functions of the event loops by endpoint.
+	loops map[string]context.CancelFunc
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newLogsReceiver(
+	_ context.Context,
+	set component.ReceiverCreateSettings,
+	config *Config,
+	nextConsumer consumer.Logs,
+	clientFactory clientFactory,
+) (component.LogsReceiver, error) {
+	err := config.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if clientFactory == nil {
+		clientFactory = newPodmanClient
+	}
+
+	return &eventsReceiver{
+		config:        config,
+		set:           set,
+		clientFactory: clientFactory,
+		nextConsumer:  nextConsumer,
+		obsrecv: obsreport.NewReceiver(obsreport.ReceiverSettings{
+			ReceiverID:             config.ID(),
+			Transport:              transport,
+			LongLivedCtx:           true,
+			ReceiverCreateSettings: set,
+		}),
+	}, nil
+}
+
+func (r *eventsReceiver) Start(_ context.Context, _ component.Host) error {
+	r.clients = newPodmanClients(r.set.Logger, r.config, r.clientFactory)
+	added, _, err := r.clients.update()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.loops = map[string]context.CancelFunc{}
+
+	r.startEventLoops(ctx, added, time.Now())
+	if r.config.DiscoverRootlessSockets {
+		r.wg.Add(1)
+		go r.discoverLoop(ctx)
+	}
+	return nil
+}
+
+func (r *eventsReceiver) startEventLoops(ctx context.Context, endpoints []string, since time.Time) {
+	for _, endpoint := range endpoints {
+		loopCtx, cancel := context.WithCancel(ctx)
+		r.loops[endpoint] = cancel
+		r.wg.Add(1)
+		go r.eventLoop(loopCtx, r.clients.clients[endpoint], since)
+	}
+}
+
+// discoverLoop watches the events of the rootless sockets found at each
+// collection interval, and stops watching the ones that disappeared.
+func (r *eventsReceiver) discoverLoop(ctx context.Context) {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.config.CollectionInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// The endpoints that cannot be reached are logged by update.
+			added, removed, _ := r.clients.update()
+			for _, endpoint := range removed {
+				r.loops[endpoint]()
+				delete(r.loops, endpoint)
+			}
+			r.startEventLoops(ctx, added, time.Now())
+		}
+	}
+}
+
+func (r *eventsReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+		r.wg.Wait()
+	}
+	return nil
+}
+
+func (r *eventsReceiver) eventLoop(ctx context.Context, c client, since time.Time) {
+	defer r.wg.Done()
+
+EVENT_LOOP:
+	for {
+		eventCh, errCh := c.events(ctx, since)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case e := <-eventCh:
+				ts := time.Unix(0, e.TimeNano)
+				// Events replayed after reconnecting have already been reported.
+				if !ts.After(since) {
+					continue
+				}
+				r.consume(ctx, eventToLogs(e))
+				since = ts
+			case err := <-errCh:
+				if ctx.Err() != nil {
+					return
+				}
+				r.set.Logger.Error("error watching podman events", zap.Error(err))
+				select {
+				case <-time.After(eventsRetryInterval):
+					continue EVENT_LOOP
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}
+}
+
+func (r *eventsReceiver) consume(ctx context.Context, logs pdata.Logs) {
+	obsCtx := r.obsrecv.StartLogsOp(ctx)
+	err := r.nextConsumer.ConsumeLogs(obsCtx, logs)
+	if err != nil {
+		r.set.Logger.Error("error consuming podman events", zap.Error(err))
+	}
+	r.obsrecv.EndLogsOp(obsCtx, typeStr, 1, err)
+}
+
+func eventToLogs(e event) pdata.Logs {
+	logs := pdata.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	resourceAttr := rl.Resource().Attributes()
+
+	attributes := e.Actor.Attributes
+	switch e.Type {
+	case "container":
+		resourceAttr.InsertString(conventions.AttributeContainerID, e.Actor.ID)
+		resourceAttr.InsertString(conventions.AttributeContainerName, attributes["name"])
+		resourceAttr.InsertString(conventions.AttributeContainerImageName, attributes["image"])
+		if podID := attributes["podId"]; podID != "" {
+			resourceAttr.InsertString("podman.pod.id", podID)
+		}
+	case "pod":
+		resourceAttr.InsertString("podman.pod.id", e.Actor.ID)
+		resourceAttr.InsertString("podman.pod.name", attributes["name"])
+	}
+
+	record := rl.InstrumentationLibraryLogs().AppendEmpty().Logs().AppendEmpty()
+	record.SetTimestamp(pdata.NewTimestampFromTime(time.Unix(0, e.TimeNano)))
+	record.SetSeverityNumber(pdata.SeverityNumberINFO)
+	record.Body().SetStringVal(e.Action)
+
+	// Some actions carry a status, e.g. "health_status: unhealthy".
+	action, status := e.Action, ""
+	if i := strings.Index(action, ":"); i >= 0 {
+		action, status = action[:i], strings.TrimSpace(action[i+1:])
+	}
+
+	attrs := record.Attributes()
+	attrs.InsertString("podman.event.type", e.Type)
+	attrs.InsertString("podman.event.action", action)
+
+	switch action {
+	case "oom":
+		record.SetSeverityNumber(pdata.SeverityNumberERROR)
+	case "died":
+		if exitCode, err := strconv.ParseInt(attributes["containerExitCode"], 10, 64); err == nil {
+			attrs.InsertInt("podman.event.exit_code", exitCode)
+			if exitCode != 0 {
+				record.SetSeverityNumber(pdata.SeverityNumberWARN)
+			}
+		}
+	case "health_status":
+		if status == "" {
+			status = attributes["health_status"]
+		}
+		attrs.InsertString("podman.event.health_status", status)
+		if status == "unhealthy" {
+			record.SetSeverityNumber(pdata.SeverityNumberWARN)
+		}
+	}
+	return logs
+}
@@ -0,0 +1,181 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+// +build !windows
+
+package podmanreceiver
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+func TestEventsReceiver(t *testing.T) {
+	origRetryInterval := eventsRetryInterval
+	eventsRetryInterval = 10 * time.Millisecond
+	defer func() { eventsRetryInterval = origRetryInterval }()
+
+	fake := newFakePodman(t, filepath.Join(t.TempDir(), "podman.sock"))
+
+	cfg := createDefaultConfig()
+	cfg.Endpoint = fake.endpoint
+	sink := new(consumertest.LogsSink)
+	r, err := newLogsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), cfg, sink, nil)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, r.Shutdown(context.Background())) }()
+
+	now := time.Now()
+	start := event{
+		Type:   "container",
+		Action: "start",
+		Actor: eventActor{ID: "c1", Attributes: map[string]string{
+			"name":  "cntrA",
+			"image": "docker.io/library/alpine:latest",
+			"podId": "p1",
+		}},
+		TimeNano: now.Add(time.Second).UnixNano(),
+	}
+	died := event{
+		Type:   "container",
+		Action: "died",
+		Actor: eventActor{ID: "c1", Attributes: map[string]string{
+			"name":              "cntrA",
+			"image":             "docker.io/library/alpine:latest",
+			"containerExitCode": "137",
+		}},
+		TimeNano: now.Add(2 * time.Second).UnixNano(),
+	}
+	podRemove := event{
+		Type:     "pod",
+		Action:   "remove",
+		Actor:    eventActor{ID: "p1", Attributes: map[string]string{"name": "pod1"}},
+		TimeNano: now.Add(3 * time.Second).UnixNano(),
+	}
+
+	// The first stream ends after two events. The events already reported
+	// are skipped when they are sent again after reconnecting.
+	fake.eventBatches <- []event{start, died}
+	fake.eventBatches <- []event{died, podRemove}
+
+	assert.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	<-fake.eventsSince
+	assert.Equal(t, time.Unix(0, died.TimeNano).Format(time.RFC3339Nano), <-fake.eventsSince)
+
+	logs := sink.AllLogs()
+	require.Len(t, logs, 3)
+
+	rl := logs[0].ResourceLogs().At(0)
+	assert.Equal(t, map[string]interface{}{
+		"container.id":         "c1",
+		"container.name":       "cntrA",
+		"container.image.name": "docker.io/library/alpine:latest",
+		"podman.pod.id":        "p1",
+	}, rl.Resource().Attributes().AsRaw())
+	record := rl.InstrumentationLibraryLogs().At(0).Logs().At(0)
+	assert.Equal(t, "start", record.Body().StringVal())
+	assert.Equal(t, pdata.SeverityNumberINFO, record.SeverityNumber())
+	assert.Equal(t, pdata.NewTimestampFromTime(time.Unix(0, start.TimeNano)), record.Timestamp())
+	assert.Equal(t, map[string]interface{}{
+		"podman.event.type":   "container",
+		"podman.event.action": "start",
+	}, record.Attributes().AsRaw())
+
+	record = logs[1].ResourceLogs().At(0).InstrumentationLibraryLogs().At(0).Logs().At(0)
+	assert.Equal(t, pdata.SeverityNumberWARN, record.SeverityNumber())
+	assert.Equal(t, map[string]interface{}{
+		"podman.event.type":      "container",
+		"podman.event.action":    "died",
+		"podman.event.exit_code": int64(137),
+	}, record.Attributes().AsRaw())
+
+	rl = logs[2].ResourceLogs().At(0)
+	assert.Equal(t, map[string]interface{}{
+		"podman.pod.id":   "p1",
+		"podman.pod.name": "pod1",
+	}, rl.Resource().Attributes().AsRaw())
+	record = rl.InstrumentationLibraryLogs().At(0).Logs().At(0)
+	assert.Equal(t, "remove", record.Body().StringVal())
+}
+
+func TestEventsReceiverRootlessSocketDiscovery(t *testing.T) {
+	dir := t.TempDir()
+	origGlob := rootlessSocketsGlob
+	rootlessSocketsGlob = filepath.Join(dir, "run", "user", "*", "podman", "podman.sock")
+	defer func() { rootlessSocketsGlob = origGlob }()
+
+	fake1000 := newFakePodman(t, filepath.Join(dir, "run", "user", "1000", "podman", "podman.sock"))
+
+	cfg := createDefaultConfig()
+	cfg.Endpoint = fake1000.endpoint
+	cfg.CollectionInterval = 10 * time.Millisecond
+	cfg.DiscoverRootlessSockets = true
+	sink := new(consumertest.LogsSink)
+	r, err := newLogsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), cfg, sink, nil)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, r.Shutdown(context.Background())) }()
+	<-fake1000.eventsSince
+
+	// The events of a rootless service started after the receiver are reported.
+	fake1001 := newFakePodman(t, filepath.Join(dir, "run", "user", "1001", "podman", "podman.sock"))
+	<-fake1001.eventsSince
+	fake1001.eventBatches <- []event{{
+		Type:     "container",
+		Action:   "start",
+		Actor:    eventActor{ID: "c2", Attributes: map[string]string{"name": "cntrB"}},
+		TimeNano: time.Now().Add(time.Second).UnixNano(),
+	}}
+
+	assert.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	v, ok := sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().Get("container.id")
+	assert.True(t, ok)
+	assert.Equal(t, "c2", v.StringVal())
+}
+
+func TestEventToLogsHealthStatus(t *testing.T) {
+	for _, e := range []event{
+		{Type: "container", Action: "health_status: unhealthy"},
+		{Type: "container", Action: "health_status", Actor: eventActor{Attributes: map[string]string{"health_status": "unhealthy"}}},
+	} {
+		record := eventToLogs(e).ResourceLogs().At(0).InstrumentationLibraryLogs().At(0).Logs().At(0)
+		assert.Equal(t, pdata.SeverityNumberWARN, record.SeverityNumber())
+		v, ok := record.Attributes().Get("podman.event.health_status")
+		assert.True(t, ok)
+		assert.Equal(t, "unhealthy", v.StringVal())
+	}
+}
+
+func TestEventsReceiverStartError(t *testing.T) {
+	cfg := createDefaultConfig()
+	cfg.Endpoint = "unix://" + filepath.Join(t.TempDir(), "missing.sock")
+	r, err := newLogsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), cfg, consumertest.NewNop(), nil)
+	require.NoError(t, err)
+	assert.Error(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, r.Shutdown(context.Background()))
+}
@@ -34,7 +34,8 @@ func NewFactory() component.ReceiverFactory {
 	return receiverhelper.NewFactory(
 		typeStr,
 		createDefaultReceiverConfig,
-		receiverhelper.WithMetrics(createMetricsReceiver))
+		receiverhelper.WithMetrics(createMetricsReceiver),
+		receiverhelper.WithLogs(createLogsReceiver))
 }
 
 func createDefaultConfig() *Config {
@@ -66,3 +67,13 @@ func createMetricsReceiver(
 
 	return dsr, nil
 }
+
+func createLogsReceiver(
+	ctx context.Context,
+	params component.ReceiverCreateSettings,
+	config config.Receiver,
+	consumer consumer.Logs,
+) (component.LogsReceiver, error) {
+	podmanConfig := config.(*Config)
+	return newLogsReceiver(ctx, params, podmanConfig, consumer, nil)
+}
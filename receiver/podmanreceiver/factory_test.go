@@ -46,6 +46,10 @@ func TestCreateReceiver(t *testing.T) {
 	metricReceiver, err := factory.CreateMetricsReceiver(context.Background(), params, config, consumertest.NewNop())
 	assert.NoError(t, err, "Metric receiver creation failed")
 	assert.NotNil(t, metricReceiver, "Receiver creation failed")
+
+	logsReceiver, err := factory.CreateLogsReceiver(context.Background(), params, config, consumertest.NewNop())
+	assert.NoError(t, err, "Logs receiver creation failed")
+	assert.NotNil(t, logsReceiver, "Receiver creation failed")
 }
 
 func TestCreateInvalidEndpoint(t *testing.T) {
@@ -19,6 +19,7 @@ package podmanreceiver // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	"go.opentelemetry.io/collector/model/pdata"
@@ -30,12 +31,21 @@ type point struct {
 	attributes map[string]string
 }
 
-func translateStatsToMetrics(stats *containerStats, ts time.Time, rm pdata.ResourceMetrics) {
+// translateStatsToMetrics converts the stats of a container. The container list
+// entry, when known, provides the image and the pod of the container.
+func translateStatsToMetrics(stats *containerStats, container *container, ts time.Time, rm pdata.ResourceMetrics) {
 	pbts := pdata.NewTimestampFromTime(ts)
 
 	resource := rm.Resource()
 	resource.Attributes().InsertString("container.name", stats.Name)
 	resource.Attributes().InsertString("container.id", stats.ContainerID)
+	if container != nil {
+		resource.Attributes().InsertString("container.image.name", container.Image)
+		if container.Pod != "" {
+			resource.Attributes().InsertString("podman.pod.id", container.Pod)
+			resource.Attributes().InsertString("podman.pod.name", container.PodName)
+		}
+	}
 
 	ms := rm.InstrumentationLibraryMetrics().AppendEmpty().Metrics()
 	appendIOMetrics(ms, stats, pbts)
@@ -51,8 +61,40 @@ func appendMemoryMetrics(ms pdata.MetricSlice, stats *containerStats, ts pdata.T
 }
 
 func appendNetworkMetrics(ms pdata.MetricSlice, stats *containerStats, ts pdata.Timestamp) {
-	sum(ms, "network.io.usage.tx_bytes", "By", []point{{intVal: stats.NetInput}}, ts)
-	sum(ms, "network.io.usage.rx_bytes", "By", []point{{intVal: stats.NetOutput}}, ts)
+	// Versions of Podman older than 4.0 only report the totals of all interfaces.
+	if len(stats.Network) == 0 {
+		sum(ms, "network.io.usage.rx_bytes", "By", []point{{intVal: stats.NetInput}}, ts)
+		sum(ms, "network.io.usage.tx_bytes", "By", []point{{intVal: stats.NetOutput}}, ts)
+		return
+	}
+
+	interfaces := make([]string, 0, len(stats.Network))
+	for iface := range stats.Network {
+		interfaces = append(interfaces, iface)
+	}
+	sort.Strings(interfaces)
+
+	networkPoints := func(value func(containerNetworkStats) uint64) []point {
+		points := make([]point, len(interfaces))
+		for i, iface := range interfaces {
+			points[i] = point{
+				intVal: value(stats.Network[iface]),
+				attributes: map[string]string{
+					"interface": iface,
+				},
+			}
+		}
+		return points
+	}
+
+	sum(ms, "network.io.usage.rx_bytes", "By", networkPoints(func(s containerNetworkStats) uint64 { return s.RxBytes }), ts)
+	sum(ms, "network.io.usage.tx_bytes", "By", networkPoints(func(s containerNetworkStats) uint64 { return s.TxBytes }), ts)
+	sum(ms, "network.io.usage.rx_packets", "{packets}", networkPoints(func(s containerNetworkStats) uint64 { return s.RxPackets }), ts)
+	sum(ms, "network.io.usage.tx_packets", "{packets}", networkPoints(func(s containerNetworkStats) uint64 { return s.TxPackets }), ts)
+	sum(ms, "network.io.usage.rx_dropped", "{packets}", networkPoints(func(s containerNetworkStats) uint64 { return s.RxDropped }), ts)
+	sum(ms, "network.io.usage.tx_dropped", "{packets}", networkPoints(func(s containerNetworkStats) uint64 { return s.TxDropped }), ts)
+	sum(ms, "network.io.usage.rx_errors", "{errors}", networkPoints(func(s containerNetworkStats) uint64 { return s.RxErrors }), ts)
+	sum(ms, "network.io.usage.tx_errors", "{errors}", networkPoints(func(s containerNetworkStats) uint64 { return s.TxErrors }), ts)
 }
 
 func appendIOMetrics(ms pdata.MetricSlice, stats *containerStats, ts pdata.Timestamp) {
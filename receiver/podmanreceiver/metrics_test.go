@@ -30,7 +30,7 @@ func TestTranslateStatsToMetrics(t *testing.T) {
 	ts := time.Now()
 	stats := genContainerStats()
 	md := pdata.NewMetrics()
-	translateStatsToMetrics(stats, ts, md.ResourceMetrics().AppendEmpty())
+	translateStatsToMetrics(stats, nil, ts, md.ResourceMetrics().AppendEmpty())
 	assertStatsEqualToMetrics(t, stats, md)
 }
 
@@ -62,9 +62,9 @@ func assertStatsEqualToMetrics(t *testing.T, podmanStats *containerStats, md pda
 			assertMetricEqual(t, m, pdata.MetricDataTypeGauge, []point{{intVal: podmanStats.MemUsage}})
 		case "container.memory.percent":
 			assertMetricEqual(t, m, pdata.MetricDataTypeGauge, []point{{doubleVal: podmanStats.MemPerc}})
-		case "container.network.io.usage.tx_bytes":
-			assertMetricEqual(t, m, pdata.MetricDataTypeSum, []point{{intVal: podmanStats.NetInput}})
 		case "container.network.io.usage.rx_bytes":
+			assertMetricEqual(t, m, pdata.MetricDataTypeSum, []point{{intVal: podmanStats.NetInput}})
+		case "container.network.io.usage.tx_bytes":
 			assertMetricEqual(t, m, pdata.MetricDataTypeSum, []point{{intVal: podmanStats.NetOutput}})
 
 		case "container.blockio.io_service_bytes_recursive.write":
@@ -91,6 +91,42 @@ func assertStatsEqualToMetrics(t *testing.T, podmanStats *containerStats, md pda
 	}
 }
 
+func TestTranslateNetworkStatsToMetrics(t *testing.T) {
+	stats := genContainerStats()
+	stats.Network = map[string]containerNetworkStats{
+		"eth1": {RxBytes: 10, TxBytes: 20, RxPackets: 1, TxPackets: 2, RxDropped: 3, TxDropped: 4, RxErrors: 5, TxErrors: 6},
+		"eth0": {RxBytes: 30, TxBytes: 40, RxPackets: 7, TxPackets: 8},
+	}
+	md := pdata.NewMetrics()
+	translateStatsToMetrics(stats, nil, time.Now(), md.ResourceMetrics().AppendEmpty())
+
+	expected := map[string][]uint64{
+		"container.network.io.usage.rx_bytes":   {30, 10},
+		"container.network.io.usage.tx_bytes":   {40, 20},
+		"container.network.io.usage.rx_packets": {7, 1},
+		"container.network.io.usage.tx_packets": {8, 2},
+		"container.network.io.usage.rx_dropped": {0, 3},
+		"container.network.io.usage.tx_dropped": {0, 4},
+		"container.network.io.usage.rx_errors":  {0, 5},
+		"container.network.io.usage.tx_errors":  {0, 6},
+	}
+	metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+	assert.Equal(t, 17, metrics.Len())
+	for i := 0; i < metrics.Len(); i++ {
+		m := metrics.At(i)
+		values, ok := expected[m.Name()]
+		if !ok {
+			continue
+		}
+		assertMetricEqual(t, m, pdata.MetricDataTypeSum, []point{
+			{intVal: values[0], attributes: map[string]string{"interface": "eth0"}},
+			{intVal: values[1], attributes: map[string]string{"interface": "eth1"}},
+		})
+		delete(expected, m.Name())
+	}
+	assert.Empty(t, expected)
+}
+
 func assertMetricEqual(t *testing.T, m pdata.Metric, dt pdata.MetricDataType, pts []point) {
 	assert.Equal(t, m.DataType(), dt)
 	switch dt {
@@ -50,6 +50,20 @@ type containerStats struct {
 	PIDs          uint64
 	UpTime        time.Duration
 	Duration      uint64
+	// Network holds the stats of each network interface of the container.
+	// It is only reported by Podman 4.0+.
+	Network map[string]containerNetworkStats
+}
+
+type containerNetworkStats struct {
+	RxBytes   uint64
+	RxDropped uint64
+	RxErrors  uint64
+	RxPackets uint64
+	TxBytes   uint64
+	TxDropped uint64
+	TxErrors  uint64
+	TxPackets uint64
 }
 
 type containerStatsReport struct {
@@ -57,10 +71,34 @@ type containerStatsReport struct {
 	Stats []containerStats
 }
 
-type clientFactory func(logger *zap.Logger, cfg *Config) (client, error)
+// container is the subset of the libpod container list entries used by the receiver.
+type container struct {
+	ID      string `json:"Id"`
+	Names   []string
+	Image   string
+	Pod     string
+	PodName string
+}
+
+// event is a Podman event as reported by the libpod events endpoint.
+type event struct {
+	Type     string
+	Action   string
+	Actor    eventActor
+	TimeNano int64 `json:"timeNano"`
+}
+
+type eventActor struct {
+	ID         string
+	Attributes map[string]string
+}
+
+type clientFactory func(logger *zap.Logger, cfg *Config, endpoint string) (client, error)
 
 type client interface {
-	stats() ([]containerStats, error)
+	stats(ctx context.Context) ([]containerStats, error)
+	list(ctx context.Context) ([]container, error)
+	events(ctx context.Context, since time.Time) (<-chan event, <-chan error)
 }
 
 type podmanClient struct {
@@ -68,8 +106,8 @@ type podmanClient struct {
 	endpoint string
 }
 
-func newPodmanClient(logger *zap.Logger, cfg *Config) (client, error) {
-	connection, err := newPodmanConnection(logger, cfg.Endpoint, cfg.SSHKey, cfg.SSHPassphrase)
+func newPodmanClient(logger *zap.Logger, cfg *Config, endpoint string) (client, error) {
+	connection, err := newPodmanConnection(logger, endpoint, cfg.SSHKey, cfg.SSHPassphrase)
 	if err != nil {
 		return nil, err
 	}
@@ -96,11 +134,11 @@ func (c *podmanClient) request(ctx context.Context, path string, params url.Valu
 	return c.conn.Do(req)
 }
 
-func (c *podmanClient) stats() ([]containerStats, error) {
+func (c *podmanClient) stats(ctx context.Context) ([]containerStats, error) {
 	params := url.Values{}
 	params.Add("stream", "false")
 
-	resp, err := c.request(context.Background(), "/containers/stats", params)
+	resp, err := c.request(ctx, "/containers/stats", params)
 	if err != nil {
 		return nil, err
 	}
@@ -122,11 +160,72 @@ func (c *podmanClient) stats() ([]containerStats, error) {
 	return report.Stats, nil
 }
 
+func (c *podmanClient) list(ctx context.Context) ([]container, error) {
+	resp, err := c.request(ctx, "/containers/json", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("container list response was %d", resp.StatusCode)
+	}
+
+	var containers []container
+	if err := json.NewDecoder(resp.Body).Decode(&containers); err != nil {
+		return nil, err
+	}
+	return containers, nil
+}
+
+// events streams the container and pod events that occurred after since until
+// the context is canceled. Any error ends the stream and is sent on the error channel.
+func (c *podmanClient) events(ctx context.Context, since time.Time) (<-chan event, <-chan error) {
+	eventCh := make(chan event)
+	errCh := make(chan error, 1)
+
+	params := url.Values{}
+	params.Add("stream", "true")
+	params.Add("since", since.Format(time.RFC3339Nano))
+	params.Add("filters", `{"type":["container","pod"]}`)
+
+	go func() {
+		resp, err := c.request(ctx, "/events", params)
+		if err != nil {
+			errCh <- err
+			return
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			errCh <- fmt.Errorf("events response was %d", resp.StatusCode)
+			return
+		}
+
+		decoder := json.NewDecoder(resp.Body)
+		for {
+			var e event
+			if err := decoder.Decode(&e); err != nil {
+				errCh <- err
+				return
+			}
+			select {
+			case eventCh <- e:
+			case <-ctx.Done():
+				errCh <- ctx.Err()
+				return
+			}
+		}
+	}()
+	return eventCh, errCh
+}
+
 func (c *podmanClient) ping() error {
 	resp, err := c.request(context.Background(), "/_ping", nil)
 	if err != nil {
 		return err
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		return fmt.Errorf("ping response was %d", resp.StatusCode)
@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+// +build !windows
+
+package podmanreceiver
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// fakePodman serves the subset of the libpod API used by the receiver over a unix socket.
+type fakePodman struct {
+	endpoint   string
+	stats      []containerStats
+	containers []container
+
+	// Each request to the events endpoint streams the next batch of events
+	// and then ends the stream. The stream is kept open once no batch is left.
+	eventBatches chan []event
+	// eventsSince receives the since parameter of the events requests.
+	eventsSince chan string
+}
+
+func newFakePodman(t *testing.T, socketPath string) *fakePodman {
+	require.NoError(t, os.MkdirAll(filepath.Dir(socketPath), 0700))
+	l, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+
+	f := &fakePodman{
+		endpoint:     "unix://" + socketPath,
+		eventBatches: make(chan []event, 10),
+		eventsSince:  make(chan string, 10),
+	}
+
+	mux := http.NewServeMux()
+	prefix := "/v" + defaultAPIVersion + "/libpod"
+	mux.HandleFunc(prefix+"/_ping", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("OK"))
+	})
+	mux.HandleFunc(prefix+"/containers/stats", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "false", r.URL.Query().Get("stream"))
+		_ = json.NewEncoder(w).Encode(containerStatsReport{Stats: f.stats})
+	})
+	mux.HandleFunc(prefix+"/containers/json", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(f.containers)
+	})
+	mux.HandleFunc(prefix+"/events", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("stream"))
+		assert.Equal(t, `{"type":["container","pod"]}`, r.URL.Query().Get("filters"))
+		f.eventsSince <- r.URL.Query().Get("since")
+
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		select {
+		case batch := <-f.eventBatches:
+			for _, e := range batch {
+				_ = json.NewEncoder(w).Encode(e)
+			}
+		case <-r.Context().Done():
+		}
+	})
+
+	srv := &http.Server{Handler: mux}
+	go func() {
+		_ = srv.Serve(l)
+	}()
+	t.Cleanup(func() {
+		_ = srv.Close()
+	})
+	return f
+}
+
+func TestPodmanClient(t *testing.T) {
+	fake := newFakePodman(t, filepath.Join(t.TempDir(), "podman.sock"))
+	fake.stats = []containerStats{{ContainerID: "c1", Name: "cntrA", CPUNano: 42}}
+	fake.containers = []container{{ID: "c1", Names: []string{"cntrA"}, Image: "alpine", Pod: "p1", PodName: "pod1"}}
+
+	cfg := createDefaultConfig()
+	c, err := newPodmanClient(zap.NewNop(), cfg, fake.endpoint)
+	require.NoError(t, err)
+
+	stats, err := c.stats(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, fake.stats, stats)
+
+	containers, err := c.list(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, fake.containers, containers)
+
+	e := event{
+		Type:     "container",
+		Action:   "start",
+		Actor:    eventActor{ID: "c1", Attributes: map[string]string{"name": "cntrA"}},
+		TimeNano: time.Now().UnixNano(),
+	}
+	fake.eventBatches <- []event{e}
+
+	since := time.Unix(1639000000, 5)
+	eventCh, errCh := c.events(context.Background(), since)
+	assert.Equal(t, since.Format(time.RFC3339Nano), <-fake.eventsSince)
+	assert.Equal(t, e, <-eventCh)
+	// The stream ends once the batch has been sent.
+	assert.Error(t, <-errCh)
+}
+
+func TestPodmanClientPingError(t *testing.T) {
+	_, err := newPodmanClient(zap.NewNop(), createDefaultConfig(), "unix://"+filepath.Join(t.TempDir(), "missing.sock"))
+	assert.Error(t, err)
+}
@@ -39,6 +39,28 @@ import (
 	"golang.org/x/crypto/ssh/knownhosts"
 )
 
+// rootlessSocketsGlob matches the API sockets of the rootless Podman services of all users.
+var rootlessSocketsGlob = "/run/user/*/podman/podman.sock"
+
+// podmanEndpoints returns the configured endpoint followed, if enabled, by the
+// endpoints of the rootless Podman sockets found on the host.
+func podmanEndpoints(cfg *Config) []string {
+	endpoints := []string{cfg.Endpoint}
+	if !cfg.DiscoverRootlessSockets {
+		return endpoints
+	}
+
+	// Glob only fails on malformed patterns.
+	sockets, _ := filepath.Glob(rootlessSocketsGlob)
+	for _, socket := range sockets {
+		endpoint := "unix://" + socket
+		if endpoint != cfg.Endpoint {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	return endpoints
+}
+
 // most of this file has been adopted from https://github.com/containers/podman/blob/main/pkg/bindings/connection.go
 // and then simplified to remove things we do not need.
 
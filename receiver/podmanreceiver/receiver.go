@@ -24,7 +24,9 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 )
 
@@ -32,7 +34,7 @@ type receiver struct {
 	config        *Config
 	set           component.ReceiverCreateSettings
 	clientFactory clientFactory
-	client        client
+	clients       *podmanClients
 }
 
 func newReceiver(
@@ -65,25 +67,134 @@ func newReceiver(
 }
 
 func (r *receiver) start(context.Context, component.Host) error {
-	c, err := r.clientFactory(r.set.Logger, r.config)
-	if err == nil {
-		r.client = c
-	}
+	r.clients = newPodmanClients(r.set.Logger, r.config, r.clientFactory)
+	_, _, err := r.clients.update()
 	return err
 }
 
-func (r *receiver) scrape(context.Context) (pdata.Metrics, error) {
-	var err error
+// podmanClients holds the clients of the configured Podman endpoint and of the
+// discovered rootless sockets.
+type podmanClients struct {
+	logger  *zap.Logger
+	config  *Config
+	factory clientFactory
 
-	stats, err := r.client.stats()
-	if err != nil {
-		r.set.Logger.Error("error fetching stats", zap.Error(err))
-		return pdata.Metrics{}, err
+	// endpoints lists the connected endpoints in the order of podmanEndpoints.
+	endpoints []string
+	clients   map[string]client
+	// unreachable holds the endpoints whose connection error was already logged.
+	unreachable map[string]bool
+}
+
+func newPodmanClients(logger *zap.Logger, config *Config, factory clientFactory) *podmanClients {
+	return &podmanClients{
+		logger:      logger,
+		config:      config,
+		factory:     factory,
+		clients:     map[string]client{},
+		unreachable: map[string]bool{},
+	}
+}
+
+// update connects to the endpoints not connected yet and forgets the rootless
+// sockets that disappeared, as rootless Podman services come and go with the
+// sessions of their users. It returns the endpoints added and removed. Endpoints
+// that cannot be reached are skipped until the next update, and an error is only
+// returned when none of them can be.
+func (pc *podmanClients) update() (added, removed []string, err error) {
+	endpoints := podmanEndpoints(pc.config)
+	listed := make(map[string]bool, len(endpoints))
+	var connected []string
+	var errs error
+	for _, endpoint := range endpoints {
+		listed[endpoint] = true
+		if _, ok := pc.clients[endpoint]; !ok {
+			c, err := pc.factory(pc.logger, pc.config, endpoint)
+			if err != nil {
+				errs = multierr.Append(errs, err)
+				if !pc.unreachable[endpoint] {
+					pc.unreachable[endpoint] = true
+					pc.logger.Warn("error connecting to podman", zap.String("endpoint", endpoint), zap.Error(err))
+				}
+				continue
+			}
+			delete(pc.unreachable, endpoint)
+			pc.clients[endpoint] = c
+			added = append(added, endpoint)
+		}
+		connected = append(connected, endpoint)
+	}
+
+	for endpoint := range pc.clients {
+		if !listed[endpoint] {
+			delete(pc.clients, endpoint)
+			removed = append(removed, endpoint)
+		}
+	}
+	for endpoint := range pc.unreachable {
+		if !listed[endpoint] {
+			delete(pc.unreachable, endpoint)
+		}
 	}
 
+	pc.endpoints = connected
+	if len(connected) == 0 {
+		return added, removed, errs
+	}
+	return added, removed, nil
+}
+
+// list returns the connected clients.
+func (pc *podmanClients) list() []client {
+	clients := make([]client, 0, len(pc.endpoints))
+	for _, endpoint := range pc.endpoints {
+		clients = append(clients, pc.clients[endpoint])
+	}
+	return clients
+}
+
+func (r *receiver) scrape(ctx context.Context) (pdata.Metrics, error) {
+	if r.config.DiscoverRootlessSockets {
+		if _, _, err := r.clients.update(); err != nil {
+			return pdata.Metrics{}, err
+		}
+	}
+
+	var errs error
+	scraped := false
+
+	now := time.Now()
 	md := pdata.NewMetrics()
-	for i := range stats {
-		translateStatsToMetrics(&stats[i], time.Now(), md.ResourceMetrics().AppendEmpty())
+	for _, c := range r.clients.list() {
+		stats, err := c.stats(ctx)
+		if err != nil {
+			r.set.Logger.Error("error fetching stats", zap.Error(err))
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		scraped = true
+
+		// The container list only provides the image and the pod of the
+		// containers, the stats are still reported if it cannot be fetched.
+		containers := map[string]*container{}
+		list, err := c.list(ctx)
+		if err != nil {
+			r.set.Logger.Warn("error listing containers", zap.Error(err))
+		}
+		for i := range list {
+			containers[list[i].ID] = &list[i]
+		}
+
+		for i := range stats {
+			translateStatsToMetrics(&stats[i], containers[stats[i].ContainerID], now, md.ResourceMetrics().AppendEmpty())
+		}
+	}
+
+	if errs != nil {
+		if !scraped {
+			return pdata.Metrics{}, errs
+		}
+		return md, scrapererror.NewPartialScrapeError(errs, 0)
 	}
 	return md, nil
 }
@@ -20,6 +20,9 @@ package podmanreceiver
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -87,13 +90,97 @@ func TestScraperLoop(t *testing.T) {
 	r.Shutdown(context.Background())
 }
 
+func TestScrapePods(t *testing.T) {
+	fake := newFakePodman(t, filepath.Join(t.TempDir(), "podman.sock"))
+	fake.stats = []containerStats{
+		{ContainerID: "c1", Name: "cntrA"},
+		{ContainerID: "c2", Name: "cntrB"},
+	}
+	fake.containers = []container{
+		{ID: "c1", Image: "docker.io/library/alpine:latest", Pod: "p1", PodName: "pod1"},
+		{ID: "c2", Image: "docker.io/library/nginx:latest"},
+	}
+
+	cfg := createDefaultConfig()
+	cfg.Endpoint = fake.endpoint
+	r := &receiver{config: cfg, set: componenttest.NewNopReceiverCreateSettings(), clientFactory: newPodmanClient}
+	require.NoError(t, r.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := r.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, md.ResourceMetrics().Len())
+
+	assert.Equal(t, map[string]interface{}{
+		"container.id":         "c1",
+		"container.name":       "cntrA",
+		"container.image.name": "docker.io/library/alpine:latest",
+		"podman.pod.id":        "p1",
+		"podman.pod.name":      "pod1",
+	}, md.ResourceMetrics().At(0).Resource().Attributes().AsRaw())
+	assert.Equal(t, map[string]interface{}{
+		"container.id":         "c2",
+		"container.name":       "cntrB",
+		"container.image.name": "docker.io/library/nginx:latest",
+	}, md.ResourceMetrics().At(1).Resource().Attributes().AsRaw())
+}
+
+func TestRootlessSocketDiscovery(t *testing.T) {
+	dir := t.TempDir()
+	origGlob := rootlessSocketsGlob
+	rootlessSocketsGlob = filepath.Join(dir, "run", "user", "*", "podman", "podman.sock")
+	defer func() { rootlessSocketsGlob = origGlob }()
+
+	fake1000 := newFakePodman(t, filepath.Join(dir, "run", "user", "1000", "podman", "podman.sock"))
+	fake1000.stats = []containerStats{{ContainerID: "c1", Name: "cntrA"}}
+	fake1001 := newFakePodman(t, filepath.Join(dir, "run", "user", "1001", "podman", "podman.sock"))
+	fake1001.stats = []containerStats{{ContainerID: "c2", Name: "cntrB"}}
+
+	cfg := createDefaultConfig()
+	cfg.Endpoint = "unix://" + filepath.Join(dir, "run", "podman", "podman.sock")
+	assert.Equal(t, []string{cfg.Endpoint}, podmanEndpoints(cfg))
+
+	r := &receiver{config: cfg, set: componenttest.NewNopReceiverCreateSettings(), clientFactory: newPodmanClient}
+	assert.Error(t, r.start(context.Background(), componenttest.NewNopHost()))
+
+	cfg.DiscoverRootlessSockets = true
+	assert.Equal(t, []string{cfg.Endpoint, fake1000.endpoint, fake1001.endpoint}, podmanEndpoints(cfg))
+
+	// The root socket does not exist, the rootless ones are still scraped.
+	require.NoError(t, r.start(context.Background(), componenttest.NewNopHost()))
+	md, err := r.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, md.ResourceMetrics().Len())
+	for i, id := range []string{"c1", "c2"} {
+		v, ok := md.ResourceMetrics().At(i).Resource().Attributes().Get("container.id")
+		assert.True(t, ok)
+		assert.Equal(t, id, v.StringVal())
+	}
+
+	// Rootless sockets are discovered again on each scrape.
+	fake1002 := newFakePodman(t, filepath.Join(dir, "run", "user", "1002", "podman", "podman.sock"))
+	fake1002.stats = []containerStats{{ContainerID: "c3", Name: "cntrC"}}
+	require.NoError(t, os.Remove(strings.TrimPrefix(fake1001.endpoint, "unix://")))
+	md, err = r.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, md.ResourceMetrics().Len())
+	for i, id := range []string{"c1", "c3"} {
+		v, ok := md.ResourceMetrics().At(i).Resource().Attributes().Get("container.id")
+		assert.True(t, ok)
+		assert.Equal(t, id, v.StringVal())
+	}
+
+	// A rootless socket listed as the endpoint is not scraped twice.
+	cfg.Endpoint = fake1000.endpoint
+	assert.Equal(t, []string{fake1000.endpoint, fake1002.endpoint}, podmanEndpoints(cfg))
+}
+
 type mockClient chan containerStatsReport
 
-func (c mockClient) factory(logger *zap.Logger, cfg *Config) (client, error) {
+func (c mockClient) factory(logger *zap.Logger, cfg *Config, endpoint string) (client, error) {
 	return c, nil
 }
 
-func (c mockClient) stats() ([]containerStats, error) {
+func (c mockClient) stats(context.Context) ([]containerStats, error) {
 	report := <-c
 	if report.Error != "" {
 		return nil, errors.New(report.Error)
@@ -101,6 +188,14 @@ func (c mockClient) stats() ([]containerStats, error) {
 	return report.Stats, nil
 }
 
+func (c mockClient) list(context.Context) ([]container, error) {
+	return nil, nil
+}
+
+func (c mockClient) events(context.Context, time.Time) (<-chan event, <-chan error) {
+	return nil, nil
+}
+
 type mockConsumer chan pdata.Metrics
 
 func (m mockConsumer) Capabilities() consumer.Capabilities {
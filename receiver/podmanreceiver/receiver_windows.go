@@ -31,3 +31,13 @@ func newReceiver(
 ) (component.MetricsReceiver, error) {
 	return nil, fmt.Errorf("podman receiver is not supported on windows")
 }
+
+func newLogsReceiver(
+	_ context.Context,
+	settings component.ReceiverCreateSettings,
+	config *Config,
+	nextConsumer consumer.Logs,
+	clientFactory interface{},
+) (component.LogsReceiver, error) {
+	return nil, fmt.Errorf("podman receiver is not supported on windows")
+}
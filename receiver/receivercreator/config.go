@@ -52,6 +52,11 @@ type receiverTemplate struct {
 	// based on receiverTemplate.
 	Rule string `mapstructure:"rule"`
 	rule rule
+
+	// ConfigFromMetadata enables taking additional config of the receiver from the
+	// io.opentelemetry.discovery.metrics/config pod annotation or container label
+	// of the matched endpoint. It takes precedence over the config of the template.
+	ConfigFromMetadata bool `mapstructure:"config_from_metadata"`
 }
 
 // resourceAttributes holds a map of default resource attributes for each Endpoint type.
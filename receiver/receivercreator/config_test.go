@@ -87,6 +87,8 @@ func TestLoadConfig(t *testing.T) {
 	assert.Equal(t, `type == "port"`, r1.receiverTemplates["examplereceiver/1"].Rule)
 	assert.Contains(t, r1.receiverTemplates, "nop/1")
 	assert.Equal(t, `type == "port"`, r1.receiverTemplates["nop/1"].Rule)
+	assert.True(t, r1.receiverTemplates["nop/1"].ConfigFromMetadata)
+	assert.False(t, r1.receiverTemplates["examplereceiver/1"].ConfigFromMetadata)
 	assert.Equal(t, userConfigMap{
 		endpointConfigKey: "localhost:12345",
 	}, r1.receiverTemplates["nop/1"].config)
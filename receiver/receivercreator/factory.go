@@ -62,6 +62,10 @@ func createDefaultConfig() config.Receiver {
 				conventions.AttributeK8SNodeName: "`name`",
 				conventions.AttributeK8SNodeUID:  "`uid`",
 			},
+			observer.ProcessType: map[string]string{
+				conventions.AttributeProcessExecutableName: "`name`",
+				conventions.AttributeProcessExecutablePath: "`executable`",
+			},
 		},
 		receiverTemplates: map[string]receiverTemplate{},
 	}
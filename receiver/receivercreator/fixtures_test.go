@@ -59,6 +59,19 @@ var hostportEndpoint = observer.Endpoint{
 	},
 }
 
+var processEndpoint = observer.Endpoint{
+	ID:     "process-1",
+	Target: "localhost",
+	Details: &observer.Process{
+		PID:        1234,
+		Name:       "java",
+		Executable: "/usr/bin/java",
+		Command:    "/usr/bin/java -jar app.jar",
+		Username:   "app",
+		Cgroup:     "/system.slice/app.service",
+	},
+}
+
 var container = observer.Container{
 	Name:          "otel-agent",
 	Image:         "otelcol",
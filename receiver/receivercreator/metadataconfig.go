@@ -0,0 +1,80 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package receivercreator // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/config"
+	"gopkg.in/yaml.v2"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+// metadataConfigKey is the pod annotation or container label holding receiver
+// config in YAML for templates with config_from_metadata enabled.
+const metadataConfigKey = "io.opentelemetry.discovery.metrics/config"
+
+// endpointMetadata returns the user-specified metadata of the endpoint that
+// may hold receiver config: pod annotations for k8s endpoints and labels for
+// container endpoints.
+func endpointMetadata(e observer.Endpoint) map[string]string {
+	switch details := e.Details.(type) {
+	case *observer.Pod:
+		return details.Annotations
+	case *observer.Port:
+		return details.Pod.Annotations
+	case *observer.K8sNode:
+		return details.Annotations
+	case *observer.Container:
+		return details.Labels
+	}
+	return nil
+}
+
+// configFromMetadata parses the receiver config found in the endpoint metadata.
+// It returns a nil map if the endpoint has no such config.
+func configFromMetadata(e observer.Endpoint) (userConfigMap, error) {
+	raw, ok := endpointMetadata(e)[metadataConfigKey]
+	if !ok {
+		return nil, nil
+	}
+
+	var data map[string]interface{}
+	if err := yaml.Unmarshal([]byte(raw), &data); err != nil {
+		return nil, fmt.Errorf("unable to parse %q: %v", metadataConfigKey, err)
+	}
+	// Normalizes nested YAML maps to string keyed maps.
+	return config.NewMapFromStringMap(data).ToStringMap(), nil
+}
+
+// mergeMetadataConfig returns the template config merged with the config found
+// in the metadata of the endpoint, if any.
+func mergeMetadataConfig(templateConfig userConfigMap, e observer.Endpoint) (userConfigMap, error) {
+	metadataConfig, err := configFromMetadata(e)
+	if err != nil || metadataConfig == nil {
+		return templateConfig, err
+	}
+	return mergeConfigs(templateConfig, metadataConfig)
+}
+
+// mergeConfigs returns a copy of base with the values of override merged in.
+func mergeConfigs(base, override userConfigMap) (userConfigMap, error) {
+	merged := config.NewMapFromStringMap(base)
+	if err := merged.Merge(config.NewMapFromStringMap(override)); err != nil {
+		return nil, err
+	}
+	return merged.ToStringMap(), nil
+}
@@ -89,7 +89,15 @@ func (obs *observerHandler) OnAdd(added []observer.Endpoint) {
 				zap.String("endpoint", e.Target),
 				zap.String("endpoint_id", string(e.ID)))
 
-			resolvedConfig, err := expandMap(template.config, env)
+			templateConfig := template.config
+			if template.ConfigFromMetadata {
+				if templateConfig, err = mergeMetadataConfig(template.config, e); err != nil {
+					obs.logger.Error("unable to load config from endpoint metadata", zap.String("receiver", template.id.String()), zap.String("endpoint_id", string(e.ID)), zap.Error(err))
+					continue
+				}
+			}
+
+			resolvedConfig, err := expandMap(templateConfig, env)
 			if err != nil {
 				obs.logger.Error("unable to resolve template config", zap.String("receiver", template.id.String()), zap.Error(err))
 				continue
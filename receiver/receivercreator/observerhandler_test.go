@@ -52,7 +52,7 @@ func TestOnAdd(t *testing.T) {
 	rcvrCfg := receiverConfig{id: config.NewComponentIDWithName("name", "1"), config: userConfigMap{"foo": "bar"}}
 	cfg := createDefaultConfig().(*Config)
 	cfg.receiverTemplates = map[string]receiverTemplate{
-		"name/1": {rcvrCfg, "", newRuleOrPanic(`type == "port"`)},
+		"name/1": {receiverConfig: rcvrCfg, rule: newRuleOrPanic(`type == "port"`)},
 	}
 	handler := &observerHandler{
 		config:                cfg,
@@ -104,7 +104,7 @@ func TestOnChange(t *testing.T) {
 	newRcvr := &nopWithEndpointReceiver{}
 	cfg := createDefaultConfig().(*Config)
 	cfg.receiverTemplates = map[string]receiverTemplate{
-		"name/1": {rcvrCfg, "", newRuleOrPanic(`type == "port"`)},
+		"name/1": {receiverConfig: rcvrCfg, rule: newRuleOrPanic(`type == "port"`)},
 	}
 	handler := &observerHandler{
 		config:                cfg,
@@ -161,3 +161,102 @@ func TestDynamicConfig(t *testing.T) {
 
 	runner.AssertExpectations(t)
 }
+
+func TestConfigFromMetadata(t *testing.T) {
+	annotatedPod := pod
+	annotatedPod.Annotations = map[string]string{
+		metadataConfigKey: "endpoint: '`endpoint`:6380'\nnested:\n  key: value\n",
+	}
+	annotatedEndpoint := observer.Endpoint{ID: "pod-2", Target: "localhost", Details: &annotatedPod}
+
+	tests := []struct {
+		name               string
+		configFromMetadata bool
+		endpoint           observer.Endpoint
+		expectedConfig     userConfigMap
+		expectedDiscovered userConfigMap
+	}{
+		{
+			name:               "enabled",
+			configFromMetadata: true,
+			endpoint:           annotatedEndpoint,
+			expectedConfig: userConfigMap{
+				"foo":             "bar",
+				endpointConfigKey: "localhost:6380",
+				"nested":          map[string]interface{}{"key": "value"},
+			},
+			expectedDiscovered: userConfigMap{},
+		},
+		{
+			name:               "disabled",
+			configFromMetadata: false,
+			endpoint:           annotatedEndpoint,
+			expectedConfig:     userConfigMap{"foo": "bar"},
+			expectedDiscovered: userConfigMap{endpointConfigKey: "localhost"},
+		},
+		{
+			name:               "no metadata config",
+			configFromMetadata: true,
+			endpoint:           podEndpoint,
+			expectedConfig:     userConfigMap{"foo": "bar"},
+			expectedDiscovered: userConfigMap{endpointConfigKey: "localhost"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := &mockRunner{}
+			id := config.NewComponentIDWithName("name", "1")
+			cfg := createDefaultConfig().(*Config)
+			cfg.receiverTemplates = map[string]receiverTemplate{
+				"name/1": {
+					receiverConfig:     receiverConfig{id: id, config: userConfigMap{"foo": "bar"}},
+					rule:               newRuleOrPanic(`type == "pod"`),
+					ConfigFromMetadata: tt.configFromMetadata,
+				},
+			}
+			handler := &observerHandler{
+				config:                cfg,
+				logger:                zap.NewNop(),
+				receiversByEndpointID: receiverMap{},
+				runner:                runner,
+			}
+			runner.On(
+				"start",
+				receiverConfig{id: id, config: tt.expectedConfig},
+				tt.expectedDiscovered,
+				mock.IsType(&resourceEnhancer{}),
+			).Return(&nopWithEndpointReceiver{}, nil)
+
+			handler.OnAdd([]observer.Endpoint{tt.endpoint})
+
+			runner.AssertExpectations(t)
+			assert.Equal(t, 1, handler.receiversByEndpointID.Size())
+		})
+	}
+}
+
+func TestConfigFromMetadataInvalid(t *testing.T) {
+	runner := &mockRunner{}
+	cfg := createDefaultConfig().(*Config)
+	cfg.receiverTemplates = map[string]receiverTemplate{
+		"name/1": {
+			receiverConfig:     receiverConfig{id: config.NewComponentIDWithName("name", "1")},
+			rule:               newRuleOrPanic(`type == "container"`),
+			ConfigFromMetadata: true,
+		},
+	}
+	handler := &observerHandler{
+		config:                cfg,
+		logger:                zap.NewNop(),
+		receiversByEndpointID: receiverMap{},
+		runner:                runner,
+	}
+
+	invalidContainer := container
+	invalidContainer.Labels = map[string]string{metadataConfigKey: "not: [valid"}
+	handler.OnAdd([]observer.Endpoint{{ID: "container-2", Target: "localhost:1234", Details: &invalidContainer}})
+
+	runner.AssertNotCalled(t, "start", mock.Anything, mock.Anything, mock.Anything)
+	assert.Equal(t, 0, handler.receiversByEndpointID.Size())
+}
@@ -46,6 +46,7 @@ func Test_ruleEval(t *testing.T) {
 		// {"unknown variable", args{`type == "port" && unknown_var == 1`, portEndpoint}, false, true},
 		{"basic port", args{`type == "port" && name == "http" && pod.labels["app"] == "redis"`, portEndpoint}, true, false},
 		{"basic hostport", args{`type == "hostport" && port == 1234 && process_name == "splunk"`, hostportEndpoint}, true, false},
+		{"basic process", args{`type == "process" && executable matches "/java$" && command contains "app.jar"`, processEndpoint}, true, false},
 		{"basic pod", args{`type == "pod" && labels["region"] == "west-1"`, podEndpoint}, true, false},
 		{"annotations", args{`type == "pod" && annotations["scrape"] == "true"`, podEndpoint}, true, false},
 		{"basic container", args{`type == "container" && labels["region"] == "east-1"`, containerEndpoint}, true, false},
@@ -89,6 +90,7 @@ func Test_newRule(t *testing.T) {
 		{"valid pod", args{`type=="pod" && port_name == "http"`}, false},
 		{"valid hostport", args{`type == "hostport" && port_name == "http"`}, false},
 		{"valid container", args{`type == "container" && port == 8080`}, false},
+		{"valid process", args{`type == "process" && name == "java"`}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -96,6 +96,10 @@ func (run *receiverRunner) loadRuntimeReceiverConfig(
 	if err != nil {
 		return nil, fmt.Errorf("failed to load template config: %v", err)
 	}
+	// Config may come from endpoint metadata so make sure it is valid before starting the receiver.
+	if err = receiverConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid receiver config: %v", err)
+	}
 	// Sets dynamically created receiver to something like receiver_creator/1/redis{endpoint="localhost:6380"}.
 	// TODO: Need to make sure this is unique (just endpoint is probably not totally sufficient).
 	receiverConfig.SetIDName(fmt.Sprintf("%s/%s{endpoint=%q}", receiver.id.Name(), run.idNamespace, cast.ToString(mergedConfig.Get(endpointConfigKey))))
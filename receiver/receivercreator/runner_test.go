@@ -15,6 +15,7 @@
 package receivercreator
 
 import (
+	"net"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -47,3 +48,49 @@ func Test_loadAndCreateRuntimeReceiver(t *testing.T) {
 		assert.IsType(t, &nopWithEndpointReceiver{}, recvr)
 	})
 }
+
+func Test_loadRuntimeReceiverConfigInvalid(t *testing.T) {
+	run := &receiverRunner{params: componenttest.NewNopReceiverCreateSettings(), idNamespace: config.NewComponentIDWithName(typeStr, "1")}
+	template, err := newReceiverTemplate("nop/1", userConfigMap{"unknown": "value"})
+	require.NoError(t, err)
+
+	_, err = run.loadRuntimeReceiverConfig(&nopWithEndpointFactory{}, template.receiverConfig, userConfigMap{
+		endpointConfigKey: "localhost:12345",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown")
+}
+
+func Test_loadRuntimeReceiverConfigValidationError(t *testing.T) {
+	run := &receiverRunner{params: componenttest.NewNopReceiverCreateSettings(), idNamespace: config.NewComponentIDWithName(typeStr, "1")}
+	template, err := newReceiverTemplate("nop/1", nil)
+	require.NoError(t, err)
+
+	// The config decodes cleanly but the endpoint taken from the endpoint metadata has no port.
+	_, err = run.loadRuntimeReceiverConfig(&nopWithValidationFactory{}, template.receiverConfig, userConfigMap{
+		endpointConfigKey: "localhost",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid receiver config")
+}
+
+type nopWithValidationConfig struct {
+	nopWithEndpointConfig `mapstructure:",squash"`
+}
+
+func (c *nopWithValidationConfig) Validate() error {
+	_, _, err := net.SplitHostPort(c.Endpoint)
+	return err
+}
+
+type nopWithValidationFactory struct {
+	nopWithEndpointFactory
+}
+
+func (*nopWithValidationFactory) CreateDefaultConfig() config.Receiver {
+	return &nopWithValidationConfig{
+		nopWithEndpointConfig: nopWithEndpointConfig{
+			ReceiverSettings: config.NewReceiverSettings(config.NewComponentID("nop")),
+		},
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package splunkhecreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkhecreceiver"
+
+import (
+	"strconv"
+	"sync"
+	"time"
+)
+
+// maxAcksPerChannel bounds the number of ack IDs kept for a channel whose
+// client does not query them. The oldest ones are forgotten first.
+const maxAcksPerChannel = 10000
+
+// ackChannels tracks the ack IDs returned to the clients on each channel
+// until they are queried.
+type ackChannels struct {
+	mu          sync.Mutex
+	channels    map[string]*ackChannel
+	idleTimeout time.Duration
+
+	// for mocking
+	now func() time.Time
+}
+
+type ackChannel struct {
+	nextID   uint64
+	acked    map[uint64]struct{}
+	lastUsed time.Time
+}
+
+func newAckChannels(idleTimeout time.Duration) *ackChannels {
+	return &ackChannels{
+		channels:    map[string]*ackChannel{},
+		idleTimeout: idleTimeout,
+		now:         time.Now,
+	}
+}
+
+// ack returns a new ack ID for the channel, acknowledged right away: the
+// events are acknowledged once accepted by the next consumer.
+func (a *ackChannels) ack(channel string) uint64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	ch, ok := a.channels[channel]
+	if !ok {
+		ch = &ackChannel{acked: map[uint64]struct{}{}}
+		a.channels[channel] = ch
+	}
+	ch.lastUsed = a.now()
+
+	id := ch.nextID
+	ch.nextID++
+	ch.acked[id] = struct{}{}
+	if id >= maxAcksPerChannel {
+		delete(ch.acked, id-maxAcksPerChannel)
+	}
+	return id
+}
+
+// query returns whether each of the ack IDs of the channel was acknowledged.
+// Acknowledged IDs are only reported once, as Splunk does.
+func (a *ackChannels) query(channel string, ids []uint64) map[string]bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	statuses := make(map[string]bool, len(ids))
+	ch, ok := a.channels[channel]
+	if ok {
+		ch.lastUsed = a.now()
+	}
+	for _, id := range ids {
+		acked := false
+		if ok {
+			if _, acked = ch.acked[id]; acked {
+				delete(ch.acked, id)
+			}
+		}
+		statuses[strconv.FormatUint(id, 10)] = acked
+	}
+	return statuses
+}
+
+// expire forgets the channels that were not used for the idle timeout.
+func (a *ackChannels) expire() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	deadline := a.now().Add(-a.idleTimeout)
+	for name, ch := range a.channels {
+		if ch.lastUsed.Before(deadline) {
+			delete(a.channels, name)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package splunkhecreceiver
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
+)
+
+func TestAckChannels(t *testing.T) {
+	acks := newAckChannels(time.Minute)
+
+	assert.Equal(t, uint64(0), acks.ack("foo"))
+	assert.Equal(t, uint64(1), acks.ack("foo"))
+	assert.Equal(t, uint64(0), acks.ack("bar"))
+
+	assert.Equal(t, map[string]bool{"0": true, "1": true, "2": false}, acks.query("foo", []uint64{0, 1, 2}))
+	// Acknowledged IDs are only reported once.
+	assert.Equal(t, map[string]bool{"0": false, "1": false}, acks.query("foo", []uint64{0, 1}))
+	assert.Equal(t, map[string]bool{"0": true}, acks.query("bar", []uint64{0}))
+	assert.Equal(t, map[string]bool{"0": false}, acks.query("unknown", []uint64{0}))
+}
+
+func TestAckChannels_MaxAcks(t *testing.T) {
+	acks := newAckChannels(time.Minute)
+	for i := 0; i <= maxAcksPerChannel; i++ {
+		acks.ack("foo")
+	}
+
+	assert.Equal(t, map[string]bool{"0": false, "1": true, "10000": true}, acks.query("foo", []uint64{0, 1, maxAcksPerChannel}))
+}
+
+func TestAckChannels_Expire(t *testing.T) {
+	now := time.Now()
+	acks := newAckChannels(time.Minute)
+	acks.now = func() time.Time { return now }
+
+	acks.ack("foo")
+	now = now.Add(30 * time.Second)
+	acks.ack("bar")
+	now = now.Add(45 * time.Second)
+	acks.expire()
+
+	assert.Equal(t, map[string]bool{"0": false}, acks.query("foo", []uint64{0}))
+	assert.Equal(t, map[string]bool{"0": true}, acks.query("bar", []uint64{0}))
+}
+
+func Test_splunkhecReceiver_Ack(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	config.Endpoint = "localhost:0" // Actually not creating the endpoint
+	config.Ack.Enabled = true
+
+	sink := new(consumertest.LogsSink)
+	rcv, err := newLogsReceiver(componenttest.NewNopReceiverCreateSettings(), *config, sink)
+	require.NoError(t, err)
+	r := rcv.(*splunkReceiver)
+	r.acks = newAckChannels(config.Ack.ChannelIdleTimeout)
+
+	msgBytes, err := json.Marshal(buildSplunkHecMsg(float64(time.Now().UnixNano())/1e6, 3))
+	require.NoError(t, err)
+
+	t.Run("missing_channel", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.handleReq(w, httptest.NewRequest("POST", "http://localhost/services/collector", bytes.NewReader(msgBytes)))
+
+		resp := w.Result()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		var hecResp splunk.HECResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&hecResp))
+		assert.Equal(t, splunk.HECResponse{Text: responseErrDataChannelMissing, Code: splunk.HECResponseCodeDataChannelMissing}, hecResp)
+		assert.Equal(t, 0, sink.LogRecordCount())
+	})
+
+	sendEvents := func(t *testing.T, req *http.Request) uint64 {
+		w := httptest.NewRecorder()
+		r.handleReq(w, req)
+
+		resp := w.Result()
+		assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+		var hecResp splunk.HECResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&hecResp))
+		assert.Equal(t, responseSuccess, hecResp.Text)
+		assert.Equal(t, splunk.HECResponseCodeSuccess, hecResp.Code)
+		require.NotNil(t, hecResp.AckID)
+		return *hecResp.AckID
+	}
+
+	req := httptest.NewRequest("POST", "http://localhost/services/collector", bytes.NewReader(msgBytes))
+	req.Header.Set(splunk.HECChannelHeader, "00000000-0000-0000-0000-000000000001")
+	assert.Equal(t, uint64(0), sendEvents(t, req))
+	req = httptest.NewRequest("POST", "http://localhost/services/collector?channel=00000000-0000-0000-0000-000000000001", bytes.NewReader(msgBytes))
+	assert.Equal(t, uint64(1), sendEvents(t, req))
+	assert.Equal(t, 2, sink.LogRecordCount())
+
+	queryAcks := func(t *testing.T, req *http.Request) (int, []byte) {
+		w := httptest.NewRecorder()
+		r.handleAckReq(w, req)
+		resp := w.Result()
+		body := new(bytes.Buffer)
+		_, err := body.ReadFrom(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, body.Bytes()
+	}
+
+	status, body := queryAcks(t, httptest.NewRequest("POST", "http://localhost/services/collector/ack", bytes.NewReader([]byte(`{"acks":[0]}`))))
+	assert.Equal(t, http.StatusBadRequest, status)
+	assert.Equal(t, errDataChannelMissingBody, body)
+
+	req = httptest.NewRequest("POST", "http://localhost/services/collector/ack", bytes.NewReader([]byte(`{"acks":`)))
+	req.Header.Set(splunk.HECChannelHeader, "00000000-0000-0000-0000-000000000001")
+	status, body = queryAcks(t, req)
+	assert.Equal(t, http.StatusBadRequest, status)
+	assert.Equal(t, errUnmarshalBodyRespBody, body)
+
+	req = httptest.NewRequest("POST", "http://localhost/services/collector/ack", bytes.NewReader([]byte(`{"acks":[0,1,2]}`)))
+	req.Header.Set(splunk.HECChannelHeader, "00000000-0000-0000-0000-000000000001")
+	status, body = queryAcks(t, req)
+	assert.Equal(t, http.StatusOK, status)
+	var ackResp splunk.AckResponse
+	require.NoError(t, json.Unmarshal(body, &ackResp))
+	assert.Equal(t, map[string]bool{"0": true, "1": true, "2": false}, ackResp.Acks)
+}
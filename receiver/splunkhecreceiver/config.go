@@ -15,6 +15,9 @@
 package splunkhecreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkhecreceiver"
 
 import (
+	"errors"
+	"time"
+
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/confighttp"
 
@@ -33,4 +36,27 @@ type Config struct {
 	RawPath string `mapstructure:"raw_path"`
 	// HecToOtelAttrs creates a mapping from HEC metadata to attributes.
 	HecToOtelAttrs splunk.HecToOtelAttrs `mapstructure:"hec_metadata_to_otel_attrs"`
+	// Ack configures indexer acknowledgement.
+	Ack AckSettings `mapstructure:"ack"`
+}
+
+// AckSettings defines configuration for indexer acknowledgement:
+// https://docs.splunk.com/Documentation/Splunk/latest/Data/AboutHECIDXAck
+type AckSettings struct {
+	// Enabled requires the requests to be sent on a channel and returns an ack ID
+	// in the response to each request accepted by the next consumer.
+	Enabled bool `mapstructure:"enabled"`
+	// Path for querying the status of ack IDs, default is '/services/collector/ack'
+	Path string `mapstructure:"path"`
+	// ChannelIdleTimeout is the time after which the ack IDs of a channel that is
+	// not used anymore are forgotten. Default is 10 minutes.
+	ChannelIdleTimeout time.Duration `mapstructure:"channel_idle_timeout"`
+}
+
+// Validate checks the receiver configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Ack.Enabled && cfg.Ack.ChannelIdleTimeout <= 0 {
+		return errors.New(`requires "ack.channel_idle_timeout" > 0`)
+	}
+	return nil
 }
@@ -17,6 +17,7 @@ package splunkhecreceiver
 import (
 	"path"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -61,6 +62,11 @@ func TestLoadConfig(t *testing.T) {
 			Index:      "myindex",
 			Host:       "myhostfield",
 		},
+		Ack: AckSettings{
+			Enabled:            true,
+			Path:               "/bar",
+			ChannelIdleTimeout: 5 * time.Minute,
+		},
 	}
 	assert.Equal(t, expectedAllSettings, r1)
 
@@ -86,6 +92,27 @@ func TestLoadConfig(t *testing.T) {
 			Index:      "com.splunk.index",
 			Host:       "host.name",
 		},
+		Ack: AckSettings{
+			Path:               "/services/collector/ack",
+			ChannelIdleTimeout: 10 * time.Minute,
+		},
 	}
 	assert.Equal(t, expectedTLSConfig, r2)
 }
+
+func TestValidateConfig(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Ack.ChannelIdleTimeout = 0
+	assert.NoError(t, cfg.Validate(), "the timeout is unused when acks are disabled")
+
+	cfg.Ack.Enabled = true
+	assert.EqualError(t, cfg.Validate(), `requires "ack.channel_idle_timeout" > 0`)
+
+	cfg.Ack.ChannelIdleTimeout = -time.Second
+	assert.EqualError(t, cfg.Validate(), `requires "ack.channel_idle_timeout" > 0`)
+
+	cfg.Ack.ChannelIdleTimeout = time.Minute
+	assert.NoError(t, cfg.Validate())
+}
@@ -16,6 +16,7 @@ package splunkhecreceiver // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
@@ -36,6 +37,8 @@ const (
 
 	// Default endpoints to bind to.
 	defaultEndpoint = ":8088"
+
+	defaultAckChannelIdleTimeout = 10 * time.Minute
 )
 
 // NewFactory creates a factory for Splunk HEC receiver.
@@ -62,6 +65,10 @@ func createDefaultConfig() config.Receiver {
 			Host:       conventions.AttributeHostName,
 		},
 		RawPath: splunk.DefaultRawPath,
+		Ack: AckSettings{
+			Path:               splunk.DefaultAckPath,
+			ChannelIdleTimeout: defaultAckChannelIdleTimeout,
+		},
 	}
 }
 
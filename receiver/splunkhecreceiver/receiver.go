@@ -49,6 +49,8 @@ const (
 	responseErrInternalServerError    = "Internal Server Error"
 	responseErrUnsupportedMetricEvent = "Unsupported metric event"
 	responseErrUnsupportedLogEvent    = "Unsupported log event"
+	responseErrDataChannelMissing     = "Data channel is missing"
+	responseSuccess                   = "Success"
 
 	// Centralizing some HTTP and related string constants.
 	gzipEncoding              = "gzip"
@@ -61,6 +63,7 @@ var (
 	errEmptyEndpoint          = errors.New("empty endpoint")
 	errInvalidMethod          = errors.New("invalid http method")
 	errInvalidEncoding        = errors.New("invalid encoding")
+	errDataChannelMissing     = errors.New("data channel is missing")
 
 	okRespBody                = initJSONResponse(responseOK)
 	invalidMethodRespBody     = initJSONResponse(responseInvalidMethod)
@@ -70,6 +73,7 @@ var (
 	errInternalServerError    = initJSONResponse(responseErrInternalServerError)
 	errUnsupportedMetricEvent = initJSONResponse(responseErrUnsupportedMetricEvent)
 	errUnsupportedLogEvent    = initJSONResponse(responseErrUnsupportedLogEvent)
+	errDataChannelMissingBody = initHECResponse(responseErrDataChannelMissing, splunk.HECResponseCodeDataChannelMissing)
 )
 
 // splunkReceiver implements the component.MetricsReceiver for Splunk HEC metric protocol.
@@ -82,6 +86,9 @@ type splunkReceiver struct {
 	shutdownWG      sync.WaitGroup
 	obsrecv         *obsreport.Receiver
 	gzipReaderPool  *sync.Pool
+	// acks is only set when indexer acknowledgement is enabled.
+	acks     *ackChannels
+	stopAcks chan struct{}
 }
 
 var _ component.MetricsReceiver = (*splunkReceiver)(nil)
@@ -179,6 +186,10 @@ func (r *splunkReceiver) Start(_ context.Context, host component.Host) error {
 	}
 
 	mx := mux.NewRouter()
+	if r.config.Ack.Enabled {
+		r.acks = newAckChannels(r.config.Ack.ChannelIdleTimeout)
+		mx.NewRoute().Path(r.config.Ack.Path).HandlerFunc(r.handleAckReq)
+	}
 	if r.logsConsumer != nil {
 		mx.NewRoute().Path(r.config.RawPath).HandlerFunc(r.handleRawReq)
 	}
@@ -202,6 +213,12 @@ func (r *splunkReceiver) Start(_ context.Context, host component.Host) error {
 		}
 	}()
 
+	if r.acks != nil {
+		r.stopAcks = make(chan struct{})
+		r.shutdownWG.Add(1)
+		go r.expireAckChannels()
+	}
+
 	return err
 }
 
@@ -209,10 +226,104 @@ func (r *splunkReceiver) Start(_ context.Context, host component.Host) error {
 // giving it a chance to perform any necessary clean-up.
 func (r *splunkReceiver) Shutdown(context.Context) error {
 	err := r.server.Close()
+	if r.stopAcks != nil {
+		close(r.stopAcks)
+	}
 	r.shutdownWG.Wait()
 	return err
 }
 
+func (r *splunkReceiver) expireAckChannels() {
+	defer r.shutdownWG.Done()
+
+	ticker := time.NewTicker(r.config.Ack.ChannelIdleTimeout)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.acks.expire()
+		case <-r.stopAcks:
+			return
+		}
+	}
+}
+
+// handleAckReq reports the status of the ack IDs of a channel.
+func (r *splunkReceiver) handleAckReq(resp http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		r.writeResponse(resp, http.StatusBadRequest, invalidMethodRespBody)
+		return
+	}
+
+	channel := requestChannel(req)
+	if channel == "" {
+		r.writeResponse(resp, http.StatusBadRequest, errDataChannelMissingBody)
+		return
+	}
+
+	var ackReq splunk.AckRequest
+	if err := jsoniter.NewDecoder(req.Body).Decode(&ackReq); err != nil {
+		r.writeResponse(resp, http.StatusBadRequest, errUnmarshalBodyRespBody)
+		return
+	}
+
+	body, err := jsoniter.Marshal(splunk.AckResponse{Acks: r.acks.query(channel, ackReq.Acks)})
+	if err != nil {
+		r.writeResponse(resp, http.StatusInternalServerError, errInternalServerError)
+		return
+	}
+	r.writeResponse(resp, http.StatusOK, body)
+}
+
+// checkChannel fails the request if acks are enabled and the request is not
+// sent on a channel. It returns the channel and whether the request can be
+// processed.
+func (r *splunkReceiver) checkChannel(ctx context.Context, resp http.ResponseWriter, req *http.Request) (string, bool) {
+	if r.acks == nil {
+		return "", true
+	}
+
+	channel := requestChannel(req)
+	if channel == "" {
+		r.failRequest(ctx, resp, http.StatusBadRequest, errDataChannelMissingBody, 0, errDataChannelMissing)
+		return "", false
+	}
+	return channel, true
+}
+
+func requestChannel(req *http.Request) string {
+	if channel := req.Header.Get(splunk.HECChannelHeader); channel != "" {
+		return channel
+	}
+	return req.URL.Query().Get(splunk.HECChannelQueryParam)
+}
+
+// writeSuccess responds to a request whose events were accepted by the next
+// consumer, with a new ack ID if the request was sent on a channel.
+func (r *splunkReceiver) writeSuccess(resp http.ResponseWriter, channel string, body []byte) {
+	if channel != "" {
+		ackID := r.acks.ack(channel)
+		var err error
+		if body, err = jsoniter.Marshal(splunk.HECResponse{Text: responseSuccess, Code: splunk.HECResponseCodeSuccess, AckID: &ackID}); err != nil {
+			r.writeResponse(resp, http.StatusInternalServerError, errInternalServerError)
+			return
+		}
+	}
+	r.writeResponse(resp, http.StatusAccepted, body)
+}
+
+func (r *splunkReceiver) writeResponse(resp http.ResponseWriter, httpStatusCode int, body []byte) {
+	if len(body) > 0 {
+		resp.Header().Set("Content-Type", "application/json")
+	}
+	resp.WriteHeader(httpStatusCode)
+	if len(body) > 0 {
+		if _, err := resp.Write(body); err != nil {
+			r.settings.Logger.Warn("Error writing HTTP response message", zap.Error(err))
+		}
+	}
+}
+
 func (r *splunkReceiver) handleRawReq(resp http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
 	ctx = r.obsrecv.StartLogsOp(ctx)
@@ -222,6 +333,11 @@ func (r *splunkReceiver) handleRawReq(resp http.ResponseWriter, req *http.Reques
 		return
 	}
 
+	channel, ok := r.checkChannel(ctx, resp, req)
+	if !ok {
+		return
+	}
+
 	encoding := req.Header.Get(httpContentEncodingHeader)
 	if encoding != "" && encoding != gzipEncoding {
 		r.failRequest(ctx, resp, http.StatusUnsupportedMediaType, invalidEncodingRespBody, 0, errInvalidEncoding)
@@ -270,7 +386,7 @@ func (r *splunkReceiver) handleRawReq(resp http.ResponseWriter, req *http.Reques
 	if consumerErr != nil {
 		r.failRequest(ctx, resp, http.StatusInternalServerError, errInternalServerError, ill.Logs().Len(), consumerErr)
 	} else {
-		resp.WriteHeader(http.StatusAccepted)
+		r.writeSuccess(resp, channel, nil)
 		r.obsrecv.EndLogsOp(ctx, typeStr, ill.Logs().Len(), nil)
 	}
 }
@@ -288,6 +404,11 @@ func (r *splunkReceiver) handleReq(resp http.ResponseWriter, req *http.Request)
 		return
 	}
 
+	channel, ok := r.checkChannel(ctx, resp, req)
+	if !ok {
+		return
+	}
+
 	encoding := req.Header.Get(httpContentEncodingHeader)
 	if encoding != "" && encoding != gzipEncoding {
 		r.failRequest(ctx, resp, http.StatusUnsupportedMediaType, invalidEncodingRespBody, 0, errInvalidEncoding)
@@ -335,13 +456,13 @@ func (r *splunkReceiver) handleReq(resp http.ResponseWriter, req *http.Request)
 		events = append(events, &msg)
 	}
 	if r.logsConsumer != nil {
-		r.consumeLogs(ctx, events, resp, req)
+		r.consumeLogs(ctx, events, resp, req, channel)
 	} else {
-		r.consumeMetrics(ctx, events, resp, req)
+		r.consumeMetrics(ctx, events, resp, req, channel)
 	}
 }
 
-func (r *splunkReceiver) consumeMetrics(ctx context.Context, events []*splunk.Event, resp http.ResponseWriter, req *http.Request) {
+func (r *splunkReceiver) consumeMetrics(ctx context.Context, events []*splunk.Event, resp http.ResponseWriter, req *http.Request, channel string) {
 	resourceCustomizer := r.createResourceCustomizer(req)
 	md, _ := splunkHecToMetricsData(r.settings.Logger, events, resourceCustomizer, r.config)
 
@@ -351,12 +472,11 @@ func (r *splunkReceiver) consumeMetrics(ctx context.Context, events []*splunk.Ev
 	if decodeErr != nil {
 		r.failRequest(ctx, resp, http.StatusInternalServerError, errInternalServerError, len(events), decodeErr)
 	} else {
-		resp.WriteHeader(http.StatusAccepted)
-		resp.Write(okRespBody)
+		r.writeSuccess(resp, channel, okRespBody)
 	}
 }
 
-func (r *splunkReceiver) consumeLogs(ctx context.Context, events []*splunk.Event, resp http.ResponseWriter, req *http.Request) {
+func (r *splunkReceiver) consumeLogs(ctx context.Context, events []*splunk.Event, resp http.ResponseWriter, req *http.Request, channel string) {
 	resourceCustomizer := r.createResourceCustomizer(req)
 	ld, err := splunkHecToLogData(r.settings.Logger, events, resourceCustomizer, r.config)
 	if err != nil {
@@ -369,8 +489,7 @@ func (r *splunkReceiver) consumeLogs(ctx context.Context, events []*splunk.Event
 	if decodeErr != nil {
 		r.failRequest(ctx, resp, http.StatusInternalServerError, errInternalServerError, len(events), decodeErr)
 	} else {
-		resp.WriteHeader(http.StatusAccepted)
-		resp.Write(okRespBody)
+		r.writeSuccess(resp, channel, okRespBody)
 	}
 }
 
@@ -422,6 +541,15 @@ func (r *splunkReceiver) failRequest(
 	}
 }
 
+func initHECResponse(text string, code int) []byte {
+	respBody, err := jsoniter.Marshal(splunk.HECResponse{Text: text, Code: code})
+	if err != nil {
+		// This is to be used in initialization so panic here is fine.
+		panic(err)
+	}
+	return respBody
+}
+
 func initJSONResponse(s string) []byte {
 	respBody, err := jsoniter.Marshal(s)
 	if err != nil {
@@ -681,6 +681,65 @@ func Test_Logs_splunkhecReceiver_IndexSourceTypePassthrough(t *testing.T) {
 	}
 }
 
+func Test_splunkhecReceiver_ExporterAck(t *testing.T) {
+	tests := []struct {
+		name     string
+		consumer consumer.Logs
+		wantErr  bool
+	}{
+		{
+			name:     "acknowledged",
+			consumer: new(consumertest.LogsSink),
+		},
+		{
+			name:     "rejected",
+			consumer: consumertest.NewErr(errors.New("rejected")),
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr := testutil.GetAvailableLocalAddress(t)
+			cfg := createDefaultConfig().(*Config)
+			cfg.Endpoint = addr
+			cfg.Ack.Enabled = true
+			rcv, err := newLogsReceiver(componenttest.NewNopReceiverCreateSettings(), *cfg, tt.consumer)
+			require.NoError(t, err)
+			require.NoError(t, rcv.Start(context.Background(), componenttest.NewNopHost()))
+			defer rcv.Shutdown(context.Background())
+
+			factory := splunkhecexporter.NewFactory()
+			exporterConfig := factory.CreateDefaultConfig().(*splunkhecexporter.Config)
+			exporterConfig.Token = "ignored"
+			exporterConfig.Endpoint = fmt.Sprintf("http://%s/services/collector", addr)
+			exporterConfig.QueueSettings.Enabled = false
+			exporterConfig.RetrySettings.Enabled = false
+			exporterConfig.Ack.Enabled = true
+			exporterConfig.Ack.PollInterval = 10 * time.Millisecond
+			exporterConfig.Ack.Timeout = 5 * time.Second
+			exporter, err := factory.CreateLogsExporter(context.Background(), componenttest.NewNopExporterCreateSettings(), exporterConfig)
+			require.NoError(t, err)
+			require.NoError(t, exporter.Start(context.Background(), componenttest.NewNopHost()))
+			defer exporter.Shutdown(context.Background())
+
+			logs := pdata.NewLogs()
+			lr := logs.ResourceLogs().AppendEmpty().InstrumentationLibraryLogs().AppendEmpty().Logs().AppendEmpty()
+			lr.SetTimestamp(pdata.NewTimestampFromTime(time.Now()))
+			lr.Body().SetStringVal("foo")
+
+			// The exporter only returns once the events are acknowledged by the receiver.
+			err = exporter.ConsumeLogs(context.Background(), logs)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, 1, tt.consumer.(*consumertest.LogsSink).LogRecordCount())
+		})
+	}
+}
+
 func Test_Metrics_splunkhecReceiver_IndexSourceTypePassthrough(t *testing.T) {
 	tests := []struct {
 		name       string